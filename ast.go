@@ -3,7 +3,11 @@ package javascript // import "vimagination.zapto.org/javascript"
 import "vimagination.zapto.org/parser"
 
 // Script represents the top-level of a parsed JavaScript text
+//
+// Hashbang, when non-nil, is the Hashbang Comment at the start of the text.
 type Script struct {
+	DirectivePrologue
+	Hashbang      *Token
 	StatementList []StatementListItem
 	Comments      [2]Comments
 	Tokens        Tokens
@@ -26,9 +30,32 @@ func ParseScript(t Tokeniser) (*Script, error) {
 	return s, nil
 }
 
+// ParseScriptWithRecovery parses a JavaScript input into an AST, recovering
+// from errors by skipping to the end of the statement that failed to parse,
+// both at the top-level and within blocks and case clauses.
+//
+// Each StatementListItem that could not be parsed is replaced by a placeholder
+// StatementListItem, which only has its Tokens set. The returned Script is
+// never nil, and every error is returned, positioned at the token that caused
+// it and ordered by that position, with only the first error at each position
+// being kept.
+//
+// When the input cannot be tokenised, the invalid input is skipped to the end
+// of its line, or to the next semi-colon or closing bracket, and tokenising
+// resumes from there.
+func ParseScriptWithRecovery(t Tokeniser) (*Script, []Error) {
+	j := newRecoveringJSParser(t)
+	s := new(Script)
+
+	s.parse(&j)
+
+	return s, j.endRecovery()
+}
+
 // ScriptToModule converts a Script type to a Module type
 func ScriptToModule(s *Script) *Module {
 	m := &Module{
+		Hashbang:        s.Hashbang,
 		ModuleListItems: make([]ModuleItem, len(s.StatementList)),
 		Comments:        s.Comments,
 		Tokens:          s.Tokens,
@@ -45,6 +72,15 @@ func ScriptToModule(s *Script) *Module {
 }
 
 func (s *Script) parse(j *jsParser) error {
+	defer j.setStrict(j.IsStrict())
+	defer j.enterScope()()
+
+	if j.Accept(TokenHashbang) {
+		s.Hashbang = j.GetLastToken()
+
+		j.AcceptRunWhitespaceNoComment()
+	}
+
 	s.Comments[0] = j.AcceptRunWhitespaceNoNewlineComments()
 	g := j.NewGoal()
 
@@ -59,7 +95,15 @@ func (s *Script) parse(j *jsParser) error {
 		s.StatementList = append(s.StatementList, StatementListItem{})
 
 		if err := s.StatementList[si].parse(&g, false, false, false); err != nil {
-			return err
+			if g = j.NewGoal(); !g.recover("StatementListItem", err, false) {
+				return err
+			}
+
+			s.StatementList[si] = StatementListItem{Tokens: g.ToTokens()}
+		} else if len(s.Directives) == si {
+			if err := j.addDirective(&s.StatementList[si], &s.DirectivePrologue); err != nil {
+				return err
+			}
 		}
 
 		j.Score(g)
@@ -67,6 +111,11 @@ func (s *Script) parse(j *jsParser) error {
 		g = j.NewGoal()
 	}
 
+	if j.IsTypescript() {
+		inlineConstEnums(s.StatementList, *j.marker())
+	}
+
+	s.Strict = j.IsStrict()
 	s.Comments[1] = j.AcceptRunWhitespaceComments()
 	s.Tokens = j.ToTokens()
 
@@ -106,14 +155,23 @@ func (j *jsParser) parseSemicolon() bool {
 // Declaration as defined in ECMA-262
 // https://262.ecma-international.org/11.0/#prod-Declaration
 //
-// Only one of ClassDeclaration, FunctionDeclaration or LexicalDeclaration must
-// be non-nil
+// Only one of ClassDeclaration, FunctionDeclaration, LexicalDeclaration,
+// TypeAliasDeclaration, InterfaceDeclaration, or NamespaceDeclaration must be
+// non-nil.
+//
+// TypeAliasDeclaration, InterfaceDeclaration, and NamespaceDeclaration are only
+// produced when parsing with a tokeniser created by AsTypescriptAST, with a
+// NamespaceDeclaration only produced here for a namespace that contains no
+// values.
 type Declaration struct {
-	ClassDeclaration    *ClassDeclaration
-	FunctionDeclaration *FunctionDeclaration
-	LexicalDeclaration  *LexicalDeclaration
-	Comments            Comments
-	Tokens              Tokens
+	ClassDeclaration     *ClassDeclaration
+	FunctionDeclaration  *FunctionDeclaration
+	LexicalDeclaration   *LexicalDeclaration
+	TypeAliasDeclaration *TypeAliasDeclaration
+	InterfaceDeclaration *InterfaceDeclaration
+	NamespaceDeclaration *NamespaceDeclaration
+	Comments             Comments
+	Tokens               Tokens
 }
 
 func (d *Declaration) parse(j *jsParser, yield, await, export bool) error {
@@ -121,22 +179,29 @@ func (d *Declaration) parse(j *jsParser, yield, await, export bool) error {
 	h := g.NewGoal()
 	i := h.NewGoal()
 
-	if i.SkipAbstract() {
+	abstract := i.SkipAbstract()
+
+	if abstract {
 		i.AcceptRunWhitespaceNoNewlineComments()
 		h.Score(i)
 		h.AcceptRunWhitespaceNoNewLine()
 	}
 
-	if tk := h.Peek(); tk == (parser.Token{Type: TokenKeyword, Data: "class"}) {
-		d.Comments = i.ToTypescriptComments()
+	if tk := h.Peek(); tk == (parser.Token{Type: TokenKeyword, Data: "class"}) || tk == (parser.Token{Type: TokenPunctuator, Data: "@"}) {
 		d.ClassDeclaration = new(ClassDeclaration)
 
+		if abstract && j.IsTypescriptAST() {
+			d.ClassDeclaration.Abstract = true
+		} else {
+			d.Comments = i.ToTypescriptComments()
+		}
+
 		if err := d.ClassDeclaration.parse(&h, yield, await, false); err != nil {
 			return j.Error("Declaration", err)
 		}
 
 		g.Score(h)
-	} else if tk = g.Peek(); tk == (parser.Token{Type: TokenKeyword, Data: "const"}) || tk == (parser.Token{Type: TokenIdentifier, Data: "let"}) {
+	} else if tk = g.Peek(); tk == (parser.Token{Type: TokenKeyword, Data: "const"}) || tk == (parser.Token{Type: TokenIdentifier, Data: "let"}) || g.isUsingDeclaration(yield, await) {
 		d.LexicalDeclaration = new(LexicalDeclaration)
 
 		if err := d.LexicalDeclaration.parse(&g, true, yield, await); err != nil {
@@ -152,6 +217,12 @@ func (d *Declaration) parse(j *jsParser, yield, await, export bool) error {
 		return j.Error("Declaration", ErrInvalidDeclaration)
 	}
 
+	if d.ClassDeclaration != nil && d.ClassDeclaration.BindingIdentifier != nil {
+		j.declare(d.ClassDeclaration.BindingIdentifier.Data)
+	} else if d.FunctionDeclaration != nil && d.FunctionDeclaration.BindingIdentifier != nil {
+		j.declare(d.FunctionDeclaration.BindingIdentifier.Data)
+	}
+
 	j.Score(g)
 
 	d.Tokens = j.ToTokens()
@@ -159,17 +230,27 @@ func (d *Declaration) parse(j *jsParser, yield, await, export bool) error {
 	return nil
 }
 
-// LetOrConst specifies whether a LexicalDeclaration is a let or const declaration
-type LetOrConst bool
+// LetOrConst specifies whether a LexicalDeclaration is a let, const, using, or
+// await using declaration
+type LetOrConst uint8
 
 // Valid LetOrConst values
 const (
-	Let   LetOrConst = false
-	Const LetOrConst = true
+	Let LetOrConst = iota
+	Const
+	Using
+	AwaitUsing
 )
 
 // LexicalDeclaration as defined in ECMA-262
 // https://262.ecma-international.org/11.0/#prod-LexicalDeclaration
+//
+// Includes the using and await using declarations from the TC39 Explicit
+// Resource Management proposal.
+// https://tc39.es/proposal-explicit-resource-management/#prod-UsingDeclaration
+//
+// When LetOrConst is Using or AwaitUsing, each LexicalBinding must be a
+// BindingIdentifier.
 type LexicalDeclaration struct {
 	LetOrConst
 	BindingList []LexicalBinding
@@ -177,12 +258,20 @@ type LexicalDeclaration struct {
 }
 
 func (ld *LexicalDeclaration) parse(j *jsParser, in, yield, await bool) error {
-	if !j.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "let"}) {
-		if !j.AcceptToken(parser.Token{Type: TokenKeyword, Data: "const"}) {
+	if j.AcceptToken(parser.Token{Type: TokenKeyword, Data: "const"}) {
+		ld.LetOrConst = Const
+	} else if j.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "using"}) {
+		ld.LetOrConst = Using
+	} else if await && j.AcceptToken(parser.Token{Type: TokenKeyword, Data: "await"}) {
+		j.AcceptRunWhitespaceNoNewLine()
+
+		if !j.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "using"}) {
 			return j.Error("LexicalDeclaration", ErrInvalidLexicalDeclaration)
 		}
 
-		ld.LetOrConst = Const
+		ld.LetOrConst = AwaitUsing
+	} else if !j.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "let"}) {
+		return j.Error("LexicalDeclaration", ErrInvalidLexicalDeclaration)
 	}
 
 	for {
@@ -190,6 +279,16 @@ func (ld *LexicalDeclaration) parse(j *jsParser, in, yield, await bool) error {
 
 		g := j.NewGoal()
 		lb := len(ld.BindingList)
+
+		if ld.LetOrConst >= Using {
+			h := g.NewGoal()
+
+			h.AcceptRunWhitespace()
+
+			if tk := h.Peek(); tk == (parser.Token{Type: TokenPunctuator, Data: "["}) || tk == (parser.Token{Type: TokenPunctuator, Data: "{"}) {
+				return h.Error("LexicalDeclaration", ErrNoIdentifier)
+			}
+		}
 		ld.BindingList = append(ld.BindingList, LexicalBinding{})
 
 		if err := ld.BindingList[lb].parse(&g, in, yield, await); err != nil {
@@ -218,6 +317,36 @@ func (ld *LexicalDeclaration) parse(j *jsParser, in, yield, await bool) error {
 	return nil
 }
 
+// skipUsingDeclaration skips over the using, or await using, keywords at the
+// start of a UsingDeclaration, returning the first bound identifier, or nil if
+// the tokens do not start a UsingDeclaration.
+func (j *jsParser) skipUsingDeclaration(yield, await bool) *Token {
+	g := j.NewGoal()
+
+	if await && g.AcceptToken(parser.Token{Type: TokenKeyword, Data: "await"}) {
+		g.AcceptRunWhitespaceNoNewLine()
+	}
+
+	if !g.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "using"}) {
+		return nil
+	}
+
+	g.AcceptRunWhitespaceNoNewLine()
+
+	tk := g.parseIdentifier(yield, await)
+	if tk != nil {
+		j.Score(g)
+	}
+
+	return tk
+}
+
+func (j *jsParser) isUsingDeclaration(yield, await bool) bool {
+	g := j.NewGoal()
+
+	return g.skipUsingDeclaration(yield, await) != nil
+}
+
 // LexicalBinding as defined in ECMA-262
 // https://262.ecma-international.org/11.0/#prod-LexicalBinding
 //
@@ -227,6 +356,7 @@ type LexicalBinding struct {
 	BindingIdentifier    *Token
 	ArrayBindingPattern  *ArrayBindingPattern
 	ObjectBindingPattern *ObjectBindingPattern
+	TypeAnnotation       *TypeAnnotation
 	Initializer          *AssignmentExpression
 	Comments             [2]Comments
 	Tokens               Tokens
@@ -251,6 +381,8 @@ func (lb *LexicalBinding) parse(j *jsParser, in, yield, await bool) error {
 		}
 	} else if lb.BindingIdentifier = g.parseIdentifier(yield, await); lb.BindingIdentifier == nil {
 		return j.Error("LexicalBinding", ErrNoIdentifier)
+	} else if err := g.checkStrictIdentifier("LexicalBinding", lb.BindingIdentifier); err != nil {
+		return err
 	}
 
 	j.Score(g)
@@ -263,8 +395,11 @@ func (lb *LexicalBinding) parse(j *jsParser, in, yield, await bool) error {
 
 	h := g.NewGoal()
 
-	if h.SkipColonType() {
-		lb.Comments[1] = append(lb.Comments[1], h.ToTypescriptComments()...)
+	if h.SkipColonType(&lb.TypeAnnotation) {
+		if lb.TypeAnnotation == nil {
+			lb.Comments[1] = append(lb.Comments[1], h.ToTypescriptComments()...)
+		}
+
 		lb.Comments[1] = append(lb.Comments[1], h.AcceptRunWhitespaceComments()...)
 
 		g.Score(h)
@@ -443,6 +578,8 @@ func (ob *ObjectBindingPattern) parse(j *jsParser, yield, await bool) error {
 
 				if ob.BindingRestProperty = j.parseIdentifier(yield, await); ob.BindingRestProperty == nil {
 					return j.Error("ObjectBindingPattern", ErrNoIdentifier)
+				} else if err := j.checkStrictIdentifier("ObjectBindingPattern", ob.BindingRestProperty); err != nil {
+					return err
 				}
 
 				ob.Comments[3] = j.AcceptRunWhitespaceNoNewlineComments()
@@ -995,10 +1132,17 @@ func (tl *TemplateLiteral) parse(j *jsParser, yield, await bool) error {
 // Only one of BindingIdentifier or FormalParameters must be non-nil.
 //
 // Only one of AssignmentExpression or FunctionBody must be non-nil.
+//
+// TypeParameters and ReturnType can only be non-nil when FormalParameters is
+// non-nil, and are only produced when parsing with a tokeniser created by
+// AsTypescriptAST.
 type ArrowFunction struct {
+	DirectivePrologue
 	Async                bool
+	TypeParameters       *TypeParameters
 	BindingIdentifier    *Token
 	FormalParameters     *FormalParameters
+	ReturnType           *TypeAnnotation
 	AssignmentExpression *AssignmentExpression
 	FunctionBody         *Block
 	Comments             [5]Comments
@@ -1017,8 +1161,11 @@ func (af *ArrowFunction) parse(j *jsParser, in, yield, await bool) error {
 
 	g := j.NewGoal()
 
-	if g.SkipGeneric() {
-		af.Comments[1] = append(af.Comments[1], g.ToTypescriptComments()...)
+	if g.SkipGeneric(&af.TypeParameters) {
+		if af.TypeParameters == nil {
+			af.Comments[1] = append(af.Comments[1], g.ToTypescriptComments()...)
+		}
+
 		af.Comments[1] = append(af.Comments[1], g.AcceptRunWhitespaceNoNewlineComments()...)
 
 		j.Score(g)
@@ -1041,8 +1188,11 @@ func (af *ArrowFunction) parse(j *jsParser, in, yield, await bool) error {
 
 		g = j.NewGoal()
 
-		if g.SkipReturnType() {
-			af.Comments[2] = append(af.Comments[2], g.ToTypescriptComments()...)
+		if g.SkipReturnType(&af.ReturnType) {
+			if af.ReturnType == nil {
+				af.Comments[2] = append(af.Comments[2], g.ToTypescriptComments()...)
+			}
+
 			af.Comments[2] = append(af.Comments[2], g.AcceptRunWhitespaceNoNewlineComments()...)
 
 			j.Score(g)
@@ -1053,6 +1203,8 @@ func (af *ArrowFunction) parse(j *jsParser, in, yield, await bool) error {
 
 		if af.BindingIdentifier = g.parseIdentifier(yield, await); af.BindingIdentifier == nil {
 			return j.Error("ArrowFunction", ErrNoIdentifier)
+		} else if err := g.checkStrictIdentifier("ArrowFunction", af.BindingIdentifier); err != nil {
+			return err
 		}
 
 		j.Score(g)
@@ -1074,10 +1226,16 @@ func (af *ArrowFunction) parse(j *jsParser, in, yield, await bool) error {
 		g := j.NewGoal()
 		af.FunctionBody = new(Block)
 
-		if err := af.FunctionBody.parse(&g, false, af.Async, true); err != nil {
+		if err := af.FunctionBody.parseFunctionBody(&g, false, af.Async, &af.DirectivePrologue); err != nil {
 			return j.Error("ArrowFunction", err)
 		}
 
+		if af.UseStrict() {
+			if err := checkStrictParameters("ArrowFunction", af.BindingIdentifier, af.FormalParameters, &af.DirectivePrologue); err != nil {
+				return j.Error("ArrowFunction", err)
+			}
+		}
+
 		j.Score(g)
 
 		af.Comments[4] = j.AcceptRunWhitespaceCommentsInList()
@@ -1090,6 +1248,8 @@ func (af *ArrowFunction) parse(j *jsParser, in, yield, await bool) error {
 		}
 
 		j.Score(g)
+
+		af.Strict = j.IsStrict()
 	}
 
 	af.Tokens = j.ToTokens()
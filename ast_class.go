@@ -11,15 +11,40 @@ import (
 // https://tc39.es/ecma262/#prod-ClassDeclaration
 //
 // Also covers ClassExpression when BindingIdentifier is nil.
+//
+// Decorators, as defined in the TC39 Decorators proposal, are optional.
+//
+// Abstract, TypeParameters, TypeArguments, and Implements are only produced
+// when parsing with a tokeniser created by AsTypescriptAST. The TypeArguments
+// are those of the ClassHeritage, and so can only be non-nil when the
+// ClassHeritage is non-nil.
 type ClassDeclaration struct {
+	Decorators        []Decorator
+	Abstract          bool
 	BindingIdentifier *Token
+	TypeParameters    *TypeParameters
 	ClassHeritage     *LeftHandSideExpression
+	TypeArguments     *TypeArguments
+	Implements        []TypeReference
 	ClassBody         []ClassElement
 	Comments          [5]Comments
 	Tokens            Tokens
 }
 
 func (cd *ClassDeclaration) parse(j *jsParser, yield, await, def bool) error {
+	defer j.setStrict(j.IsStrict())
+
+	j.setStrict(true)
+
+	ds, err := j.parseDecorators(yield, await)
+	if err != nil {
+		return j.Error("ClassDeclaration", err)
+	}
+
+	cd.Decorators = ds
+
+	j.AcceptRunWhitespace()
+
 	if !j.AcceptToken(parser.Token{Type: TokenKeyword, Data: "class"}) {
 		return j.Error("ClassDeclaration", ErrInvalidClassDeclaration)
 	}
@@ -32,14 +57,19 @@ func (cd *ClassDeclaration) parse(j *jsParser, yield, await, def bool) error {
 		if !def {
 			return j.Error("ClassDeclaration", ErrNoIdentifier)
 		}
+	} else if err := j.checkStrictIdentifier("ClassDeclaration", cd.BindingIdentifier); err != nil {
+		return err
 	} else {
 		cd.Comments[1] = j.AcceptRunWhitespaceComments()
 
 		j.AcceptRunWhitespace()
 	}
 
-	if g := j.NewGoal(); g.SkipGeneric() {
-		cd.Comments[1] = append(cd.Comments[1], g.ToTypescriptComments()...)
+	if g := j.NewGoal(); g.SkipGeneric(&cd.TypeParameters) {
+		if cd.TypeParameters == nil {
+			cd.Comments[1] = append(cd.Comments[1], g.ToTypescriptComments()...)
+		}
+
 		cd.Comments[1] = append(cd.Comments[1], g.AcceptRunWhitespaceComments()...)
 
 		j.Score(g)
@@ -59,8 +89,11 @@ func (cd *ClassDeclaration) parse(j *jsParser, yield, await, def bool) error {
 		j.Score(g)
 		j.AcceptRunWhitespace()
 
-		if g = j.NewGoal(); g.SkipTypeArguments() {
-			cd.Comments[2] = g.ToTypescriptComments()
+		if g = j.NewGoal(); g.SkipTypeArguments(&cd.TypeArguments) {
+			if cd.TypeArguments == nil {
+				cd.Comments[2] = g.ToTypescriptComments()
+			}
+
 			cd.Comments[2] = append(cd.Comments[2], g.AcceptRunWhitespaceComments()...)
 
 			j.Score(g)
@@ -68,8 +101,11 @@ func (cd *ClassDeclaration) parse(j *jsParser, yield, await, def bool) error {
 		}
 	}
 
-	if g := j.NewGoal(); g.SkipHeritage() {
-		cd.Comments[2] = append(cd.Comments[2], g.ToTypescriptComments()...)
+	if g := j.NewGoal(); g.SkipHeritage(&cd.Implements) {
+		if cd.Implements == nil {
+			cd.Comments[2] = append(cd.Comments[2], g.ToTypescriptComments()...)
+		}
+
 		cd.Comments[2] = append(cd.Comments[2], g.AcceptRunWhitespaceComments()...)
 
 		j.Score(g)
@@ -121,17 +157,36 @@ func (cd *ClassDeclaration) parse(j *jsParser, yield, await, def bool) error {
 
 		i := h.NewGoal()
 
-		if i.SkipParameterProperties() {
-			i.AcceptRunWhitespace()
-		}
+		var tm *TypeMember
 
-		if i.SkipAbstractField() || i.SkipIndexSignature() {
+		if i.SkipClassSignature(&tm) {
 			i.parseSemicolon()
 
-			c = append(c, i.ToTypescriptComments()...)
+			if tm == nil {
+				c = append(c, i.ToTypescriptComments()...)
 
-			h.Score(i)
-			g.Score(h)
+				h.Score(i)
+				g.Score(h)
+
+				continue
+			}
+
+			c = append(c, g.AcceptRunWhitespaceComments()...)
+
+			g.AcceptRunWhitespace()
+			g.Score(i)
+
+			cd.ClassBody = append(cd.ClassBody, ClassElement{
+				TypeMember: tm,
+				Comments:   [3]Comments{c, nil, g.AcceptRunWhitespaceNoNewlineComments()},
+				Tokens:     g.ToTokens(),
+			})
+
+			j.Score(g)
+			j.AcceptRunWhitespaceNoComment()
+
+			g = j.NewGoal()
+			c = nil
 
 			continue
 		}
@@ -168,26 +223,54 @@ func (cd *ClassDeclaration) parse(j *jsParser, yield, await, def bool) error {
 
 	cd.Tokens = j.ToTokens()
 
+	if j.IsTypescript() {
+		if err := cd.lowerParameterProperties(j.UseDefineForClassFields(), j.AssignClassFields()); err != nil {
+			return j.Error("ClassDeclaration", err)
+		}
+	}
+
 	return nil
 }
 
 // ClassElement as defined in ECMA-262
 // https://tc39.es/ecma262/#prod-ClassElement
 //
-// Only one of MethodDefinition, FieldDefinition, or ClassStaticBlock must be
-// non-nil.
+// Only one of MethodDefinition, FieldDefinition, ClassStaticBlock, or
+// TypeMember must be non-nil.
 //
-// If ClassStaticBlock is non-nil, Static should be true
+// TypeMember, which is only produced when parsing with a tokeniser created by
+// AsTypescriptAST, holds either an abstract member, with an 'abstract'
+// modifier, or an index signature.
+//
+// If ClassStaticBlock is non-nil, Static should be true, and there should be no
+// Decorators.
+//
+// Accessor, the auto-accessor keyword from the TC39 Decorators proposal, can
+// only be true when FieldDefinition is non-nil.
 type ClassElement struct {
+	Decorators       []Decorator
 	Static           bool
+	Accessor         bool
 	MethodDefinition *MethodDefinition
 	FieldDefinition  *FieldDefinition
 	ClassStaticBlock *Block
+	TypeMember       *TypeMember
 	Comments         [3]Comments
 	Tokens           Tokens
 }
 
 func (ce *ClassElement) parse(j *jsParser, yield, await bool) error {
+	ds, err := j.parseDecorators(yield, await)
+	if err != nil {
+		return j.Error("ClassElement", err)
+	}
+
+	ce.Decorators = ds
+
+	if len(ds) > 0 {
+		j.AcceptRunWhitespaceNoComment()
+	}
+
 	g := j.NewGoal()
 
 	g.AcceptRunWhitespace()
@@ -228,8 +311,25 @@ func (ce *ClassElement) parse(j *jsParser, yield, await bool) error {
 
 	g.AcceptRunWhitespace()
 
+	if g.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "accessor"}) {
+		g.AcceptRunWhitespaceNoNewLine()
+
+		if tk := g.Peek(); tk.Type != TokenLineTerminator && tk.Type != TokenSingleLineComment && tk.Type != TokenMultiLineComment && (tk.Type != TokenPunctuator || tk.Data == "[") && tk.Type != TokenRightBracePunctuator {
+			ce.Accessor = true
+			ce.Comments[1] = append(ce.Comments[1], j.AcceptRunWhitespaceComments()...)
+
+			j.AcceptRunWhitespace()
+			j.Skip()
+			j.AcceptRunWhitespaceNoComment()
+		}
+	}
+
+	g = j.NewGoal()
+
+	g.AcceptRunWhitespace()
+
 	if g.SkipReadOnly() {
-		ce.Comments[1] = j.AcceptRunWhitespaceComments()
+		ce.Comments[1] = append(ce.Comments[1], j.AcceptRunWhitespaceComments()...)
 
 		j.AcceptRunWhitespace()
 
@@ -248,7 +348,11 @@ func (ce *ClassElement) parse(j *jsParser, yield, await bool) error {
 
 	g.AcceptRunWhitespace()
 
-	if ce.Static && g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "{"}) {
+	if ce.Static && !ce.Accessor && g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "{"}) {
+		if len(ce.Decorators) > 0 {
+			return j.Error("ClassElement", ErrInvalidDecorator)
+		}
+
 		ce.Comments[1] = append(ce.Comments[1], j.AcceptRunWhitespaceComments()...)
 
 		j.AcceptRunWhitespace()
@@ -282,7 +386,7 @@ func (ce *ClassElement) parse(j *jsParser, yield, await bool) error {
 			tk := h.Peek()
 
 			isMethod = tk == (parser.Token{Type: TokenPunctuator, Data: "*"}) || tk == (parser.Token{Type: TokenPunctuator, Data: "["}) || tk == (parser.Token{Type: TokenPunctuator, Data: "("}) || tk.Type == TokenIdentifier || tk.Type == TokenPrivateIdentifier
-			if !isMethod && h.SkipGeneric() {
+			if !isMethod && h.SkipGeneric(nil) {
 				h.AcceptRunWhitespace()
 
 				tk := h.Peek()
@@ -306,10 +410,16 @@ func (ce *ClassElement) parse(j *jsParser, yield, await bool) error {
 		}
 
 		if isMethod {
+			if ce.Accessor {
+				return j.Error("ClassElement", ErrInvalidAccessor)
+			}
+
 			ce.MethodDefinition = &MethodDefinition{ClassElementName: cen}
 
 			if err := ce.MethodDefinition.parse(&g, ce.Static, true, yield, await); err != nil {
 				return j.Error("ClassElement", err)
+			} else if len(ce.Decorators) > 0 && !ce.Static && ce.MethodDefinition.isConstructor() {
+				return j.Error("ClassElement", ErrInvalidDecorator)
 			}
 		} else {
 			ce.FieldDefinition = &FieldDefinition{ClassElementName: cen}
@@ -370,10 +480,17 @@ func (ce *ClassElement) parse(j *jsParser, yield, await bool) error {
 // ClassElementName as defined in ECMA-262
 // https://tc39.es/ecma262/#prod-ClassElementName
 //
-// Only one of PropertyName or PrivateIdentifier must be non-nil
+// Only one of PropertyName or PrivateIdentifier must be non-nil.
+//
+// TypeParameters and Overloads, which are only produced when parsing with a
+// tokeniser created by AsTypescriptAST, can only be non-nil for the name of a
+// method, with the Overloads holding the signatures of any overloads that
+// precede the method.
 type ClassElementName struct {
 	PropertyName      *PropertyName
 	PrivateIdentifier *Token
+	Overloads         []CallSignature
+	TypeParameters    *TypeParameters
 	Comments          [2]Comments
 	Tokens            Tokens
 }
@@ -405,8 +522,11 @@ func (cen *ClassElementName) parse(j *jsParser, static, class, yield, await bool
 
 		h := g.NewGoal()
 
-		if h.SkipGeneric() {
-			cen.Comments[1] = append(cen.Comments[1], h.ToTypescriptComments()...)
+		if h.SkipGeneric(&cen.TypeParameters) {
+			if cen.TypeParameters == nil {
+				cen.Comments[1] = append(cen.Comments[1], h.ToTypescriptComments()...)
+			}
+
 			cen.Comments[1] = append(cen.Comments[1], h.AcceptRunWhitespaceComments()...)
 
 			g.Score(h)
@@ -420,7 +540,10 @@ func (cen *ClassElementName) parse(j *jsParser, static, class, yield, await bool
 		h = g.NewGoal()
 
 		if h.SkipMethodOverload(static, cen, yield, await) {
-			cen.Comments[1] = append(cen.Comments[1], h.ToTypescriptComments()...)
+			if cen.Overloads == nil {
+				cen.Comments[1] = append(cen.Comments[1], h.ToTypescriptComments()...)
+			}
+
 			cen.Comments[1] = append(cen.Comments[1], h.AcceptRunWhitespaceComments()...)
 
 			g.Score(h)
@@ -439,8 +562,13 @@ func (cen *ClassElementName) hasSingleLineComment() bool {
 
 // FieldDefinition as defined in ECMA-262
 // https://tc39.es/ecma262/#prod-FieldDefinition
+//
+// Optional and TypeAnnotation are only produced when parsing with a tokeniser
+// created by AsTypescriptAST.
 type FieldDefinition struct {
 	ClassElementName ClassElementName
+	Optional         bool
+	TypeAnnotation   *TypeAnnotation
 	Initializer      *AssignmentExpression
 	Comments         Comments
 	Tokens           Tokens
@@ -462,7 +590,7 @@ func (fd *FieldDefinition) parse(j *jsParser, yield, await bool) error {
 
 		i.AcceptRunWhitespace()
 
-		if i.SkipColonType() {
+		if i.SkipColonType(nil) {
 			h.Score(i)
 		}
 
@@ -476,8 +604,10 @@ func (fd *FieldDefinition) parse(j *jsParser, yield, await bool) error {
 		g = j.NewGoal()
 
 		g.AcceptRunWhitespace()
-	} else if h.SkipOptionalColonType() {
-		fd.Comments = h.ToTypescriptComments()
+	} else if h.SkipOptionalColonType(&fd.Optional, &fd.TypeAnnotation) {
+		if !fd.Optional && fd.TypeAnnotation == nil {
+			fd.Comments = h.ToTypescriptComments()
+		}
 
 		g.Score(h)
 		j.Score(g)
@@ -523,10 +653,14 @@ const (
 
 // MethodDefinition as specified in ECMA-262
 // https://tc39.es/ecma262/#prod-MethodDefinition
+//
+// ReturnType is only produced when parsing with a tokeniser created by
+// AsTypescriptAST.
 type MethodDefinition struct {
 	Type             MethodType
 	ClassElementName ClassElementName
 	Params           FormalParameters
+	ReturnType       *TypeAnnotation
 	FunctionBody     Block
 	Comments         [4]Comments
 	Tokens           Tokens
@@ -552,7 +686,7 @@ func (md *MethodDefinition) parse(j *jsParser, static, class, yield, await bool)
 		case parser.Token{Type: TokenIdentifier, Data: "async"}:
 			g.Skip()
 
-			if t := g.AcceptRunWhitespaceNoNewLine(); t == TokenLineTerminator || t == TokenSingleLineComment || t == TokenMultiLineComment || g.SkipGeneric() {
+			if t := g.AcceptRunWhitespaceNoNewLine(); t == TokenLineTerminator || t == TokenSingleLineComment || t == TokenMultiLineComment || g.SkipGeneric(nil) {
 				break
 			}
 
@@ -672,19 +806,30 @@ func (md *MethodDefinition) parse(j *jsParser, static, class, yield, await bool)
 
 	g := j.NewGoal()
 
-	if g.SkipReturnType() {
-		md.Comments[2] = append(md.Comments[2], g.ToTypescriptComments()...)
+	if g.SkipReturnType(&md.ReturnType) {
+		if md.ReturnType == nil {
+			md.Comments[2] = append(md.Comments[2], g.ToTypescriptComments()...)
+		}
+
 		md.Comments[2] = append(md.Comments[2], g.AcceptRunWhitespaceComments()...)
 
 		j.Score(g)
 		j.AcceptRunWhitespace()
 	}
 
+	var dp DirectivePrologue
+
 	g = j.NewGoal()
-	if err := md.FunctionBody.parse(&g, md.Type == MethodGenerator, md.Type == MethodAsync, true); err != nil {
+	if err := md.FunctionBody.parseFunctionBody(&g, md.Type == MethodGenerator, md.Type == MethodAsync, &dp); err != nil {
 		return j.Error("MethodDefinition", err)
 	}
 
+	if dp.UseStrict() {
+		if err := checkStrictParameters("MethodDefinition", nil, &md.Params, &dp); err != nil {
+			return j.Error("MethodDefinition", err)
+		}
+	}
+
 	j.Score(g)
 
 	md.Comments[3] = j.AcceptRunWhitespaceCommentsInList()
@@ -732,6 +877,10 @@ func (pn *PropertyName) parse(j *jsParser, yield, await bool) error {
 		}
 	} else if j.Accept(TokenIdentifier, TokenKeyword, TokenStringLiteral, TokenNumericLiteral, TokenBooleanLiteral, TokenNullLiteral, TokenFutureReservedWord) {
 		pn.LiteralPropertyName = j.GetLastToken()
+
+		if err := j.checkStrictLiteral("PropertyName", pn.LiteralPropertyName); err != nil {
+			return err
+		}
 	} else {
 		return j.Error("PropertyName", ErrInvalidPropertyName)
 	}
@@ -740,3 +889,159 @@ func (pn *PropertyName) parse(j *jsParser, yield, await bool) error {
 
 	return nil
 }
+
+// Decorator as defined in the TC39 Decorators proposal.
+// https://tc39.es/proposal-decorators/#prod-Decorator
+//
+// Only one of DecoratorMemberExpression or DecoratorParenthesizedExpression
+// must be non-nil.
+//
+// Arguments can only be non-nil when DecoratorMemberExpression is non-nil, and
+// represents a DecoratorCallExpression.
+type Decorator struct {
+	DecoratorMemberExpression        *DecoratorMemberExpression
+	DecoratorParenthesizedExpression *ParenthesizedExpression
+	Arguments                        *Arguments
+	Comments                         [2]Comments
+	Tokens                           Tokens
+}
+
+func (d *Decorator) parse(j *jsParser, yield, await bool) error {
+	d.Comments[0] = j.AcceptRunWhitespaceComments()
+
+	j.AcceptRunWhitespace()
+
+	if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "@"}) {
+		return j.Error("Decorator", ErrInvalidDecorator)
+	}
+
+	j.AcceptRunWhitespace()
+
+	g := j.NewGoal()
+
+	if g.Peek() == (parser.Token{Type: TokenPunctuator, Data: "("}) {
+		d.DecoratorParenthesizedExpression = new(ParenthesizedExpression)
+		if err := d.DecoratorParenthesizedExpression.parse(&g, yield, await); err != nil {
+			return j.Error("Decorator", err)
+		}
+
+		j.Score(g)
+	} else {
+		d.DecoratorMemberExpression = new(DecoratorMemberExpression)
+		if err := d.DecoratorMemberExpression.parse(&g, yield, await); err != nil {
+			return j.Error("Decorator", err)
+		}
+
+		j.Score(g)
+
+		g = j.NewGoal()
+
+		g.AcceptRunWhitespace()
+
+		if g.Peek() == (parser.Token{Type: TokenPunctuator, Data: "("}) {
+			h := g.NewGoal()
+
+			d.Arguments = new(Arguments)
+			if err := d.Arguments.parse(&h, yield, await); err != nil {
+				return g.Error("Decorator", err)
+			}
+
+			g.Score(h)
+			j.Score(g)
+		}
+	}
+
+	d.Comments[1] = j.AcceptRunWhitespaceNoNewlineComments()
+	d.Tokens = j.ToTokens()
+
+	return nil
+}
+
+func (j *jsParser) parseDecorators(yield, await bool) ([]Decorator, error) {
+	var ds []Decorator
+
+	for {
+		g := j.NewGoal()
+
+		g.AcceptRunWhitespace()
+
+		if g.Peek() != (parser.Token{Type: TokenPunctuator, Data: "@"}) {
+			return ds, nil
+		}
+
+		g = j.NewGoal()
+		d := len(ds)
+
+		ds = append(ds, Decorator{})
+		if err := ds[d].parse(&g, yield, await); err != nil {
+			return nil, err
+		}
+
+		j.Score(g)
+	}
+}
+
+func (j *jsParser) skipDecorators() bool {
+	g := j.NewGoal()
+
+	if ds, err := g.parseDecorators(false, false); err != nil || len(ds) == 0 {
+		return false
+	}
+
+	j.Score(g)
+
+	return true
+}
+
+// DecoratorMemberExpression as defined in the TC39 Decorators proposal.
+// https://tc39.es/proposal-decorators/#prod-DecoratorMemberExpression
+//
+// If DecoratorMemberExpression is nil, IdentifierReference must be non-nil;
+// otherwise, only one of IdentifierName or PrivateIdentifier must be non-nil.
+type DecoratorMemberExpression struct {
+	DecoratorMemberExpression *DecoratorMemberExpression
+	IdentifierReference       *Token
+	IdentifierName            *Token
+	PrivateIdentifier         *Token
+	Tokens                    Tokens
+}
+
+func (dme *DecoratorMemberExpression) parse(j *jsParser, yield, await bool) error {
+	if dme.IdentifierReference = j.parseIdentifier(yield, await); dme.IdentifierReference == nil {
+		return j.Error("DecoratorMemberExpression", ErrNoIdentifier)
+	} else if err := j.checkStrictIdentifier("DecoratorMemberExpression", dme.IdentifierReference); err != nil {
+		return err
+	}
+
+	dme.Tokens = j.ToTokens()
+
+	for {
+		g := j.NewGoal()
+
+		g.AcceptRunWhitespace()
+
+		if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "."}) {
+			return nil
+		}
+
+		g.AcceptRunWhitespace()
+
+		ndme := &DecoratorMemberExpression{
+			DecoratorMemberExpression: new(DecoratorMemberExpression),
+		}
+
+		if g.Accept(TokenPrivateIdentifier) {
+			ndme.PrivateIdentifier = g.GetLastToken()
+		} else if g.Accept(TokenIdentifier, TokenKeyword) {
+			ndme.IdentifierName = g.GetLastToken()
+		} else {
+			return g.Error("DecoratorMemberExpression", ErrNoIdentifier)
+		}
+
+		j.Score(g)
+
+		*ndme.DecoratorMemberExpression = *dme
+		ndme.Tokens = j.ToTokens()
+		*dme = *ndme
+	}
+}
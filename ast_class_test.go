@@ -4243,3 +4243,241 @@ func TestPropertyName(t *testing.T) {
 		return pn, err
 	})
 }
+
+func TestDecorator(t *testing.T) {
+	doTests(t, []sourceFn{
+		{``, func(t *test, tk Tokens) { // 1
+			t.Err = Error{
+				Err:     ErrInvalidDecorator,
+				Parsing: "Decorator",
+				Token:   tk[0],
+			}
+		}},
+		{`@a`, func(t *test, tk Tokens) { // 2
+			t.Output = Decorator{
+				DecoratorMemberExpression: &DecoratorMemberExpression{
+					IdentifierReference: &tk[1],
+					Tokens:              tk[1:2],
+				},
+				Tokens: tk[:2],
+			}
+		}},
+		{`@a.b`, func(t *test, tk Tokens) { // 3
+			t.Output = Decorator{
+				DecoratorMemberExpression: &DecoratorMemberExpression{
+					DecoratorMemberExpression: &DecoratorMemberExpression{
+						IdentifierReference: &tk[1],
+						Tokens:              tk[1:2],
+					},
+					IdentifierName: &tk[3],
+					Tokens:         tk[1:4],
+				},
+				Tokens: tk[:4],
+			}
+		}},
+		{`@a.#b.c()`, func(t *test, tk Tokens) { // 4
+			t.Output = Decorator{
+				DecoratorMemberExpression: &DecoratorMemberExpression{
+					DecoratorMemberExpression: &DecoratorMemberExpression{
+						DecoratorMemberExpression: &DecoratorMemberExpression{
+							IdentifierReference: &tk[1],
+							Tokens:              tk[1:2],
+						},
+						PrivateIdentifier: &tk[3],
+						Tokens:            tk[1:4],
+					},
+					IdentifierName: &tk[5],
+					Tokens:         tk[1:6],
+				},
+				Arguments: &Arguments{
+					Tokens: tk[6:8],
+				},
+				Tokens: tk[:8],
+			}
+		}},
+		{`@(a)`, func(t *test, tk Tokens) { // 5
+			litA := makeConditionLiteral(tk, 2)
+			t.Output = Decorator{
+				DecoratorParenthesizedExpression: &ParenthesizedExpression{
+					Expressions: []AssignmentExpression{
+						{
+							ConditionalExpression: &litA,
+							Tokens:                tk[2:3],
+						},
+					},
+					Tokens: tk[1:4],
+				},
+				Tokens: tk[:4],
+			}
+		}},
+		{`@1`, func(t *test, tk Tokens) { // 6
+			t.Err = Error{
+				Err: Error{
+					Err:     ErrNoIdentifier,
+					Parsing: "DecoratorMemberExpression",
+					Token:   tk[1],
+				},
+				Parsing: "Decorator",
+				Token:   tk[1],
+			}
+		}},
+		{`@a.()`, func(t *test, tk Tokens) { // 7
+			t.Err = Error{
+				Err: Error{
+					Err:     ErrNoIdentifier,
+					Parsing: "DecoratorMemberExpression",
+					Token:   tk[3],
+				},
+				Parsing: "Decorator",
+				Token:   tk[1],
+			}
+		}},
+		{"/* A */ @a /* B */", func(t *test, tk Tokens) { // 8
+			t.Output = Decorator{
+				DecoratorMemberExpression: &DecoratorMemberExpression{
+					IdentifierReference: &tk[3],
+					Tokens:              tk[3:4],
+				},
+				Comments: [2]Comments{{&tk[0]}, {&tk[5]}},
+				Tokens:   tk[:6],
+			}
+		}},
+	}, func(t *test) (Type, error) {
+		var d Decorator
+
+		err := d.parse(&t.Tokens, t.Yield, t.Await)
+
+		return d, err
+	})
+}
+
+func TestDecoratedClass(t *testing.T) {
+	doTests(t, []sourceFn{
+		{`@a class b {}`, func(t *test, tk Tokens) { // 1
+			t.Output = ClassDeclaration{
+				Decorators: []Decorator{
+					{
+						DecoratorMemberExpression: &DecoratorMemberExpression{
+							IdentifierReference: &tk[1],
+							Tokens:              tk[1:2],
+						},
+						Tokens: tk[:2],
+					},
+				},
+				BindingIdentifier: &tk[5],
+				Tokens:            tk[:9],
+			}
+		}},
+		{`class a { @b c; }`, func(t *test, tk Tokens) { // 2
+			t.Output = ClassDeclaration{
+				BindingIdentifier: &tk[2],
+				ClassBody: []ClassElement{
+					{
+						Decorators: []Decorator{
+							{
+								DecoratorMemberExpression: &DecoratorMemberExpression{
+									IdentifierReference: &tk[7],
+									Tokens:              tk[7:8],
+								},
+								Tokens: tk[6:8],
+							},
+						},
+						FieldDefinition: &FieldDefinition{
+							ClassElementName: ClassElementName{
+								PropertyName: &PropertyName{
+									LiteralPropertyName: &tk[9],
+									Tokens:              tk[9:10],
+								},
+								Tokens: tk[9:10],
+							},
+							Tokens: tk[9:10],
+						},
+						Tokens: tk[6:11],
+					},
+				},
+				Tokens: tk[:13],
+			}
+		}},
+		{`class a { @b static {} }`, func(t *test, tk Tokens) { // 3
+			t.Err = Error{
+				Err: Error{
+					Err:     ErrInvalidDecorator,
+					Parsing: "ClassElement",
+					Token:   tk[11],
+				},
+				Parsing: "ClassDeclaration",
+				Token:   tk[6],
+			}
+		}},
+		{`class a { @b accessor c; }`, func(t *test, tk Tokens) { // 4
+			t.Output = ClassDeclaration{
+				BindingIdentifier: &tk[2],
+				ClassBody: []ClassElement{
+					{
+						Decorators: []Decorator{
+							{
+								DecoratorMemberExpression: &DecoratorMemberExpression{
+									IdentifierReference: &tk[7],
+									Tokens:              tk[7:8],
+								},
+								Tokens: tk[6:8],
+							},
+						},
+						Accessor: true,
+						FieldDefinition: &FieldDefinition{
+							ClassElementName: ClassElementName{
+								PropertyName: &PropertyName{
+									LiteralPropertyName: &tk[11],
+									Tokens:              tk[11:12],
+								},
+								Tokens: tk[11:12],
+							},
+							Tokens: tk[11:12],
+						},
+						Tokens: tk[6:13],
+					},
+				},
+				Tokens: tk[:15],
+			}
+		}},
+		{`class a { accessor b() {} }`, func(t *test, tk Tokens) { // 5
+			t.Err = Error{
+				Err: Error{
+					Err:     ErrInvalidAccessor,
+					Parsing: "ClassElement",
+					Token:   tk[8],
+				},
+				Parsing: "ClassDeclaration",
+				Token:   tk[6],
+			}
+		}},
+		{`class a { @b constructor() {} }`, func(t *test, tk Tokens) { // 6
+			t.Err = Error{
+				Err: Error{
+					Err:     ErrInvalidDecorator,
+					Parsing: "ClassElement",
+					Token:   tk[9],
+				},
+				Parsing: "ClassDeclaration",
+				Token:   tk[6],
+			}
+		}},
+		{`class a { @b 'constructor'() {} }`, func(t *test, tk Tokens) { // 7
+			t.Err = Error{
+				Err: Error{
+					Err:     ErrInvalidDecorator,
+					Parsing: "ClassElement",
+					Token:   tk[9],
+				},
+				Parsing: "ClassDeclaration",
+				Token:   tk[6],
+			}
+		}},
+	}, func(t *test) (Type, error) {
+		var cd ClassDeclaration
+
+		err := cd.parse(&t.Tokens, t.Yield, t.Await, false)
+
+		return cd, err
+	})
+}
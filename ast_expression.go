@@ -154,7 +154,7 @@ func (ae *AssignmentExpression) parse(j *jsParser, in, yield, await bool) error
 		g.Skip()
 		g.AcceptRunWhitespaceNoNewLine()
 
-		if g.SkipGeneric() {
+		if g.SkipGeneric(nil) {
 			g.AcceptRunWhitespaceNoNewLine()
 		}
 
@@ -175,7 +175,7 @@ func (ae *AssignmentExpression) parse(j *jsParser, in, yield, await bool) error
 
 		g.AcceptRunWhitespace()
 
-		if g.SkipGeneric() {
+		if g.SkipGeneric(nil) {
 			g = j.NewGoal()
 
 			ae.ArrowFunction = new(ArrowFunction)
@@ -190,7 +190,7 @@ func (ae *AssignmentExpression) parse(j *jsParser, in, yield, await bool) error
 			g.SkipDepth()
 			g.AcceptRunWhitespaceNoNewLine()
 
-			if g.SkipReturnType() {
+			if g.SkipReturnType(nil) {
 				g.AcceptRunWhitespaceNoNewLine()
 			}
 
@@ -248,7 +248,7 @@ func (ae *AssignmentExpression) parse(j *jsParser, in, yield, await bool) error
 					if ae.AssignmentOperator == AssignmentAssign && lhs.isCoverAssignmentPattern() {
 						ae.AssignmentPattern = new(AssignmentPattern)
 						if err := ae.AssignmentPattern.from(&lhs.NewExpression.MemberExpression); err != nil {
-							z := jsParser(lhs.Tokens[:0])
+							z := jsParser{tokens: lhs.Tokens[:0]}
 
 							return z.Error("AssignmentExpression", err)
 						}
@@ -346,7 +346,7 @@ func (lhs *LeftHandSideExpression) parse(j *jsParser, yield, await bool) error {
 
 			h.AcceptRunWhitespace()
 
-			if h.SkipTypeArguments() {
+			if h.SkipTypeArguments(nil) {
 				h.AcceptRunWhitespace()
 			}
 
@@ -473,14 +473,14 @@ func (a *AssignmentPattern) from(me *MemberExpression) error {
 	if p.ArrayLiteral != nil {
 		a.ArrayAssignmentPattern = new(ArrayAssignmentPattern)
 		if err := a.ArrayAssignmentPattern.from(p.ArrayLiteral); err != nil {
-			z := jsParser(p.ArrayLiteral.Tokens[:0])
+			z := jsParser{tokens: p.ArrayLiteral.Tokens[:0]}
 
 			return z.Error("AssignmentPattern", err)
 		}
 	} else {
 		a.ObjectAssignmentPattern = new(ObjectAssignmentPattern)
 		if err := a.ObjectAssignmentPattern.from(p.ObjectLiteral); err != nil {
-			z := jsParser(p.ObjectLiteral.Tokens[:0])
+			z := jsParser{tokens: p.ObjectLiteral.Tokens[:0]}
 
 			return z.Error("AssignmentPattern", err)
 		}
@@ -523,19 +523,19 @@ func (o *ObjectAssignmentPattern) from(ol *ObjectLiteral) error {
 				var dat DestructuringAssignmentTarget
 
 				if pd.AssignmentExpression.AssignmentOperator != AssignmentNone {
-					z := jsParser(pd.AssignmentExpression.Tokens[:0])
+					z := jsParser{tokens: pd.AssignmentExpression.Tokens[:0]}
 
 					return z.Error("ObjectAssignmentPattern", ErrInvalidAssignment)
 				}
 
 				if err := dat.from(pd.AssignmentExpression); err != nil {
-					z := jsParser(pd.AssignmentExpression.Tokens[:0])
+					z := jsParser{tokens: pd.AssignmentExpression.Tokens[:0]}
 
 					return z.Error("ObjectAssignmentPattern", err)
 				}
 
 				if dat.AssignmentPattern != nil {
-					z := jsParser(dat.Tokens[:0])
+					z := jsParser{tokens: dat.Tokens[:0]}
 
 					return z.Error("ObjectAssignmentPattern", ErrBadRestElement)
 				}
@@ -548,7 +548,7 @@ func (o *ObjectAssignmentPattern) from(ol *ObjectLiteral) error {
 		}
 
 		if err := o.AssignmentPropertyList[n].from(pd); err != nil {
-			z := jsParser(pd.Tokens[:0])
+			z := jsParser{tokens: pd.Tokens[:0]}
 
 			return z.Error("ObjectAssignmentPattern", err)
 		}
@@ -577,13 +577,13 @@ type AssignmentProperty struct {
 
 func (a *AssignmentProperty) from(pd *PropertyDefinition) error {
 	if pd.MethodDefinition != nil || pd.PropertyName == nil {
-		z := jsParser(pd.Tokens[:0])
+		z := jsParser{tokens: pd.Tokens[:0]}
 
 		return z.Error("AssignmentProperty", ErrInvalidAssignmentProperty)
 	}
 
 	if pd.PropertyName.LiteralPropertyName == nil {
-		z := jsParser(pd.Tokens[:0])
+		z := jsParser{tokens: pd.Tokens[:0]}
 
 		return z.Error("AssignmentProperty", z.Error("PropertyName", ErrNotSimple))
 	}
@@ -617,7 +617,7 @@ func (a *AssignmentProperty) from(pd *PropertyDefinition) error {
 	} else {
 		a.DestructuringAssignmentTarget = new(DestructuringAssignmentTarget)
 		if err := a.DestructuringAssignmentTarget.from(pd.AssignmentExpression); err != nil {
-			z := jsParser(pd.Tokens[:0])
+			z := jsParser{tokens: pd.Tokens[:0]}
 
 			return z.Error("AssignmentProperty", err)
 		}
@@ -653,7 +653,7 @@ func (d *DestructuringAssignmentTarget) from(ae *AssignmentExpression) error {
 		d.AssignmentPattern = ae.AssignmentPattern
 		d.Tokens = ae.AssignmentPattern.Tokens
 	} else if ae.ConditionalExpression == nil {
-		z := jsParser(ae.Tokens[:0])
+		z := jsParser{tokens: ae.Tokens[:0]}
 
 		return z.Error("DestructuringAssignmentTarget", ErrInvalidDestructuringAssignmentTarget)
 	} else {
@@ -661,19 +661,19 @@ func (d *DestructuringAssignmentTarget) from(ae *AssignmentExpression) error {
 		case *ArrayLiteral, *ObjectLiteral:
 			d.AssignmentPattern = new(AssignmentPattern)
 			if err := d.AssignmentPattern.from(&ae.ConditionalExpression.LogicalORExpression.LogicalANDExpression.BitwiseORExpression.BitwiseXORExpression.BitwiseANDExpression.EqualityExpression.RelationalExpression.ShiftExpression.AdditiveExpression.MultiplicativeExpression.ExponentiationExpression.UnaryExpression.UpdateExpression.LeftHandSideExpression.NewExpression.MemberExpression); err != nil {
-				z := jsParser(ae.Tokens[:0])
+				z := jsParser{tokens: ae.Tokens[:0]}
 
 				return z.Error("DestructuringAssignmentTarget", err)
 			}
 		case *CallExpression, *MemberExpression, *PrimaryExpression:
 			d.LeftHandSideExpression = ae.ConditionalExpression.LogicalORExpression.LogicalANDExpression.BitwiseORExpression.BitwiseXORExpression.BitwiseANDExpression.EqualityExpression.RelationalExpression.ShiftExpression.AdditiveExpression.MultiplicativeExpression.ExponentiationExpression.UnaryExpression.UpdateExpression.LeftHandSideExpression
 			if !d.LeftHandSideExpression.IsSimple() {
-				z := jsParser(ae.Tokens[:0])
+				z := jsParser{tokens: ae.Tokens[:0]}
 
 				return z.Error("DestructuringAssignmentTarget", ErrInvalidDestructuringAssignmentTarget)
 			}
 		default:
-			z := jsParser(ae.Tokens[:0])
+			z := jsParser{tokens: ae.Tokens[:0]}
 
 			return z.Error("DestructuringAssignmentTarget", ErrInvalidDestructuringAssignmentTarget)
 		}
@@ -700,14 +700,14 @@ func (a *AssignmentElement) from(ae *AssignmentExpression) error {
 	switch ae.AssignmentOperator {
 	case AssignmentNone, AssignmentAssign:
 		if err := a.DestructuringAssignmentTarget.from(ae); err != nil {
-			z := jsParser(ae.Tokens[:0])
+			z := jsParser{tokens: ae.Tokens[:0]}
 
 			return z.Error("AssignmentElement", err)
 		}
 
 		a.Initializer = ae.AssignmentExpression
 	default:
-		z := jsParser(ae.Tokens[:0])
+		z := jsParser{tokens: ae.Tokens[:0]}
 
 		return z.Error("AssignmentElement", ErrInvalidAssignment)
 	}
@@ -736,7 +736,7 @@ func (a *ArrayAssignmentPattern) from(al *ArrayLiteral) error {
 
 	for _, ae := range al.ElementList {
 		if hasSpread {
-			z := jsParser(al.Tokens[:0])
+			z := jsParser{tokens: al.Tokens[:0]}
 
 			return z.Error("ArrayAssignmentPattern", ErrBadRestElement)
 		} else if ae.Spread {
@@ -745,19 +745,19 @@ func (a *ArrayAssignmentPattern) from(al *ArrayLiteral) error {
 			var dat DestructuringAssignmentTarget
 
 			if ae.AssignmentExpression.AssignmentOperator != AssignmentNone {
-				z := jsParser(al.Tokens[:0])
+				z := jsParser{tokens: al.Tokens[:0]}
 
 				return z.Error("ArrayAssignmentPattern", ErrInvalidAssignment)
 			}
 
 			if err := dat.from(&ae.AssignmentExpression); err != nil {
-				z := jsParser(al.Tokens[:0])
+				z := jsParser{tokens: al.Tokens[:0]}
 
 				return z.Error("ArrayAssignmentPattern", err)
 			}
 
 			if dat.AssignmentPattern != nil {
-				z := jsParser(al.Tokens[:0])
+				z := jsParser{tokens: al.Tokens[:0]}
 
 				return z.Error("ArrayAssignmentPattern", ErrBadRestElement)
 			}
@@ -768,7 +768,7 @@ func (a *ArrayAssignmentPattern) from(al *ArrayLiteral) error {
 			var e AssignmentElement
 
 			if err := e.from(&ae.AssignmentExpression); err != nil {
-				z := jsParser(al.Tokens[:0])
+				z := jsParser{tokens: al.Tokens[:0]}
 
 				return z.Error("ArrayAssignmentPattern", err)
 			}
@@ -892,7 +892,7 @@ func (oc *OptionalChain) parse(j *jsParser, yield, await bool) error {
 
 	var c Comments
 
-	if g.SkipTypeArguments() {
+	if g.SkipTypeArguments(nil) {
 		c = g.ToTypescriptComments()
 		c = append(c, g.AcceptRunWhitespaceComments()...)
 
@@ -980,7 +980,7 @@ func (oc *OptionalChain) parse(j *jsParser, yield, await bool) error {
 
 		h := g.NewGoal()
 
-		if h.SkipTypeArguments() {
+		if h.SkipTypeArguments(nil) {
 			b = append(h.ToTypescriptComments(), h.AcceptRunWhitespaceComments()...)
 			h.AcceptRunWhitespace()
 		}
@@ -1327,7 +1327,7 @@ func (me *MemberExpression) parse(j *jsParser, yield, await bool) error {
 
 			k := i.NewGoal()
 
-			if k.SkipTypeArguments() {
+			if k.SkipTypeArguments(nil) {
 				me.MemberExpression.Comments[4] = append(me.MemberExpression.Comments[4], k.ToTypescriptComments()...)
 				me.MemberExpression.Comments[4] = append(me.MemberExpression.Comments[4], k.AcceptRunWhitespaceCommentsInList()...)
 
@@ -1526,6 +1526,10 @@ func (pe *PrimaryExpression) parse(j *jsParser, yield, await bool) error {
 		pe.This = j.GetLastToken()
 	} else if j.Accept(TokenNullLiteral, TokenBooleanLiteral, TokenNumericLiteral, TokenStringLiteral, TokenRegularExpressionLiteral) {
 		pe.Literal = j.GetLastToken()
+
+		if err := j.checkStrictLiteral("PrimaryExpression", pe.Literal); err != nil {
+			return err
+		}
 	} else if t := j.Peek(); t == (parser.Token{Type: TokenPunctuator, Data: "["}) {
 		g := j.NewGoal()
 
@@ -1553,7 +1557,7 @@ func (pe *PrimaryExpression) parse(j *jsParser, yield, await bool) error {
 		}
 
 		j.Score(g)
-	} else if t == (parser.Token{Type: TokenKeyword, Data: "class"}) {
+	} else if t == (parser.Token{Type: TokenKeyword, Data: "class"}) || t == (parser.Token{Type: TokenPunctuator, Data: "@"}) {
 		g := j.NewGoal()
 
 		pe.ClassExpression = new(ClassDeclaration)
@@ -1609,6 +1613,8 @@ func (pe *PrimaryExpression) parse(j *jsParser, yield, await bool) error {
 
 		if pe.IdentifierReference = g.parseIdentifier(yield, await); pe.IdentifierReference == nil {
 			return j.Error("PrimaryExpression", ErrNoIdentifier)
+		} else if err := g.checkStrictIdentifier("PrimaryExpression", pe.IdentifierReference); err != nil {
+			return err
 		}
 
 		j.Score(g)
@@ -1868,7 +1874,7 @@ func (ce *CallExpression) parse(j *jsParser, me *MemberExpression, yield, await
 
 		g := j.NewGoal()
 
-		if g.SkipTypeArguments() {
+		if g.SkipTypeArguments(nil) {
 			ce.Comments[1] = append(ce.Comments[1], g.ToTypescriptComments()...)
 
 			g.AcceptRunWhitespace()
@@ -1930,7 +1936,7 @@ func (ce *CallExpression) parse(j *jsParser, me *MemberExpression, yield, await
 			case "<":
 				i := h.NewGoal()
 
-				if i.SkipTypeArguments() {
+				if i.SkipTypeArguments(nil) {
 					f = append(i.ToTypescriptComments(), i.AcceptRunWhitespaceComments()...)
 
 					i.AcceptRunWhitespace()
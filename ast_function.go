@@ -22,10 +22,18 @@ const (
 //
 // Include TC39 proposal for async generator functions
 // https://github.com/tc39/proposal-async-iteration#async-generator-functions
+//
+// Overloads, TypeParameters, and ReturnType are only produced when parsing
+// with a tokeniser created by AsTypescriptAST, with the Overloads holding the
+// signatures of any overloads that precede the function.
 type FunctionDeclaration struct {
+	DirectivePrologue
 	Type              FunctionType
 	BindingIdentifier *Token
+	Overloads         []CallSignature
+	TypeParameters    *TypeParameters
 	FormalParameters  FormalParameters
+	ReturnType        *TypeAnnotation
 	FunctionBody      Block
 	Comments          [5]Comments
 	Tokens            Tokens
@@ -61,18 +69,30 @@ func (fd *FunctionDeclaration) parse(j *jsParser, yield, await, def, export bool
 
 	if bi := j.parseIdentifier(yield, await); bi == nil && !def {
 		return j.Error("FunctionDeclaration", ErrNoIdentifier)
+	} else if err := j.checkStrictIdentifier("FunctionDeclaration", bi); err != nil {
+		return err
 	} else {
 		fd.BindingIdentifier = bi
 		g := j.NewGoal()
 
 		async := fd.Type == FunctionAsync || fd.Type == FunctionAsyncGenerator
 
-		if g.SkipFunctionOverload(bi, yield, await, def, export, async) {
+		var cs *CallSignature
+
+		if g.SkipFunctionOverload(&cs, bi, yield, await, def, export, async) {
+			if cs != nil {
+				fd.Overloads = append(fd.Overloads, *cs)
+			}
+
 			h := g.NewGoal()
 
 			h.AcceptRunWhitespace()
 
-			for g.SkipFunctionOverload(bi, yield, await, def, export, async) {
+			for g.SkipFunctionOverload(&cs, bi, yield, await, def, export, async) {
+				if cs != nil {
+					fd.Overloads = append(fd.Overloads, *cs)
+				}
+
 				g.Score(h)
 
 				h = g.NewGoal()
@@ -80,7 +100,9 @@ func (fd *FunctionDeclaration) parse(j *jsParser, yield, await, def, export bool
 				h.AcceptRunWhitespace()
 			}
 
-			fd.Comments[3] = g.ToTypescriptComments()
+			if fd.Overloads == nil {
+				fd.Comments[3] = g.ToTypescriptComments()
+			}
 
 			j.Score(g)
 		}
@@ -92,8 +114,11 @@ func (fd *FunctionDeclaration) parse(j *jsParser, yield, await, def, export bool
 
 	g := j.NewGoal()
 
-	if g.SkipGeneric() {
-		fd.Comments[3] = append(fd.Comments[3], g.ToTypescriptComments()...)
+	if g.SkipGeneric(&fd.TypeParameters) {
+		if fd.TypeParameters == nil {
+			fd.Comments[3] = append(fd.Comments[3], g.ToTypescriptComments()...)
+		}
+
 		fd.Comments[3] = append(fd.Comments[3], g.AcceptRunWhitespaceComments()...)
 
 		j.Score(g)
@@ -114,8 +139,11 @@ func (fd *FunctionDeclaration) parse(j *jsParser, yield, await, def, export bool
 
 	g = j.NewGoal()
 
-	if g.SkipReturnType() {
-		fd.Comments[4] = append(fd.Comments[4], g.ToTypescriptComments()...)
+	if g.SkipReturnType(&fd.ReturnType) {
+		if fd.ReturnType == nil {
+			fd.Comments[4] = append(fd.Comments[4], g.ToTypescriptComments()...)
+		}
+
 		fd.Comments[4] = append(fd.Comments[4], g.AcceptRunWhitespaceComments()...)
 
 		j.Score(g)
@@ -124,10 +152,16 @@ func (fd *FunctionDeclaration) parse(j *jsParser, yield, await, def, export bool
 
 	g = j.NewGoal()
 
-	if err := fd.FunctionBody.parse(&g, fd.Type == FunctionGenerator, fd.Type == FunctionAsync, true); err != nil {
+	if err := fd.FunctionBody.parseFunctionBody(&g, fd.Type == FunctionGenerator, fd.Type == FunctionAsync, &fd.DirectivePrologue); err != nil {
 		return j.Error("FunctionDeclaration", err)
 	}
 
+	if fd.UseStrict() {
+		if err := checkStrictParameters("FunctionDeclaration", fd.BindingIdentifier, &fd.FormalParameters, &fd.DirectivePrologue); err != nil {
+			return j.Error("FunctionDeclaration", err)
+		}
+	}
+
 	j.Score(g)
 
 	fd.Tokens = j.ToTokens()
@@ -140,11 +174,15 @@ func (fd *FunctionDeclaration) parse(j *jsParser, yield, await, def, export bool
 //
 // Only one of BindingIdentifier, ArrayBindingPattern, or ObjectBindingPattern
 // can be non-nil.
+//
+// TypeAnnotation, which is only produced when parsing with a tokeniser created
+// by AsTypescriptAST, applies to the rest element.
 type FormalParameters struct {
 	FormalParameterList  []BindingElement
 	BindingIdentifier    *Token
 	ArrayBindingPattern  *ArrayBindingPattern
 	ObjectBindingPattern *ObjectBindingPattern
+	TypeAnnotation       *TypeAnnotation
 	Comments             [5]Comments
 	Tokens               Tokens
 }
@@ -215,6 +253,8 @@ func (fp *FormalParameters) parse(j *jsParser, yield, await bool) error {
 					}
 				} else if fp.BindingIdentifier = g.parseIdentifier(yield, await); fp.BindingIdentifier == nil {
 					return j.Error("FormalParameters", ErrNoIdentifier)
+				} else if err := g.checkStrictIdentifier("FormalParameters", fp.BindingIdentifier); err != nil {
+					return err
 				}
 
 				j.Score(g)
@@ -226,8 +266,11 @@ func (fp *FormalParameters) parse(j *jsParser, yield, await bool) error {
 
 				h := g.NewGoal()
 
-				if h.SkipColonType() {
-					fp.Comments[3] = append(fp.Comments[3], h.ToTypescriptComments()...)
+				if h.SkipColonType(&fp.TypeAnnotation) {
+					if fp.TypeAnnotation == nil {
+						fp.Comments[3] = append(fp.Comments[3], h.ToTypescriptComments()...)
+					}
+
 					fp.Comments[3] = append(fp.Comments[3], h.AcceptRunWhitespaceNoNewlineComments()...)
 
 					g.Score(h)
@@ -248,11 +291,48 @@ func (fp *FormalParameters) parse(j *jsParser, yield, await bool) error {
 			g = j.NewGoal()
 			be := len(fp.FormalParameterList)
 
-			fp.FormalParameterList = append(fp.FormalParameterList, BindingElement{})
+			ds, err := g.parseDecorators(yield, await)
+			if err != nil {
+				return j.Error("FormalParameters", err)
+			}
+
+			var c Comments
+
+			h := g.NewGoal()
+
+			h.AcceptRunWhitespace()
+
+			if h.SkipParameterModifiers() {
+				c = g.AcceptRunWhitespaceComments()
+
+				g.AcceptRunWhitespace()
+
+				h = g.NewGoal()
+
+				h.SkipParameterModifiers()
+
+				c = append(c, h.ToTypescriptComments()...)
+
+				g.Score(h)
+
+				h = g.NewGoal()
+
+				h.AcceptRunWhitespace()
+
+				if tk := h.Peek(); isParameterProperty(c) && (tk == parser.Token{Type: TokenPunctuator, Data: "{"} || tk == parser.Token{Type: TokenPunctuator, Data: "["}) {
+					return h.Error("FormalParameters", ErrInvalidParameter)
+				}
+			}
+
+			fp.FormalParameterList = append(fp.FormalParameterList, BindingElement{Decorators: ds})
 			if err := fp.FormalParameterList[be].parse(&g, nil, yield, await); err != nil {
 				return j.Error("FormalParameters", err)
 			}
 
+			if c != nil {
+				fp.FormalParameterList[be].Comments[0] = append(c, fp.FormalParameterList[be].Comments[0]...)
+			}
+
 			j.Score(g)
 
 			g = j.NewGoal()
@@ -300,10 +380,19 @@ func (fp *FormalParameters) hasSingleLineComment() bool {
 // must be non-nil.
 //
 // The Initializer is optional.
+//
+// Decorators, as defined in the TC39 Decorators proposal, are only permitted
+// on the elements of a FormalParameterList.
+//
+// Optional and TypeAnnotation are only produced when parsing with a tokeniser
+// created by AsTypescriptAST.
 type BindingElement struct {
+	Decorators           []Decorator
 	SingleNameBinding    *Token
 	ArrayBindingPattern  *ArrayBindingPattern
 	ObjectBindingPattern *ObjectBindingPattern
+	Optional             bool
+	TypeAnnotation       *TypeAnnotation
 	Initializer          *AssignmentExpression
 	Comments             [2]Comments
 	Tokens               Tokens
@@ -332,6 +421,8 @@ func (be *BindingElement) parse(j *jsParser, singleNameBinding *Token, yield, aw
 		}
 	} else if be.SingleNameBinding = g.parseIdentifier(yield, await); be.SingleNameBinding == nil {
 		return j.Error("BindingElement", ErrNoIdentifier)
+	} else if err := g.checkStrictIdentifier("BindingElement", be.SingleNameBinding); err != nil {
+		return err
 	}
 
 	j.Score(g)
@@ -344,8 +435,11 @@ func (be *BindingElement) parse(j *jsParser, singleNameBinding *Token, yield, aw
 
 	h := g.NewGoal()
 
-	if h.SkipOptionalColonType() {
-		be.Comments[1] = append(be.Comments[1], h.ToTypescriptComments()...)
+	if h.SkipOptionalColonType(&be.Optional, &be.TypeAnnotation) {
+		if !be.Optional && be.TypeAnnotation == nil {
+			be.Comments[1] = append(be.Comments[1], h.ToTypescriptComments()...)
+		}
+
 		be.Comments[1] = append(be.Comments[1], h.AcceptRunWhitespaceCommentsInList()...)
 
 		g.Score(h)
@@ -5,13 +5,19 @@ import (
 )
 
 // Module represents the top-level of a parsed JavaScript module
+//
+// Hashbang, when non-nil, is the Hashbang Comment at the start of the module.
 type Module struct {
+	Hashbang        *Token
 	ModuleListItems []ModuleItem
 	Comments        [2]Comments
 	Tokens          Tokens
 }
 
 // ParseModule parses a JavaScript module
+//
+// A module is always strict mode code, and so with statements, legacy octal
+// literals and reserved identifiers, such as 'package', are rejected.
 func ParseModule(t Tokeniser) (*Module, error) {
 	j, err := newJSParser(t)
 	if err != nil {
@@ -26,7 +32,41 @@ func ParseModule(t Tokeniser) (*Module, error) {
 	return m, nil
 }
 
+// ParseModuleWithRecovery parses a JavaScript module into an AST, recovering
+// from errors by skipping to the end of the statement that failed to parse,
+// both at the top-level and within blocks and case clauses.
+//
+// Each ModuleItem or StatementListItem that could not be parsed is replaced by
+// a placeholder, which only has its Tokens set. The returned Module is never
+// nil, and every error is returned, positioned at the token that caused it
+// and ordered by that position, with only the first error at each position
+// being kept.
+//
+// When the input cannot be tokenised, the invalid input is skipped to the end
+// of its line, or to the next semi-colon or closing bracket, and tokenising
+// resumes from there.
+func ParseModuleWithRecovery(t Tokeniser) (*Module, []Error) {
+	j := newRecoveringJSParser(t)
+	m := new(Module)
+
+	m.parse(&j)
+
+	return m, j.endRecovery()
+}
+
 func (m *Module) parse(j *jsParser) error {
+	defer j.setStrict(j.IsStrict())
+
+	j.setStrict(true)
+
+	defer j.enterScope()()
+
+	if j.Accept(TokenHashbang) {
+		m.Hashbang = j.GetLastToken()
+
+		j.AcceptRunWhitespaceNoComment()
+	}
+
 	m.Comments[0] = j.AcceptRunWhitespaceNoNewlineComments()
 	g := j.NewGoal()
 
@@ -38,7 +78,11 @@ func (m *Module) parse(j *jsParser) error {
 
 		m.ModuleListItems = append(m.ModuleListItems, ModuleItem{})
 		if err := m.ModuleListItems[ml].parse(&g); err != nil {
-			return err
+			if g = j.NewGoal(); !g.recover("ModuleItem", err, false) {
+				return err
+			}
+
+			m.ModuleListItems[ml] = ModuleItem{Tokens: g.ToTokens()}
 		}
 
 		j.Score(g)
@@ -46,6 +90,10 @@ func (m *Module) parse(j *jsParser) error {
 		g = j.NewGoal()
 	}
 
+	if j.IsTypescript() {
+		inlineConstEnums(m.ModuleListItems, *j.marker())
+	}
+
 	m.Comments[1] = j.AcceptRunWhitespaceComments()
 	m.Tokens = j.ToTokens()
 
@@ -57,6 +105,8 @@ func (m *Module) parse(j *jsParser) error {
 //
 // Only one of ImportDeclaration, StatementListItem, or ExportDeclaration must
 // be non-nil.
+//
+// All may be nil for a placeholder produced by ParseModuleWithRecovery.
 type ModuleItem struct {
 	ImportDeclaration *ImportDeclaration
 	StatementListItem *StatementListItem
@@ -69,17 +119,78 @@ func (ml *ModuleItem) parse(j *jsParser) error {
 
 	g.AcceptRunWhitespace()
 
-	switch g.Peek() {
+	tk := g.Peek()
+
+	if tk == (parser.Token{Type: TokenPunctuator, Data: "@"}) {
+		h := g.NewGoal()
+
+		if h.skipDecorators() {
+			h.AcceptRunWhitespace()
+
+			if h.Peek() == (parser.Token{Type: TokenKeyword, Data: "export"}) {
+				tk = h.Peek()
+			}
+		}
+	}
+
+	switch tk {
 	case parser.Token{Type: TokenKeyword, Data: "export"}:
-		if g.SkipExportType() {
-			ml.StatementListItem = &StatementListItem{
-				Comments: [2]Comments{g.ToTypescriptComments()},
-				Tokens:   g.ToTokens(),
+		var d *Declaration
+
+		if g.SkipExportType(&d) {
+			if d != nil {
+				ml.ExportDeclaration = &ExportDeclaration{
+					Declaration: d,
+					Tokens:      g.ToTokens(),
+				}
+			} else {
+				ml.StatementListItem = &StatementListItem{
+					Comments: [2]Comments{g.ToTypescriptComments()},
+					Tokens:   g.ToTokens(),
+				}
 			}
 
 			break
 		}
 
+		if h := g.NewGoal(); h.AcceptToken(parser.Token{Type: TokenKeyword, Data: "export"}) {
+			h.AcceptRunWhitespace()
+
+			if h.isEnumOrNamespace() {
+				s, d, err := h.parseEnumOrNamespace(nil)
+				if err != nil {
+					return g.Error("ModuleItem", err)
+				}
+
+				g.Score(h)
+
+				if s != nil && s.VariableStatement != nil {
+					ml.ExportDeclaration = &ExportDeclaration{
+						VariableStatement: s.VariableStatement,
+						Tokens:            g.ToTokens(),
+					}
+				} else if s != nil {
+					s.Tokens = h.ToTokens()
+					ml.StatementListItem = &StatementListItem{
+						Statement: s,
+						Tokens:    g.ToTokens(),
+					}
+				} else if d != nil {
+					ml.ExportDeclaration = &ExportDeclaration{
+						Declaration: d,
+						Tokens:      g.ToTokens(),
+					}
+				} else {
+					ml.StatementListItem = &StatementListItem{
+						Comments: [2]Comments{g.ToTypescriptComments()},
+						Tokens:   g.ToTokens(),
+					}
+				}
+
+				break
+			}
+		}
+
 		g = j.NewGoal()
 
 		ml.ExportDeclaration = new(ExportDeclaration)
@@ -165,6 +276,10 @@ func (id *ImportDeclaration) parse(j *jsParser) error {
 
 		id.FromClause.Tokens = g.ToTokens()
 		id.ModuleSpecifier = &id.FromClause.Tokens[0]
+
+		if err := j.checkStrictLiteral("ImportDeclaration", id.ModuleSpecifier); err != nil {
+			return err
+		}
 	} else {
 		j.AcceptRunWhitespaceNoComment()
 
@@ -363,6 +478,8 @@ func (ic *ImportClause) parse(j *jsParser) error {
 
 		if ic.ImportedDefaultBinding = g.parseIdentifier(false, false); ic.ImportedDefaultBinding == nil {
 			return j.Error("ImportClause", ErrNoIdentifier)
+		} else if err := g.checkStrictIdentifier("ImportClause", ic.ImportedDefaultBinding); err != nil {
+			return err
 		}
 
 		j.Score(g)
@@ -402,6 +519,8 @@ func (ic *ImportClause) parse(j *jsParser) error {
 
 		if ic.NameSpaceImport = j.parseIdentifier(false, false); ic.NameSpaceImport == nil {
 			return j.Error("ImportClause", ErrNoIdentifier)
+		} else if err := j.checkStrictIdentifier("ImportClause", ic.NameSpaceImport); err != nil {
+			return err
 		}
 	} else if j.Peek() == (parser.Token{Type: TokenPunctuator, Data: "{"}) {
 		g := j.NewGoal()
@@ -446,6 +565,11 @@ func (fc *FromClause) parse(j *jsParser) error {
 	}
 
 	fc.ModuleSpecifier = j.GetLastToken()
+
+	if err := j.checkStrictLiteral("FromClause", fc.ModuleSpecifier); err != nil {
+		return err
+	}
+
 	fc.Tokens = j.ToTokens()
 
 	return nil
@@ -592,6 +716,8 @@ func (is *ImportSpecifier) parse(j *jsParser) error {
 			is.IdentifierName = is.ImportedBinding
 			if is.ImportedBinding = g.parseIdentifier(false, false); is.ImportedBinding == nil {
 				return g.Error("ImportSpecifier", ErrNoIdentifier)
+			} else if err := g.checkStrictIdentifier("ImportSpecifier", is.ImportedBinding); err != nil {
+				return err
 			}
 
 			j.Score(g)
@@ -625,7 +751,12 @@ func (is *ImportSpecifier) hasSingleLineComment() bool {
 // DefaultAssignmentExpression to be non-nil.
 //
 // FromClause can be non-nil exclusively or paired with ExportClause.
+//
+// Decorators, as defined in the TC39 Decorators proposal, are those that
+// precede the export keyword, and are only valid when the exported
+// ClassDeclaration has no Decorators of its own.
 type ExportDeclaration struct {
+	Decorators                  []Decorator
 	ExportClause                *ExportClause
 	ExportFromClause            *Token
 	FromClause                  *FromClause
@@ -643,10 +774,33 @@ func (ed *ExportDeclaration) parse(j *jsParser) error {
 
 	j.AcceptRunWhitespace()
 
+	ds, err := j.parseDecorators(false, true)
+	if err != nil {
+		return j.Error("ExportDeclaration", err)
+	}
+
+	ed.Decorators = ds
+
+	j.AcceptRunWhitespace()
+
 	if !j.AcceptToken(parser.Token{Type: TokenKeyword, Data: "export"}) {
 		return j.Error("ExportDeclaration", ErrInvalidExportDeclaration)
 	}
 
+	if len(ds) > 0 {
+		g := j.NewGoal()
+
+		g.AcceptRunWhitespace()
+
+		if g.AcceptToken(parser.Token{Type: TokenKeyword, Data: "default"}) {
+			g.AcceptRunWhitespace()
+		}
+
+		if g.Peek() != (parser.Token{Type: TokenKeyword, Data: "class"}) {
+			return g.Error("ExportDeclaration", ErrInvalidDecorator)
+		}
+	}
+
 	ed.Comments[1] = j.AcceptRunWhitespaceComments()
 
 	j.AcceptRunWhitespace()
@@ -666,7 +820,7 @@ func (ed *ExportDeclaration) parse(j *jsParser) error {
 			}
 
 			j.Score(g)
-		case "class":
+		case "class", "@":
 			ed.DefaultClass = new(ClassDeclaration)
 			if err := ed.DefaultClass.parse(&g, false, false, true); err != nil {
 				return j.Error("ExportDeclaration", err)
@@ -684,12 +838,18 @@ func (ed *ExportDeclaration) parse(j *jsParser) error {
 			if i.Peek() == (parser.Token{Type: TokenKeyword, Data: "class"}) {
 				h.Skip()
 
-				ed.Comments[2] = append(ed.Comments[2], h.ToTypescriptComments()...)
+				ed.DefaultClass = new(ClassDeclaration)
+
+				if h.IsTypescriptAST() {
+					ed.DefaultClass.Abstract = true
+				} else {
+					ed.Comments[2] = append(ed.Comments[2], h.ToTypescriptComments()...)
+				}
+
 				ed.Comments[2] = append(ed.Comments[2], h.AcceptRunWhitespaceComments()...)
 
 				h.AcceptRunWhitespace()
 
-				ed.DefaultClass = new(ClassDeclaration)
 				if err := ed.DefaultClass.parse(&h, false, false, true); err != nil {
 					return j.Error("ExportDeclaration", err)
 				}
@@ -797,7 +957,10 @@ func (ed *ExportDeclaration) parse(j *jsParser) error {
 		if !j.parseSemicolon() {
 			return j.Error("ExportDeclaration", ErrMissingSemiColon)
 		}
+	} else if g.AcceptRunWhitespace(); g.isUsingDeclaration(false, true) {
+		return g.Error("ExportDeclaration", ErrInvalidExportDeclaration)
 	} else {
+		g = j.NewGoal()
 		ed.Declaration = new(Declaration)
 		if err := ed.Declaration.parse(&g, false, true, true); err != nil {
 			return j.Error("ExportDeclaration", err)
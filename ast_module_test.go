@@ -1,6 +1,10 @@
 package javascript
 
-import "testing"
+import (
+	"testing"
+
+	"vimagination.zapto.org/parser"
+)
 
 func TestModuleOld(t *testing.T) {
 	doTests(t, []sourceFn{
@@ -485,7 +489,8 @@ func TestModuleOld(t *testing.T) {
 									FunctionBody: Block{
 										Tokens: tk[7:9],
 									},
-									Tokens: tk[2:9],
+									DirectivePrologue: DirectivePrologue{Strict: true},
+									Tokens:            tk[2:9],
 								},
 								Tokens: tk[2:9],
 							},
@@ -509,7 +514,8 @@ func TestModuleOld(t *testing.T) {
 								FunctionBody: Block{
 									Tokens: tk[7:9],
 								},
-								Tokens: tk[4:9],
+								DirectivePrologue: DirectivePrologue{Strict: true},
+								Tokens:            tk[4:9],
 							},
 							Tokens: tk[:9],
 						},
@@ -712,6 +718,23 @@ func TestModule(t *testing.T) {
 				Tokens:   tk[:5],
 			}
 		}},
+		{"#!/usr/bin/env node\n;", func(t *test, tk Tokens) { // 6
+			t.Output = Module{
+				Hashbang: &tk[0],
+				ModuleListItems: []ModuleItem{
+					{
+						StatementListItem: &StatementListItem{
+							Statement: &Statement{
+								Tokens: tk[2:3],
+							},
+							Tokens: tk[2:3],
+						},
+						Tokens: tk[2:3],
+					},
+				},
+				Tokens: tk[:3],
+			}
+		}},
 	}, func(t *test) (Type, error) {
 		var m Module
 
@@ -2071,6 +2094,20 @@ func TestExportDeclaration(t *testing.T) {
 				Tokens:   tk[:9],
 			}
 		}},
+		{"export using a = b;", func(t *test, tk Tokens) { // 31
+			t.Err = Error{
+				Err:     ErrInvalidExportDeclaration,
+				Parsing: "ExportDeclaration",
+				Token:   tk[2],
+			}
+		}},
+		{"export await using a = b;", func(t *test, tk Tokens) { // 32
+			t.Err = Error{
+				Err:     ErrInvalidExportDeclaration,
+				Parsing: "ExportDeclaration",
+				Token:   tk[2],
+			}
+		}},
 	}, func(t *test) (Type, error) {
 		var ed ExportDeclaration
 
@@ -2251,3 +2288,119 @@ func TestExportSpecifier(t *testing.T) {
 		return es, err
 	})
 }
+
+func TestParseModuleWithRecovery(t *testing.T) {
+	for n, test := range [...]struct {
+		Input        string
+		Placeholders []bool
+		ErrLines     []uint64
+	}{
+		{ // 1
+			"let a = 1;\nconst b = 2;",
+			[]bool{false, false},
+			nil,
+		},
+		{ // 2
+			"let a = 1;\nlet = ;\nfoo(\n  1 2);\nfunction f() { a b }\nconst c = 3;",
+			[]bool{false, true, true, false, false},
+			[]uint64{1, 3, 4},
+		},
+		{ // 3
+			"a b\nc();",
+			[]bool{true, false},
+			[]uint64{0},
+		},
+		{ // 4
+			"import a;\nexport {a};",
+			[]bool{true, false},
+			[]uint64{0},
+		},
+		{ // 5
+			"a();\nb = 'c",
+			[]bool{false, true},
+			[]uint64{1},
+		},
+		{ // 6
+			"with (a) b;\nvar c = 010;",
+			[]bool{true, true},
+			[]uint64{0, 1},
+		},
+		{ // 7
+			"function f() {\n\ta b;\n\tc d;\n\treturn 1;\n}\ne();",
+			[]bool{false, false},
+			[]uint64{1, 2},
+		},
+		{ // 8
+			"function f() {\n\tif (a) {\n\t\tb c;\n\t}\n\td e\n}",
+			[]bool{false},
+			[]uint64{2, 4},
+		},
+		{ // 9
+			"switch (a) {\ncase 1:\n\tb c;\n\tbreak;\ndefault:\n\td e\n}",
+			[]bool{false},
+			[]uint64{2, 5},
+		},
+		{ // 10
+			"a b;\nc = 'd",
+			[]bool{true, true},
+			[]uint64{0, 1},
+		},
+		{ // 11
+			"a = {\n\tb: [1, {c: 2}]\n} d;\ne();",
+			[]bool{true, false},
+			[]uint64{2},
+		},
+		{ // 12
+			"if (a) {\n\tb = {c: 1}\n} else d e\nf();",
+			[]bool{true, false},
+			[]uint64{2},
+		},
+		{ // 13
+			"a(;\nb();",
+			[]bool{true, false},
+			[]uint64{0, 1},
+		},
+		{ // 14
+			"a = 'b\nc();\nd = #;\ne();",
+			[]bool{true, false, true, false},
+			[]uint64{0, 2},
+		},
+		{ // 15
+			"a = 1 +\n\n;\nb();",
+			[]bool{true, false},
+			[]uint64{2},
+		},
+		{ // 16
+			"function f() {\n\ta(;\n\tb();\n}\nc();",
+			[]bool{false, false},
+			[]uint64{1},
+		},
+		{ // 17
+			"a = [1, 2 3];\nb(\n\tc d,\n\te\n);\nf();",
+			[]bool{true, true, false, true, false},
+			[]uint64{0, 2, 4},
+		},
+	} {
+		m, errs := ParseModuleWithRecovery(makeTokeniser(parser.NewStringTokeniser(test.Input)))
+
+		if len(m.ModuleListItems) != len(test.Placeholders) {
+			t.Errorf("test %d: expecting %d items, got %d", n+1, len(test.Placeholders), len(m.ModuleListItems))
+		} else {
+			for i, mi := range m.ModuleListItems {
+				if placeholder := mi.ImportDeclaration == nil && mi.StatementListItem == nil && mi.ExportDeclaration == nil; placeholder != test.Placeholders[i] {
+					t.Errorf("test %d.%d: expecting placeholder %v, got %v", n+1, i+1, test.Placeholders[i], placeholder)
+				}
+			}
+		}
+
+		if len(errs) != len(test.ErrLines) {
+			t.Errorf("test %d: expecting %d errors, got %d: %v", n+1, len(test.ErrLines), len(errs), errs)
+		} else {
+			for i, err := range errs {
+				if err.Token.Line != test.ErrLines[i] {
+					t.Errorf("test %d.%d: expecting error on line %d, got: %s", n+1, i+1, test.ErrLines[i], err)
+				}
+			}
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package javascript
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
 	"slices"
 	"strings"
@@ -61,7 +63,22 @@ func tokeniserFlags(t Tokeniser) (bool, bool) {
 	return false, false
 }
 
-type jsParser Tokens
+type jsParser struct {
+	tokens Tokens
+	state  *parseState
+}
+
+// parseState holds the state of a parse that is shared between all of its
+// goals.
+type parseState struct {
+	errs   *[]Error
+	strict bool
+
+	// declarations holds, for each enclosing statement list of a Typescript
+	// parse, the names of the declarations that enums and namespaces can merge
+	// with, each mapped to the members exported by namespaces of that name.
+	declarations []map[string][]*Token
+}
 
 // Tokeniser is an interface representing a tokeniser.
 type Tokeniser interface {
@@ -72,14 +89,16 @@ type Tokeniser interface {
 }
 
 func newJSParser(t Tokeniser) (jsParser, error) {
-	ts, jsx := tokeniserFlags(t)
-
-	if !ts && !jsx {
-		t.TokeniserState(new(jsTokeniser).inputElement)
+	if ts, jsx := tokeniserFlags(t); !ts && !jsx {
+		t.TokeniserState(new(jsTokeniser).hashbang)
 	}
 
+	return tokenise(t)
+}
+
+func tokenise(t Tokeniser) (jsParser, error) {
 	var (
-		tokens             jsParser
+		tokens             Tokens
 		pos, line, linePos uint64
 		err                error
 	)
@@ -147,31 +166,144 @@ func newJSParser(t Tokeniser) (jsParser, error) {
 		pos += uint64(len(tk.Data))
 	}
 
-	return tokens[0:0:len(tokens)], err
+	if sm := tokeniserSourceMap(t); sm != nil && err == nil {
+		err = sm.rebase(tokens)
+	}
+
+	return jsParser{tokens: tokens[0:0:len(tokens)], state: new(parseState)}, err
+}
+
+func newRecoveringJSParser(t Tokeniser) jsParser {
+	ts, jsx := tokeniserFlags(t)
+	r := &recoveringTokeniser{jsTokeniser: jsTokeniser{isTypescript: ts, isJSX: jsx}}
+
+	t.TokeniserState(r.wrap(r.hashbang))
+
+	j, err := tokenise(t)
+	j.state.errs = new([]Error)
+	tks := j.tokens[:cap(j.tokens)]
+
+	for _, te := range r.errs {
+		if n, _ := slices.BinarySearchFunc(tks, te.pos, comparePos); n < len(tks) {
+			*j.state.errs = append(*j.state.errs, Error{
+				Err:     te.err,
+				Parsing: "Tokens",
+				Token:   tks[n],
+			})
+		}
+	}
+
+	if err != nil {
+		if len(tks) > 0 && tks[len(tks)-1].Type == parser.TokenError {
+			last := &tks[len(tks)-1]
+			last.Type = parser.TokenDone
+			last.Data = ""
+
+			if ts {
+				last.Data = tsMarker
+			}
+		}
+
+		*j.state.errs = append(*j.state.errs, j.toError("Tokens", err))
+	}
+
+	return j
+}
+
+func (j *jsParser) recoveryErrors() *[]Error {
+	if j.state != nil {
+		return j.state.errs
+	}
+
+	return nil
+}
+
+func (j *jsParser) endRecovery() []Error {
+	errs := j.recoveryErrors()
+	if errs == nil {
+		return nil
+	}
+
+	slices.SortStableFunc(*errs, func(a, b Error) int {
+		return cmp.Compare(a.Token.Pos, b.Token.Pos)
+	})
+
+	return slices.CompactFunc(*errs, func(a, b Error) bool {
+		return a.Token.Pos == b.Token.Pos
+	})
+}
+
+func comparePos(tk Token, pos uint64) int {
+	return cmp.Compare(tk.Pos, pos)
+}
+
+// marker returns the final token of the parsed tokens, which holds the
+// Typescript settings for the parse.
+func (j *jsParser) marker() *Token {
+	return &j.tokens[:cap(j.tokens)][cap(j.tokens)-1]
+}
+
+// recover, when parsing with recovery, records the error, positioned at the
+// first non-whitespace token at or after the one that caused it, and skips to
+// the end of the statement containing it.
+//
+// When nested is true, a closing brace that ends the enclosing statement list
+// is not skipped.
+//
+// Returns false when not parsing with recovery, or when there is nothing to
+// skip.
+func (j *jsParser) recover(parsingFunc string, err error, nested bool) bool {
+	errs := j.recoveryErrors()
+	if errs == nil {
+		return false
+	}
+
+	e := j.toError(parsingFunc, err)
+
+	for inner := e; errors.As(inner.Err, &inner); {
+		e.Token = inner.Token
+	}
+
+	tks := j.tokens[:cap(j.tokens)]
+
+	if n, _ := slices.BinarySearchFunc(tks, e.Token.Pos, comparePos); n < len(tks) {
+		for ; n < len(tks)-1 && isWhitespace(tks[n].Type); n++ {
+		}
+
+		e.Token = tks[n]
+	}
+
+	if j.SkipStatement(nested, e.Token.Pos); len(j.tokens) == 0 {
+		return false
+	}
+
+	*errs = append(*errs, e)
+
+	return true
 }
 
 func (j jsParser) NewGoal() jsParser {
-	return j[len(j):]
+	return jsParser{tokens: j.tokens[len(j.tokens):], state: j.state}
 }
 
 func (j *jsParser) Score(k jsParser) {
-	*j = (*j)[:len(*j)+len(k)]
+	j.tokens = j.tokens[:len(j.tokens)+len(k.tokens)]
 }
 
 func (j *jsParser) next() *Token {
-	l := len(*j)
-	if l == cap(*j) {
-		return &(*j)[l-1]
+	l := len(j.tokens)
+	if l == cap(j.tokens) {
+		return &j.tokens[l-1]
 	}
 
-	*j = (*j)[:l+1]
-	tk := (*j)[l]
+	j.tokens = j.tokens[:l+1]
+	tk := j.tokens[l]
 
 	return &tk
 }
 
 func (j *jsParser) backup() {
-	*j = (*j)[:len(*j)-1]
+	j.tokens = j.tokens[:len(j.tokens)-1]
 }
 
 func (j *jsParser) Peek() parser.Token {
@@ -256,6 +388,91 @@ func (j *jsParser) SkipDepth() bool {
 	return true
 }
 
+// SkipStatement skips the tokens of a statement that failed to parse with an
+// error at the given position.
+//
+// Once past the error, the statement ends at a semi-colon with no brackets
+// open, or at a line terminator with no brackets open other than those opened
+// before the error. A closing brace that would close the enclosing statement
+// list also ends the statement, and is only skipped when nested is false.
+func (j *jsParser) SkipStatement(nested bool, errPos uint64) {
+	var (
+		open     []string
+		errDepth = -1
+	)
+
+	for {
+		switch j.Peek().Type {
+		case parser.TokenDone, parser.TokenError:
+			return
+		}
+
+		tk := j.next()
+
+		if errDepth == -1 && tk.Pos >= errPos {
+			errDepth = len(open)
+		}
+
+		switch tk.Type {
+		case TokenLineTerminator:
+			if errDepth != -1 && len(open) <= errDepth {
+				j.backup()
+
+				return
+			}
+		case TokenRightBracePunctuator:
+			l := len(open) - 1
+
+			for ; l >= 0 && open[l] != "{"; l-- {
+			}
+
+			if l == -1 {
+				if nested {
+					j.backup()
+				}
+
+				return
+			}
+
+			open = open[:l]
+		case TokenPunctuator:
+			switch tk.Data {
+			case "[", "(", "{":
+				open = append(open, tk.Data)
+			case "]", ")":
+				if l := len(open) - 1; l >= 0 && open[l] == depthOpener(tk.Data) {
+					open = open[:l]
+				}
+			case ";":
+				if errDepth != -1 && len(open) == 0 {
+					return
+				}
+			}
+		}
+
+		if errDepth > len(open) {
+			errDepth = len(open)
+		}
+	}
+}
+
+func depthOpener(closer string) string {
+	if closer == ")" {
+		return "("
+	}
+
+	return "["
+}
+
+func isWhitespace(typ parser.TokenType) bool {
+	switch typ {
+	case TokenWhitespace, TokenLineTerminator, TokenSingleLineComment, TokenMultiLineComment:
+		return true
+	}
+
+	return false
+}
+
 func (j *jsParser) AcceptToken(tk parser.Token) bool {
 	if j.next().Token == tk {
 		return true
@@ -267,18 +484,18 @@ func (j *jsParser) AcceptToken(tk parser.Token) bool {
 }
 
 func (j *jsParser) ToTokens() Tokens {
-	return Tokens((*j)[:len(*j):len(*j)])
+	return j.tokens[:len(j.tokens):len(j.tokens)]
 }
 
 func (j jsParser) ToTypescriptComments() Comments {
-	if len(j) == 0 {
+	if len(j.tokens) == 0 {
 		return nil
 	}
 
-	c := make(Comments, len(j))
+	c := make(Comments, len(j.tokens))
 
-	for n := range j {
-		c[n] = &(j)[n]
+	for n := range j.tokens {
+		c[n] = &j.tokens[n]
 		c[n].Type |= tokenTypescript
 	}
 
@@ -383,7 +600,7 @@ func (j *jsParser) AcceptRunWhitespaceCommentsInList() Comments {
 }
 
 func (j *jsParser) GetLastToken() *Token {
-	return &(*j)[len(*j)-1]
+	return &j.tokens[len(j.tokens)-1]
 }
 
 // Error is a parsing error with trace details.
@@ -403,6 +620,16 @@ func (e Error) Unwrap() error {
 	return e.Err
 }
 
+func (j *jsParser) toError(parsingFunc string, err error) Error {
+	var e Error
+
+	if errors.As(err, &e) {
+		return e
+	}
+
+	return j.Error(parsingFunc, err).(Error)
+}
+
 func (j *jsParser) Error(parsingFunc string, err error) error {
 	tk := j.next()
 
@@ -12,13 +12,13 @@ import (
 func TestNewJSParser(t *testing.T) {
 	for n, test := range [...]struct {
 		Source   string
-		JSParser jsParser
+		JSParser Tokens
 		Err      error
 	}{
-		{"", jsParser{Token{Token: parser.Token{Type: parser.TokenDone}}}, nil},
+		{"", Tokens{Token{Token: parser.Token{Type: parser.TokenDone}}}, nil},
 		{
 			"\"use strict\";\n\nvar hello = `World\n!`;",
-			jsParser{
+			Tokens{
 				{
 					parser.Token{Type: TokenStringLiteral, Data: "\"use strict\""},
 					0, 0, 0,
@@ -71,18 +71,18 @@ func TestNewJSParser(t *testing.T) {
 			nil,
 		},
 		{
-			"@",
-			jsParser{
+			"¬",
+			Tokens{
 				{
-					parser.Token{Type: parser.TokenError, Data: "invalid character: @"},
+					parser.Token{Type: parser.TokenError, Data: "invalid character: ¬"},
 					0, 0, 0,
 				},
 			},
 			Error{
-				Err:     fmt.Errorf("%w: %s", ErrInvalidCharacter, "@"),
+				Err:     fmt.Errorf("%w: %s", ErrInvalidCharacter, "¬"),
 				Parsing: "Tokens",
 				Token: Token{
-					parser.Token{Type: parser.TokenError, Data: "invalid character: @"},
+					parser.Token{Type: parser.TokenError, Data: "invalid character: ¬"},
 					0, 0, 0,
 				},
 			},
@@ -94,7 +94,7 @@ func TestNewJSParser(t *testing.T) {
 			t.Errorf("test %d: expecting error %q, got %q", n+1, test.Err, err)
 		}
 
-		for m, tk := range j[:cap(j)] {
+		for m, tk := range j.tokens[:cap(j.tokens)] {
 			tkp := j.Peek()
 			tkn := j.next()
 			tkl := j.GetLastToken()
@@ -110,18 +110,18 @@ func TestNewJSParser(t *testing.T) {
 
 		if test.Err == nil {
 			if tk := j.next(); tk.Type != parser.TokenDone {
-				t.Errorf("test %d: expecting TokenDone, got %v", cap(j)+1, tk)
+				t.Errorf("test %d: expecting TokenDone, got %v", cap(j.tokens)+1, tk)
 			}
 		}
 	}
 
 	pErr := Error{
-		Err:     fmt.Errorf("%w: %s", ErrInvalidCharacter, "@"),
+		Err:     fmt.Errorf("%w: %s", ErrInvalidCharacter, "¬"),
 		Parsing: "Tokens",
 		Token: Token{
 			Token: parser.Token{
 				Type: parser.TokenError,
-				Data: "invalid character: @",
+				Data: "invalid character: ¬",
 			},
 			Pos:     0,
 			Line:    0,
@@ -129,11 +129,11 @@ func TestNewJSParser(t *testing.T) {
 		},
 	}
 
-	if _, err := ParseScript(makeTokeniser(parser.NewStringTokeniser("@"))); !reflect.DeepEqual(err, pErr) {
+	if _, err := ParseScript(makeTokeniser(parser.NewStringTokeniser("¬"))); !reflect.DeepEqual(err, pErr) {
 		t.Errorf("Script token error test: expecting %s, got %s", pErr, err)
 	}
 
-	if _, err := ParseModule(makeTokeniser(parser.NewStringTokeniser("@"))); !reflect.DeepEqual(err, pErr) {
+	if _, err := ParseModule(makeTokeniser(parser.NewStringTokeniser("¬"))); !reflect.DeepEqual(err, pErr) {
 		t.Errorf("Module token error test: expecting %s, got %s", pErr, err)
 	}
 
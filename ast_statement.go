@@ -11,10 +11,31 @@ type Block struct {
 }
 
 func (b *Block) parse(j *jsParser, yield, await, ret bool) error {
+	return b.parseStatementList(j, yield, await, ret, nil)
+}
+
+// parseFunctionBody parses the Block as the body of a function, filling in
+// the given DirectivePrologue. The remainder of the body is parsed as strict
+// mode code when it contains a 'use strict' directive.
+func (b *Block) parseFunctionBody(j *jsParser, yield, await bool, dp *DirectivePrologue) error {
+	defer j.setStrict(j.IsStrict())
+
+	if err := b.parseStatementList(j, yield, await, true, dp); err != nil {
+		return err
+	}
+
+	dp.Strict = j.IsStrict()
+
+	return nil
+}
+
+func (b *Block) parseStatementList(j *jsParser, yield, await, ret bool, dp *DirectivePrologue) error {
 	if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "{"}) {
 		return j.Error("Block", ErrMissingOpeningBrace)
 	}
 
+	defer j.enterScope()()
+
 	b.Comments[0] = j.AcceptRunWhitespaceNoNewlineComments()
 
 	for {
@@ -33,7 +54,15 @@ func (b *Block) parse(j *jsParser, yield, await, ret bool) error {
 
 		b.StatementList = append(b.StatementList, StatementListItem{})
 		if err := b.StatementList[si].parse(&g, yield, await, ret); err != nil {
-			return j.Error("Block", err)
+			if g = j.NewGoal(); !g.recover("Block", err, true) {
+				return j.Error("Block", err)
+			}
+
+			b.StatementList[si] = StatementListItem{Tokens: g.ToTokens()}
+		} else if dp != nil && len(dp.Directives) == si {
+			if err := j.addDirective(&b.StatementList[si], dp); err != nil {
+				return j.Error("Block", err)
+			}
 		}
 
 		j.Score(g)
@@ -52,6 +81,9 @@ func (b *Block) parse(j *jsParser, yield, await, ret bool) error {
 // StatementListItem as defined in ECMA-262
 // https://262.ecma-international.org/11.0/#prod-StatementListItem
 // Only one of Statement, or Declaration must be non-nil.
+//
+// Both may be nil for a placeholder produced by ParseModuleWithRecovery or
+// ParseScriptWithRecovery.
 type StatementListItem struct {
 	Statement   *Statement
 	Declaration *Declaration
@@ -66,8 +98,15 @@ func (si *StatementListItem) parse(j *jsParser, yield, await, ret bool) error {
 
 	g := j.NewGoal()
 
-	if g.SkipType() || g.SkipInterface() || g.SkipDeclare() {
-		si.Comments[1] = g.ToTypescriptComments()
+	var d Declaration
+
+	if g.SkipType(&d.TypeAliasDeclaration) || g.SkipInterface(&d.InterfaceDeclaration) || g.SkipDeclare() {
+		if d.TypeAliasDeclaration != nil || d.InterfaceDeclaration != nil {
+			d.Tokens = g.ToTokens()
+			si.Declaration = &d
+		} else {
+			si.Comments[1] = g.ToTypescriptComments()
+		}
 
 		j.Score(g)
 
@@ -78,11 +117,45 @@ func (si *StatementListItem) parse(j *jsParser, yield, await, ret bool) error {
 
 	g = j.NewGoal()
 
+	if g.isEnumOrNamespace() {
+		s, d, err := g.parseEnumOrNamespace(nil)
+		if err != nil {
+			return j.Error("StatementListItem", err)
+		}
+
+		if s != nil {
+			s.Tokens = g.ToTokens()
+			si.Statement = s
+		} else if d != nil {
+			si.Declaration = d
+		} else {
+			si.Comments[1] = g.ToTypescriptComments()
+		}
+
+		j.Score(g)
+
+		si.Tokens = j.ToTokens()
+
+		return nil
+	}
+
 	var declaration bool
 
 	switch t := g.Peek(); t {
 	case parser.Token{Type: TokenIdentifier, Data: "let"}, parser.Token{Type: TokenKeyword, Data: "const"}:
 		declaration = true
+	case parser.Token{Type: TokenIdentifier, Data: "using"}, parser.Token{Type: TokenKeyword, Data: "await"}:
+		declaration = g.isUsingDeclaration(yield, await)
+	case parser.Token{Type: TokenPunctuator, Data: "@"}:
+		if g.skipDecorators() {
+			g.AcceptRunWhitespace()
+
+			if g.AcceptToken(parser.Token{Type: TokenKeyword, Data: "class"}) {
+				g.AcceptRunWhitespace()
+
+				declaration = g.parseIdentifier(yield, await) != nil
+			}
+		}
 	case parser.Token{Type: TokenIdentifier, Data: "abstract"}:
 		g.Skip()
 		g.AcceptRunWhitespaceNoNewLine()
@@ -166,6 +239,12 @@ const (
 // StatementBreak.
 //
 // If Type is StatementThrow, ExpressionStatement must be non-nil.
+//
+// EnumDeclaration and NamespaceDeclaration, which are only produced when
+// parsing with a tokeniser created by AsTypescriptAST, hold the Typescript
+// declaration that was lowered to the ExpressionStatement, when that
+// declaration merges with an earlier declaration of the same name. Only one of
+// them can be non-nil.
 type Statement struct {
 	Type                    StatementType
 	BlockStatement          *Block
@@ -181,6 +260,8 @@ type Statement struct {
 	LabelledItemFunction    *FunctionDeclaration
 	LabelledItemStatement   *Statement
 	TryStatement            *TryStatement
+	EnumDeclaration         *EnumDeclaration
+	NamespaceDeclaration    *NamespaceDeclaration
 	Comments                [2]Comments
 	Tokens                  Tokens
 }
@@ -243,6 +324,8 @@ func (s *Statement) parse(j *jsParser, yield, await, ret bool) error {
 
 			if s.LabelIdentifier = g.parseIdentifier(yield, await); s.LabelIdentifier == nil {
 				return g.Error("Statement", ErrNoIdentifier)
+			} else if err := g.checkStrictIdentifier("Statement", s.LabelIdentifier); err != nil {
+				return err
 			}
 
 			s.Comments[1] = g.AcceptRunWhitespaceComments()
@@ -341,6 +424,10 @@ func (s *Statement) parse(j *jsParser, yield, await, ret bool) error {
 
 				g.AcceptRunWhitespace()
 
+				if err := g.checkStrictIdentifier("Statement", i); err != nil {
+					return err
+				}
+
 				s.LabelIdentifier = i
 				h := g.NewGoal()
 
@@ -650,6 +737,10 @@ const (
 	ForAwaitOfVar
 	ForAwaitOfLet
 	ForAwaitOfConst
+	ForOfUsing
+	ForOfAwaitUsing
+	ForAwaitOfUsing
+	ForAwaitOfAwaitUsing
 )
 
 // IterationStatementFor is the for part of IterationStatement as defined
@@ -659,12 +750,17 @@ const (
 // Includes TC39 proposal for for-await-of
 // https://github.com/tc39/proposal-async-iteration#the-async-iteration-statement-for-await-of
 //
+// Includes the using and await using declarations from the TC39 Explicit
+// Resource Management proposal.
+// https://tc39.es/proposal-explicit-resource-management/#sec-for-in-and-for-of-statements
+//
 // The Type determines which fields must be non-nil:
 //
 //	ForInLeftHandSide: LeftHandSideExpression and In
 //	ForInVar, ForInLet, ForInConst: ForBindingIdentifier, ForBindingPatternObject, or ForBindingPatternArray and In
 //	ForOfLeftHandSide, ForAwaitOfLeftHandSide: LeftHandSideExpression and Of
 //	ForOfVar, ForAwaitOfVar, ForOfLet, ForAwaitOfLet, ForOfConst, ForAwaitOfConst: ForBindingIdentifier, ForBindingPatternObject, or ForBindingPatternArray and Of
+//	ForOfUsing, ForOfAwaitUsing, ForAwaitOfUsing, ForAwaitOfAwaitUsing: ForBindingIdentifier and Of
 type IterationStatementFor struct {
 	Type ForType
 
@@ -748,6 +844,24 @@ func (is *IterationStatementFor) parse(j *jsParser, yield, await, ret bool) erro
 		if is.Type > 4 && j.Peek() == (parser.Token{Type: TokenKeyword, Data: "const"}) {
 			is.Type++
 		}
+	case parser.Token{Type: TokenIdentifier, Data: "using"}, parser.Token{Type: TokenKeyword, Data: "await"}:
+		g := j.NewGoal()
+
+		if tk := g.skipUsingDeclaration(yield, await); tk != nil && (tk.Data != "of" || j.Peek().Data == "await") {
+			g.AcceptRunWhitespace()
+
+			if g.Peek() != (parser.Token{Type: TokenIdentifier, Data: "of"}) {
+				is.Type = ForNormalLexicalDeclaration
+			} else if j.Peek().Data == "await" {
+				is.Type = ForOfAwaitUsing
+			} else {
+				is.Type = ForOfUsing
+			}
+		} else if forAwait {
+			is.Type = ForOfLeftHandSide
+		} else {
+			is.Type = ForNormalExpression
+		}
 	default:
 		if forAwait {
 			is.Type = ForOfLeftHandSide
@@ -843,9 +957,14 @@ func (is *IterationStatementFor) parse(j *jsParser, yield, await, ret bool) erro
 
 			j.AcceptRunWhitespaceNoComment()
 		}
-	case ForInVar, ForInLet, ForInConst, ForOfVar, ForOfLet, ForOfConst:
+	case ForInVar, ForInLet, ForInConst, ForOfVar, ForOfLet, ForOfConst, ForOfUsing, ForOfAwaitUsing:
 		j.Skip()
 
+		if is.Type == ForOfAwaitUsing {
+			j.AcceptRunWhitespaceNoNewLine()
+			j.Skip()
+		}
+
 		is.Comments[4] = j.AcceptRunWhitespaceComments()
 
 		j.AcceptRunWhitespace()
@@ -872,6 +991,8 @@ func (is *IterationStatementFor) parse(j *jsParser, yield, await, ret bool) erro
 		default:
 			if is.ForBindingIdentifier = j.parseIdentifier(yield, await); is.ForBindingIdentifier == nil {
 				return j.Error("IterationStatementFor", ErrNoIdentifier)
+			} else if err := j.checkStrictIdentifier("IterationStatementFor", is.ForBindingIdentifier); err != nil {
+				return err
 			}
 		}
 
@@ -950,7 +1071,7 @@ func (is *IterationStatementFor) parse(j *jsParser, yield, await, ret bool) erro
 		}
 
 		j.Score(g)
-	case ForOfLeftHandSide, ForOfVar, ForOfLet, ForOfConst:
+	case ForOfLeftHandSide, ForOfVar, ForOfLet, ForOfConst, ForOfUsing, ForOfAwaitUsing:
 		if !j.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "of"}) {
 			return j.Error("IterationStatementFor", ErrInvalidForAwaitLoop)
 		}
@@ -968,7 +1089,11 @@ func (is *IterationStatementFor) parse(j *jsParser, yield, await, ret bool) erro
 	}
 
 	if forAwait {
-		is.Type += 4
+		if is.Type >= ForOfUsing {
+			is.Type += 2
+		} else {
+			is.Type += 4
+		}
 	}
 
 	is.Comments[6] = j.AcceptRunWhitespaceComments()
@@ -1052,6 +1177,8 @@ func (ss *SwitchStatement) parse(j *jsParser, yield, await, ret bool) error {
 		return j.Error("SwitchStatement", ErrMissingOpeningBrace)
 	}
 
+	defer j.enterScope()()
+
 	ss.Comments[4] = j.AcceptRunWhitespaceNoNewlineComments()
 
 	for {
@@ -1100,7 +1227,11 @@ func (ss *SwitchStatement) parse(j *jsParser, yield, await, ret bool) error {
 
 				ss.DefaultClause = append(ss.DefaultClause, StatementListItem{})
 				if err := ss.DefaultClause[sl].parse(&g, yield, await, ret); err != nil {
-					return j.Error("SwitchStatement", err)
+					if g = j.NewGoal(); !g.recover("SwitchStatement", err, true) {
+						return j.Error("SwitchStatement", err)
+					}
+
+					ss.DefaultClause[sl] = StatementListItem{Tokens: g.ToTokens()}
 				}
 
 				j.Score(g)
@@ -1186,7 +1317,14 @@ func (cc *CaseClause) parse(j *jsParser, yield, await, ret bool) error {
 
 		cc.StatementList = append(cc.StatementList, StatementListItem{})
 		if err := cc.StatementList[sl].parse(&g, yield, await, ret); err != nil {
-			return g.Error("CaseClause", err)
+			h := j.NewGoal()
+
+			if !h.recover("CaseClause", err, true) {
+				return g.Error("CaseClause", err)
+			}
+
+			cc.StatementList[sl] = StatementListItem{Tokens: h.ToTokens()}
+			g = h
 		}
 
 		j.Score(g)
@@ -1207,7 +1345,9 @@ type WithStatement struct {
 }
 
 func (ws *WithStatement) parse(j *jsParser, yield, await, ret bool) error {
-	if !j.AcceptToken(parser.Token{Type: TokenKeyword, Data: "with"}) {
+	if j.IsStrict() {
+		return j.Error("WithStatement", ErrStrictWith)
+	} else if !j.AcceptToken(parser.Token{Type: TokenKeyword, Data: "with"}) {
 		return j.Error("WithStatement", ErrInvalidWithStatement)
 	}
 
@@ -1334,6 +1474,8 @@ func (ts *TryStatement) parse(j *jsParser, yield, await, ret bool) error {
 			default:
 				if ts.CatchParameterBindingIdentifier = g.parseIdentifier(yield, await); ts.CatchParameterBindingIdentifier == nil {
 					return j.Error("TryStatement", ErrNoIdentifier)
+				} else if err := g.checkStrictIdentifier("TryStatement", ts.CatchParameterBindingIdentifier); err != nil {
+					return err
 				}
 			}
 
@@ -1400,8 +1542,15 @@ func (ts *TryStatement) parse(j *jsParser, yield, await, ret bool) error {
 // https://262.ecma-international.org/11.0/#prod-VariableStatement
 //
 // VariableDeclarationList must have a length or at least one.
+//
+// EnumDeclaration and NamespaceDeclaration, which are only produced when
+// parsing with a tokeniser created by AsTypescriptAST, hold the Typescript
+// declaration that was lowered to the VariableStatement. Only one of them can
+// be non-nil.
 type VariableStatement struct {
 	VariableDeclarationList []VariableDeclaration
+	EnumDeclaration         *EnumDeclaration
+	NamespaceDeclaration    *NamespaceDeclaration
 	Tokens                  Tokens
 }
 
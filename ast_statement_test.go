@@ -6429,6 +6429,88 @@ func TestIterationStatementFor(t *testing.T) {
 				Tokens:   tk[:37],
 			}
 		}},
+		{"for (using a of b);", func(t *test, tk Tokens) { // 121
+			litB := makeConditionLiteral(tk, 9)
+			t.Output = IterationStatementFor{
+				Type:                 ForOfUsing,
+				ForBindingIdentifier: &tk[5],
+				Of: &AssignmentExpression{
+					ConditionalExpression: &litB,
+					Tokens:                tk[9:10],
+				},
+				Statement: Statement{
+					Tokens: tk[11:12],
+				},
+				Tokens: tk[:12],
+			}
+		}},
+		{"for await (await using a of b);", func(t *test, tk Tokens) { // 122
+			litB := makeConditionLiteral(tk, 13)
+			t.Await = true
+			t.Output = IterationStatementFor{
+				Type:                 ForAwaitOfAwaitUsing,
+				ForBindingIdentifier: &tk[9],
+				Of: &AssignmentExpression{
+					ConditionalExpression: &litB,
+					Tokens:                tk[13:14],
+				},
+				Statement: Statement{
+					Tokens: tk[15:16],
+				},
+				Tokens: tk[:16],
+			}
+		}},
+		{"for (using of b);", func(t *test, tk Tokens) { // 123
+			litB := makeConditionLiteral(tk, 7)
+			t.Output = IterationStatementFor{
+				Type: ForOfLeftHandSide,
+				LeftHandSideExpression: &LeftHandSideExpression{
+					NewExpression: &NewExpression{
+						MemberExpression: MemberExpression{
+							PrimaryExpression: &PrimaryExpression{
+								IdentifierReference: &tk[3],
+								Tokens:              tk[3:4],
+							},
+							Tokens: tk[3:4],
+						},
+						Tokens: tk[3:4],
+					},
+					Tokens: tk[3:4],
+				},
+				Of: &AssignmentExpression{
+					ConditionalExpression: &litB,
+					Tokens:                tk[7:8],
+				},
+				Statement: Statement{
+					Tokens: tk[9:10],
+				},
+				Tokens: tk[:10],
+			}
+		}},
+		{"for (using a = b;;);", func(t *test, tk Tokens) { // 124
+			litB := makeConditionLiteral(tk, 9)
+			t.Output = IterationStatementFor{
+				Type: ForNormalLexicalDeclaration,
+				InitLexical: &LexicalDeclaration{
+					LetOrConst: Using,
+					BindingList: []LexicalBinding{
+						{
+							BindingIdentifier: &tk[5],
+							Initializer: &AssignmentExpression{
+								ConditionalExpression: &litB,
+								Tokens:                tk[9:10],
+							},
+							Tokens: tk[5:10],
+						},
+					},
+					Tokens: tk[3:11],
+				},
+				Statement: Statement{
+					Tokens: tk[13:14],
+				},
+				Tokens: tk[:14],
+			}
+		}},
 	}, func(t *test) (Type, error) {
 		var is IterationStatementFor
 
@@ -44,7 +44,7 @@ func doTests(t *testing.T, tests []sourceFn, fn func(*test) (Type, error), isJSX
 			continue
 		}
 
-		tt.Fn(&ts, Tokens(ts.Tokens[:cap(ts.Tokens)]))
+		tt.Fn(&ts, ts.Tokens.tokens[:cap(ts.Tokens.tokens)])
 
 		if output, err := fn(&ts); !reflect.DeepEqual(err, ts.Err) {
 			t.Errorf("test %d: expecting error: %v, got %v", n+1, ts.Err, err)
@@ -408,7 +408,8 @@ for(
 									},
 									Tokens: tk[25:49],
 								},
-								Tokens: tk[16:49],
+								DirectivePrologue: DirectivePrologue{Strict: true},
+								Tokens:            tk[16:49],
 							},
 							Tokens: tk[16:49],
 						},
@@ -476,7 +477,8 @@ for(
 						Tokens: tk[50:91],
 					},
 				},
-				Tokens: tk[:91],
+				DirectivePrologue: DirectivePrologue{Directives: []*Token{&tk[1]}, Strict: true},
+				Tokens:            tk[:91],
 			}
 		}},
 		{`if (typeof a === "b" && typeof c.d == "e") {}`, func(t *test, tk Tokens) { // 2
@@ -1300,6 +1302,53 @@ func TestLexicalDeclaration(t *testing.T) {
 				Token:   tk[3],
 			}
 		}},
+		{"using a;", func(t *test, tk Tokens) { // 17
+			t.Output = LexicalDeclaration{
+				LetOrConst: Using,
+				BindingList: []LexicalBinding{
+					{
+						BindingIdentifier: &tk[2],
+						Tokens:            tk[2:3],
+					},
+				},
+				Tokens: tk[:4],
+			}
+		}},
+		{"await using a;", func(t *test, tk Tokens) { // 18
+			t.Await = true
+			t.Output = LexicalDeclaration{
+				LetOrConst: AwaitUsing,
+				BindingList: []LexicalBinding{
+					{
+						BindingIdentifier: &tk[4],
+						Tokens:            tk[4:5],
+					},
+				},
+				Tokens: tk[:6],
+			}
+		}},
+		{"await using a;", func(t *test, tk Tokens) { // 19
+			t.Err = Error{
+				Err:     ErrInvalidLexicalDeclaration,
+				Parsing: "LexicalDeclaration",
+				Token:   tk[0],
+			}
+		}},
+		{"await\nusing a;", func(t *test, tk Tokens) { // 20
+			t.Await = true
+			t.Err = Error{
+				Err:     ErrInvalidLexicalDeclaration,
+				Parsing: "LexicalDeclaration",
+				Token:   tk[1],
+			}
+		}},
+		{"using a = b, [c] = d;", func(t *test, tk Tokens) { // 21
+			t.Err = Error{
+				Err:     ErrNoIdentifier,
+				Parsing: "LexicalDeclaration",
+				Token:   tk[9],
+			}
+		}},
 	}, func(t *test) (Type, error) {
 		var ld LexicalDeclaration
 
@@ -2564,7 +2613,7 @@ func TestPropertyDefinition(t *testing.T) {
 				},
 				Tokens: tk[2:3],
 			}
-			t.Tokens = jsParser(tk[2:2])
+			t.Tokens = jsParser{tokens: tk[2:2]}
 		}},
 		{"a\n=\n", func(t *test, tk Tokens) { // 6
 			t.Err = Error{
@@ -4578,3 +4627,25 @@ func TestErrUnwrap(t *testing.T) {
 		t.Errorf("error could not be correctly unwrapped")
 	}
 }
+
+func TestParseScriptWithRecovery(t *testing.T) {
+	s, errs := ParseScriptWithRecovery(makeTokeniser(parser.NewStringTokeniser("var a = 1;\nif (a) {\n\tb c;\n\te();\n}\nd(a);")))
+
+	if len(s.StatementList) != 3 {
+		t.Errorf("expecting 3 statements, got %d", len(s.StatementList))
+	} else if is := s.StatementList[1].Statement; is == nil || is.IfStatement == nil || is.IfStatement.Statement.BlockStatement == nil {
+		t.Errorf("expecting if statement, got %v", s.StatementList[1])
+	} else if sl := is.IfStatement.Statement.BlockStatement.StatementList; len(sl) != 2 {
+		t.Errorf("expecting 2 statements in block, got %d", len(sl))
+	} else if sl[0].Statement != nil || sl[0].Declaration != nil || len(sl[0].Tokens) == 0 {
+		t.Errorf("expecting placeholder statement, got %v", sl[0])
+	} else if sl[1].Statement == nil {
+		t.Errorf("expecting statement after placeholder, got %v", sl[1])
+	}
+
+	if len(errs) != 1 {
+		t.Errorf("expecting 1 error, got %d: %v", len(errs), errs)
+	} else if errs[0].Token.Line != 2 || !errors.Is(errs[0], ErrMissingSemiColon) {
+		t.Errorf("expecting missing semi-colon error on line 2, got: %s", errs[0])
+	}
+}
@@ -0,0 +1,1967 @@
+package javascript
+
+import "vimagination.zapto.org/parser"
+
+// TypeOperatorType determines which operator, if any, is applied by a
+// TypeOperator.
+type TypeOperatorType uint8
+
+// Valid TypeOperatorTypes.
+const (
+	TypeOperatorNone TypeOperatorType = iota
+	TypeOperatorKeyof
+	TypeOperatorUnique
+	TypeOperatorReadonly
+	TypeOperatorInfer
+)
+
+// MappedTypeModifier determines how the readonly and optional modifiers of a
+// MappedType are applied.
+type MappedTypeModifier uint8
+
+// Valid MappedTypeModifiers.
+const (
+	MappedTypeModifierNone MappedTypeModifier = iota
+	MappedTypeModifierPresent
+	MappedTypeModifierAdd
+	MappedTypeModifierRemove
+)
+
+// TypeAliasDeclaration represents a Typescript 'type' declaration.
+//
+// Only produced when parsing with a tokeniser created by AsTypescriptAST.
+type TypeAliasDeclaration struct {
+	BindingIdentifier *Token
+	TypeParameters    *TypeParameters
+	Type              TypeExpression
+	Tokens            Tokens
+}
+
+func (ta *TypeAliasDeclaration) parse(j *jsParser) error {
+	if !j.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "type"}) {
+		return j.Error("TypeAliasDeclaration", ErrInvalidTypeDeclaration)
+	}
+
+	j.AcceptRunWhitespace()
+
+	if ta.BindingIdentifier = j.parseIdentifier(false, false); ta.BindingIdentifier == nil {
+		return j.Error("TypeAliasDeclaration", ErrNoIdentifier)
+	}
+
+	j.AcceptRunWhitespace()
+
+	if j.Peek() == (parser.Token{Type: TokenPunctuator, Data: "<"}) {
+		g := j.NewGoal()
+
+		ta.TypeParameters = new(TypeParameters)
+		if err := ta.TypeParameters.parse(&g); err != nil {
+			return j.Error("TypeAliasDeclaration", err)
+		}
+
+		j.Score(g)
+		j.AcceptRunWhitespace()
+	}
+
+	if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "="}) {
+		return j.Error("TypeAliasDeclaration", ErrMissingEquals)
+	}
+
+	j.AcceptRunWhitespace()
+
+	g := j.NewGoal()
+
+	if err := ta.Type.parse(&g); err != nil {
+		return j.Error("TypeAliasDeclaration", err)
+	}
+
+	j.Score(g)
+
+	g = j.NewGoal()
+
+	g.AcceptRunWhitespace()
+
+	if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ";"}) {
+		j.Score(g)
+	}
+
+	ta.Tokens = j.ToTokens()
+
+	return nil
+}
+
+// InterfaceDeclaration represents a Typescript 'interface' declaration.
+//
+// Only produced when parsing with a tokeniser created by AsTypescriptAST.
+type InterfaceDeclaration struct {
+	BindingIdentifier *Token
+	TypeParameters    *TypeParameters
+	Extends           []TypeReference
+	ObjectType        ObjectType
+	Tokens            Tokens
+}
+
+func (id *InterfaceDeclaration) parse(j *jsParser) error {
+	if !j.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "interface"}) {
+		return j.Error("InterfaceDeclaration", ErrInvalidTypeDeclaration)
+	}
+
+	j.AcceptRunWhitespace()
+
+	if id.BindingIdentifier = j.parseIdentifier(false, false); id.BindingIdentifier == nil {
+		return j.Error("InterfaceDeclaration", ErrNoIdentifier)
+	}
+
+	j.AcceptRunWhitespace()
+
+	if j.Peek() == (parser.Token{Type: TokenPunctuator, Data: "<"}) {
+		g := j.NewGoal()
+
+		id.TypeParameters = new(TypeParameters)
+		if err := id.TypeParameters.parse(&g); err != nil {
+			return j.Error("InterfaceDeclaration", err)
+		}
+
+		j.Score(g)
+		j.AcceptRunWhitespace()
+	}
+
+	if j.AcceptToken(parser.Token{Type: TokenKeyword, Data: "extends"}) {
+		for {
+			j.AcceptRunWhitespace()
+
+			g := j.NewGoal()
+			e := len(id.Extends)
+
+			id.Extends = append(id.Extends, TypeReference{})
+			if err := id.Extends[e].parse(&g); err != nil {
+				return j.Error("InterfaceDeclaration", err)
+			}
+
+			j.Score(g)
+			j.AcceptRunWhitespace()
+
+			if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ","}) {
+				break
+			}
+		}
+	}
+
+	g := j.NewGoal()
+
+	if err := id.ObjectType.parse(&g); err != nil {
+		return j.Error("InterfaceDeclaration", err)
+	}
+
+	j.Score(g)
+
+	id.Tokens = j.ToTokens()
+
+	return nil
+}
+
+func (d *Declaration) isTypescript() bool {
+	return d.ClassDeclaration == nil && d.FunctionDeclaration == nil && d.LexicalDeclaration == nil && (d.TypeAliasDeclaration != nil || d.InterfaceDeclaration != nil || d.NamespaceDeclaration != nil)
+}
+
+// EnumDeclaration represents a Typescript 'enum' declaration.
+//
+// Only produced when parsing with a tokeniser created by AsTypescriptAST, as
+// the EnumDeclaration of the VariableStatement that the enum is lowered to.
+type EnumDeclaration struct {
+	Const             bool
+	BindingIdentifier *Token
+	EnumMembers       []EnumMember
+	Tokens            Tokens
+}
+
+// EnumMember represents a single member of a Typescript EnumDeclaration.
+//
+// The Name is either an identifier or a string literal.
+//
+// Value is the constant value of the member, as evaluated when lowering the
+// enum, and is nil when the value cannot be determined during parsing.
+type EnumMember struct {
+	Name        *Token
+	Initializer *AssignmentExpression
+	Value       *AssignmentExpression
+	Tokens      Tokens
+}
+
+// NamespaceDeclaration represents a Typescript 'namespace' or 'module'
+// declaration.
+//
+// Only produced when parsing with a tokeniser created by AsTypescriptAST. A
+// namespace that contains values is the NamespaceDeclaration of the
+// VariableStatement that it is lowered to, otherwise it is produced as a
+// Declaration.
+//
+// NamespaceName holds each identifier of a dotted name, and ModuleListItems
+// holds the body of the namespace, as written.
+type NamespaceDeclaration struct {
+	NamespaceName   []*Token
+	ModuleListItems []ModuleItem
+	Tokens          Tokens
+}
+
+// TypeParameters represents a list of Typescript generic type parameters.
+type TypeParameters struct {
+	TypeParameters []TypeParameter
+	Tokens         Tokens
+}
+
+func (tp *TypeParameters) parse(j *jsParser) error {
+	if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "<"}) {
+		return j.Error("TypeParameters", ErrMissingOpeningBracket)
+	}
+
+	for {
+		j.AcceptRunWhitespace()
+
+		if len(tp.TypeParameters) > 0 && j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ">"}) {
+			break
+		}
+
+		g := j.NewGoal()
+		p := len(tp.TypeParameters)
+
+		tp.TypeParameters = append(tp.TypeParameters, TypeParameter{})
+		if err := tp.TypeParameters[p].parse(&g); err != nil {
+			return j.Error("TypeParameters", err)
+		}
+
+		j.Score(g)
+		j.AcceptRunWhitespace()
+
+		if j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ">"}) {
+			break
+		} else if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ","}) {
+			return j.Error("TypeParameters", ErrMissingComma)
+		}
+	}
+
+	tp.Tokens = j.ToTokens()
+
+	return nil
+}
+
+// TypeParameter represents a single Typescript generic type parameter, with
+// optional constraint and default types.
+type TypeParameter struct {
+	Const      bool
+	Identifier *Token
+	Constraint *TypeExpression
+	Default    *TypeExpression
+	Tokens     Tokens
+}
+
+func (tp *TypeParameter) parse(j *jsParser) error {
+	if j.AcceptToken(parser.Token{Type: TokenKeyword, Data: "const"}) {
+		tp.Const = true
+
+		j.AcceptRunWhitespace()
+	}
+
+	if tp.Identifier = j.parseIdentifier(false, false); tp.Identifier == nil {
+		return j.Error("TypeParameter", ErrNoIdentifier)
+	}
+
+	g := j.NewGoal()
+
+	g.AcceptRunWhitespace()
+
+	if g.AcceptToken(parser.Token{Type: TokenKeyword, Data: "extends"}) {
+		g.AcceptRunWhitespace()
+
+		h := g.NewGoal()
+
+		tp.Constraint = new(TypeExpression)
+		if err := tp.Constraint.parse(&h); err != nil {
+			return g.Error("TypeParameter", err)
+		}
+
+		g.Score(h)
+		j.Score(g)
+
+		g = j.NewGoal()
+
+		g.AcceptRunWhitespace()
+	}
+
+	if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "="}) {
+		g.AcceptRunWhitespace()
+
+		h := g.NewGoal()
+
+		tp.Default = new(TypeExpression)
+		if err := tp.Default.parse(&h); err != nil {
+			return g.Error("TypeParameter", err)
+		}
+
+		g.Score(h)
+		j.Score(g)
+	}
+
+	tp.Tokens = j.ToTokens()
+
+	return nil
+}
+
+// TypeArguments represents a list of Typescript generic type arguments.
+type TypeArguments struct {
+	TypeArguments []TypeExpression
+	Tokens        Tokens
+}
+
+func (ta *TypeArguments) parse(j *jsParser) error {
+	if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "<"}) {
+		return j.Error("TypeArguments", ErrMissingOpeningBracket)
+	}
+
+	for {
+		j.AcceptRunWhitespace()
+
+		g := j.NewGoal()
+		a := len(ta.TypeArguments)
+
+		ta.TypeArguments = append(ta.TypeArguments, TypeExpression{})
+		if err := ta.TypeArguments[a].parse(&g); err != nil {
+			return j.Error("TypeArguments", err)
+		}
+
+		j.Score(g)
+		j.AcceptRunWhitespace()
+
+		if j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ">"}) {
+			break
+		} else if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ","}) {
+			return j.Error("TypeArguments", ErrMissingComma)
+		}
+	}
+
+	ta.Tokens = j.ToTokens()
+
+	return nil
+}
+
+// TypeAnnotation represents a colon prefixed Typescript type.
+//
+// TypePredicate is only set for function return types, and contains either
+// the parameter identifier or 'this' keyword of an 'is' type predicate.
+type TypeAnnotation struct {
+	TypePredicate *Token
+	Type          TypeExpression
+	Tokens        Tokens
+}
+
+func (ta *TypeAnnotation) parse(j *jsParser, returnType bool) error {
+	if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ":"}) {
+		return j.Error("TypeAnnotation", ErrMissingColon)
+	}
+
+	j.AcceptRunWhitespace()
+
+	if returnType {
+		ta.TypePredicate = j.parseTypePredicate()
+	}
+
+	g := j.NewGoal()
+
+	if err := ta.Type.parse(&g); err != nil {
+		return j.Error("TypeAnnotation", err)
+	}
+
+	j.Score(g)
+
+	ta.Tokens = j.ToTokens()
+
+	return nil
+}
+
+func (j *jsParser) parseTypePredicate() *Token {
+	g := j.NewGoal()
+
+	if g.parseIdentifier(false, false) == nil && !g.AcceptToken(parser.Token{Type: TokenKeyword, Data: "this"}) {
+		return nil
+	}
+
+	tk := g.GetLastToken()
+
+	g.AcceptRunWhitespaceNoNewLine()
+
+	if !g.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "is"}) {
+		return nil
+	}
+
+	g.AcceptRunWhitespace()
+	j.Score(g)
+
+	return tk
+}
+
+// TypeExpression represents any Typescript type.
+//
+// Only one of FunctionTypeExpression or UnionType must be non-nil.
+//
+// When ExtendsType is non-nil, the TypeExpression is a conditional type and
+// both TrueType and FalseType must also be non-nil.
+type TypeExpression struct {
+	FunctionTypeExpression *FunctionTypeExpression
+	UnionType              *UnionType
+	ExtendsType            *TypeExpression
+	TrueType               *TypeExpression
+	FalseType              *TypeExpression
+	Tokens                 Tokens
+}
+
+func (te *TypeExpression) parse(j *jsParser) error {
+	g := j.NewGoal()
+
+	var ft FunctionTypeExpression
+
+	if err := ft.parse(&g); err == nil {
+		te.FunctionTypeExpression = &ft
+
+		j.Score(g)
+
+		te.Tokens = j.ToTokens()
+
+		return nil
+	}
+
+	g = j.NewGoal()
+
+	te.UnionType = new(UnionType)
+	if err := te.UnionType.parse(&g); err != nil {
+		return j.Error("TypeExpression", err)
+	}
+
+	j.Score(g)
+
+	g = j.NewGoal()
+
+	g.AcceptRunWhitespaceNoNewLine()
+
+	if g.AcceptToken(parser.Token{Type: TokenKeyword, Data: "extends"}) {
+		for _, t := range [...]**TypeExpression{&te.ExtendsType, &te.TrueType, &te.FalseType} {
+			if te.TrueType != nil {
+				if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ":"}) {
+					return g.Error("TypeExpression", ErrMissingColon)
+				}
+			} else if te.ExtendsType != nil && !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "?"}) {
+				return g.Error("TypeExpression", ErrInvalidConditionalType)
+			}
+
+			g.AcceptRunWhitespace()
+
+			h := g.NewGoal()
+
+			*t = new(TypeExpression)
+			if err := (*t).parse(&h); err != nil {
+				return g.Error("TypeExpression", err)
+			}
+
+			g.Score(h)
+			j.Score(g)
+
+			g = j.NewGoal()
+
+			g.AcceptRunWhitespace()
+		}
+	}
+
+	te.Tokens = j.ToTokens()
+
+	return nil
+}
+
+// FunctionTypeExpression represents a Typescript function or constructor
+// type.
+//
+// TypePredicate, when non-nil, contains either the parameter identifier or
+// 'this' keyword of an 'is' type predicate.
+type FunctionTypeExpression struct {
+	New            bool
+	TypeParameters *TypeParameters
+	ParameterList  ParameterList
+	TypePredicate  *Token
+	ReturnType     TypeExpression
+	Tokens         Tokens
+}
+
+func (ft *FunctionTypeExpression) parse(j *jsParser) error {
+	if j.AcceptToken(parser.Token{Type: TokenKeyword, Data: "new"}) {
+		ft.New = true
+
+		j.AcceptRunWhitespace()
+	}
+
+	if j.Peek() == (parser.Token{Type: TokenPunctuator, Data: "<"}) {
+		g := j.NewGoal()
+
+		ft.TypeParameters = new(TypeParameters)
+		if err := ft.TypeParameters.parse(&g); err != nil {
+			return j.Error("FunctionTypeExpression", err)
+		}
+
+		j.Score(g)
+		j.AcceptRunWhitespace()
+	}
+
+	g := j.NewGoal()
+
+	if err := ft.ParameterList.parse(&g); err != nil {
+		return j.Error("FunctionTypeExpression", err)
+	}
+
+	j.Score(g)
+	j.AcceptRunWhitespace()
+
+	if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "=>"}) {
+		return j.Error("FunctionTypeExpression", ErrMissingArrow)
+	}
+
+	j.AcceptRunWhitespace()
+
+	ft.TypePredicate = j.parseTypePredicate()
+	g = j.NewGoal()
+
+	if err := ft.ReturnType.parse(&g); err != nil {
+		return j.Error("FunctionTypeExpression", err)
+	}
+
+	j.Score(g)
+
+	ft.Tokens = j.ToTokens()
+
+	return nil
+}
+
+// UnionType represents a list of Typescript types separated by '|'.
+type UnionType struct {
+	IntersectionTypes []IntersectionType
+	Tokens            Tokens
+}
+
+func (ut *UnionType) parse(j *jsParser) error {
+	if j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "|"}) {
+		j.AcceptRunWhitespace()
+	}
+
+	for {
+		g := j.NewGoal()
+		i := len(ut.IntersectionTypes)
+
+		ut.IntersectionTypes = append(ut.IntersectionTypes, IntersectionType{})
+		if err := ut.IntersectionTypes[i].parse(&g, i == 0); err != nil {
+			return j.Error("UnionType", err)
+		}
+
+		j.Score(g)
+
+		g = j.NewGoal()
+
+		g.AcceptRunWhitespace()
+
+		if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "|"}) {
+			break
+		}
+
+		g.AcceptRunWhitespace()
+		j.Score(g)
+	}
+
+	ut.Tokens = j.ToTokens()
+
+	return nil
+}
+
+// IntersectionType represents a list of Typescript types separated by '&'.
+type IntersectionType struct {
+	TypeOperators []TypeOperator
+	Tokens        Tokens
+}
+
+func (it *IntersectionType) parse(j *jsParser, leading bool) error {
+	if leading && j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "&"}) {
+		j.AcceptRunWhitespace()
+	}
+
+	for {
+		g := j.NewGoal()
+		o := len(it.TypeOperators)
+
+		it.TypeOperators = append(it.TypeOperators, TypeOperator{})
+		if err := it.TypeOperators[o].parse(&g); err != nil {
+			return j.Error("IntersectionType", err)
+		}
+
+		j.Score(g)
+
+		g = j.NewGoal()
+
+		g.AcceptRunWhitespace()
+
+		if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "&"}) {
+			break
+		}
+
+		g.AcceptRunWhitespace()
+		j.Score(g)
+	}
+
+	it.Tokens = j.ToTokens()
+
+	return nil
+}
+
+// TypeOperator represents a Typescript type with an optional prefixed
+// operator.
+//
+// When Operator is TypeOperatorNone, PostfixType must be non-nil.
+//
+// When Operator is TypeOperatorInfer, InferIdentifier must be non-nil.
+//
+// Otherwise, TypeOperator must be non-nil.
+type TypeOperator struct {
+	Operator        TypeOperatorType
+	TypeOperator    *TypeOperator
+	InferIdentifier *Token
+	PostfixType     *PostfixType
+	Tokens          Tokens
+}
+
+func (to *TypeOperator) parse(j *jsParser) error {
+	if tk := j.Peek(); tk.Type == TokenIdentifier {
+		switch tk.Data {
+		case "keyof":
+			to.Operator = TypeOperatorKeyof
+		case "unique":
+			to.Operator = TypeOperatorUnique
+		case "readonly":
+			to.Operator = TypeOperatorReadonly
+		case "infer":
+			to.Operator = TypeOperatorInfer
+		}
+	}
+
+	if to.Operator != TypeOperatorNone {
+		j.Skip()
+		j.AcceptRunWhitespace()
+
+		if to.Operator == TypeOperatorInfer {
+			if to.InferIdentifier = j.parseIdentifier(false, false); to.InferIdentifier == nil {
+				return j.Error("TypeOperator", ErrNoIdentifier)
+			}
+		} else {
+			g := j.NewGoal()
+
+			to.TypeOperator = new(TypeOperator)
+			if err := to.TypeOperator.parse(&g); err != nil {
+				return j.Error("TypeOperator", err)
+			}
+
+			j.Score(g)
+		}
+	} else {
+		g := j.NewGoal()
+
+		to.PostfixType = new(PostfixType)
+		if err := to.PostfixType.parse(&g); err != nil {
+			return j.Error("TypeOperator", err)
+		}
+
+		j.Score(g)
+	}
+
+	to.Tokens = j.ToTokens()
+
+	return nil
+}
+
+// PostfixType represents a Typescript type with optional array, indexed
+// access, or non-null suffixes.
+//
+// Only one of PrimaryType or PostfixType must be non-nil.
+//
+// When PostfixType is non-nil, exactly one of IndexType, Array, or NonNull
+// must be set.
+type PostfixType struct {
+	PrimaryType *PrimaryType
+	PostfixType *PostfixType
+	IndexType   *TypeExpression
+	Array       bool
+	NonNull     bool
+	Tokens      Tokens
+}
+
+func (pt *PostfixType) parse(j *jsParser) error {
+	g := j.NewGoal()
+
+	pt.PrimaryType = new(PrimaryType)
+	if err := pt.PrimaryType.parse(&g); err != nil {
+		return j.Error("PostfixType", err)
+	}
+
+	j.Score(g)
+
+	for {
+		pt.Tokens = j.ToTokens()
+		g := j.NewGoal()
+
+		g.AcceptRunWhitespaceNoNewLine()
+
+		var (
+			it      *TypeExpression
+			nonNull bool
+		)
+
+		if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "["}) {
+			g.AcceptRunWhitespace()
+
+			if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "]"}) {
+				h := g.NewGoal()
+
+				it = new(TypeExpression)
+				if err := it.parse(&h); err != nil {
+					return g.Error("PostfixType", err)
+				}
+
+				g.Score(h)
+				g.AcceptRunWhitespace()
+
+				if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "]"}) {
+					return g.Error("PostfixType", ErrMissingClosingBracket)
+				}
+			}
+		} else if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "!"}) {
+			nonNull = true
+		} else {
+			return nil
+		}
+
+		j.Score(g)
+
+		npt := new(PostfixType)
+		*npt = *pt
+		*pt = PostfixType{
+			PostfixType: npt,
+			IndexType:   it,
+			Array:       it == nil && !nonNull,
+			NonNull:     nonNull,
+		}
+	}
+}
+
+// PrimaryType represents the simplest Typescript types.
+//
+// Only one of LiteralType, TemplateLiteralType, ParenthesizedType,
+// PredefinedType, ObjectType, MappedType, TupleType, This, ImportType,
+// TypeQuery, or TypeReference must be non-nil.
+type PrimaryType struct {
+	LiteralType         *LiteralType
+	TemplateLiteralType *TemplateLiteralType
+	ParenthesizedType   *TypeExpression
+	PredefinedType      *Token
+	ObjectType          *ObjectType
+	MappedType          *MappedType
+	TupleType           *TupleType
+	This                *Token
+	ImportType          *ImportType
+	TypeQuery           *TypeQuery
+	TypeReference       *TypeReference
+	Tokens              Tokens
+}
+
+func (pt *PrimaryType) parse(j *jsParser) error {
+	g := j.NewGoal()
+
+	var err error
+
+	switch tk := g.Peek(); tk.Type {
+	case TokenNullLiteral, TokenBooleanLiteral, TokenNumericLiteral, TokenStringLiteral, TokenNoSubstitutionTemplate:
+		pt.LiteralType = new(LiteralType)
+		err = pt.LiteralType.parse(&g)
+	case TokenTemplateHead:
+		pt.TemplateLiteralType = new(TemplateLiteralType)
+		err = pt.TemplateLiteralType.parse(&g)
+	case TokenKeyword:
+		switch tk.Data {
+		case "void":
+			g.Skip()
+
+			pt.PredefinedType = g.GetLastToken()
+		case "this":
+			g.Skip()
+
+			pt.This = g.GetLastToken()
+		case "import":
+			pt.ImportType = new(ImportType)
+			err = pt.ImportType.parse(&g)
+		case "typeof":
+			pt.TypeQuery = new(TypeQuery)
+			err = pt.TypeQuery.parse(&g)
+		default:
+			err = ErrInvalidType
+		}
+	case TokenPunctuator:
+		switch tk.Data {
+		case "-":
+			pt.LiteralType = new(LiteralType)
+			err = pt.LiteralType.parse(&g)
+		case "(":
+			g.Skip()
+			g.AcceptRunWhitespace()
+
+			h := g.NewGoal()
+
+			pt.ParenthesizedType = new(TypeExpression)
+			if err = pt.ParenthesizedType.parse(&h); err == nil {
+				g.Score(h)
+				g.AcceptRunWhitespace()
+
+				if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ")"}) {
+					err = ErrMissingClosingParenthesis
+				}
+			}
+		case "{":
+			if g.isMappedType() {
+				pt.MappedType = new(MappedType)
+				err = pt.MappedType.parse(&g)
+			} else {
+				pt.ObjectType = new(ObjectType)
+				err = pt.ObjectType.parse(&g)
+			}
+		case "[":
+			pt.TupleType = new(TupleType)
+			err = pt.TupleType.parse(&g)
+		default:
+			err = ErrInvalidType
+		}
+	default:
+		if isPredefinedType(tk) {
+			h := g.NewGoal()
+
+			h.Skip()
+			h.AcceptRunWhitespace()
+
+			if !h.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "."}) {
+				g.Skip()
+
+				pt.PredefinedType = g.GetLastToken()
+
+				break
+			}
+		}
+
+		pt.TypeReference = new(TypeReference)
+		err = pt.TypeReference.parse(&g)
+	}
+
+	if err != nil {
+		return j.Error("PrimaryType", err)
+	}
+
+	j.Score(g)
+
+	pt.Tokens = j.ToTokens()
+
+	return nil
+}
+
+func isPredefinedType(tk parser.Token) bool {
+	if tk.Type == TokenIdentifier {
+		switch tk.Data {
+		case "any", "number", "boolean", "string", "symbol", "unknown", "bigint", "undefined", "never", "object":
+			return true
+		}
+	}
+
+	return tk == parser.Token{Type: TokenKeyword, Data: "void"}
+}
+
+func (j *jsParser) isMappedType() bool {
+	g := j.NewGoal()
+
+	g.Skip()
+	g.AcceptRunWhitespace()
+
+	if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "+"}) || g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "-"}) {
+		g.AcceptRunWhitespace()
+
+		return g.Peek() == parser.Token{Type: TokenIdentifier, Data: "readonly"}
+	}
+
+	if g.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "readonly"}) {
+		g.AcceptRunWhitespace()
+	}
+
+	if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "["}) {
+		return false
+	}
+
+	g.AcceptRunWhitespace()
+
+	if g.parseIdentifier(false, false) == nil {
+		return false
+	}
+
+	g.AcceptRunWhitespace()
+
+	return g.Peek() == parser.Token{Type: TokenKeyword, Data: "in"}
+}
+
+// LiteralType represents a Typescript literal type.
+//
+// Negative can only be true when Literal is a TokenNumericLiteral.
+type LiteralType struct {
+	Negative bool
+	Literal  *Token
+	Tokens   Tokens
+}
+
+func (lt *LiteralType) parse(j *jsParser) error {
+	if j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "-"}) {
+		lt.Negative = true
+
+		j.AcceptRunWhitespace()
+
+		if !j.Accept(TokenNumericLiteral) {
+			return j.Error("LiteralType", ErrInvalidNumber)
+		}
+	} else if !j.Accept(TokenNullLiteral, TokenBooleanLiteral, TokenNumericLiteral, TokenStringLiteral, TokenNoSubstitutionTemplate) {
+		return j.Error("LiteralType", ErrInvalidType)
+	}
+
+	lt.Literal = j.GetLastToken()
+	lt.Tokens = j.ToTokens()
+
+	return nil
+}
+
+// TemplateLiteralType represents a Typescript template literal type.
+//
+// The length of TemplateMiddleList must be one less than the length of Types.
+type TemplateLiteralType struct {
+	TemplateHead       *Token
+	Types              []TypeExpression
+	TemplateMiddleList []*Token
+	TemplateTail       *Token
+	Tokens             Tokens
+}
+
+func (tl *TemplateLiteralType) parse(j *jsParser) error {
+	if !j.Accept(TokenTemplateHead) {
+		return j.Error("TemplateLiteralType", ErrInvalidTemplate)
+	}
+
+	tl.TemplateHead = j.GetLastToken()
+
+	for {
+		j.AcceptRunWhitespace()
+
+		g := j.NewGoal()
+		t := len(tl.Types)
+
+		tl.Types = append(tl.Types, TypeExpression{})
+		if err := tl.Types[t].parse(&g); err != nil {
+			return j.Error("TemplateLiteralType", err)
+		}
+
+		j.Score(g)
+		j.AcceptRunWhitespace()
+
+		if j.Accept(TokenTemplateTail) {
+			tl.TemplateTail = j.GetLastToken()
+
+			break
+		} else if !j.Accept(TokenTemplateMiddle) {
+			return j.Error("TemplateLiteralType", ErrInvalidTemplate)
+		}
+
+		tl.TemplateMiddleList = append(tl.TemplateMiddleList, j.GetLastToken())
+	}
+
+	tl.Tokens = j.ToTokens()
+
+	return nil
+}
+
+// ObjectType represents a Typescript object type literal.
+type ObjectType struct {
+	TypeMembers []TypeMember
+	Tokens      Tokens
+}
+
+func (ot *ObjectType) parse(j *jsParser) error {
+	if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "{"}) {
+		return j.Error("ObjectType", ErrMissingOpeningBrace)
+	}
+
+	j.AcceptRunWhitespace()
+
+	for !j.Accept(TokenRightBracePunctuator) {
+		g := j.NewGoal()
+		m := len(ot.TypeMembers)
+
+		ot.TypeMembers = append(ot.TypeMembers, TypeMember{})
+		if err := ot.TypeMembers[m].parse(&g); err != nil {
+			return j.Error("ObjectType", err)
+		}
+
+		j.Score(g)
+
+		var nl bool
+
+		switch j.AcceptRunWhitespaceNoNewLine() {
+		case TokenLineTerminator, TokenSingleLineComment, TokenMultiLineComment:
+			nl = true
+		}
+
+		j.AcceptRunWhitespace()
+
+		sep := j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ";"}) || j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ","})
+
+		j.AcceptRunWhitespace()
+
+		if !sep && !nl && j.Peek().Type != TokenRightBracePunctuator {
+			return j.Error("ObjectType", ErrMissingSemiColon)
+		}
+	}
+
+	ot.Tokens = j.ToTokens()
+
+	return nil
+}
+
+// TypeMember represents a single member of a Typescript ObjectType.
+//
+// Only one of CallSignature, ConstructSignature, PropertySignature,
+// MethodSignature, or IndexSignature must be non-nil.
+type TypeMember struct {
+	CallSignature      *CallSignature
+	ConstructSignature *CallSignature
+	PropertySignature  *PropertySignature
+	MethodSignature    *MethodSignature
+	IndexSignature     *IndexSignature
+	Tokens             Tokens
+}
+
+func (tm *TypeMember) parse(j *jsParser) error {
+	if tk := j.Peek(); tk == (parser.Token{Type: TokenPunctuator, Data: "("}) || tk == (parser.Token{Type: TokenPunctuator, Data: "<"}) {
+		g := j.NewGoal()
+
+		tm.CallSignature = new(CallSignature)
+		if err := tm.CallSignature.parse(&g); err != nil {
+			return j.Error("TypeMember", err)
+		}
+
+		j.Score(g)
+
+		tm.Tokens = j.ToTokens()
+
+		return nil
+	}
+
+	if g := j.NewGoal(); g.AcceptToken(parser.Token{Type: TokenKeyword, Data: "new"}) {
+		g.AcceptRunWhitespace()
+
+		if tk := g.Peek(); tk == (parser.Token{Type: TokenPunctuator, Data: "("}) || tk == (parser.Token{Type: TokenPunctuator, Data: "<"}) {
+			h := g.NewGoal()
+
+			tm.ConstructSignature = new(CallSignature)
+			if err := tm.ConstructSignature.parse(&h); err != nil {
+				return j.Error("TypeMember", err)
+			}
+
+			g.Score(h)
+			j.Score(g)
+
+			tm.Tokens = j.ToTokens()
+
+			return nil
+		}
+	}
+
+	g := j.NewGoal()
+
+	var is IndexSignature
+
+	if err := is.parse(&g); err == nil {
+		tm.IndexSignature = &is
+
+		j.Score(g)
+
+		tm.Tokens = j.ToTokens()
+
+		return nil
+	}
+
+	g = j.NewGoal()
+
+	var ms MethodSignature
+
+	if err := ms.parse(&g); err == nil {
+		tm.MethodSignature = &ms
+
+		j.Score(g)
+
+		tm.Tokens = j.ToTokens()
+
+		return nil
+	}
+
+	g = j.NewGoal()
+
+	tm.PropertySignature = new(PropertySignature)
+	if err := tm.PropertySignature.parse(&g); err != nil {
+		return j.Error("TypeMember", err)
+	}
+
+	j.Score(g)
+
+	tm.Tokens = j.ToTokens()
+
+	return nil
+}
+
+func (j *jsParser) parseTypeModifiers() []*Token {
+	var modifiers []*Token
+
+	for {
+		tk := j.Peek()
+
+		if tk.Type != TokenIdentifier && tk != (parser.Token{Type: TokenKeyword, Data: "const"}) {
+			return modifiers
+		}
+
+		switch tk.Data {
+		case "abstract", "async", "const", "public", "private", "protected", "readonly", "static":
+		default:
+			return modifiers
+		}
+
+		g := j.NewGoal()
+
+		g.Skip()
+		g.AcceptRunWhitespace()
+
+		if tk := g.Peek(); tk.Type == TokenRightBracePunctuator || tk.Type == TokenPunctuator && tk.Data != "[" && tk.Data != "*" || tk.Type == parser.TokenDone {
+			return modifiers
+		}
+
+		j.Skip()
+
+		modifiers = append(modifiers, j.GetLastToken())
+
+		j.AcceptRunWhitespace()
+	}
+}
+
+func (pn *PropertyName) parseType(j *jsParser) error {
+	if j.Accept(TokenPrivateIdentifier) {
+		pn.LiteralPropertyName = j.GetLastToken()
+		pn.Tokens = j.ToTokens()
+
+		return nil
+	}
+
+	return pn.parse(j, false, false)
+}
+
+// PropertySignature represents a property of a Typescript ObjectType.
+type PropertySignature struct {
+	Modifiers      []*Token
+	PropertyName   PropertyName
+	Optional       bool
+	TypeAnnotation *TypeAnnotation
+	Tokens         Tokens
+}
+
+func (ps *PropertySignature) parse(j *jsParser) error {
+	ps.Modifiers = j.parseTypeModifiers()
+	g := j.NewGoal()
+
+	if err := ps.PropertyName.parseType(&g); err != nil {
+		return j.Error("PropertySignature", err)
+	}
+
+	j.Score(g)
+
+	g = j.NewGoal()
+
+	g.AcceptRunWhitespace()
+
+	if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "?"}) {
+		ps.Optional = true
+
+		j.Score(g)
+
+		g = j.NewGoal()
+
+		g.AcceptRunWhitespace()
+	}
+
+	if g.Peek() == (parser.Token{Type: TokenPunctuator, Data: ":"}) {
+		h := g.NewGoal()
+
+		ps.TypeAnnotation = new(TypeAnnotation)
+		if err := ps.TypeAnnotation.parse(&h, false); err != nil {
+			return g.Error("PropertySignature", err)
+		}
+
+		g.Score(h)
+		j.Score(g)
+	}
+
+	ps.Tokens = j.ToTokens()
+
+	return nil
+}
+
+// MethodSignature represents a method of a Typescript ObjectType.
+//
+// The Type can only be one of MethodNormal, MethodGetter, or MethodSetter, and
+// Optional can only be true for MethodNormal.
+type MethodSignature struct {
+	Modifiers     []*Token
+	Type          MethodType
+	PropertyName  PropertyName
+	Optional      bool
+	CallSignature CallSignature
+	Tokens        Tokens
+}
+
+func (ms *MethodSignature) parse(j *jsParser) error {
+	ms.Modifiers = j.parseTypeModifiers()
+
+	if tk := j.Peek(); tk == (parser.Token{Type: TokenIdentifier, Data: "get"}) || tk == (parser.Token{Type: TokenIdentifier, Data: "set"}) {
+		g := j.NewGoal()
+
+		g.Skip()
+		g.AcceptRunWhitespace()
+
+		if tk := g.Peek(); tk.Type != TokenRightBracePunctuator && (tk.Type != TokenPunctuator || tk.Data == "[") {
+			if j.Peek().Data == "get" {
+				ms.Type = MethodGetter
+			} else {
+				ms.Type = MethodSetter
+			}
+
+			j.Score(g)
+		}
+	}
+
+	g := j.NewGoal()
+
+	if err := ms.PropertyName.parseType(&g); err != nil {
+		return j.Error("MethodSignature", err)
+	}
+
+	j.Score(g)
+	j.AcceptRunWhitespace()
+
+	if ms.Type == MethodNormal && j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "?"}) {
+		ms.Optional = true
+
+		j.AcceptRunWhitespace()
+	}
+
+	g = j.NewGoal()
+
+	if err := ms.CallSignature.parse(&g); err != nil {
+		return j.Error("MethodSignature", err)
+	}
+
+	j.Score(g)
+
+	ms.Tokens = j.ToTokens()
+
+	return nil
+}
+
+// CallSignature represents the parameters and return type of a Typescript
+// function signature.
+type CallSignature struct {
+	TypeParameters *TypeParameters
+	ParameterList  ParameterList
+	ReturnType     *TypeAnnotation
+	Tokens         Tokens
+}
+
+func (cs *CallSignature) parse(j *jsParser) error {
+	if j.Peek() == (parser.Token{Type: TokenPunctuator, Data: "<"}) {
+		g := j.NewGoal()
+
+		cs.TypeParameters = new(TypeParameters)
+		if err := cs.TypeParameters.parse(&g); err != nil {
+			return j.Error("CallSignature", err)
+		}
+
+		j.Score(g)
+		j.AcceptRunWhitespace()
+	}
+
+	g := j.NewGoal()
+
+	if err := cs.ParameterList.parse(&g); err != nil {
+		return j.Error("CallSignature", err)
+	}
+
+	j.Score(g)
+
+	g = j.NewGoal()
+
+	g.AcceptRunWhitespace()
+
+	if g.Peek() == (parser.Token{Type: TokenPunctuator, Data: ":"}) {
+		h := g.NewGoal()
+
+		cs.ReturnType = new(TypeAnnotation)
+		if err := cs.ReturnType.parse(&h, true); err != nil {
+			return g.Error("CallSignature", err)
+		}
+
+		g.Score(h)
+		j.Score(g)
+	}
+
+	cs.Tokens = j.ToTokens()
+
+	return nil
+}
+
+// ParameterList represents the parameters of a Typescript function type or
+// signature.
+type ParameterList struct {
+	Parameters    []Parameter
+	RestParameter *Parameter
+	Tokens        Tokens
+}
+
+func (pl *ParameterList) parse(j *jsParser) error {
+	if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "("}) {
+		return j.Error("ParameterList", ErrMissingOpeningParenthesis)
+	}
+
+	var optional bool
+
+	for {
+		j.AcceptRunWhitespace()
+
+		if j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ")"}) {
+			break
+		}
+
+		g := j.NewGoal()
+
+		if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "..."}) {
+			g.AcceptRunWhitespace()
+
+			h := g.NewGoal()
+
+			pl.RestParameter = new(Parameter)
+			if err := pl.RestParameter.parse(&h, false); err != nil {
+				return j.Error("ParameterList", err)
+			}
+
+			g.Score(h)
+			j.Score(g)
+			j.AcceptRunWhitespace()
+
+			if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ")"}) {
+				return j.Error("ParameterList", ErrMissingClosingParenthesis)
+			}
+
+			break
+		}
+
+		p := len(pl.Parameters)
+
+		pl.Parameters = append(pl.Parameters, Parameter{})
+		if err := pl.Parameters[p].parse(&g, p == 0); err != nil {
+			return j.Error("ParameterList", err)
+		}
+
+		if param := pl.Parameters[p]; param.Optional || param.Initializer != nil {
+			optional = true
+		} else if optional {
+			return j.Error("ParameterList", ErrInvalidParameter)
+		}
+
+		j.Score(g)
+		j.AcceptRunWhitespace()
+
+		if j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ")"}) {
+			break
+		} else if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ","}) {
+			return j.Error("ParameterList", ErrMissingComma)
+		}
+	}
+
+	pl.Tokens = j.ToTokens()
+
+	return nil
+}
+
+// Parameter represents a single parameter of a Typescript ParameterList.
+//
+// Identifier can be the 'this' keyword for the first parameter of a list.
+type Parameter struct {
+	Accessibility  *Token
+	Identifier     *Token
+	Optional       bool
+	TypeAnnotation *TypeAnnotation
+	Initializer    *AssignmentExpression
+	Tokens         Tokens
+}
+
+func (p *Parameter) parse(j *jsParser, first bool) error {
+	if tk := j.Peek(); tk.Type == TokenIdentifier && (tk.Data == "public" || tk.Data == "private" || tk.Data == "protected") {
+		g := j.NewGoal()
+
+		g.Skip()
+		g.AcceptRunWhitespace()
+
+		if g.parseIdentifier(false, false) != nil {
+			j.Skip()
+
+			p.Accessibility = j.GetLastToken()
+
+			j.AcceptRunWhitespace()
+		}
+	}
+
+	if p.Identifier = j.parseIdentifier(false, false); p.Identifier == nil {
+		if p.Accessibility != nil || !first || !j.AcceptToken(parser.Token{Type: TokenKeyword, Data: "this"}) {
+			return j.Error("Parameter", ErrNoIdentifier)
+		}
+
+		p.Identifier = j.GetLastToken()
+	}
+
+	g := j.NewGoal()
+
+	g.AcceptRunWhitespace()
+
+	if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "?"}) {
+		p.Optional = true
+
+		j.Score(g)
+
+		g = j.NewGoal()
+
+		g.AcceptRunWhitespace()
+	}
+
+	if g.Peek() == (parser.Token{Type: TokenPunctuator, Data: ":"}) {
+		h := g.NewGoal()
+
+		p.TypeAnnotation = new(TypeAnnotation)
+		if err := p.TypeAnnotation.parse(&h, false); err != nil {
+			return g.Error("Parameter", err)
+		}
+
+		g.Score(h)
+		j.Score(g)
+
+		g = j.NewGoal()
+
+		g.AcceptRunWhitespace()
+	}
+
+	if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "="}) {
+		g.AcceptRunWhitespaceNoComment()
+
+		h := g.NewGoal()
+
+		p.Initializer = new(AssignmentExpression)
+		if err := p.Initializer.parse(&h, false, false, false); err != nil {
+			return g.Error("Parameter", err)
+		}
+
+		g.Score(h)
+		j.Score(g)
+	}
+
+	p.Tokens = j.ToTokens()
+
+	return nil
+}
+
+// IndexSignature represents an index signature member of a Typescript
+// ObjectType.
+type IndexSignature struct {
+	Modifiers      []*Token
+	Identifier     *Token
+	IndexType      TypeAnnotation
+	Optional       bool
+	TypeAnnotation *TypeAnnotation
+	Tokens         Tokens
+}
+
+func (is *IndexSignature) parse(j *jsParser) error {
+	is.Modifiers = j.parseTypeModifiers()
+
+	if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "["}) {
+		return j.Error("IndexSignature", ErrMissingOpeningBracket)
+	}
+
+	j.AcceptRunWhitespace()
+
+	if is.Identifier = j.parseIdentifier(false, false); is.Identifier == nil {
+		return j.Error("IndexSignature", ErrNoIdentifier)
+	}
+
+	j.AcceptRunWhitespace()
+
+	g := j.NewGoal()
+
+	if err := is.IndexType.parse(&g, false); err != nil {
+		return j.Error("IndexSignature", err)
+	}
+
+	j.Score(g)
+	j.AcceptRunWhitespace()
+
+	if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "]"}) {
+		return j.Error("IndexSignature", ErrMissingClosingBracket)
+	}
+
+	g = j.NewGoal()
+
+	g.AcceptRunWhitespace()
+
+	if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "?"}) {
+		is.Optional = true
+
+		j.Score(g)
+
+		g = j.NewGoal()
+
+		g.AcceptRunWhitespace()
+	}
+
+	if g.Peek() == (parser.Token{Type: TokenPunctuator, Data: ":"}) {
+		h := g.NewGoal()
+
+		is.TypeAnnotation = new(TypeAnnotation)
+		if err := is.TypeAnnotation.parse(&h, false); err != nil {
+			return g.Error("IndexSignature", err)
+		}
+
+		g.Score(h)
+		j.Score(g)
+	}
+
+	is.Tokens = j.ToTokens()
+
+	return nil
+}
+
+// MappedType represents a Typescript mapped object type.
+//
+// The Readonly modifier is MappedTypeModifierPresent for a plain 'readonly',
+// and MappedTypeModifierAdd or MappedTypeModifierRemove when prefixed with '+'
+// or '-' respectively; likewise for the Optional '?' modifier.
+type MappedType struct {
+	Readonly       MappedTypeModifier
+	Identifier     *Token
+	Constraint     TypeExpression
+	NameType       *TypeExpression
+	Optional       MappedTypeModifier
+	TypeAnnotation *TypeAnnotation
+	Tokens         Tokens
+}
+
+func (mt *MappedType) parse(j *jsParser) error {
+	if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "{"}) {
+		return j.Error("MappedType", ErrMissingOpeningBrace)
+	}
+
+	j.AcceptRunWhitespace()
+
+	if mt.Readonly = j.parseMappedTypeModifier(parser.Token{Type: TokenIdentifier, Data: "readonly"}); mt.Readonly != MappedTypeModifierNone {
+		j.AcceptRunWhitespace()
+	}
+
+	if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "["}) {
+		return j.Error("MappedType", ErrMissingOpeningBracket)
+	}
+
+	j.AcceptRunWhitespace()
+
+	if mt.Identifier = j.parseIdentifier(false, false); mt.Identifier == nil {
+		return j.Error("MappedType", ErrNoIdentifier)
+	}
+
+	j.AcceptRunWhitespace()
+
+	if !j.AcceptToken(parser.Token{Type: TokenKeyword, Data: "in"}) {
+		return j.Error("MappedType", ErrInvalidType)
+	}
+
+	j.AcceptRunWhitespace()
+
+	g := j.NewGoal()
+
+	if err := mt.Constraint.parse(&g); err != nil {
+		return j.Error("MappedType", err)
+	}
+
+	j.Score(g)
+	j.AcceptRunWhitespace()
+
+	if j.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "as"}) {
+		j.AcceptRunWhitespace()
+
+		g := j.NewGoal()
+
+		mt.NameType = new(TypeExpression)
+		if err := mt.NameType.parse(&g); err != nil {
+			return j.Error("MappedType", err)
+		}
+
+		j.Score(g)
+		j.AcceptRunWhitespace()
+	}
+
+	if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "]"}) {
+		return j.Error("MappedType", ErrMissingClosingBracket)
+	}
+
+	j.AcceptRunWhitespace()
+
+	if mt.Optional = j.parseMappedTypeModifier(parser.Token{Type: TokenPunctuator, Data: "?"}); mt.Optional != MappedTypeModifierNone {
+		j.AcceptRunWhitespace()
+	}
+
+	if j.Peek() == (parser.Token{Type: TokenPunctuator, Data: ":"}) {
+		g := j.NewGoal()
+
+		mt.TypeAnnotation = new(TypeAnnotation)
+		if err := mt.TypeAnnotation.parse(&g, false); err != nil {
+			return j.Error("MappedType", err)
+		}
+
+		j.Score(g)
+		j.AcceptRunWhitespace()
+	}
+
+	if j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ";"}) || j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ","}) {
+		j.AcceptRunWhitespace()
+	}
+
+	if !j.Accept(TokenRightBracePunctuator) {
+		return j.Error("MappedType", ErrMissingClosingBrace)
+	}
+
+	mt.Tokens = j.ToTokens()
+
+	return nil
+}
+
+func (j *jsParser) parseMappedTypeModifier(tk parser.Token) MappedTypeModifier {
+	if j.AcceptToken(tk) {
+		return MappedTypeModifierPresent
+	}
+
+	g := j.NewGoal()
+
+	m := MappedTypeModifierAdd
+
+	if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "-"}) {
+		m = MappedTypeModifierRemove
+	} else if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "+"}) {
+		return MappedTypeModifierNone
+	}
+
+	g.AcceptRunWhitespace()
+
+	if !g.AcceptToken(tk) {
+		return MappedTypeModifierNone
+	}
+
+	j.Score(g)
+
+	return m
+}
+
+// TupleType represents a Typescript tuple type.
+type TupleType struct {
+	TupleElements []TupleElement
+	Tokens        Tokens
+}
+
+func (tt *TupleType) parse(j *jsParser) error {
+	if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "["}) {
+		return j.Error("TupleType", ErrMissingOpeningBracket)
+	}
+
+	for {
+		j.AcceptRunWhitespace()
+
+		if j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "]"}) {
+			break
+		}
+
+		g := j.NewGoal()
+		e := len(tt.TupleElements)
+
+		tt.TupleElements = append(tt.TupleElements, TupleElement{})
+		if err := tt.TupleElements[e].parse(&g); err != nil {
+			return j.Error("TupleType", err)
+		}
+
+		j.Score(g)
+		j.AcceptRunWhitespace()
+
+		if j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "]"}) {
+			break
+		} else if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ","}) {
+			return j.Error("TupleType", ErrMissingComma)
+		}
+	}
+
+	tt.Tokens = j.ToTokens()
+
+	return nil
+}
+
+// TupleElement represents a single, optionally labelled, element of a
+// Typescript TupleType.
+type TupleElement struct {
+	Spread   bool
+	Label    *Token
+	Optional bool
+	Type     TypeExpression
+	Tokens   Tokens
+}
+
+func (te *TupleElement) parse(j *jsParser) error {
+	if j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "..."}) {
+		te.Spread = true
+
+		j.AcceptRunWhitespace()
+	}
+
+	if g := j.NewGoal(); g.Accept(TokenIdentifier, TokenKeyword) {
+		label := g.GetLastToken()
+
+		g.AcceptRunWhitespace()
+
+		optional := g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "?"})
+
+		g.AcceptRunWhitespace()
+
+		if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ":"}) {
+			te.Label = label
+			te.Optional = optional
+
+			g.AcceptRunWhitespace()
+			j.Score(g)
+		}
+	}
+
+	g := j.NewGoal()
+
+	if err := te.Type.parse(&g); err != nil {
+		return j.Error("TupleElement", err)
+	}
+
+	j.Score(g)
+
+	if te.Label == nil {
+		g = j.NewGoal()
+
+		g.AcceptRunWhitespace()
+
+		if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "?"}) {
+			te.Optional = true
+
+			j.Score(g)
+		}
+	}
+
+	te.Tokens = j.ToTokens()
+
+	return nil
+}
+
+// ImportType represents a Typescript import type, such as
+// import("module").Type.
+type ImportType struct {
+	Type          TypeExpression
+	TypeReference *TypeReference
+	TypeArguments *TypeArguments
+	Tokens        Tokens
+}
+
+func (it *ImportType) parse(j *jsParser) error {
+	if !j.AcceptToken(parser.Token{Type: TokenKeyword, Data: "import"}) {
+		return j.Error("ImportType", ErrInvalidImport)
+	}
+
+	j.AcceptRunWhitespace()
+
+	if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "("}) {
+		return j.Error("ImportType", ErrMissingOpeningParenthesis)
+	}
+
+	j.AcceptRunWhitespace()
+
+	g := j.NewGoal()
+
+	if err := it.Type.parse(&g); err != nil {
+		return j.Error("ImportType", err)
+	}
+
+	j.Score(g)
+	j.AcceptRunWhitespace()
+
+	if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ")"}) {
+		return j.Error("ImportType", ErrMissingClosingParenthesis)
+	}
+
+	g = j.NewGoal()
+
+	g.AcceptRunWhitespace()
+
+	if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "."}) {
+		g.AcceptRunWhitespace()
+
+		h := g.NewGoal()
+
+		it.TypeReference = new(TypeReference)
+		if err := it.TypeReference.parse(&h); err != nil {
+			return g.Error("ImportType", err)
+		}
+
+		g.Score(h)
+		j.Score(g)
+	} else if g.Peek() == (parser.Token{Type: TokenPunctuator, Data: "<"}) {
+		h := g.NewGoal()
+
+		it.TypeArguments = new(TypeArguments)
+		if err := it.TypeArguments.parse(&h); err != nil {
+			return g.Error("ImportType", err)
+		}
+
+		g.Score(h)
+		j.Score(g)
+	}
+
+	it.Tokens = j.ToTokens()
+
+	return nil
+}
+
+// TypeQuery represents a Typescript 'typeof' type query.
+type TypeQuery struct {
+	EntityName    []*Token
+	TypeArguments *TypeArguments
+	Tokens        Tokens
+}
+
+func (tq *TypeQuery) parse(j *jsParser) error {
+	if !j.AcceptToken(parser.Token{Type: TokenKeyword, Data: "typeof"}) {
+		return j.Error("TypeQuery", ErrInvalidType)
+	}
+
+	j.AcceptRunWhitespace()
+
+	if tk := j.parseIdentifier(false, false); tk == nil {
+		return j.Error("TypeQuery", ErrNoIdentifier)
+	} else {
+		tq.EntityName = append(tq.EntityName, tk)
+	}
+
+	for {
+		g := j.NewGoal()
+
+		g.AcceptRunWhitespace()
+
+		if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "."}) {
+			break
+		}
+
+		g.AcceptRunWhitespace()
+
+		if !g.Accept(TokenIdentifier, TokenKeyword, TokenPrivateIdentifier) {
+			return g.Error("TypeQuery", ErrNoIdentifier)
+		}
+
+		j.Score(g)
+
+		tq.EntityName = append(tq.EntityName, j.GetLastToken())
+	}
+
+	tq.TypeArguments = j.parseOptionalTypeArguments()
+	tq.Tokens = j.ToTokens()
+
+	return nil
+}
+
+func (j *jsParser) parseOptionalTypeArguments() *TypeArguments {
+	g := j.NewGoal()
+
+	g.AcceptRunWhitespaceNoNewLine()
+
+	if g.Peek() != (parser.Token{Type: TokenPunctuator, Data: "<"}) {
+		return nil
+	}
+
+	h := g.NewGoal()
+
+	var ta TypeArguments
+
+	if ta.parse(&h) != nil {
+		return nil
+	}
+
+	g.Score(h)
+	j.Score(g)
+
+	return &ta
+}
+
+// TypeReference represents a, possibly qualified, reference to a named
+// Typescript type, with optional type arguments.
+type TypeReference struct {
+	TypeName      []*Token
+	TypeArguments *TypeArguments
+	Tokens        Tokens
+}
+
+func (tr *TypeReference) parse(j *jsParser) error {
+	if tk := j.parseIdentifier(false, false); tk == nil {
+		return j.Error("TypeReference", ErrNoIdentifier)
+	} else {
+		tr.TypeName = append(tr.TypeName, tk)
+	}
+
+	for {
+		g := j.NewGoal()
+
+		g.AcceptRunWhitespace()
+
+		if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "."}) {
+			break
+		}
+
+		g.AcceptRunWhitespace()
+
+		tk := g.parseIdentifier(false, false)
+		if tk == nil {
+			return g.Error("TypeReference", ErrNoIdentifier)
+		}
+
+		j.Score(g)
+
+		tr.TypeName = append(tr.TypeName, tk)
+	}
+
+	tr.TypeArguments = j.parseOptionalTypeArguments()
+	tr.Tokens = j.ToTokens()
+
+	return nil
+}
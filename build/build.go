@@ -0,0 +1,662 @@
+// Package build provides constructors for creating JavaScript AST nodes.
+package build // import "vimagination.zapto.org/javascript/build"
+
+import (
+	"math"
+	"slices"
+	"strconv"
+
+	"vimagination.zapto.org/javascript"
+	"vimagination.zapto.org/javascript/internal"
+	"vimagination.zapto.org/parser"
+)
+
+// Ident creates an expression referencing the named identifier.
+//
+// Ident panics if the name is not a valid identifier, either because it
+// contains characters not allowed in an identifier or because it is a reserved
+// word.
+func Ident(name string) *javascript.AssignmentExpression {
+	return primary(&javascript.PrimaryExpression{IdentifierReference: identifier(name)})
+}
+
+// Str creates a double quoted string literal.
+func Str(s string) *javascript.AssignmentExpression {
+	return literal(javascript.TokenStringLiteral, javascript.Quote(s))
+}
+
+// Num creates a numeric literal.
+//
+// Negative numbers are created with a unary minus, and NaN and infinite values
+// reference the NaN and Infinity identifiers.
+func Num(f float64) *javascript.AssignmentExpression {
+	var ae *javascript.AssignmentExpression
+
+	if math.IsNaN(f) {
+		return Ident("NaN")
+	} else if math.IsInf(f, 0) {
+		ae = Ident("Infinity")
+	} else if a := math.Abs(f); a == math.Trunc(a) && a < 1e21 {
+		ae = literal(javascript.TokenNumericLiteral, strconv.FormatFloat(a, 'f', -1, 64))
+	} else {
+		ae = literal(javascript.TokenNumericLiteral, strconv.FormatFloat(a, 'g', -1, 64))
+	}
+
+	if math.Signbit(f) {
+		ue := javascript.UnwrapConditional(ae.ConditionalExpression).(*javascript.PrimaryExpression)
+
+		return &javascript.AssignmentExpression{
+			ConditionalExpression: javascript.WrapConditional(&javascript.UnaryExpression{
+				UnaryOperators: []javascript.UnaryOperatorComments{{UnaryOperator: javascript.UnaryMinus}},
+				UpdateExpression: javascript.UpdateExpression{
+					LeftHandSideExpression: &javascript.LeftHandSideExpression{
+						NewExpression: &javascript.NewExpression{
+							MemberExpression: javascript.MemberExpression{PrimaryExpression: ue},
+						},
+					},
+				},
+			}),
+		}
+	}
+
+	return ae
+}
+
+// Bool creates a boolean literal.
+func Bool(b bool) *javascript.AssignmentExpression {
+	return literal(javascript.TokenBooleanLiteral, strconv.FormatBool(b))
+}
+
+// Null creates a null literal.
+func Null() *javascript.AssignmentExpression {
+	return literal(javascript.TokenNullLiteral, "null")
+}
+
+// This creates a this expression.
+func This() *javascript.AssignmentExpression {
+	return primary(&javascript.PrimaryExpression{This: token(javascript.TokenKeyword, "this")})
+}
+
+// Member creates a property access of the given object.
+//
+// When prop is a valid identifier name the dot notation is used, otherwise the
+// property is accessed with a quoted string.
+func Member(obj *javascript.AssignmentExpression, prop string) *javascript.AssignmentExpression {
+	if !isIdentifier(prop) {
+		return Index(obj, Str(prop))
+	}
+
+	if ce := callExpression(obj); ce != nil {
+		return callToAssignment(&javascript.CallExpression{CallExpression: ce, IdentifierName: token(javascript.TokenIdentifier, prop)})
+	}
+
+	return memberToAssignment(&javascript.MemberExpression{MemberExpression: object(obj), IdentifierName: token(javascript.TokenIdentifier, prop)})
+}
+
+// Index creates a computed property access of the given object.
+func Index(obj, index *javascript.AssignmentExpression) *javascript.AssignmentExpression {
+	e := expression(index)
+
+	if ce := callExpression(obj); ce != nil {
+		return callToAssignment(&javascript.CallExpression{CallExpression: ce, Expression: e})
+	}
+
+	return memberToAssignment(&javascript.MemberExpression{MemberExpression: object(obj), Expression: e})
+}
+
+// Call creates a call of the callee with the given arguments.
+func Call(callee *javascript.AssignmentExpression, args ...*javascript.AssignmentExpression) *javascript.AssignmentExpression {
+	if ce := callExpression(callee); ce != nil {
+		return callToAssignment(&javascript.CallExpression{CallExpression: ce, Arguments: arguments(args)})
+	}
+
+	return callToAssignment(&javascript.CallExpression{MemberExpression: member(callee), Arguments: arguments(args)})
+}
+
+// New creates a new expression, constructing the callee with the given
+// arguments.
+func New(callee *javascript.AssignmentExpression, args ...*javascript.AssignmentExpression) *javascript.AssignmentExpression {
+	return memberToAssignment(&javascript.MemberExpression{MemberExpression: member(callee), Arguments: arguments(args)})
+}
+
+// Assign creates an assignment of the value to the target.
+func Assign(target, value *javascript.AssignmentExpression) *javascript.AssignmentExpression {
+	return &javascript.AssignmentExpression{
+		LeftHandSideExpression: leftHandSide(target),
+		AssignmentOperator:     javascript.AssignmentAssign,
+		AssignmentExpression:   value,
+	}
+}
+
+// Array creates an array literal containing the given elements.
+//
+// A nil element creates a hole in the array.
+func Array(elements ...*javascript.AssignmentExpression) *javascript.AssignmentExpression {
+	al := &javascript.ArrayLiteral{ElementList: make([]javascript.ArrayElement, len(elements))}
+
+	for n, e := range elements {
+		if e != nil {
+			al.ElementList[n].AssignmentExpression = *e
+		}
+	}
+
+	return primary(&javascript.PrimaryExpression{ArrayLiteral: al})
+}
+
+// Prop creates a property definition, for use with Object.
+//
+// When name is not a valid identifier name, it is quoted.
+func Prop(name string, value *javascript.AssignmentExpression) javascript.PropertyDefinition {
+	pn := &javascript.PropertyName{LiteralPropertyName: token(javascript.TokenIdentifier, name)}
+
+	if !isIdentifier(name) {
+		pn.LiteralPropertyName = token(javascript.TokenStringLiteral, javascript.Quote(name))
+	}
+
+	return javascript.PropertyDefinition{PropertyName: pn, AssignmentExpression: value}
+}
+
+// Object creates an object literal containing the given properties.
+func Object(props ...javascript.PropertyDefinition) *javascript.AssignmentExpression {
+	return primary(&javascript.PrimaryExpression{ObjectLiteral: &javascript.ObjectLiteral{PropertyDefinitionList: props}})
+}
+
+// Arrow creates an arrow function with the named parameters that returns the
+// body expression.
+//
+// Arrow panics if any parameter is not a valid identifier.
+func Arrow(params []string, body *javascript.AssignmentExpression) *javascript.AssignmentExpression {
+	if startsWithBrace(body) {
+		body = paren(body)
+	}
+
+	return &javascript.AssignmentExpression{
+		ArrowFunction: &javascript.ArrowFunction{
+			FormalParameters:     formalParameters(params),
+			AssignmentExpression: body,
+		},
+	}
+}
+
+// ArrowBlock creates an arrow function with the named parameters and a body of
+// the given statements.
+//
+// ArrowBlock panics if any parameter is not a valid identifier.
+func ArrowBlock(params []string, body ...*javascript.StatementListItem) *javascript.AssignmentExpression {
+	return &javascript.AssignmentExpression{
+		ArrowFunction: &javascript.ArrowFunction{
+			FormalParameters: formalParameters(params),
+			FunctionBody:     block(body),
+		},
+	}
+}
+
+// Function creates a function expression with the named parameters and a body
+// of the given statements.
+//
+// The name may be empty to create an anonymous function.
+//
+// Function panics if the name, when not empty, or any parameter is not a valid
+// identifier.
+func Function(name string, params []string, body ...*javascript.StatementListItem) *javascript.AssignmentExpression {
+	return primary(&javascript.PrimaryExpression{FunctionExpression: function(name, params, body)})
+}
+
+// FunctionDeclaration creates a function declaration with the named parameters
+// and a body of the given statements.
+//
+// FunctionDeclaration panics if the name or any parameter is not a valid
+// identifier.
+func FunctionDeclaration(name string, params []string, body ...*javascript.StatementListItem) *javascript.StatementListItem {
+	if name == "" {
+		panic("build: function declaration requires a name")
+	}
+
+	return &javascript.StatementListItem{
+		Declaration: &javascript.Declaration{FunctionDeclaration: function(name, params, body)},
+	}
+}
+
+// ExprStmt creates an expression statement.
+//
+// Expressions that would otherwise be parsed as a different statement, such as
+// those beginning with an object literal, function expression, or the let
+// identifier, are parenthesised.
+func ExprStmt(e *javascript.AssignmentExpression) *javascript.StatementListItem {
+	if pe := leftmost(e); startsWithBrace(e) || pe != nil && (pe.FunctionExpression != nil || pe.ClassExpression != nil || pe.IdentifierReference != nil && pe.IdentifierReference.Data == "let") {
+		e = paren(e)
+	}
+
+	return statement(&javascript.Statement{ExpressionStatement: expression(e)})
+}
+
+// Const creates a const declaration of the name, initialised to the given
+// expression.
+//
+// Const panics if the name is not a valid identifier, or if the
+// expression is nil, as a const declaration requires an initialiser.
+func Const(name string, e *javascript.AssignmentExpression) *javascript.StatementListItem {
+	if e == nil {
+		panic("build: const declaration requires an initialiser")
+	}
+
+	return lexicalDeclaration(javascript.Const, name, e)
+}
+
+// Let creates a let declaration of the name, initialised to the given
+// expression, which may be nil.
+//
+// Let panics if the name is not a valid identifier.
+func Let(name string, e *javascript.AssignmentExpression) *javascript.StatementListItem {
+	return lexicalDeclaration(javascript.Let, name, e)
+}
+
+// Var creates a var statement for the name, initialised to the given
+// expression, which may be nil.
+//
+// Var panics if the name is not a valid identifier.
+func Var(name string, e *javascript.AssignmentExpression) *javascript.StatementListItem {
+	return statement(&javascript.Statement{
+		VariableStatement: &javascript.VariableStatement{
+			VariableDeclarationList: []javascript.VariableDeclaration{
+				{
+					BindingIdentifier: identifier(name),
+					Initializer:       e,
+				},
+			},
+		},
+	})
+}
+
+// Return creates a return statement, returning the given expression, which may
+// be nil.
+func Return(e *javascript.AssignmentExpression) *javascript.StatementListItem {
+	s := &javascript.Statement{Type: javascript.StatementReturn}
+
+	if e != nil {
+		s.ExpressionStatement = expression(e)
+	}
+
+	return statement(s)
+}
+
+// Block creates a block statement containing the given statements.
+func Block(stmts ...*javascript.StatementListItem) *javascript.StatementListItem {
+	return statement(&javascript.Statement{BlockStatement: block(stmts)})
+}
+
+// If creates an if statement, with an optional else statement.
+//
+// A nil then statement creates an empty statement. Declarations used as either
+// statement are wrapped in a block, as is the then statement when it would
+// otherwise take the else statement.
+func If(cond *javascript.AssignmentExpression, then, els *javascript.StatementListItem) *javascript.StatementListItem {
+	if then == nil {
+		then = statement(&javascript.Statement{})
+	}
+
+	is := &javascript.IfStatement{
+		Expression: *expression(cond),
+		Statement:  *toStatement(then),
+	}
+
+	if els != nil {
+		if hasDanglingIf(&is.Statement) {
+			is.Statement = javascript.Statement{BlockStatement: block([]*javascript.StatementListItem{then})}
+		}
+
+		is.ElseStatement = toStatement(els)
+	}
+
+	return statement(&javascript.Statement{IfStatement: is})
+}
+
+// Module creates a module containing the given statements.
+func Module(stmts ...*javascript.StatementListItem) *javascript.Module {
+	m := &javascript.Module{ModuleListItems: make([]javascript.ModuleItem, len(stmts))}
+
+	for n, s := range stmts {
+		m.ModuleListItems[n].StatementListItem = s
+	}
+
+	return m
+}
+
+func token(typ parser.TokenType, data string) *javascript.Token {
+	return &javascript.Token{
+		Token: parser.Token{
+			Type: typ,
+			Data: data,
+		},
+	}
+}
+
+var reservedWords = [...]string{"await", "break", "case", "catch", "class", "const", "continue", "debugger", "default", "delete", "do", "else", "enum", "export", "extends", "false", "finally", "for", "function", "if", "import", "in", "instanceof", "new", "null", "return", "super", "switch", "this", "throw", "true", "try", "typeof", "var", "void", "while", "with", "yield"}
+
+func identifier(name string) *javascript.Token {
+	if !isIdentifier(name) {
+		panic("build: invalid identifier name: " + strconv.Quote(name))
+	} else if slices.Contains(reservedWords[:], name) {
+		panic("build: reserved word used as identifier: " + strconv.Quote(name))
+	}
+
+	return token(javascript.TokenIdentifier, name)
+}
+
+func isIdentifier(str string) bool {
+	for n, r := range str {
+		if (n == 0 && !internal.IsIDStart(r)) || (n > 0 && !internal.IsIDContinue(r)) {
+			return false
+		}
+	}
+
+	return str != ""
+}
+
+func literal(typ parser.TokenType, data string) *javascript.AssignmentExpression {
+	return primary(&javascript.PrimaryExpression{Literal: token(typ, data)})
+}
+
+func primary(pe *javascript.PrimaryExpression) *javascript.AssignmentExpression {
+	return memberToAssignment(&javascript.MemberExpression{PrimaryExpression: pe})
+}
+
+func memberToAssignment(me *javascript.MemberExpression) *javascript.AssignmentExpression {
+	return &javascript.AssignmentExpression{ConditionalExpression: javascript.WrapConditional(me)}
+}
+
+func callToAssignment(ce *javascript.CallExpression) *javascript.AssignmentExpression {
+	return &javascript.AssignmentExpression{ConditionalExpression: javascript.WrapConditional(ce)}
+}
+
+func paren(e *javascript.AssignmentExpression) *javascript.AssignmentExpression {
+	return primary(&javascript.PrimaryExpression{
+		ParenthesizedExpression: &javascript.ParenthesizedExpression{
+			Expressions: []javascript.AssignmentExpression{*e},
+		},
+	})
+}
+
+func expression(e *javascript.AssignmentExpression) *javascript.Expression {
+	return &javascript.Expression{Expressions: []javascript.AssignmentExpression{*e}}
+}
+
+func unwrap(e *javascript.AssignmentExpression) javascript.ConditionalWrappable {
+	if e.ConditionalExpression == nil || e.AssignmentOperator != javascript.AssignmentNone || e.Yield {
+		return nil
+	}
+
+	return javascript.UnwrapConditional(e.ConditionalExpression)
+}
+
+func callExpression(e *javascript.AssignmentExpression) *javascript.CallExpression {
+	ce, _ := unwrap(e).(*javascript.CallExpression)
+
+	return ce
+}
+
+func member(e *javascript.AssignmentExpression) *javascript.MemberExpression {
+	switch w := unwrap(e).(type) {
+	case *javascript.MemberExpression:
+		return w
+	case *javascript.PrimaryExpression, *javascript.ArrayLiteral, *javascript.ObjectLiteral, *javascript.FunctionDeclaration, *javascript.ClassDeclaration, *javascript.TemplateLiteral, *javascript.ParenthesizedExpression, *javascript.JSXElement, *javascript.JSXFragment:
+		return &e.ConditionalExpression.LogicalORExpression.LogicalANDExpression.BitwiseORExpression.BitwiseXORExpression.BitwiseANDExpression.EqualityExpression.RelationalExpression.ShiftExpression.AdditiveExpression.MultiplicativeExpression.ExponentiationExpression.UnaryExpression.UpdateExpression.LeftHandSideExpression.NewExpression.MemberExpression
+	}
+
+	return &javascript.MemberExpression{PrimaryExpression: &javascript.PrimaryExpression{
+		ParenthesizedExpression: &javascript.ParenthesizedExpression{
+			Expressions: []javascript.AssignmentExpression{*e},
+		},
+	}}
+}
+
+func object(e *javascript.AssignmentExpression) *javascript.MemberExpression {
+	if pe, ok := unwrap(e).(*javascript.PrimaryExpression); ok && pe.Literal != nil && pe.Literal.Type == javascript.TokenNumericLiteral {
+		e = paren(e)
+	}
+
+	return member(e)
+}
+
+func leftHandSide(e *javascript.AssignmentExpression) *javascript.LeftHandSideExpression {
+	switch w := unwrap(e).(type) {
+	case *javascript.CallExpression:
+		return &javascript.LeftHandSideExpression{CallExpression: w}
+	case *javascript.OptionalExpression:
+		return &javascript.LeftHandSideExpression{OptionalExpression: w}
+	case *javascript.NewExpression:
+		return &javascript.LeftHandSideExpression{NewExpression: w}
+	}
+
+	return &javascript.LeftHandSideExpression{NewExpression: &javascript.NewExpression{MemberExpression: *member(e)}}
+}
+
+func arguments(args []*javascript.AssignmentExpression) *javascript.Arguments {
+	a := &javascript.Arguments{ArgumentList: make([]javascript.Argument, len(args))}
+
+	for n, arg := range args {
+		a.ArgumentList[n].AssignmentExpression = *arg
+	}
+
+	return a
+}
+
+func formalParameters(params []string) *javascript.FormalParameters {
+	fp := &javascript.FormalParameters{FormalParameterList: make([]javascript.BindingElement, len(params))}
+
+	for n, param := range params {
+		fp.FormalParameterList[n].SingleNameBinding = identifier(param)
+	}
+
+	return fp
+}
+
+func block(stmts []*javascript.StatementListItem) *javascript.Block {
+	b := &javascript.Block{StatementList: make([]javascript.StatementListItem, len(stmts))}
+
+	for n, s := range stmts {
+		b.StatementList[n] = *s
+	}
+
+	return b
+}
+
+func function(name string, params []string, body []*javascript.StatementListItem) *javascript.FunctionDeclaration {
+	fd := &javascript.FunctionDeclaration{
+		FormalParameters: *formalParameters(params),
+		FunctionBody:     *block(body),
+	}
+
+	if name != "" {
+		fd.BindingIdentifier = identifier(name)
+	}
+
+	return fd
+}
+
+func startsWithBrace(ae *javascript.AssignmentExpression) bool {
+	if ae.AssignmentPattern != nil {
+		return ae.AssignmentPattern.ObjectAssignmentPattern != nil
+	}
+
+	pe := leftmost(ae)
+
+	return pe != nil && pe.ObjectLiteral != nil
+}
+
+// leftmost returns the PrimaryExpression at the start of the given expression,
+// or nil if the expression starts with an operator or keyword.
+func leftmost(ae *javascript.AssignmentExpression) *javascript.PrimaryExpression {
+	if ae.Yield || ae.ArrowFunction != nil || ae.AssignmentPattern != nil {
+		return nil
+	} else if ae.LeftHandSideExpression != nil {
+		return leftmostLHS(ae.LeftHandSideExpression)
+	} else if ae.ConditionalExpression == nil {
+		return nil
+	}
+
+	var bor *javascript.BitwiseORExpression
+
+	if ce := ae.ConditionalExpression.CoalesceExpression; ce != nil {
+		for ce.CoalesceExpressionHead != nil {
+			ce = ce.CoalesceExpressionHead
+		}
+
+		bor = &ce.BitwiseORExpression
+	} else {
+		lor := ae.ConditionalExpression.LogicalORExpression
+
+		for lor.LogicalORExpression != nil {
+			lor = lor.LogicalORExpression
+		}
+
+		land := &lor.LogicalANDExpression
+
+		for land.LogicalANDExpression != nil {
+			land = land.LogicalANDExpression
+		}
+
+		bor = &land.BitwiseORExpression
+	}
+
+	for bor.BitwiseORExpression != nil {
+		bor = bor.BitwiseORExpression
+	}
+
+	bxor := &bor.BitwiseXORExpression
+
+	for bxor.BitwiseXORExpression != nil {
+		bxor = bxor.BitwiseXORExpression
+	}
+
+	band := &bxor.BitwiseANDExpression
+
+	for band.BitwiseANDExpression != nil {
+		band = band.BitwiseANDExpression
+	}
+
+	ee := &band.EqualityExpression
+
+	for ee.EqualityExpression != nil {
+		ee = ee.EqualityExpression
+	}
+
+	re := &ee.RelationalExpression
+
+	for re.RelationalExpression != nil {
+		re = re.RelationalExpression
+	}
+
+	if re.PrivateIdentifier != nil {
+		return nil
+	}
+
+	se := &re.ShiftExpression
+
+	for se.ShiftExpression != nil {
+		se = se.ShiftExpression
+	}
+
+	ad := &se.AdditiveExpression
+
+	for ad.AdditiveExpression != nil {
+		ad = ad.AdditiveExpression
+	}
+
+	me := &ad.MultiplicativeExpression
+
+	for me.MultiplicativeExpression != nil {
+		me = me.MultiplicativeExpression
+	}
+
+	ex := &me.ExponentiationExpression
+
+	for ex.ExponentiationExpression != nil {
+		ex = ex.ExponentiationExpression
+	}
+
+	if len(ex.UnaryExpression.UnaryOperators) > 0 || ex.UnaryExpression.UpdateExpression.LeftHandSideExpression == nil {
+		return nil
+	}
+
+	return leftmostLHS(ex.UnaryExpression.UpdateExpression.LeftHandSideExpression)
+}
+
+func leftmostLHS(lhs *javascript.LeftHandSideExpression) *javascript.PrimaryExpression {
+	var (
+		me *javascript.MemberExpression
+		ce = lhs.CallExpression
+	)
+
+	if lhs.NewExpression != nil {
+		if len(lhs.NewExpression.News) > 0 {
+			return nil
+		}
+
+		me = &lhs.NewExpression.MemberExpression
+	} else if oe := lhs.OptionalExpression; oe != nil {
+		for oe.OptionalExpression != nil {
+			oe = oe.OptionalExpression
+		}
+
+		me, ce = oe.MemberExpression, oe.CallExpression
+	}
+
+	if ce != nil {
+		for ce.CallExpression != nil {
+			ce = ce.CallExpression
+		}
+
+		me = ce.MemberExpression
+	}
+
+	for me != nil && me.Arguments == nil {
+		if me.PrimaryExpression != nil {
+			return me.PrimaryExpression
+		}
+
+		me = me.MemberExpression
+	}
+
+	return nil
+}
+
+func statement(s *javascript.Statement) *javascript.StatementListItem {
+	return &javascript.StatementListItem{Statement: s}
+}
+
+func toStatement(s *javascript.StatementListItem) *javascript.Statement {
+	if s.Statement != nil {
+		return s.Statement
+	}
+
+	return &javascript.Statement{BlockStatement: block([]*javascript.StatementListItem{s})}
+}
+
+func hasDanglingIf(s *javascript.Statement) bool {
+	for s.IfStatement != nil {
+		if s.IfStatement.ElseStatement == nil {
+			return true
+		}
+
+		s = s.IfStatement.ElseStatement
+	}
+
+	return false
+}
+
+func lexicalDeclaration(letOrConst javascript.LetOrConst, name string, e *javascript.AssignmentExpression) *javascript.StatementListItem {
+	return &javascript.StatementListItem{
+		Declaration: &javascript.Declaration{
+			LexicalDeclaration: &javascript.LexicalDeclaration{
+				LetOrConst: letOrConst,
+				BindingList: []javascript.LexicalBinding{
+					{
+						BindingIdentifier: identifier(name),
+						Initializer:       e,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,312 @@
+package build
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"vimagination.zapto.org/javascript"
+	"vimagination.zapto.org/parser"
+)
+
+func TestExpressions(t *testing.T) {
+	for n, test := range [...]struct {
+		Input  *javascript.AssignmentExpression
+		Output string
+	}{
+		{ // 1
+			Ident("a"),
+			"a",
+		},
+		{ // 2
+			Str("a\"b\n"),
+			"\"a\\\"b\\n\"",
+		},
+		{ // 3
+			Num(1000000),
+			"1000000",
+		},
+		{ // 4
+			Num(0.5),
+			"0.5",
+		},
+		{ // 5
+			Num(-2),
+			"-2",
+		},
+		{ // 6
+			Num(1e21),
+			"1e+21",
+		},
+		{ // 7
+			Num(math.NaN()),
+			"NaN",
+		},
+		{ // 8
+			Num(math.Inf(-1)),
+			"-Infinity",
+		},
+		{ // 9
+			Array(Bool(true), Null(), This()),
+			"[true, null, this]",
+		},
+		{ // 10
+			Member(Ident("a"), "b"),
+			"a.b",
+		},
+		{ // 11
+			Member(Ident("a"), "b-c"),
+			"a[\"b-c\"]",
+		},
+		{ // 12
+			Member(Member(Ident("a"), "b"), "c"),
+			"a.b.c",
+		},
+		{ // 13
+			Member(Call(Ident("a")), "b"),
+			"a().b",
+		},
+		{ // 14
+			Member(Num(1), "toString"),
+			"(1).toString",
+		},
+		{ // 15
+			Member(Num(-1), "toString"),
+			"(-1).toString",
+		},
+		{ // 16
+			Index(Ident("a"), Num(0)),
+			"a[0]",
+		},
+		{ // 17
+			Index(Call(Ident("a")), Str("b")),
+			"a()[\"b\"]",
+		},
+		{ // 18
+			Call(Member(Ident("console"), "log"), Str("a"), Ident("b")),
+			"console.log(\"a\", b)",
+		},
+		{ // 19
+			Call(Call(Ident("a"), Num(1)), Num(2)),
+			"a(1)(2)",
+		},
+		{ // 20
+			Call(Arrow(nil, Ident("a"))),
+			"(() => a)()",
+		},
+		{ // 21
+			New(Ident("A"), Num(1)),
+			"new A(1)",
+		},
+		{ // 22
+			New(Call(Ident("a"))),
+			"new (a())()",
+		},
+		{ // 23
+			New(Member(Ident("a"), "B")),
+			"new a.B()",
+		},
+		{ // 24
+			Assign(Member(This(), "a"), Ident("b")),
+			"this.a = b",
+		},
+		{ // 25
+			Assign(Ident("a"), Assign(Ident("b"), Num(1))),
+			"a = b = 1",
+		},
+		{ // 26
+			Object(Prop("a", Num(1)), Prop("b c", Str("d"))),
+			"{a: 1, \"b c\": \"d\"}",
+		},
+		{ // 27
+			Arrow([]string{"a", "b"}, Call(Ident("c"), Ident("a"), Ident("b"))),
+			"(a, b) => c(a, b)",
+		},
+		{ // 28
+			Arrow(nil, Object()),
+			"() => ({})",
+		},
+		{ // 29
+			ArrowBlock([]string{"a"}, Return(Ident("a"))),
+			"(a) => {\n\treturn a;\n}",
+		},
+		{ // 30
+			Function("", []string{"a"}, Return(nil)),
+			"function (a) {\n\treturn;\n}",
+		},
+		{ // 31
+			Function("a", nil),
+			"function a() {}",
+		},
+		{ // 32
+			Arrow(nil, Member(Object(), "x")),
+			"() => ({}.x)",
+		},
+		{ // 33
+			Arrow(nil, Assign(Member(Object(), "x"), Num(1))),
+			"() => ({}.x = 1)",
+		},
+		{ // 34
+			Array(Num(1), nil, Num(2), nil),
+			"[1, , 2, ,]",
+		},
+	} {
+		if output := fmt.Sprintf("%s", test.Input); output != test.Output {
+			t.Errorf("test %d: expecting output %q, got %q", n+1, test.Output, output)
+		}
+	}
+}
+
+func TestStatements(t *testing.T) {
+	for n, test := range [...]struct {
+		Input  *javascript.StatementListItem
+		Output string
+	}{
+		{ // 1
+			ExprStmt(Call(Ident("a"))),
+			"a();",
+		},
+		{ // 2
+			ExprStmt(Member(Object(), "a")),
+			"({}.a);",
+		},
+		{ // 3
+			ExprStmt(Call(Function("", nil))),
+			"(function () {}());",
+		},
+		{ // 4
+			Const("a", Num(1)),
+			"const a = 1;",
+		},
+		{ // 5
+			Let("a", nil),
+			"let a;",
+		},
+		{ // 6
+			Var("a", Str("b")),
+			"var a = \"b\";",
+		},
+		{ // 7
+			Return(Ident("a")),
+			"return a;",
+		},
+		{ // 8
+			Block(ExprStmt(Ident("a")), Return(nil)),
+			"{\n\ta;\n\treturn;\n}",
+		},
+		{ // 9
+			If(Ident("a"), ExprStmt(Call(Ident("b"))), nil),
+			"if (a) b();",
+		},
+		{ // 10
+			If(Ident("a"), Return(Ident("b")), Return(Ident("c"))),
+			"if (a) return b; else return c;",
+		},
+		{ // 11
+			If(Ident("a"), Const("b", Num(1)), nil),
+			"if (a) {\n\tconst b = 1;\n}",
+		},
+		{ // 12
+			If(Ident("a"), If(Ident("b"), Return(nil), nil), Return(Ident("c"))),
+			"if (a) {\n\tif (b) return;\n} else return c;",
+		},
+		{ // 13
+			If(Ident("a"), Block(Return(nil)), If(Ident("b"), Return(nil), nil)),
+			"if (a) {\n\treturn;\n} else if (b) return;",
+		},
+		{ // 14
+			FunctionDeclaration("a", []string{"b"}, Return(Ident("b"))),
+			"function a(b) {\n\treturn b;\n}",
+		},
+		{ // 15
+			ExprStmt(Index(Ident("let"), Num(0))),
+			"(let[0]);",
+		},
+		{ // 16
+			ExprStmt(Assign(Member(Call(Function("", nil)), "a"), Num(1))),
+			"(function () {}().a = 1);",
+		},
+		{ // 17
+			ExprStmt(Call(Ident("a"), Object())),
+			"a({});",
+		},
+		{ // 18
+			ExprStmt(New(Function("", nil))),
+			"new function () {}();",
+		},
+		{ // 19
+			If(Ident("a"), nil, nil),
+			"if (a) ;",
+		},
+		{ // 20
+			If(Ident("a"), nil, Return(nil)),
+			"if (a) ; else return;",
+		},
+		{ // 21
+			ExprStmt(Assign(Member(Ident("a"), "class"), Object(Prop("if", Num(1)), Prop("this", Num(2))))),
+			"a.class = {if: 1, this: 2};",
+		},
+	} {
+		if output := fmt.Sprintf("%s", test.Input); output != test.Output {
+			t.Errorf("test %d: expecting output %q, got %q", n+1, test.Output, output)
+		}
+	}
+}
+
+func TestPanics(t *testing.T) {
+	for n, test := range [...]func(){
+		func() { Ident("1a") },                                // 1
+		func() { Ident("") },                                  // 2
+		func() { Const("a", nil) },                            // 3
+		func() { Const("a-b", Num(1)) },                       // 4
+		func() { Let("a b", nil) },                            // 5
+		func() { Var("", nil) },                               // 6
+		func() { Function("a.b", nil) },                       // 7
+		func() { Function("", []string{"a", "1"}) },           // 8
+		func() { FunctionDeclaration("", nil) },               // 9
+		func() { Arrow([]string{"a-b"}, Num(1)) },             // 10
+		func() { ArrowBlock([]string{""}) },                   // 11
+		func() { Ident("if") },                                // 12
+		func() { Const("class", Num(1)) },                     // 13
+		func() { Let("for", nil) },                            // 14
+		func() { Var("this", nil) },                           // 15
+		func() { Function("new", nil) },                       // 16
+		func() { FunctionDeclaration("a", []string{"null"}) }, // 17
+		func() { Arrow([]string{"yield"}, Num(1)) },           // 18
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("test %d: expecting panic", n+1)
+				}
+			}()
+
+			test()
+		}()
+	}
+}
+
+func TestModule(t *testing.T) {
+	m := Module(
+		Const("a", Object(Prop("b", Arrow([]string{"c"}, Member(Ident("c"), "d"))))),
+		If(Call(Member(Ident("a"), "b"), Ident("e")), ExprStmt(Assign(Ident("f"), New(Ident("G")))), nil),
+	)
+
+	const expected = "const a = {b: (c) => c.d};\n\nif (a.b(e)) f = new G();"
+
+	output := fmt.Sprintf("%s", m)
+	if output != expected {
+		t.Fatalf("expecting output %q, got %q", expected, output)
+	}
+
+	tk := parser.NewStringTokeniser(output)
+
+	p, err := javascript.ParseModule(&tk)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if reparsed := fmt.Sprintf("%s", p); reparsed != expected {
+		t.Errorf("expecting parsed output %q, got %q", expected, reparsed)
+	}
+}
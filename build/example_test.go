@@ -0,0 +1,25 @@
+package build_test
+
+import (
+	"fmt"
+
+	"vimagination.zapto.org/javascript/build"
+)
+
+func Example() {
+	m := build.Module(
+		build.Const("greet", build.Arrow([]string{"name"}, build.Call(build.Member(build.Ident("console"), "log"), build.Str("Hello, "), build.Ident("name")))),
+		build.If(
+			build.Member(build.Ident("window"), "user"),
+			build.ExprStmt(build.Call(build.Ident("greet"), build.Member(build.Member(build.Ident("window"), "user"), "name"))),
+			build.ExprStmt(build.Call(build.Ident("greet"), build.Str("World"))),
+		),
+	)
+
+	fmt.Printf("%s", m)
+
+	// Output:
+	// const greet = (name) => console.log("Hello, ", name);
+	//
+	// if (window.user) greet(window.user.name); else greet("World");
+}
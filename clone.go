@@ -0,0 +1,188 @@
+package javascript
+
+import (
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// CloneOption is a flag that changes how a JavaScript type is cloned.
+type CloneOption uint8
+
+// Valid CloneOption's.
+const (
+	// DropTokens leaves the Tokens fields, which hold the source tokens of
+	// each type, empty in the copy.
+	DropTokens CloneOption = 1 << iota
+
+	// DropComments removes all comments from the copy.
+	DropComments
+)
+
+type cloner struct {
+	options CloneOption
+	tokens  map[*Token]*Token
+}
+
+func newCloner(opts []CloneOption) *cloner {
+	c := &cloner{tokens: make(map[*Token]*Token)}
+
+	for _, opt := range opts {
+		c.options |= opt
+	}
+
+	return c
+}
+
+// Clone returns a copy of the Token.
+func (t *Token) Clone() *Token {
+	return t.clone(newCloner(nil))
+}
+
+func (t *Token) clone(c *cloner) *Token {
+	if t == nil {
+		return nil
+	} else if tk, ok := c.tokens[t]; ok {
+		return tk
+	}
+
+	tk := new(Token)
+	*tk = *t
+	c.tokens[t] = tk
+
+	return tk
+}
+
+func (t Tokens) clone(c *cloner) Tokens {
+	if c.options&DropTokens != 0 {
+		return nil
+	}
+
+	return slices.Clone(t)
+}
+
+func (cm Comments) clone(c *cloner) Comments {
+	if cm == nil || c.options&DropComments != 0 {
+		return nil
+	}
+
+	d := make(Comments, len(cm))
+
+	for n, tk := range cm {
+		d[n] = tk.clone(c)
+	}
+
+	return d
+}
+
+func (d *DirectivePrologue) clone(c *cloner) *DirectivePrologue {
+	e := *d
+
+	if d.Directives != nil {
+		e.Directives = make([]*Token, len(d.Directives))
+
+		for n, tk := range d.Directives {
+			e.Directives[n] = tk.clone(c)
+		}
+	}
+
+	return &e
+}
+
+func (t *Token) equal(u *Token) bool {
+	if t == nil || u == nil {
+		return t == u
+	}
+
+	if t.Type != u.Type {
+		return false
+	} else if t.Data == u.Data {
+		return true
+	}
+
+	switch t.Type {
+	case TokenStringLiteral:
+		a, errA := Unquote(t.Data)
+		b, errB := Unquote(u.Data)
+
+		return errA == nil && errB == nil && a == b
+	case TokenNumericLiteral:
+		return equalNumbers(t.Data, u.Data)
+	}
+
+	return false
+}
+
+func equalNumbers(a, b string) bool {
+	if strings.HasSuffix(a, "n") != strings.HasSuffix(b, "n") {
+		return false
+	} else if strings.HasSuffix(a, "n") {
+		x, okA := new(big.Int).SetString(a[:len(a)-1], 0)
+		y, okB := new(big.Int).SetString(b[:len(b)-1], 0)
+
+		return okA && okB && x.Cmp(y) == 0
+	}
+
+	x, okA := numberValue(a)
+	y, okB := numberValue(b)
+
+	return okA && okB && x == y
+}
+
+func numberValue(num string) (float64, bool) {
+	if len(num) > 1 && num[0] == '0' && strings.Trim(num, "01234567") == "" {
+		n, err := strconv.ParseUint(num[1:], 8, 64)
+
+		return float64(n), err == nil
+	}
+
+	v, ok := parseNumber(num)
+
+	return v.num, ok
+}
+
+func (t Tokens) equal(u Tokens) bool {
+	return slices.EqualFunc(t, u, func(a, b Token) bool {
+		return a.equal(&b)
+	})
+}
+
+func (d *DirectivePrologue) equal(e *DirectivePrologue) bool {
+	return d.Strict == e.Strict
+}
+
+func equalTokens(a, b []*Token) bool {
+	return slices.EqualFunc(a, b, (*Token).equal)
+}
+
+func equalSlice[T any, PT interface {
+	*T
+	equal(PT) bool
+}](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for n := range a {
+		if !PT(&a[n]).equal(&b[n]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func equalType[T any, PT interface {
+	*T
+	equal(PT) bool
+}](a PT, b Type) bool {
+	switch b := b.(type) {
+	case PT:
+		return a.equal(b)
+	case T:
+		return a.equal(&b)
+	}
+
+	return false
+}
@@ -0,0 +1,280 @@
+package javascript
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"vimagination.zapto.org/parser"
+)
+
+func tokenPointers(v reflect.Value, tks map[*Token]struct{}) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return
+		}
+
+		if tk, ok := v.Interface().(*Token); ok {
+			tks[tk] = struct{}{}
+
+			return
+		}
+
+		tokenPointers(v.Elem(), tks)
+	case reflect.Struct:
+		for n := range v.NumField() {
+			tokenPointers(v.Field(n), tks)
+		}
+	case reflect.Slice, reflect.Array:
+		for n := range v.Len() {
+			tokenPointers(v.Index(n), tks)
+		}
+	}
+}
+
+func hasTokens(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Pointer:
+		return !v.IsNil() && hasTokens(v.Elem())
+	case reflect.Struct:
+		for n := range v.NumField() {
+			if hasTokens(v.Field(n)) {
+				return true
+			}
+		}
+	case reflect.Slice:
+		if v.Type() == reflect.TypeFor[Tokens]() {
+			return v.Len() > 0
+		}
+
+		fallthrough
+	case reflect.Array:
+		for n := range v.Len() {
+			if hasTokens(v.Index(n)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func TestClone(t *testing.T) {
+	for n, test := range [...]struct {
+		Input     string
+		Options   []CloneOption
+		Output    string
+		HasTokens bool
+	}{
+		{ // 1
+			"\"use strict\";\n\nfunction a(b, ...c) {\n\treturn b + c[0];\n}",
+			nil,
+			"\"use strict\";\n\nfunction a(b, ...c) {\n\treturn b + c[0];\n}",
+			true,
+		},
+		{ // 2
+			"// A\nclass A extends B {\n\t#c = 1;\n\tstatic d() {\n\t\treturn `e${this.#c}f`; // G\n\t}\n}",
+			nil,
+			"// A\n\nclass A extends B {\n\t#c = 1;\n\tstatic d() {\n\t\treturn `e${this.#c}f`; // G\n\t}\n}",
+			true,
+		},
+		{ // 3
+			"// A\nclass A extends B {\n\t#c = 1;\n\tstatic d() {\n\t\treturn `e${this.#c}f`; // G\n\t}\n}",
+			[]CloneOption{DropComments},
+			"class A extends B {\n\t#c = 1;\n\tstatic d() {\n\t\treturn `e${this.#c}f`;\n\t}\n}",
+			true,
+		},
+		{ // 4
+			"import {a as b} from './c';\n\nexport const d = async (e = {f, ...g}) => await b(e);",
+			[]CloneOption{DropTokens},
+			"import {a as b} from './c';\n\nexport const d = async (e = {f: f, ...g}) => await b(e);",
+			false,
+		},
+		{ // 5
+			"/* A */ label: for (const [a, {b = 1}] of c) if (a?.d ?? b) continue label; // B",
+			[]CloneOption{DropTokens, DropComments},
+			"label: for (const [a, {b: b = 1}] of c) if (a?.d ?? b) continue label;",
+			false,
+		},
+	} {
+		tk := parser.NewStringTokeniser(test.Input)
+
+		m, err := ParseModule(&tk)
+		if err != nil {
+			t.Errorf("test %d: unexpected error: %s", n+1, err)
+
+			continue
+		}
+
+		original := fmt.Sprintf("%+s", m)
+		c := m.Clone(test.Options...)
+
+		if output := fmt.Sprintf("%+s", c); output != test.Output {
+			t.Errorf("test %d: expecting output %q, got %q", n+1, test.Output, output)
+		} else if !Equal(m, c) {
+			t.Errorf("test %d: expecting clone to equal original", n+1)
+		} else if hasTokens := hasTokens(reflect.ValueOf(c)); hasTokens != test.HasTokens {
+			t.Errorf("test %d: expecting HasTokens %v, got %v", n+1, test.HasTokens, hasTokens)
+		} else if output := fmt.Sprintf("%+s", m); output != original {
+			t.Errorf("test %d: expecting original to be unchanged, got %q", n+1, output)
+		}
+
+		originalTokens, clonedTokens := make(map[*Token]struct{}), make(map[*Token]struct{})
+
+		tokenPointers(reflect.ValueOf(m), originalTokens)
+		tokenPointers(reflect.ValueOf(c), clonedTokens)
+
+		for tk := range clonedTokens {
+			if _, ok := originalTokens[tk]; ok {
+				t.Errorf("test %d: expecting no shared tokens, found %q", n+1, tk.Data)
+
+				break
+			}
+		}
+	}
+}
+
+func TestCloneDirectives(t *testing.T) {
+	tk := parser.NewStringTokeniser("function a() {\n\t'use strict';\n}")
+
+	s, err := ParseScript(&tk)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	fd := s.StatementList[0].Declaration.FunctionDeclaration
+	c := Clone(*fd).(FunctionDeclaration)
+
+	if !c.Strict {
+		t.Errorf("expecting clone to be strict")
+	} else if len(c.Directives) != 1 {
+		t.Errorf("expecting 1 directive, got %d", len(c.Directives))
+	} else if literal := UnwrapConditional(c.FunctionBody.StatementList[0].Statement.ExpressionStatement.Expressions[0].ConditionalExpression).(*PrimaryExpression).Literal; c.Directives[0] != literal {
+		t.Errorf("expecting directive to be the cloned literal")
+	} else if c.Directives[0] == fd.Directives[0] {
+		t.Errorf("expecting directive to not be the original literal")
+	}
+}
+
+func TestEqual(t *testing.T) {
+	for n, test := range [...]struct {
+		A, B  string
+		Equal bool
+	}{
+		{ // 1
+			"a + b;",
+			"a+b",
+			true,
+		},
+		{ // 2
+			"a + b;",
+			"a - b;",
+			false,
+		},
+		{ // 3
+			"a + b;",
+			"(a + b);",
+			false,
+		},
+		{ // 4
+			"function a(b) { return b; }",
+			"function a(b) {\n\t// Comment\n\treturn /* b */ b;\n}",
+			true,
+		},
+		{ // 5
+			"function a(b) { return b; }",
+			"function a(c) { return c; }",
+			false,
+		},
+		{ // 6
+			"'a';",
+			"\"a\";",
+			true,
+		},
+		{ // 7
+			"let a = 1;",
+			"const a = 1;",
+			false,
+		},
+		{ // 8
+			"a;\nb;",
+			"a;",
+			false,
+		},
+		{ // 9
+			"class A {\n\tstatic b() {}\n}",
+			"class A { static b(){} }",
+			true,
+		},
+		{ // 10
+			"class A {\n\tstatic b() {}\n}",
+			"class A { b(){} }",
+			false,
+		},
+		{ // 11
+			"a(1, 2);",
+			"a(1);",
+			false,
+		},
+		{ // 12
+			"'a\\x62';",
+			"'ab';",
+			true,
+		},
+		{ // 13
+			"'a';",
+			"'b';",
+			false,
+		},
+		{ // 14
+			"a = 0x10 + 1_000 + 1e3 + .5;",
+			"a = 16 + 1000 + 1000 + 0.5;",
+			true,
+		},
+		{ // 15
+			"a = 0b11n;",
+			"a = 3n;",
+			true,
+		},
+		{ // 16
+			"a = 3n;",
+			"a = 3;",
+			false,
+		},
+		{ // 17
+			"a = 1;",
+			"a = 2;",
+			false,
+		},
+	} {
+		tkA := parser.NewStringTokeniser(test.A)
+		tkB := parser.NewStringTokeniser(test.B)
+
+		a, err := ParseModule(&tkA)
+		if err != nil {
+			t.Errorf("test %d: unexpected error: %s", n+1, err)
+
+			continue
+		}
+
+		b, err := ParseModule(&tkB)
+		if err != nil {
+			t.Errorf("test %d: unexpected error: %s", n+1, err)
+
+			continue
+		}
+
+		if Equal(a, b) != test.Equal {
+			t.Errorf("test %d: expecting Equal to be %v", n+1, test.Equal)
+		} else if Equal(b, a) != test.Equal {
+			t.Errorf("test %d: expecting reversed Equal to be %v", n+1, test.Equal)
+		} else if Equal(*a, b) != test.Equal {
+			t.Errorf("test %d: expecting value Equal to be %v", n+1, test.Equal)
+		}
+	}
+
+	if Equal(&Module{}, &Script{}) {
+		t.Errorf("expecting differing types to not be equal")
+	}
+}
@@ -0,0 +1,3500 @@
+package javascript
+
+// File automatically generated with format.sh.
+
+// Clone returns a deep copy of the given JavaScript type, which will be of the
+// same type as the given type.
+//
+// Token pointers that are shared within the given type, such as those of
+// Directives, will also be shared within the copy.
+func Clone(t Type, opts ...CloneOption) Type {
+	c := newCloner(opts)
+
+	switch t := t.(type) {
+	case Token:
+		return *t.clone(c)
+	case *Token:
+		return t.clone(c)
+	case Tokens:
+		return t.clone(c)
+	case AdditiveExpression:
+		return *t.clone(c)
+	case *AdditiveExpression:
+		return t.clone(c)
+	case Argument:
+		return *t.clone(c)
+	case *Argument:
+		return t.clone(c)
+	case Arguments:
+		return *t.clone(c)
+	case *Arguments:
+		return t.clone(c)
+	case ArrayAssignmentPattern:
+		return *t.clone(c)
+	case *ArrayAssignmentPattern:
+		return t.clone(c)
+	case ArrayBindingPattern:
+		return *t.clone(c)
+	case *ArrayBindingPattern:
+		return t.clone(c)
+	case ArrayElement:
+		return *t.clone(c)
+	case *ArrayElement:
+		return t.clone(c)
+	case ArrayLiteral:
+		return *t.clone(c)
+	case *ArrayLiteral:
+		return t.clone(c)
+	case ArrowFunction:
+		return *t.clone(c)
+	case *ArrowFunction:
+		return t.clone(c)
+	case AssignmentElement:
+		return *t.clone(c)
+	case *AssignmentElement:
+		return t.clone(c)
+	case AssignmentExpression:
+		return *t.clone(c)
+	case *AssignmentExpression:
+		return t.clone(c)
+	case AssignmentPattern:
+		return *t.clone(c)
+	case *AssignmentPattern:
+		return t.clone(c)
+	case AssignmentProperty:
+		return *t.clone(c)
+	case *AssignmentProperty:
+		return t.clone(c)
+	case BindingElement:
+		return *t.clone(c)
+	case *BindingElement:
+		return t.clone(c)
+	case BindingProperty:
+		return *t.clone(c)
+	case *BindingProperty:
+		return t.clone(c)
+	case BitwiseANDExpression:
+		return *t.clone(c)
+	case *BitwiseANDExpression:
+		return t.clone(c)
+	case BitwiseORExpression:
+		return *t.clone(c)
+	case *BitwiseORExpression:
+		return t.clone(c)
+	case BitwiseXORExpression:
+		return *t.clone(c)
+	case *BitwiseXORExpression:
+		return t.clone(c)
+	case Block:
+		return *t.clone(c)
+	case *Block:
+		return t.clone(c)
+	case CallExpression:
+		return *t.clone(c)
+	case *CallExpression:
+		return t.clone(c)
+	case CallSignature:
+		return *t.clone(c)
+	case *CallSignature:
+		return t.clone(c)
+	case CaseClause:
+		return *t.clone(c)
+	case *CaseClause:
+		return t.clone(c)
+	case ClassDeclaration:
+		return *t.clone(c)
+	case *ClassDeclaration:
+		return t.clone(c)
+	case ClassElement:
+		return *t.clone(c)
+	case *ClassElement:
+		return t.clone(c)
+	case ClassElementName:
+		return *t.clone(c)
+	case *ClassElementName:
+		return t.clone(c)
+	case CoalesceExpression:
+		return *t.clone(c)
+	case *CoalesceExpression:
+		return t.clone(c)
+	case CommentsToken:
+		return *t.clone(c)
+	case *CommentsToken:
+		return t.clone(c)
+	case ConditionalExpression:
+		return *t.clone(c)
+	case *ConditionalExpression:
+		return t.clone(c)
+	case Declaration:
+		return *t.clone(c)
+	case *Declaration:
+		return t.clone(c)
+	case Decorator:
+		return *t.clone(c)
+	case *Decorator:
+		return t.clone(c)
+	case DecoratorMemberExpression:
+		return *t.clone(c)
+	case *DecoratorMemberExpression:
+		return t.clone(c)
+	case DestructuringAssignmentTarget:
+		return *t.clone(c)
+	case *DestructuringAssignmentTarget:
+		return t.clone(c)
+	case EnumDeclaration:
+		return *t.clone(c)
+	case *EnumDeclaration:
+		return t.clone(c)
+	case EnumMember:
+		return *t.clone(c)
+	case *EnumMember:
+		return t.clone(c)
+	case EqualityExpression:
+		return *t.clone(c)
+	case *EqualityExpression:
+		return t.clone(c)
+	case ExponentiationExpression:
+		return *t.clone(c)
+	case *ExponentiationExpression:
+		return t.clone(c)
+	case ExportClause:
+		return *t.clone(c)
+	case *ExportClause:
+		return t.clone(c)
+	case ExportDeclaration:
+		return *t.clone(c)
+	case *ExportDeclaration:
+		return t.clone(c)
+	case ExportSpecifier:
+		return *t.clone(c)
+	case *ExportSpecifier:
+		return t.clone(c)
+	case Expression:
+		return *t.clone(c)
+	case *Expression:
+		return t.clone(c)
+	case FieldDefinition:
+		return *t.clone(c)
+	case *FieldDefinition:
+		return t.clone(c)
+	case FormalParameters:
+		return *t.clone(c)
+	case *FormalParameters:
+		return t.clone(c)
+	case FromClause:
+		return *t.clone(c)
+	case *FromClause:
+		return t.clone(c)
+	case FunctionDeclaration:
+		return *t.clone(c)
+	case *FunctionDeclaration:
+		return t.clone(c)
+	case FunctionTypeExpression:
+		return *t.clone(c)
+	case *FunctionTypeExpression:
+		return t.clone(c)
+	case IfStatement:
+		return *t.clone(c)
+	case *IfStatement:
+		return t.clone(c)
+	case ImportClause:
+		return *t.clone(c)
+	case *ImportClause:
+		return t.clone(c)
+	case ImportDeclaration:
+		return *t.clone(c)
+	case *ImportDeclaration:
+		return t.clone(c)
+	case ImportSpecifier:
+		return *t.clone(c)
+	case *ImportSpecifier:
+		return t.clone(c)
+	case ImportType:
+		return *t.clone(c)
+	case *ImportType:
+		return t.clone(c)
+	case IndexSignature:
+		return *t.clone(c)
+	case *IndexSignature:
+		return t.clone(c)
+	case InterfaceDeclaration:
+		return *t.clone(c)
+	case *InterfaceDeclaration:
+		return t.clone(c)
+	case IntersectionType:
+		return *t.clone(c)
+	case *IntersectionType:
+		return t.clone(c)
+	case IterationStatementDo:
+		return *t.clone(c)
+	case *IterationStatementDo:
+		return t.clone(c)
+	case IterationStatementFor:
+		return *t.clone(c)
+	case *IterationStatementFor:
+		return t.clone(c)
+	case IterationStatementWhile:
+		return *t.clone(c)
+	case *IterationStatementWhile:
+		return t.clone(c)
+	case JSXAttribute:
+		return *t.clone(c)
+	case *JSXAttribute:
+		return t.clone(c)
+	case JSXChild:
+		return *t.clone(c)
+	case *JSXChild:
+		return t.clone(c)
+	case JSXElement:
+		return *t.clone(c)
+	case *JSXElement:
+		return t.clone(c)
+	case JSXElementName:
+		return *t.clone(c)
+	case *JSXElementName:
+		return t.clone(c)
+	case JSXFragment:
+		return *t.clone(c)
+	case *JSXFragment:
+		return t.clone(c)
+	case LeftHandSideExpression:
+		return *t.clone(c)
+	case *LeftHandSideExpression:
+		return t.clone(c)
+	case LexicalBinding:
+		return *t.clone(c)
+	case *LexicalBinding:
+		return t.clone(c)
+	case LexicalDeclaration:
+		return *t.clone(c)
+	case *LexicalDeclaration:
+		return t.clone(c)
+	case LiteralType:
+		return *t.clone(c)
+	case *LiteralType:
+		return t.clone(c)
+	case LogicalANDExpression:
+		return *t.clone(c)
+	case *LogicalANDExpression:
+		return t.clone(c)
+	case LogicalORExpression:
+		return *t.clone(c)
+	case *LogicalORExpression:
+		return t.clone(c)
+	case MappedType:
+		return *t.clone(c)
+	case *MappedType:
+		return t.clone(c)
+	case MemberExpression:
+		return *t.clone(c)
+	case *MemberExpression:
+		return t.clone(c)
+	case MethodDefinition:
+		return *t.clone(c)
+	case *MethodDefinition:
+		return t.clone(c)
+	case MethodSignature:
+		return *t.clone(c)
+	case *MethodSignature:
+		return t.clone(c)
+	case Module:
+		return *t.clone(c)
+	case *Module:
+		return t.clone(c)
+	case ModuleItem:
+		return *t.clone(c)
+	case *ModuleItem:
+		return t.clone(c)
+	case MultiplicativeExpression:
+		return *t.clone(c)
+	case *MultiplicativeExpression:
+		return t.clone(c)
+	case NamedImports:
+		return *t.clone(c)
+	case *NamedImports:
+		return t.clone(c)
+	case NamespaceDeclaration:
+		return *t.clone(c)
+	case *NamespaceDeclaration:
+		return t.clone(c)
+	case NewExpression:
+		return *t.clone(c)
+	case *NewExpression:
+		return t.clone(c)
+	case ObjectAssignmentPattern:
+		return *t.clone(c)
+	case *ObjectAssignmentPattern:
+		return t.clone(c)
+	case ObjectBindingPattern:
+		return *t.clone(c)
+	case *ObjectBindingPattern:
+		return t.clone(c)
+	case ObjectLiteral:
+		return *t.clone(c)
+	case *ObjectLiteral:
+		return t.clone(c)
+	case ObjectType:
+		return *t.clone(c)
+	case *ObjectType:
+		return t.clone(c)
+	case OptionalChain:
+		return *t.clone(c)
+	case *OptionalChain:
+		return t.clone(c)
+	case OptionalExpression:
+		return *t.clone(c)
+	case *OptionalExpression:
+		return t.clone(c)
+	case Parameter:
+		return *t.clone(c)
+	case *Parameter:
+		return t.clone(c)
+	case ParameterList:
+		return *t.clone(c)
+	case *ParameterList:
+		return t.clone(c)
+	case ParenthesizedExpression:
+		return *t.clone(c)
+	case *ParenthesizedExpression:
+		return t.clone(c)
+	case PostfixType:
+		return *t.clone(c)
+	case *PostfixType:
+		return t.clone(c)
+	case PrimaryExpression:
+		return *t.clone(c)
+	case *PrimaryExpression:
+		return t.clone(c)
+	case PrimaryType:
+		return *t.clone(c)
+	case *PrimaryType:
+		return t.clone(c)
+	case PropertyDefinition:
+		return *t.clone(c)
+	case *PropertyDefinition:
+		return t.clone(c)
+	case PropertyName:
+		return *t.clone(c)
+	case *PropertyName:
+		return t.clone(c)
+	case PropertySignature:
+		return *t.clone(c)
+	case *PropertySignature:
+		return t.clone(c)
+	case RelationalExpression:
+		return *t.clone(c)
+	case *RelationalExpression:
+		return t.clone(c)
+	case Script:
+		return *t.clone(c)
+	case *Script:
+		return t.clone(c)
+	case ShiftExpression:
+		return *t.clone(c)
+	case *ShiftExpression:
+		return t.clone(c)
+	case Statement:
+		return *t.clone(c)
+	case *Statement:
+		return t.clone(c)
+	case StatementListItem:
+		return *t.clone(c)
+	case *StatementListItem:
+		return t.clone(c)
+	case SwitchStatement:
+		return *t.clone(c)
+	case *SwitchStatement:
+		return t.clone(c)
+	case TemplateLiteral:
+		return *t.clone(c)
+	case *TemplateLiteral:
+		return t.clone(c)
+	case TemplateLiteralType:
+		return *t.clone(c)
+	case *TemplateLiteralType:
+		return t.clone(c)
+	case TryStatement:
+		return *t.clone(c)
+	case *TryStatement:
+		return t.clone(c)
+	case TupleElement:
+		return *t.clone(c)
+	case *TupleElement:
+		return t.clone(c)
+	case TupleType:
+		return *t.clone(c)
+	case *TupleType:
+		return t.clone(c)
+	case TypeAliasDeclaration:
+		return *t.clone(c)
+	case *TypeAliasDeclaration:
+		return t.clone(c)
+	case TypeAnnotation:
+		return *t.clone(c)
+	case *TypeAnnotation:
+		return t.clone(c)
+	case TypeArguments:
+		return *t.clone(c)
+	case *TypeArguments:
+		return t.clone(c)
+	case TypeExpression:
+		return *t.clone(c)
+	case *TypeExpression:
+		return t.clone(c)
+	case TypeMember:
+		return *t.clone(c)
+	case *TypeMember:
+		return t.clone(c)
+	case TypeOperator:
+		return *t.clone(c)
+	case *TypeOperator:
+		return t.clone(c)
+	case TypeParameter:
+		return *t.clone(c)
+	case *TypeParameter:
+		return t.clone(c)
+	case TypeParameters:
+		return *t.clone(c)
+	case *TypeParameters:
+		return t.clone(c)
+	case TypeQuery:
+		return *t.clone(c)
+	case *TypeQuery:
+		return t.clone(c)
+	case TypeReference:
+		return *t.clone(c)
+	case *TypeReference:
+		return t.clone(c)
+	case UnaryExpression:
+		return *t.clone(c)
+	case *UnaryExpression:
+		return t.clone(c)
+	case UnaryOperatorComments:
+		return *t.clone(c)
+	case *UnaryOperatorComments:
+		return t.clone(c)
+	case UnionType:
+		return *t.clone(c)
+	case *UnionType:
+		return t.clone(c)
+	case UpdateExpression:
+		return *t.clone(c)
+	case *UpdateExpression:
+		return t.clone(c)
+	case VariableStatement:
+		return *t.clone(c)
+	case *VariableStatement:
+		return t.clone(c)
+	case WithClause:
+		return *t.clone(c)
+	case *WithClause:
+		return t.clone(c)
+	case WithEntry:
+		return *t.clone(c)
+	case *WithEntry:
+		return t.clone(c)
+	case WithStatement:
+		return *t.clone(c)
+	case *WithStatement:
+		return t.clone(c)
+	}
+
+	return nil
+}
+
+// Clone returns a deep copy of the AdditiveExpression.
+func (f *AdditiveExpression) Clone(opts ...CloneOption) *AdditiveExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *AdditiveExpression) clone(c *cloner) *AdditiveExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.AdditiveExpression = f.AdditiveExpression.clone(c)
+	g.MultiplicativeExpression = *f.MultiplicativeExpression.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the Argument.
+func (f *Argument) Clone(opts ...CloneOption) *Argument {
+	return f.clone(newCloner(opts))
+}
+
+func (f *Argument) clone(c *cloner) *Argument {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.AssignmentExpression = *f.AssignmentExpression.clone(c)
+	g.Comments = f.Comments.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the Arguments.
+func (f *Arguments) Clone(opts ...CloneOption) *Arguments {
+	return f.clone(newCloner(opts))
+}
+
+func (f *Arguments) clone(c *cloner) *Arguments {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.ArgumentList != nil {
+		g.ArgumentList = make([]Argument, len(f.ArgumentList))
+
+		for n := range f.ArgumentList {
+			g.ArgumentList[n] = *f.ArgumentList[n].clone(c)
+		}
+	}
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the ArrayAssignmentPattern.
+func (f *ArrayAssignmentPattern) Clone(opts ...CloneOption) *ArrayAssignmentPattern {
+	return f.clone(newCloner(opts))
+}
+
+func (f *ArrayAssignmentPattern) clone(c *cloner) *ArrayAssignmentPattern {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.AssignmentElements != nil {
+		g.AssignmentElements = make([]AssignmentElement, len(f.AssignmentElements))
+
+		for n := range f.AssignmentElements {
+			g.AssignmentElements[n] = *f.AssignmentElements[n].clone(c)
+		}
+	}
+
+	g.AssignmentRestElement = f.AssignmentRestElement.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the ArrayBindingPattern.
+func (f *ArrayBindingPattern) Clone(opts ...CloneOption) *ArrayBindingPattern {
+	return f.clone(newCloner(opts))
+}
+
+func (f *ArrayBindingPattern) clone(c *cloner) *ArrayBindingPattern {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.BindingElementList != nil {
+		g.BindingElementList = make([]BindingElement, len(f.BindingElementList))
+
+		for n := range f.BindingElementList {
+			g.BindingElementList[n] = *f.BindingElementList[n].clone(c)
+		}
+	}
+
+	g.BindingRestElement = f.BindingRestElement.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the ArrayElement.
+func (f *ArrayElement) Clone(opts ...CloneOption) *ArrayElement {
+	return f.clone(newCloner(opts))
+}
+
+func (f *ArrayElement) clone(c *cloner) *ArrayElement {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.AssignmentExpression = *f.AssignmentExpression.clone(c)
+	g.Comments = f.Comments.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the ArrayLiteral.
+func (f *ArrayLiteral) Clone(opts ...CloneOption) *ArrayLiteral {
+	return f.clone(newCloner(opts))
+}
+
+func (f *ArrayLiteral) clone(c *cloner) *ArrayLiteral {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.ElementList != nil {
+		g.ElementList = make([]ArrayElement, len(f.ElementList))
+
+		for n := range f.ElementList {
+			g.ElementList[n] = *f.ElementList[n].clone(c)
+		}
+	}
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the ArrowFunction.
+func (f *ArrowFunction) Clone(opts ...CloneOption) *ArrowFunction {
+	return f.clone(newCloner(opts))
+}
+
+func (f *ArrowFunction) clone(c *cloner) *ArrowFunction {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.DirectivePrologue = *f.DirectivePrologue.clone(c)
+	g.TypeParameters = f.TypeParameters.clone(c)
+	g.BindingIdentifier = f.BindingIdentifier.clone(c)
+	g.FormalParameters = f.FormalParameters.clone(c)
+	g.ReturnType = f.ReturnType.clone(c)
+	g.AssignmentExpression = f.AssignmentExpression.clone(c)
+	g.FunctionBody = f.FunctionBody.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the AssignmentElement.
+func (f *AssignmentElement) Clone(opts ...CloneOption) *AssignmentElement {
+	return f.clone(newCloner(opts))
+}
+
+func (f *AssignmentElement) clone(c *cloner) *AssignmentElement {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.DestructuringAssignmentTarget = *f.DestructuringAssignmentTarget.clone(c)
+	g.Initializer = f.Initializer.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the AssignmentExpression.
+func (f *AssignmentExpression) Clone(opts ...CloneOption) *AssignmentExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *AssignmentExpression) clone(c *cloner) *AssignmentExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.ConditionalExpression = f.ConditionalExpression.clone(c)
+	g.ArrowFunction = f.ArrowFunction.clone(c)
+	g.LeftHandSideExpression = f.LeftHandSideExpression.clone(c)
+	g.AssignmentPattern = f.AssignmentPattern.clone(c)
+	g.AssignmentExpression = f.AssignmentExpression.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the AssignmentPattern.
+func (f *AssignmentPattern) Clone(opts ...CloneOption) *AssignmentPattern {
+	return f.clone(newCloner(opts))
+}
+
+func (f *AssignmentPattern) clone(c *cloner) *AssignmentPattern {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.ObjectAssignmentPattern = f.ObjectAssignmentPattern.clone(c)
+	g.ArrayAssignmentPattern = f.ArrayAssignmentPattern.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the AssignmentProperty.
+func (f *AssignmentProperty) Clone(opts ...CloneOption) *AssignmentProperty {
+	return f.clone(newCloner(opts))
+}
+
+func (f *AssignmentProperty) clone(c *cloner) *AssignmentProperty {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.PropertyName = *f.PropertyName.clone(c)
+	g.DestructuringAssignmentTarget = f.DestructuringAssignmentTarget.clone(c)
+	g.Initializer = f.Initializer.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the BindingElement.
+func (f *BindingElement) Clone(opts ...CloneOption) *BindingElement {
+	return f.clone(newCloner(opts))
+}
+
+func (f *BindingElement) clone(c *cloner) *BindingElement {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.Decorators != nil {
+		g.Decorators = make([]Decorator, len(f.Decorators))
+
+		for n := range f.Decorators {
+			g.Decorators[n] = *f.Decorators[n].clone(c)
+		}
+	}
+
+	g.SingleNameBinding = f.SingleNameBinding.clone(c)
+	g.ArrayBindingPattern = f.ArrayBindingPattern.clone(c)
+	g.ObjectBindingPattern = f.ObjectBindingPattern.clone(c)
+	g.TypeAnnotation = f.TypeAnnotation.clone(c)
+	g.Initializer = f.Initializer.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the BindingProperty.
+func (f *BindingProperty) Clone(opts ...CloneOption) *BindingProperty {
+	return f.clone(newCloner(opts))
+}
+
+func (f *BindingProperty) clone(c *cloner) *BindingProperty {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.PropertyName = *f.PropertyName.clone(c)
+	g.BindingElement = *f.BindingElement.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the BitwiseANDExpression.
+func (f *BitwiseANDExpression) Clone(opts ...CloneOption) *BitwiseANDExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *BitwiseANDExpression) clone(c *cloner) *BitwiseANDExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.BitwiseANDExpression = f.BitwiseANDExpression.clone(c)
+	g.EqualityExpression = *f.EqualityExpression.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the BitwiseORExpression.
+func (f *BitwiseORExpression) Clone(opts ...CloneOption) *BitwiseORExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *BitwiseORExpression) clone(c *cloner) *BitwiseORExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.BitwiseORExpression = f.BitwiseORExpression.clone(c)
+	g.BitwiseXORExpression = *f.BitwiseXORExpression.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the BitwiseXORExpression.
+func (f *BitwiseXORExpression) Clone(opts ...CloneOption) *BitwiseXORExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *BitwiseXORExpression) clone(c *cloner) *BitwiseXORExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.BitwiseXORExpression = f.BitwiseXORExpression.clone(c)
+	g.BitwiseANDExpression = *f.BitwiseANDExpression.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the Block.
+func (f *Block) Clone(opts ...CloneOption) *Block {
+	return f.clone(newCloner(opts))
+}
+
+func (f *Block) clone(c *cloner) *Block {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.StatementList != nil {
+		g.StatementList = make([]StatementListItem, len(f.StatementList))
+
+		for n := range f.StatementList {
+			g.StatementList[n] = *f.StatementList[n].clone(c)
+		}
+	}
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the CallExpression.
+func (f *CallExpression) Clone(opts ...CloneOption) *CallExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *CallExpression) clone(c *cloner) *CallExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.MemberExpression = f.MemberExpression.clone(c)
+	g.ImportCall = f.ImportCall.clone(c)
+	g.CallExpression = f.CallExpression.clone(c)
+	g.Arguments = f.Arguments.clone(c)
+	g.Expression = f.Expression.clone(c)
+	g.IdentifierName = f.IdentifierName.clone(c)
+	g.TemplateLiteral = f.TemplateLiteral.clone(c)
+	g.PrivateIdentifier = f.PrivateIdentifier.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the CallSignature.
+func (f *CallSignature) Clone(opts ...CloneOption) *CallSignature {
+	return f.clone(newCloner(opts))
+}
+
+func (f *CallSignature) clone(c *cloner) *CallSignature {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.TypeParameters = f.TypeParameters.clone(c)
+	g.ParameterList = *f.ParameterList.clone(c)
+	g.ReturnType = f.ReturnType.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the CaseClause.
+func (f *CaseClause) Clone(opts ...CloneOption) *CaseClause {
+	return f.clone(newCloner(opts))
+}
+
+func (f *CaseClause) clone(c *cloner) *CaseClause {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.Expression = *f.Expression.clone(c)
+
+	if f.StatementList != nil {
+		g.StatementList = make([]StatementListItem, len(f.StatementList))
+
+		for n := range f.StatementList {
+			g.StatementList[n] = *f.StatementList[n].clone(c)
+		}
+	}
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the ClassDeclaration.
+func (f *ClassDeclaration) Clone(opts ...CloneOption) *ClassDeclaration {
+	return f.clone(newCloner(opts))
+}
+
+func (f *ClassDeclaration) clone(c *cloner) *ClassDeclaration {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.Decorators != nil {
+		g.Decorators = make([]Decorator, len(f.Decorators))
+
+		for n := range f.Decorators {
+			g.Decorators[n] = *f.Decorators[n].clone(c)
+		}
+	}
+
+	g.BindingIdentifier = f.BindingIdentifier.clone(c)
+	g.TypeParameters = f.TypeParameters.clone(c)
+	g.ClassHeritage = f.ClassHeritage.clone(c)
+	g.TypeArguments = f.TypeArguments.clone(c)
+
+	if f.Implements != nil {
+		g.Implements = make([]TypeReference, len(f.Implements))
+
+		for n := range f.Implements {
+			g.Implements[n] = *f.Implements[n].clone(c)
+		}
+	}
+
+	if f.ClassBody != nil {
+		g.ClassBody = make([]ClassElement, len(f.ClassBody))
+
+		for n := range f.ClassBody {
+			g.ClassBody[n] = *f.ClassBody[n].clone(c)
+		}
+	}
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the ClassElement.
+func (f *ClassElement) Clone(opts ...CloneOption) *ClassElement {
+	return f.clone(newCloner(opts))
+}
+
+func (f *ClassElement) clone(c *cloner) *ClassElement {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.Decorators != nil {
+		g.Decorators = make([]Decorator, len(f.Decorators))
+
+		for n := range f.Decorators {
+			g.Decorators[n] = *f.Decorators[n].clone(c)
+		}
+	}
+
+	g.MethodDefinition = f.MethodDefinition.clone(c)
+	g.FieldDefinition = f.FieldDefinition.clone(c)
+	g.ClassStaticBlock = f.ClassStaticBlock.clone(c)
+	g.TypeMember = f.TypeMember.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the ClassElementName.
+func (f *ClassElementName) Clone(opts ...CloneOption) *ClassElementName {
+	return f.clone(newCloner(opts))
+}
+
+func (f *ClassElementName) clone(c *cloner) *ClassElementName {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.PropertyName = f.PropertyName.clone(c)
+	g.PrivateIdentifier = f.PrivateIdentifier.clone(c)
+
+	if f.Overloads != nil {
+		g.Overloads = make([]CallSignature, len(f.Overloads))
+
+		for n := range f.Overloads {
+			g.Overloads[n] = *f.Overloads[n].clone(c)
+		}
+	}
+
+	g.TypeParameters = f.TypeParameters.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the CoalesceExpression.
+func (f *CoalesceExpression) Clone(opts ...CloneOption) *CoalesceExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *CoalesceExpression) clone(c *cloner) *CoalesceExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.CoalesceExpressionHead = f.CoalesceExpressionHead.clone(c)
+	g.BitwiseORExpression = *f.BitwiseORExpression.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the CommentsToken.
+func (f *CommentsToken) Clone(opts ...CloneOption) *CommentsToken {
+	return f.clone(newCloner(opts))
+}
+
+func (f *CommentsToken) clone(c *cloner) *CommentsToken {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Token = f.Token.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the ConditionalExpression.
+func (f *ConditionalExpression) Clone(opts ...CloneOption) *ConditionalExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *ConditionalExpression) clone(c *cloner) *ConditionalExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.LogicalORExpression = f.LogicalORExpression.clone(c)
+	g.CoalesceExpression = f.CoalesceExpression.clone(c)
+	g.True = f.True.clone(c)
+	g.False = f.False.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the Declaration.
+func (f *Declaration) Clone(opts ...CloneOption) *Declaration {
+	return f.clone(newCloner(opts))
+}
+
+func (f *Declaration) clone(c *cloner) *Declaration {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.ClassDeclaration = f.ClassDeclaration.clone(c)
+	g.FunctionDeclaration = f.FunctionDeclaration.clone(c)
+	g.LexicalDeclaration = f.LexicalDeclaration.clone(c)
+	g.TypeAliasDeclaration = f.TypeAliasDeclaration.clone(c)
+	g.InterfaceDeclaration = f.InterfaceDeclaration.clone(c)
+	g.NamespaceDeclaration = f.NamespaceDeclaration.clone(c)
+	g.Comments = f.Comments.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the Decorator.
+func (f *Decorator) Clone(opts ...CloneOption) *Decorator {
+	return f.clone(newCloner(opts))
+}
+
+func (f *Decorator) clone(c *cloner) *Decorator {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.DecoratorMemberExpression = f.DecoratorMemberExpression.clone(c)
+	g.DecoratorParenthesizedExpression = f.DecoratorParenthesizedExpression.clone(c)
+	g.Arguments = f.Arguments.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the DecoratorMemberExpression.
+func (f *DecoratorMemberExpression) Clone(opts ...CloneOption) *DecoratorMemberExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *DecoratorMemberExpression) clone(c *cloner) *DecoratorMemberExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.DecoratorMemberExpression = f.DecoratorMemberExpression.clone(c)
+	g.IdentifierReference = f.IdentifierReference.clone(c)
+	g.IdentifierName = f.IdentifierName.clone(c)
+	g.PrivateIdentifier = f.PrivateIdentifier.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the DestructuringAssignmentTarget.
+func (f *DestructuringAssignmentTarget) Clone(opts ...CloneOption) *DestructuringAssignmentTarget {
+	return f.clone(newCloner(opts))
+}
+
+func (f *DestructuringAssignmentTarget) clone(c *cloner) *DestructuringAssignmentTarget {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.LeftHandSideExpression = f.LeftHandSideExpression.clone(c)
+	g.AssignmentPattern = f.AssignmentPattern.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the EnumDeclaration.
+func (f *EnumDeclaration) Clone(opts ...CloneOption) *EnumDeclaration {
+	return f.clone(newCloner(opts))
+}
+
+func (f *EnumDeclaration) clone(c *cloner) *EnumDeclaration {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.BindingIdentifier = f.BindingIdentifier.clone(c)
+
+	if f.EnumMembers != nil {
+		g.EnumMembers = make([]EnumMember, len(f.EnumMembers))
+
+		for n := range f.EnumMembers {
+			g.EnumMembers[n] = *f.EnumMembers[n].clone(c)
+		}
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the EnumMember.
+func (f *EnumMember) Clone(opts ...CloneOption) *EnumMember {
+	return f.clone(newCloner(opts))
+}
+
+func (f *EnumMember) clone(c *cloner) *EnumMember {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.Name = f.Name.clone(c)
+	g.Initializer = f.Initializer.clone(c)
+	g.Value = f.Value.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the EqualityExpression.
+func (f *EqualityExpression) Clone(opts ...CloneOption) *EqualityExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *EqualityExpression) clone(c *cloner) *EqualityExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.EqualityExpression = f.EqualityExpression.clone(c)
+	g.RelationalExpression = *f.RelationalExpression.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the ExponentiationExpression.
+func (f *ExponentiationExpression) Clone(opts ...CloneOption) *ExponentiationExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *ExponentiationExpression) clone(c *cloner) *ExponentiationExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.ExponentiationExpression = f.ExponentiationExpression.clone(c)
+	g.UnaryExpression = *f.UnaryExpression.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the ExportClause.
+func (f *ExportClause) Clone(opts ...CloneOption) *ExportClause {
+	return f.clone(newCloner(opts))
+}
+
+func (f *ExportClause) clone(c *cloner) *ExportClause {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.ExportList != nil {
+		g.ExportList = make([]ExportSpecifier, len(f.ExportList))
+
+		for n := range f.ExportList {
+			g.ExportList[n] = *f.ExportList[n].clone(c)
+		}
+	}
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the ExportDeclaration.
+func (f *ExportDeclaration) Clone(opts ...CloneOption) *ExportDeclaration {
+	return f.clone(newCloner(opts))
+}
+
+func (f *ExportDeclaration) clone(c *cloner) *ExportDeclaration {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.Decorators != nil {
+		g.Decorators = make([]Decorator, len(f.Decorators))
+
+		for n := range f.Decorators {
+			g.Decorators[n] = *f.Decorators[n].clone(c)
+		}
+	}
+
+	g.ExportClause = f.ExportClause.clone(c)
+	g.ExportFromClause = f.ExportFromClause.clone(c)
+	g.FromClause = f.FromClause.clone(c)
+	g.VariableStatement = f.VariableStatement.clone(c)
+	g.Declaration = f.Declaration.clone(c)
+	g.DefaultFunction = f.DefaultFunction.clone(c)
+	g.DefaultClass = f.DefaultClass.clone(c)
+	g.DefaultAssignmentExpression = f.DefaultAssignmentExpression.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the ExportSpecifier.
+func (f *ExportSpecifier) Clone(opts ...CloneOption) *ExportSpecifier {
+	return f.clone(newCloner(opts))
+}
+
+func (f *ExportSpecifier) clone(c *cloner) *ExportSpecifier {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.IdentifierName = f.IdentifierName.clone(c)
+	g.EIdentifierName = f.EIdentifierName.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the Expression.
+func (f *Expression) Clone(opts ...CloneOption) *Expression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *Expression) clone(c *cloner) *Expression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.Expressions != nil {
+		g.Expressions = make([]AssignmentExpression, len(f.Expressions))
+
+		for n := range f.Expressions {
+			g.Expressions[n] = *f.Expressions[n].clone(c)
+		}
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the FieldDefinition.
+func (f *FieldDefinition) Clone(opts ...CloneOption) *FieldDefinition {
+	return f.clone(newCloner(opts))
+}
+
+func (f *FieldDefinition) clone(c *cloner) *FieldDefinition {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.ClassElementName = *f.ClassElementName.clone(c)
+	g.TypeAnnotation = f.TypeAnnotation.clone(c)
+	g.Initializer = f.Initializer.clone(c)
+	g.Comments = f.Comments.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the FormalParameters.
+func (f *FormalParameters) Clone(opts ...CloneOption) *FormalParameters {
+	return f.clone(newCloner(opts))
+}
+
+func (f *FormalParameters) clone(c *cloner) *FormalParameters {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.FormalParameterList != nil {
+		g.FormalParameterList = make([]BindingElement, len(f.FormalParameterList))
+
+		for n := range f.FormalParameterList {
+			g.FormalParameterList[n] = *f.FormalParameterList[n].clone(c)
+		}
+	}
+
+	g.BindingIdentifier = f.BindingIdentifier.clone(c)
+	g.ArrayBindingPattern = f.ArrayBindingPattern.clone(c)
+	g.ObjectBindingPattern = f.ObjectBindingPattern.clone(c)
+	g.TypeAnnotation = f.TypeAnnotation.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the FromClause.
+func (f *FromClause) Clone(opts ...CloneOption) *FromClause {
+	return f.clone(newCloner(opts))
+}
+
+func (f *FromClause) clone(c *cloner) *FromClause {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.ModuleSpecifier = f.ModuleSpecifier.clone(c)
+	g.Comments = f.Comments.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the FunctionDeclaration.
+func (f *FunctionDeclaration) Clone(opts ...CloneOption) *FunctionDeclaration {
+	return f.clone(newCloner(opts))
+}
+
+func (f *FunctionDeclaration) clone(c *cloner) *FunctionDeclaration {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.DirectivePrologue = *f.DirectivePrologue.clone(c)
+	g.BindingIdentifier = f.BindingIdentifier.clone(c)
+
+	if f.Overloads != nil {
+		g.Overloads = make([]CallSignature, len(f.Overloads))
+
+		for n := range f.Overloads {
+			g.Overloads[n] = *f.Overloads[n].clone(c)
+		}
+	}
+
+	g.TypeParameters = f.TypeParameters.clone(c)
+	g.FormalParameters = *f.FormalParameters.clone(c)
+	g.ReturnType = f.ReturnType.clone(c)
+	g.FunctionBody = *f.FunctionBody.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the FunctionTypeExpression.
+func (f *FunctionTypeExpression) Clone(opts ...CloneOption) *FunctionTypeExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *FunctionTypeExpression) clone(c *cloner) *FunctionTypeExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.TypeParameters = f.TypeParameters.clone(c)
+	g.ParameterList = *f.ParameterList.clone(c)
+	g.TypePredicate = f.TypePredicate.clone(c)
+	g.ReturnType = *f.ReturnType.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the IfStatement.
+func (f *IfStatement) Clone(opts ...CloneOption) *IfStatement {
+	return f.clone(newCloner(opts))
+}
+
+func (f *IfStatement) clone(c *cloner) *IfStatement {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.Expression = *f.Expression.clone(c)
+	g.Statement = *f.Statement.clone(c)
+	g.ElseStatement = f.ElseStatement.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the ImportClause.
+func (f *ImportClause) Clone(opts ...CloneOption) *ImportClause {
+	return f.clone(newCloner(opts))
+}
+
+func (f *ImportClause) clone(c *cloner) *ImportClause {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.ImportedDefaultBinding = f.ImportedDefaultBinding.clone(c)
+	g.NameSpaceImport = f.NameSpaceImport.clone(c)
+	g.NamedImports = f.NamedImports.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the ImportDeclaration.
+func (f *ImportDeclaration) Clone(opts ...CloneOption) *ImportDeclaration {
+	return f.clone(newCloner(opts))
+}
+
+func (f *ImportDeclaration) clone(c *cloner) *ImportDeclaration {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.ImportClause = f.ImportClause.clone(c)
+	g.FromClause = *f.FromClause.clone(c)
+	g.WithClause = f.WithClause.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the ImportSpecifier.
+func (f *ImportSpecifier) Clone(opts ...CloneOption) *ImportSpecifier {
+	return f.clone(newCloner(opts))
+}
+
+func (f *ImportSpecifier) clone(c *cloner) *ImportSpecifier {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.IdentifierName = f.IdentifierName.clone(c)
+	g.ImportedBinding = f.ImportedBinding.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the ImportType.
+func (f *ImportType) Clone(opts ...CloneOption) *ImportType {
+	return f.clone(newCloner(opts))
+}
+
+func (f *ImportType) clone(c *cloner) *ImportType {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.Type = *f.Type.clone(c)
+	g.TypeReference = f.TypeReference.clone(c)
+	g.TypeArguments = f.TypeArguments.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the IndexSignature.
+func (f *IndexSignature) Clone(opts ...CloneOption) *IndexSignature {
+	return f.clone(newCloner(opts))
+}
+
+func (f *IndexSignature) clone(c *cloner) *IndexSignature {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.Modifiers != nil {
+		g.Modifiers = make([]*Token, len(f.Modifiers))
+
+		for n := range f.Modifiers {
+			g.Modifiers[n] = f.Modifiers[n].clone(c)
+		}
+	}
+
+	g.Identifier = f.Identifier.clone(c)
+	g.IndexType = *f.IndexType.clone(c)
+	g.TypeAnnotation = f.TypeAnnotation.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the InterfaceDeclaration.
+func (f *InterfaceDeclaration) Clone(opts ...CloneOption) *InterfaceDeclaration {
+	return f.clone(newCloner(opts))
+}
+
+func (f *InterfaceDeclaration) clone(c *cloner) *InterfaceDeclaration {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.BindingIdentifier = f.BindingIdentifier.clone(c)
+	g.TypeParameters = f.TypeParameters.clone(c)
+
+	if f.Extends != nil {
+		g.Extends = make([]TypeReference, len(f.Extends))
+
+		for n := range f.Extends {
+			g.Extends[n] = *f.Extends[n].clone(c)
+		}
+	}
+
+	g.ObjectType = *f.ObjectType.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the IntersectionType.
+func (f *IntersectionType) Clone(opts ...CloneOption) *IntersectionType {
+	return f.clone(newCloner(opts))
+}
+
+func (f *IntersectionType) clone(c *cloner) *IntersectionType {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.TypeOperators != nil {
+		g.TypeOperators = make([]TypeOperator, len(f.TypeOperators))
+
+		for n := range f.TypeOperators {
+			g.TypeOperators[n] = *f.TypeOperators[n].clone(c)
+		}
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the IterationStatementDo.
+func (f *IterationStatementDo) Clone(opts ...CloneOption) *IterationStatementDo {
+	return f.clone(newCloner(opts))
+}
+
+func (f *IterationStatementDo) clone(c *cloner) *IterationStatementDo {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.Statement = *f.Statement.clone(c)
+	g.Expression = *f.Expression.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the IterationStatementFor.
+func (f *IterationStatementFor) Clone(opts ...CloneOption) *IterationStatementFor {
+	return f.clone(newCloner(opts))
+}
+
+func (f *IterationStatementFor) clone(c *cloner) *IterationStatementFor {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.InitExpression = f.InitExpression.clone(c)
+	g.InitLexical = f.InitLexical.clone(c)
+	g.Conditional = f.Conditional.clone(c)
+	g.Afterthought = f.Afterthought.clone(c)
+	g.LeftHandSideExpression = f.LeftHandSideExpression.clone(c)
+	g.ForBindingIdentifier = f.ForBindingIdentifier.clone(c)
+	g.ForBindingPatternObject = f.ForBindingPatternObject.clone(c)
+	g.ForBindingPatternArray = f.ForBindingPatternArray.clone(c)
+	g.In = f.In.clone(c)
+	g.Of = f.Of.clone(c)
+	g.Statement = *f.Statement.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the IterationStatementWhile.
+func (f *IterationStatementWhile) Clone(opts ...CloneOption) *IterationStatementWhile {
+	return f.clone(newCloner(opts))
+}
+
+func (f *IterationStatementWhile) clone(c *cloner) *IterationStatementWhile {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.Expression = *f.Expression.clone(c)
+	g.Statement = *f.Statement.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the JSXAttribute.
+func (f *JSXAttribute) Clone(opts ...CloneOption) *JSXAttribute {
+	return f.clone(newCloner(opts))
+}
+
+func (f *JSXAttribute) clone(c *cloner) *JSXAttribute {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.Namespace = f.Namespace.clone(c)
+	g.Identifier = f.Identifier.clone(c)
+	g.JSXString = f.JSXString.clone(c)
+	g.JSXFragment = f.JSXFragment.clone(c)
+	g.JSXElement = f.JSXElement.clone(c)
+	g.AssignmentExpression = f.AssignmentExpression.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the JSXChild.
+func (f *JSXChild) Clone(opts ...CloneOption) *JSXChild {
+	return f.clone(newCloner(opts))
+}
+
+func (f *JSXChild) clone(c *cloner) *JSXChild {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.JSXText = f.JSXText.clone(c)
+	g.JSXElement = f.JSXElement.clone(c)
+	g.JSXFragment = f.JSXFragment.clone(c)
+	g.JSXChildExpression = f.JSXChildExpression.clone(c)
+	g.Comments = f.Comments.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the JSXElement.
+func (f *JSXElement) Clone(opts ...CloneOption) *JSXElement {
+	return f.clone(newCloner(opts))
+}
+
+func (f *JSXElement) clone(c *cloner) *JSXElement {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.ElementName = *f.ElementName.clone(c)
+
+	if f.Attributes != nil {
+		g.Attributes = make([]JSXAttribute, len(f.Attributes))
+
+		for n := range f.Attributes {
+			g.Attributes[n] = *f.Attributes[n].clone(c)
+		}
+	}
+
+	if f.Children != nil {
+		g.Children = make([]JSXChild, len(f.Children))
+
+		for n := range f.Children {
+			g.Children[n] = *f.Children[n].clone(c)
+		}
+	}
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the JSXElementName.
+func (f *JSXElementName) Clone(opts ...CloneOption) *JSXElementName {
+	return f.clone(newCloner(opts))
+}
+
+func (f *JSXElementName) clone(c *cloner) *JSXElementName {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.Namespace = f.Namespace.clone(c)
+	g.Identifier = f.Identifier.clone(c)
+
+	if f.MemberExpression != nil {
+		g.MemberExpression = make([]CommentsToken, len(f.MemberExpression))
+
+		for n := range f.MemberExpression {
+			g.MemberExpression[n] = *f.MemberExpression[n].clone(c)
+		}
+	}
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the JSXFragment.
+func (f *JSXFragment) Clone(opts ...CloneOption) *JSXFragment {
+	return f.clone(newCloner(opts))
+}
+
+func (f *JSXFragment) clone(c *cloner) *JSXFragment {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.Children != nil {
+		g.Children = make([]JSXChild, len(f.Children))
+
+		for n := range f.Children {
+			g.Children[n] = *f.Children[n].clone(c)
+		}
+	}
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the LeftHandSideExpression.
+func (f *LeftHandSideExpression) Clone(opts ...CloneOption) *LeftHandSideExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *LeftHandSideExpression) clone(c *cloner) *LeftHandSideExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.NewExpression = f.NewExpression.clone(c)
+	g.CallExpression = f.CallExpression.clone(c)
+	g.OptionalExpression = f.OptionalExpression.clone(c)
+	g.Comments = f.Comments.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the LexicalBinding.
+func (f *LexicalBinding) Clone(opts ...CloneOption) *LexicalBinding {
+	return f.clone(newCloner(opts))
+}
+
+func (f *LexicalBinding) clone(c *cloner) *LexicalBinding {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.BindingIdentifier = f.BindingIdentifier.clone(c)
+	g.ArrayBindingPattern = f.ArrayBindingPattern.clone(c)
+	g.ObjectBindingPattern = f.ObjectBindingPattern.clone(c)
+	g.TypeAnnotation = f.TypeAnnotation.clone(c)
+	g.Initializer = f.Initializer.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the LexicalDeclaration.
+func (f *LexicalDeclaration) Clone(opts ...CloneOption) *LexicalDeclaration {
+	return f.clone(newCloner(opts))
+}
+
+func (f *LexicalDeclaration) clone(c *cloner) *LexicalDeclaration {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.BindingList != nil {
+		g.BindingList = make([]LexicalBinding, len(f.BindingList))
+
+		for n := range f.BindingList {
+			g.BindingList[n] = *f.BindingList[n].clone(c)
+		}
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the LiteralType.
+func (f *LiteralType) Clone(opts ...CloneOption) *LiteralType {
+	return f.clone(newCloner(opts))
+}
+
+func (f *LiteralType) clone(c *cloner) *LiteralType {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.Literal = f.Literal.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the LogicalANDExpression.
+func (f *LogicalANDExpression) Clone(opts ...CloneOption) *LogicalANDExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *LogicalANDExpression) clone(c *cloner) *LogicalANDExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.LogicalANDExpression = f.LogicalANDExpression.clone(c)
+	g.BitwiseORExpression = *f.BitwiseORExpression.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the LogicalORExpression.
+func (f *LogicalORExpression) Clone(opts ...CloneOption) *LogicalORExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *LogicalORExpression) clone(c *cloner) *LogicalORExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.LogicalORExpression = f.LogicalORExpression.clone(c)
+	g.LogicalANDExpression = *f.LogicalANDExpression.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the MappedType.
+func (f *MappedType) Clone(opts ...CloneOption) *MappedType {
+	return f.clone(newCloner(opts))
+}
+
+func (f *MappedType) clone(c *cloner) *MappedType {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.Identifier = f.Identifier.clone(c)
+	g.Constraint = *f.Constraint.clone(c)
+	g.NameType = f.NameType.clone(c)
+	g.TypeAnnotation = f.TypeAnnotation.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the MemberExpression.
+func (f *MemberExpression) Clone(opts ...CloneOption) *MemberExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *MemberExpression) clone(c *cloner) *MemberExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.MemberExpression = f.MemberExpression.clone(c)
+	g.PrimaryExpression = f.PrimaryExpression.clone(c)
+	g.Expression = f.Expression.clone(c)
+	g.IdentifierName = f.IdentifierName.clone(c)
+	g.TemplateLiteral = f.TemplateLiteral.clone(c)
+	g.Arguments = f.Arguments.clone(c)
+	g.PrivateIdentifier = f.PrivateIdentifier.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the MethodDefinition.
+func (f *MethodDefinition) Clone(opts ...CloneOption) *MethodDefinition {
+	return f.clone(newCloner(opts))
+}
+
+func (f *MethodDefinition) clone(c *cloner) *MethodDefinition {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.ClassElementName = *f.ClassElementName.clone(c)
+	g.Params = *f.Params.clone(c)
+	g.ReturnType = f.ReturnType.clone(c)
+	g.FunctionBody = *f.FunctionBody.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the MethodSignature.
+func (f *MethodSignature) Clone(opts ...CloneOption) *MethodSignature {
+	return f.clone(newCloner(opts))
+}
+
+func (f *MethodSignature) clone(c *cloner) *MethodSignature {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.Modifiers != nil {
+		g.Modifiers = make([]*Token, len(f.Modifiers))
+
+		for n := range f.Modifiers {
+			g.Modifiers[n] = f.Modifiers[n].clone(c)
+		}
+	}
+
+	g.PropertyName = *f.PropertyName.clone(c)
+	g.CallSignature = *f.CallSignature.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the Module.
+func (f *Module) Clone(opts ...CloneOption) *Module {
+	return f.clone(newCloner(opts))
+}
+
+func (f *Module) clone(c *cloner) *Module {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.Hashbang = f.Hashbang.clone(c)
+
+	if f.ModuleListItems != nil {
+		g.ModuleListItems = make([]ModuleItem, len(f.ModuleListItems))
+
+		for n := range f.ModuleListItems {
+			g.ModuleListItems[n] = *f.ModuleListItems[n].clone(c)
+		}
+	}
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the ModuleItem.
+func (f *ModuleItem) Clone(opts ...CloneOption) *ModuleItem {
+	return f.clone(newCloner(opts))
+}
+
+func (f *ModuleItem) clone(c *cloner) *ModuleItem {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.ImportDeclaration = f.ImportDeclaration.clone(c)
+	g.StatementListItem = f.StatementListItem.clone(c)
+	g.ExportDeclaration = f.ExportDeclaration.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the MultiplicativeExpression.
+func (f *MultiplicativeExpression) Clone(opts ...CloneOption) *MultiplicativeExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *MultiplicativeExpression) clone(c *cloner) *MultiplicativeExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.MultiplicativeExpression = f.MultiplicativeExpression.clone(c)
+	g.ExponentiationExpression = *f.ExponentiationExpression.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the NamedImports.
+func (f *NamedImports) Clone(opts ...CloneOption) *NamedImports {
+	return f.clone(newCloner(opts))
+}
+
+func (f *NamedImports) clone(c *cloner) *NamedImports {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.ImportList != nil {
+		g.ImportList = make([]ImportSpecifier, len(f.ImportList))
+
+		for n := range f.ImportList {
+			g.ImportList[n] = *f.ImportList[n].clone(c)
+		}
+	}
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the NamespaceDeclaration.
+func (f *NamespaceDeclaration) Clone(opts ...CloneOption) *NamespaceDeclaration {
+	return f.clone(newCloner(opts))
+}
+
+func (f *NamespaceDeclaration) clone(c *cloner) *NamespaceDeclaration {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.NamespaceName != nil {
+		g.NamespaceName = make([]*Token, len(f.NamespaceName))
+
+		for n := range f.NamespaceName {
+			g.NamespaceName[n] = f.NamespaceName[n].clone(c)
+		}
+	}
+
+	if f.ModuleListItems != nil {
+		g.ModuleListItems = make([]ModuleItem, len(f.ModuleListItems))
+
+		for n := range f.ModuleListItems {
+			g.ModuleListItems[n] = *f.ModuleListItems[n].clone(c)
+		}
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the NewExpression.
+func (f *NewExpression) Clone(opts ...CloneOption) *NewExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *NewExpression) clone(c *cloner) *NewExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.News != nil {
+		g.News = make([]Comments, len(f.News))
+
+		for n := range f.News {
+			g.News[n] = f.News[n].clone(c)
+		}
+	}
+
+	g.MemberExpression = *f.MemberExpression.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the ObjectAssignmentPattern.
+func (f *ObjectAssignmentPattern) Clone(opts ...CloneOption) *ObjectAssignmentPattern {
+	return f.clone(newCloner(opts))
+}
+
+func (f *ObjectAssignmentPattern) clone(c *cloner) *ObjectAssignmentPattern {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.AssignmentPropertyList != nil {
+		g.AssignmentPropertyList = make([]AssignmentProperty, len(f.AssignmentPropertyList))
+
+		for n := range f.AssignmentPropertyList {
+			g.AssignmentPropertyList[n] = *f.AssignmentPropertyList[n].clone(c)
+		}
+	}
+
+	g.AssignmentRestElement = f.AssignmentRestElement.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the ObjectBindingPattern.
+func (f *ObjectBindingPattern) Clone(opts ...CloneOption) *ObjectBindingPattern {
+	return f.clone(newCloner(opts))
+}
+
+func (f *ObjectBindingPattern) clone(c *cloner) *ObjectBindingPattern {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.BindingPropertyList != nil {
+		g.BindingPropertyList = make([]BindingProperty, len(f.BindingPropertyList))
+
+		for n := range f.BindingPropertyList {
+			g.BindingPropertyList[n] = *f.BindingPropertyList[n].clone(c)
+		}
+	}
+
+	g.BindingRestProperty = f.BindingRestProperty.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the ObjectLiteral.
+func (f *ObjectLiteral) Clone(opts ...CloneOption) *ObjectLiteral {
+	return f.clone(newCloner(opts))
+}
+
+func (f *ObjectLiteral) clone(c *cloner) *ObjectLiteral {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.PropertyDefinitionList != nil {
+		g.PropertyDefinitionList = make([]PropertyDefinition, len(f.PropertyDefinitionList))
+
+		for n := range f.PropertyDefinitionList {
+			g.PropertyDefinitionList[n] = *f.PropertyDefinitionList[n].clone(c)
+		}
+	}
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the ObjectType.
+func (f *ObjectType) Clone(opts ...CloneOption) *ObjectType {
+	return f.clone(newCloner(opts))
+}
+
+func (f *ObjectType) clone(c *cloner) *ObjectType {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.TypeMembers != nil {
+		g.TypeMembers = make([]TypeMember, len(f.TypeMembers))
+
+		for n := range f.TypeMembers {
+			g.TypeMembers[n] = *f.TypeMembers[n].clone(c)
+		}
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the OptionalChain.
+func (f *OptionalChain) Clone(opts ...CloneOption) *OptionalChain {
+	return f.clone(newCloner(opts))
+}
+
+func (f *OptionalChain) clone(c *cloner) *OptionalChain {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.OptionalChain = f.OptionalChain.clone(c)
+	g.Arguments = f.Arguments.clone(c)
+	g.Expression = f.Expression.clone(c)
+	g.IdentifierName = f.IdentifierName.clone(c)
+	g.TemplateLiteral = f.TemplateLiteral.clone(c)
+	g.PrivateIdentifier = f.PrivateIdentifier.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the OptionalExpression.
+func (f *OptionalExpression) Clone(opts ...CloneOption) *OptionalExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *OptionalExpression) clone(c *cloner) *OptionalExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.MemberExpression = f.MemberExpression.clone(c)
+	g.CallExpression = f.CallExpression.clone(c)
+	g.OptionalExpression = f.OptionalExpression.clone(c)
+	g.OptionalChain = *f.OptionalChain.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the Parameter.
+func (f *Parameter) Clone(opts ...CloneOption) *Parameter {
+	return f.clone(newCloner(opts))
+}
+
+func (f *Parameter) clone(c *cloner) *Parameter {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.Accessibility = f.Accessibility.clone(c)
+	g.Identifier = f.Identifier.clone(c)
+	g.TypeAnnotation = f.TypeAnnotation.clone(c)
+	g.Initializer = f.Initializer.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the ParameterList.
+func (f *ParameterList) Clone(opts ...CloneOption) *ParameterList {
+	return f.clone(newCloner(opts))
+}
+
+func (f *ParameterList) clone(c *cloner) *ParameterList {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.Parameters != nil {
+		g.Parameters = make([]Parameter, len(f.Parameters))
+
+		for n := range f.Parameters {
+			g.Parameters[n] = *f.Parameters[n].clone(c)
+		}
+	}
+
+	g.RestParameter = f.RestParameter.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the ParenthesizedExpression.
+func (f *ParenthesizedExpression) Clone(opts ...CloneOption) *ParenthesizedExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *ParenthesizedExpression) clone(c *cloner) *ParenthesizedExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.Expressions != nil {
+		g.Expressions = make([]AssignmentExpression, len(f.Expressions))
+
+		for n := range f.Expressions {
+			g.Expressions[n] = *f.Expressions[n].clone(c)
+		}
+	}
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the PostfixType.
+func (f *PostfixType) Clone(opts ...CloneOption) *PostfixType {
+	return f.clone(newCloner(opts))
+}
+
+func (f *PostfixType) clone(c *cloner) *PostfixType {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.PrimaryType = f.PrimaryType.clone(c)
+	g.PostfixType = f.PostfixType.clone(c)
+	g.IndexType = f.IndexType.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the PrimaryExpression.
+func (f *PrimaryExpression) Clone(opts ...CloneOption) *PrimaryExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *PrimaryExpression) clone(c *cloner) *PrimaryExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.This = f.This.clone(c)
+	g.IdentifierReference = f.IdentifierReference.clone(c)
+	g.Literal = f.Literal.clone(c)
+	g.ArrayLiteral = f.ArrayLiteral.clone(c)
+	g.ObjectLiteral = f.ObjectLiteral.clone(c)
+	g.FunctionExpression = f.FunctionExpression.clone(c)
+	g.ClassExpression = f.ClassExpression.clone(c)
+	g.TemplateLiteral = f.TemplateLiteral.clone(c)
+	g.ParenthesizedExpression = f.ParenthesizedExpression.clone(c)
+	g.JSXElement = f.JSXElement.clone(c)
+	g.JSXFragment = f.JSXFragment.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the PrimaryType.
+func (f *PrimaryType) Clone(opts ...CloneOption) *PrimaryType {
+	return f.clone(newCloner(opts))
+}
+
+func (f *PrimaryType) clone(c *cloner) *PrimaryType {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.LiteralType = f.LiteralType.clone(c)
+	g.TemplateLiteralType = f.TemplateLiteralType.clone(c)
+	g.ParenthesizedType = f.ParenthesizedType.clone(c)
+	g.PredefinedType = f.PredefinedType.clone(c)
+	g.ObjectType = f.ObjectType.clone(c)
+	g.MappedType = f.MappedType.clone(c)
+	g.TupleType = f.TupleType.clone(c)
+	g.This = f.This.clone(c)
+	g.ImportType = f.ImportType.clone(c)
+	g.TypeQuery = f.TypeQuery.clone(c)
+	g.TypeReference = f.TypeReference.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the PropertyDefinition.
+func (f *PropertyDefinition) Clone(opts ...CloneOption) *PropertyDefinition {
+	return f.clone(newCloner(opts))
+}
+
+func (f *PropertyDefinition) clone(c *cloner) *PropertyDefinition {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.PropertyName = f.PropertyName.clone(c)
+	g.AssignmentExpression = f.AssignmentExpression.clone(c)
+	g.MethodDefinition = f.MethodDefinition.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the PropertyName.
+func (f *PropertyName) Clone(opts ...CloneOption) *PropertyName {
+	return f.clone(newCloner(opts))
+}
+
+func (f *PropertyName) clone(c *cloner) *PropertyName {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.LiteralPropertyName = f.LiteralPropertyName.clone(c)
+	g.ComputedPropertyName = f.ComputedPropertyName.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the PropertySignature.
+func (f *PropertySignature) Clone(opts ...CloneOption) *PropertySignature {
+	return f.clone(newCloner(opts))
+}
+
+func (f *PropertySignature) clone(c *cloner) *PropertySignature {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.Modifiers != nil {
+		g.Modifiers = make([]*Token, len(f.Modifiers))
+
+		for n := range f.Modifiers {
+			g.Modifiers[n] = f.Modifiers[n].clone(c)
+		}
+	}
+
+	g.PropertyName = *f.PropertyName.clone(c)
+	g.TypeAnnotation = f.TypeAnnotation.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the RelationalExpression.
+func (f *RelationalExpression) Clone(opts ...CloneOption) *RelationalExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *RelationalExpression) clone(c *cloner) *RelationalExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.PrivateIdentifier = f.PrivateIdentifier.clone(c)
+	g.RelationalExpression = f.RelationalExpression.clone(c)
+	g.ShiftExpression = *f.ShiftExpression.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the Script.
+func (f *Script) Clone(opts ...CloneOption) *Script {
+	return f.clone(newCloner(opts))
+}
+
+func (f *Script) clone(c *cloner) *Script {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.DirectivePrologue = *f.DirectivePrologue.clone(c)
+	g.Hashbang = f.Hashbang.clone(c)
+
+	if f.StatementList != nil {
+		g.StatementList = make([]StatementListItem, len(f.StatementList))
+
+		for n := range f.StatementList {
+			g.StatementList[n] = *f.StatementList[n].clone(c)
+		}
+	}
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the ShiftExpression.
+func (f *ShiftExpression) Clone(opts ...CloneOption) *ShiftExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *ShiftExpression) clone(c *cloner) *ShiftExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.ShiftExpression = f.ShiftExpression.clone(c)
+	g.AdditiveExpression = *f.AdditiveExpression.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the Statement.
+func (f *Statement) Clone(opts ...CloneOption) *Statement {
+	return f.clone(newCloner(opts))
+}
+
+func (f *Statement) clone(c *cloner) *Statement {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.BlockStatement = f.BlockStatement.clone(c)
+	g.VariableStatement = f.VariableStatement.clone(c)
+	g.ExpressionStatement = f.ExpressionStatement.clone(c)
+	g.IfStatement = f.IfStatement.clone(c)
+	g.IterationStatementDo = f.IterationStatementDo.clone(c)
+	g.IterationStatementWhile = f.IterationStatementWhile.clone(c)
+	g.IterationStatementFor = f.IterationStatementFor.clone(c)
+	g.SwitchStatement = f.SwitchStatement.clone(c)
+	g.WithStatement = f.WithStatement.clone(c)
+	g.LabelIdentifier = f.LabelIdentifier.clone(c)
+	g.LabelledItemFunction = f.LabelledItemFunction.clone(c)
+	g.LabelledItemStatement = f.LabelledItemStatement.clone(c)
+	g.TryStatement = f.TryStatement.clone(c)
+	g.EnumDeclaration = f.EnumDeclaration.clone(c)
+	g.NamespaceDeclaration = f.NamespaceDeclaration.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the StatementListItem.
+func (f *StatementListItem) Clone(opts ...CloneOption) *StatementListItem {
+	return f.clone(newCloner(opts))
+}
+
+func (f *StatementListItem) clone(c *cloner) *StatementListItem {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.Statement = f.Statement.clone(c)
+	g.Declaration = f.Declaration.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the SwitchStatement.
+func (f *SwitchStatement) Clone(opts ...CloneOption) *SwitchStatement {
+	return f.clone(newCloner(opts))
+}
+
+func (f *SwitchStatement) clone(c *cloner) *SwitchStatement {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.Expression = *f.Expression.clone(c)
+
+	if f.CaseClauses != nil {
+		g.CaseClauses = make([]CaseClause, len(f.CaseClauses))
+
+		for n := range f.CaseClauses {
+			g.CaseClauses[n] = *f.CaseClauses[n].clone(c)
+		}
+	}
+
+	if f.DefaultClause != nil {
+		g.DefaultClause = make([]StatementListItem, len(f.DefaultClause))
+
+		for n := range f.DefaultClause {
+			g.DefaultClause[n] = *f.DefaultClause[n].clone(c)
+		}
+	}
+
+	if f.PostDefaultCaseClauses != nil {
+		g.PostDefaultCaseClauses = make([]CaseClause, len(f.PostDefaultCaseClauses))
+
+		for n := range f.PostDefaultCaseClauses {
+			g.PostDefaultCaseClauses[n] = *f.PostDefaultCaseClauses[n].clone(c)
+		}
+	}
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the TemplateLiteral.
+func (f *TemplateLiteral) Clone(opts ...CloneOption) *TemplateLiteral {
+	return f.clone(newCloner(opts))
+}
+
+func (f *TemplateLiteral) clone(c *cloner) *TemplateLiteral {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.NoSubstitutionTemplate = f.NoSubstitutionTemplate.clone(c)
+	g.TemplateHead = f.TemplateHead.clone(c)
+
+	if f.Expressions != nil {
+		g.Expressions = make([]Expression, len(f.Expressions))
+
+		for n := range f.Expressions {
+			g.Expressions[n] = *f.Expressions[n].clone(c)
+		}
+	}
+
+	if f.TemplateMiddleList != nil {
+		g.TemplateMiddleList = make([]*Token, len(f.TemplateMiddleList))
+
+		for n := range f.TemplateMiddleList {
+			g.TemplateMiddleList[n] = f.TemplateMiddleList[n].clone(c)
+		}
+	}
+
+	g.TemplateTail = f.TemplateTail.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the TemplateLiteralType.
+func (f *TemplateLiteralType) Clone(opts ...CloneOption) *TemplateLiteralType {
+	return f.clone(newCloner(opts))
+}
+
+func (f *TemplateLiteralType) clone(c *cloner) *TemplateLiteralType {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.TemplateHead = f.TemplateHead.clone(c)
+
+	if f.Types != nil {
+		g.Types = make([]TypeExpression, len(f.Types))
+
+		for n := range f.Types {
+			g.Types[n] = *f.Types[n].clone(c)
+		}
+	}
+
+	if f.TemplateMiddleList != nil {
+		g.TemplateMiddleList = make([]*Token, len(f.TemplateMiddleList))
+
+		for n := range f.TemplateMiddleList {
+			g.TemplateMiddleList[n] = f.TemplateMiddleList[n].clone(c)
+		}
+	}
+
+	g.TemplateTail = f.TemplateTail.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the TryStatement.
+func (f *TryStatement) Clone(opts ...CloneOption) *TryStatement {
+	return f.clone(newCloner(opts))
+}
+
+func (f *TryStatement) clone(c *cloner) *TryStatement {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.TryBlock = *f.TryBlock.clone(c)
+	g.CatchParameterBindingIdentifier = f.CatchParameterBindingIdentifier.clone(c)
+	g.CatchParameterObjectBindingPattern = f.CatchParameterObjectBindingPattern.clone(c)
+	g.CatchParameterArrayBindingPattern = f.CatchParameterArrayBindingPattern.clone(c)
+	g.CatchBlock = f.CatchBlock.clone(c)
+	g.FinallyBlock = f.FinallyBlock.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the TupleElement.
+func (f *TupleElement) Clone(opts ...CloneOption) *TupleElement {
+	return f.clone(newCloner(opts))
+}
+
+func (f *TupleElement) clone(c *cloner) *TupleElement {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.Label = f.Label.clone(c)
+	g.Type = *f.Type.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the TupleType.
+func (f *TupleType) Clone(opts ...CloneOption) *TupleType {
+	return f.clone(newCloner(opts))
+}
+
+func (f *TupleType) clone(c *cloner) *TupleType {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.TupleElements != nil {
+		g.TupleElements = make([]TupleElement, len(f.TupleElements))
+
+		for n := range f.TupleElements {
+			g.TupleElements[n] = *f.TupleElements[n].clone(c)
+		}
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the TypeAliasDeclaration.
+func (f *TypeAliasDeclaration) Clone(opts ...CloneOption) *TypeAliasDeclaration {
+	return f.clone(newCloner(opts))
+}
+
+func (f *TypeAliasDeclaration) clone(c *cloner) *TypeAliasDeclaration {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.BindingIdentifier = f.BindingIdentifier.clone(c)
+	g.TypeParameters = f.TypeParameters.clone(c)
+	g.Type = *f.Type.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the TypeAnnotation.
+func (f *TypeAnnotation) Clone(opts ...CloneOption) *TypeAnnotation {
+	return f.clone(newCloner(opts))
+}
+
+func (f *TypeAnnotation) clone(c *cloner) *TypeAnnotation {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.TypePredicate = f.TypePredicate.clone(c)
+	g.Type = *f.Type.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the TypeArguments.
+func (f *TypeArguments) Clone(opts ...CloneOption) *TypeArguments {
+	return f.clone(newCloner(opts))
+}
+
+func (f *TypeArguments) clone(c *cloner) *TypeArguments {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.TypeArguments != nil {
+		g.TypeArguments = make([]TypeExpression, len(f.TypeArguments))
+
+		for n := range f.TypeArguments {
+			g.TypeArguments[n] = *f.TypeArguments[n].clone(c)
+		}
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the TypeExpression.
+func (f *TypeExpression) Clone(opts ...CloneOption) *TypeExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *TypeExpression) clone(c *cloner) *TypeExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.FunctionTypeExpression = f.FunctionTypeExpression.clone(c)
+	g.UnionType = f.UnionType.clone(c)
+	g.ExtendsType = f.ExtendsType.clone(c)
+	g.TrueType = f.TrueType.clone(c)
+	g.FalseType = f.FalseType.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the TypeMember.
+func (f *TypeMember) Clone(opts ...CloneOption) *TypeMember {
+	return f.clone(newCloner(opts))
+}
+
+func (f *TypeMember) clone(c *cloner) *TypeMember {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.CallSignature = f.CallSignature.clone(c)
+	g.ConstructSignature = f.ConstructSignature.clone(c)
+	g.PropertySignature = f.PropertySignature.clone(c)
+	g.MethodSignature = f.MethodSignature.clone(c)
+	g.IndexSignature = f.IndexSignature.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the TypeOperator.
+func (f *TypeOperator) Clone(opts ...CloneOption) *TypeOperator {
+	return f.clone(newCloner(opts))
+}
+
+func (f *TypeOperator) clone(c *cloner) *TypeOperator {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.TypeOperator = f.TypeOperator.clone(c)
+	g.InferIdentifier = f.InferIdentifier.clone(c)
+	g.PostfixType = f.PostfixType.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the TypeParameter.
+func (f *TypeParameter) Clone(opts ...CloneOption) *TypeParameter {
+	return f.clone(newCloner(opts))
+}
+
+func (f *TypeParameter) clone(c *cloner) *TypeParameter {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.Identifier = f.Identifier.clone(c)
+	g.Constraint = f.Constraint.clone(c)
+	g.Default = f.Default.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the TypeParameters.
+func (f *TypeParameters) Clone(opts ...CloneOption) *TypeParameters {
+	return f.clone(newCloner(opts))
+}
+
+func (f *TypeParameters) clone(c *cloner) *TypeParameters {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.TypeParameters != nil {
+		g.TypeParameters = make([]TypeParameter, len(f.TypeParameters))
+
+		for n := range f.TypeParameters {
+			g.TypeParameters[n] = *f.TypeParameters[n].clone(c)
+		}
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the TypeQuery.
+func (f *TypeQuery) Clone(opts ...CloneOption) *TypeQuery {
+	return f.clone(newCloner(opts))
+}
+
+func (f *TypeQuery) clone(c *cloner) *TypeQuery {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.EntityName != nil {
+		g.EntityName = make([]*Token, len(f.EntityName))
+
+		for n := range f.EntityName {
+			g.EntityName[n] = f.EntityName[n].clone(c)
+		}
+	}
+
+	g.TypeArguments = f.TypeArguments.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the TypeReference.
+func (f *TypeReference) Clone(opts ...CloneOption) *TypeReference {
+	return f.clone(newCloner(opts))
+}
+
+func (f *TypeReference) clone(c *cloner) *TypeReference {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.TypeName != nil {
+		g.TypeName = make([]*Token, len(f.TypeName))
+
+		for n := range f.TypeName {
+			g.TypeName[n] = f.TypeName[n].clone(c)
+		}
+	}
+
+	g.TypeArguments = f.TypeArguments.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the UnaryExpression.
+func (f *UnaryExpression) Clone(opts ...CloneOption) *UnaryExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *UnaryExpression) clone(c *cloner) *UnaryExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.UnaryOperators != nil {
+		g.UnaryOperators = make([]UnaryOperatorComments, len(f.UnaryOperators))
+
+		for n := range f.UnaryOperators {
+			g.UnaryOperators[n] = *f.UnaryOperators[n].clone(c)
+		}
+	}
+
+	g.UpdateExpression = *f.UpdateExpression.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the UnaryOperatorComments.
+func (f *UnaryOperatorComments) Clone(opts ...CloneOption) *UnaryOperatorComments {
+	return f.clone(newCloner(opts))
+}
+
+func (f *UnaryOperatorComments) clone(c *cloner) *UnaryOperatorComments {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.Comments = f.Comments.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the UnionType.
+func (f *UnionType) Clone(opts ...CloneOption) *UnionType {
+	return f.clone(newCloner(opts))
+}
+
+func (f *UnionType) clone(c *cloner) *UnionType {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.IntersectionTypes != nil {
+		g.IntersectionTypes = make([]IntersectionType, len(f.IntersectionTypes))
+
+		for n := range f.IntersectionTypes {
+			g.IntersectionTypes[n] = *f.IntersectionTypes[n].clone(c)
+		}
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the UpdateExpression.
+func (f *UpdateExpression) Clone(opts ...CloneOption) *UpdateExpression {
+	return f.clone(newCloner(opts))
+}
+
+func (f *UpdateExpression) clone(c *cloner) *UpdateExpression {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.LeftHandSideExpression = f.LeftHandSideExpression.clone(c)
+	g.UnaryExpression = f.UnaryExpression.clone(c)
+	g.Comments = f.Comments.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the VariableStatement.
+func (f *VariableStatement) Clone(opts ...CloneOption) *VariableStatement {
+	return f.clone(newCloner(opts))
+}
+
+func (f *VariableStatement) clone(c *cloner) *VariableStatement {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.EnumDeclaration = f.EnumDeclaration.clone(c)
+	g.NamespaceDeclaration = f.NamespaceDeclaration.clone(c)
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the WithClause.
+func (f *WithClause) Clone(opts ...CloneOption) *WithClause {
+	return f.clone(newCloner(opts))
+}
+
+func (f *WithClause) clone(c *cloner) *WithClause {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+
+	if f.WithEntries != nil {
+		g.WithEntries = make([]WithEntry, len(f.WithEntries))
+
+		for n := range f.WithEntries {
+			g.WithEntries[n] = *f.WithEntries[n].clone(c)
+		}
+	}
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the WithEntry.
+func (f *WithEntry) Clone(opts ...CloneOption) *WithEntry {
+	return f.clone(newCloner(opts))
+}
+
+func (f *WithEntry) clone(c *cloner) *WithEntry {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.AttributeKey = f.AttributeKey.clone(c)
+	g.Value = f.Value.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
+
+// Clone returns a deep copy of the WithStatement.
+func (f *WithStatement) Clone(opts ...CloneOption) *WithStatement {
+	return f.clone(newCloner(opts))
+}
+
+func (f *WithStatement) clone(c *cloner) *WithStatement {
+	if f == nil {
+		return nil
+	}
+
+	g := *f
+	g.Expression = *f.Expression.clone(c)
+	g.Statement = *f.Statement.clone(c)
+
+	for n := range f.Comments {
+		g.Comments[n] = f.Comments[n].clone(c)
+	}
+
+	g.Tokens = f.Tokens.clone(c)
+
+	return &g
+}
@@ -0,0 +1,968 @@
+// Package dts generates Typescript declaration files from a parsed Typescript module.
+package dts // import "vimagination.zapto.org/javascript/dts"
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"vimagination.zapto.org/javascript"
+	"vimagination.zapto.org/javascript/internal"
+	"vimagination.zapto.org/javascript/walk"
+)
+
+// Write writes a Typescript declaration file (.d.ts) for the given Module to
+// the given Writer.
+//
+// The Module should have been parsed from a Tokeniser wrapped with
+// javascript.AsTypescriptAST so that type annotations, type aliases,
+// interfaces, enums, namespaces, overloads and abstract class members are
+// available.
+//
+// The declarations retained are imports, re-exports, type aliases,
+// interfaces, any Typescript declare statements, and the signatures of
+// exported variables, functions, classes, enums and namespaces; all
+// implementation bodies are stripped. Local declarations that are exported by
+// name, either with an export clause or a default export, or that are
+// referenced by the types of declared items, are declared without being
+// exported; when the module has no export clause, `export {};` is added so
+// that these are not implicitly exported. Only the exported members of a
+// namespace are declared.
+//
+// Types that are not annotated are inferred from literal and function
+// initialisers, and otherwise are given the type any. Functions without a
+// return type annotation are declared as returning void when their bodies
+// contain no return statement with a value. Each name bound by a destructuring
+// pattern is declared separately, with the type any unless it is annotated.
+//
+// When a function or method has overloads, only the overload signatures are
+// declared. Enums are declared with their evaluated member values.
+func Write(w io.Writer, m *javascript.Module) error {
+	d := declarations{declared: exportedNames(m), exported: map[string]struct{}{}}
+
+	d.addReferenced(m.ModuleListItems)
+	d.moduleItems(m.ModuleListItems)
+
+	if d.private && !d.scoped {
+		d.line("export {};")
+	}
+
+	_, err := io.WriteString(w, d.String())
+
+	return err
+}
+
+func exportedNames(m *javascript.Module) map[string]struct{} {
+	exported := map[string]struct{}{}
+
+	for _, mi := range m.ModuleListItems {
+		ed := mi.ExportDeclaration
+		if ed == nil {
+			continue
+		}
+
+		if ed.ExportClause != nil && ed.FromClause == nil {
+			for _, es := range ed.ExportClause.ExportList {
+				if es.IdentifierName != nil {
+					exported[es.IdentifierName.Data] = struct{}{}
+				}
+			}
+		} else if name := identifier(ed.DefaultAssignmentExpression); name != "" {
+			exported[name] = struct{}{}
+		}
+	}
+
+	return exported
+}
+
+type declarations struct {
+	strings.Builder
+	declared  map[string]struct{}
+	exported  map[string]struct{}
+	namespace bool
+	private   bool
+	scoped    bool
+}
+
+func (d *declarations) line(format string, args ...any) {
+	fmt.Fprintf(d, format, args...)
+	d.WriteString("\n")
+}
+
+// isDeclared returns true when any of the given names is that of a local
+// declaration that is to be declared.
+func (d *declarations) isDeclared(names ...*javascript.Token) bool {
+	for _, name := range names {
+		if _, ok := d.declared[name.Data]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// prefix returns the modifiers for a declaration, which are not ambient
+// within a namespace, as the namespace itself is declared.
+func (d *declarations) prefix(export bool) string {
+	switch {
+	case d.namespace && export:
+		return "export "
+	case d.namespace:
+		return ""
+	case export:
+		return "export declare "
+	}
+
+	d.private = true
+
+	return "declare "
+}
+
+// addReferenced adds to the declared names those of the local declarations
+// that are referenced by the types of the items that will be declared,
+// including those of other referenced local declarations.
+func (d *declarations) addReferenced(items []javascript.ModuleItem) {
+	var (
+		locals = map[string]*javascript.StatementListItem{}
+		done   = map[*javascript.StatementListItem]struct{}{}
+		refs   = references{}
+	)
+
+	for n := range items {
+		if ed := items[n].ExportDeclaration; ed != nil {
+			refs.Handle(ed)
+		} else if si := items[n].StatementListItem; si != nil {
+			if de := si.Declaration; de != nil && (de.TypeAliasDeclaration != nil || de.InterfaceDeclaration != nil || de.NamespaceDeclaration != nil) {
+				refs.Handle(si)
+			}
+
+			for _, name := range localNames(si) {
+				locals[name.Data] = si
+			}
+		}
+	}
+
+	for {
+		var next []*javascript.StatementListItem
+
+		for _, names := range [...]map[string]struct{}{d.declared, refs} {
+			for name := range names {
+				if si, ok := locals[name]; ok {
+					if _, ok := done[si]; !ok {
+						done[si] = struct{}{}
+						next = append(next, si)
+					}
+				}
+			}
+		}
+
+		if len(next) == 0 {
+			break
+		}
+
+		for _, si := range next {
+			refs.Handle(si)
+		}
+	}
+
+	for name := range refs {
+		if _, ok := locals[name]; ok {
+			d.declared[name] = struct{}{}
+		}
+	}
+}
+
+func localNames(si *javascript.StatementListItem) []*javascript.Token {
+	var names []*javascript.Token
+
+	if si.Declaration != nil {
+		switch de := si.Declaration; {
+		case de.FunctionDeclaration != nil && de.FunctionDeclaration.BindingIdentifier != nil:
+			names = append(names, de.FunctionDeclaration.BindingIdentifier)
+		case de.ClassDeclaration != nil && de.ClassDeclaration.BindingIdentifier != nil:
+			names = append(names, de.ClassDeclaration.BindingIdentifier)
+		case de.LexicalDeclaration != nil:
+			for _, lb := range de.LexicalDeclaration.BindingList {
+				names = append(names, boundNames(lb)...)
+			}
+		}
+	} else if si.Statement != nil && si.Statement.VariableStatement != nil {
+		for _, vd := range si.Statement.VariableStatement.VariableDeclarationList {
+			names = append(names, boundNames(vd)...)
+		}
+	}
+
+	return names
+}
+
+// references collects the names of the types, and of the classes extended,
+// that are referenced by declarations, not including those referenced only
+// within function bodies.
+type references map[string]struct{}
+
+func (r references) Handle(t javascript.Type) error {
+	switch t := t.(type) {
+	case *javascript.Block:
+		return nil
+	case *javascript.ArrowFunction:
+		if t.TypeParameters != nil {
+			r.Handle(t.TypeParameters)
+		}
+
+		if t.FormalParameters != nil {
+			r.Handle(t.FormalParameters)
+		}
+
+		if t.ReturnType != nil {
+			r.Handle(t.ReturnType)
+		}
+
+		return nil
+	case *javascript.TypeReference:
+		r[t.TypeName[0].Data] = struct{}{}
+	case *javascript.TypeQuery:
+		r[t.EntityName[0].Data] = struct{}{}
+	case *javascript.ClassDeclaration:
+		if t.ClassHeritage != nil {
+			var heritage walk.Handler
+
+			heritage = walk.HandlerFunc(func(t javascript.Type) error {
+				if pe, ok := t.(*javascript.PrimaryExpression); ok && pe.IdentifierReference != nil {
+					r[pe.IdentifierReference.Data] = struct{}{}
+				}
+
+				return walk.Walk(t, heritage)
+			})
+
+			walk.Walk(t.ClassHeritage, heritage)
+		}
+	}
+
+	return walk.Walk(t, r)
+}
+
+func (d *declarations) moduleItems(items []javascript.ModuleItem) {
+	for _, mi := range items {
+		if mi.ImportDeclaration != nil {
+			d.importDeclaration(&mi)
+		} else if s := mi.StatementListItem; s != nil && s.Statement != nil && (s.Statement.EnumDeclaration != nil || s.Statement.NamespaceDeclaration != nil) {
+			d.mergedDeclaration(s.Statement)
+		} else if mi.StatementListItem != nil {
+			if !d.namespace {
+				d.statementListItem(mi.StatementListItem)
+			}
+		} else if mi.ExportDeclaration != nil {
+			d.exportDeclaration(mi.ExportDeclaration)
+		}
+	}
+}
+
+func (d *declarations) importDeclaration(mi *javascript.ModuleItem) {
+	if mi.ImportDeclaration.ImportClause != nil {
+		d.statement(mi.Tokens)
+	}
+}
+
+func (d *declarations) statement(tks javascript.Tokens) {
+	if s := strings.TrimSpace(source(tks, false)); !strings.HasSuffix(s, ";") {
+		d.line("%s;", s)
+	} else {
+		d.line("%s", s)
+	}
+}
+
+func (d *declarations) statementListItem(si *javascript.StatementListItem) {
+	if si.Statement == nil && si.Declaration == nil {
+		d.typescript(si.Tokens)
+	} else if si.Declaration != nil {
+		d.declaration(si.Declaration, false)
+	} else if vs := si.Statement.VariableStatement; vs != nil {
+		for _, vd := range vs.VariableDeclarationList {
+			if d.isDeclared(boundNames(vd)...) {
+				d.variableStatement(d.prefix(false), vs)
+
+				break
+			}
+		}
+	}
+}
+
+func (d *declarations) typescript(tks javascript.Tokens) {
+	if ts := strings.TrimSpace(source(tks, true)); ts != "" {
+		if !strings.HasSuffix(ts, ";") && !strings.HasSuffix(ts, "}") {
+			ts += ";"
+		}
+
+		if rest, ok := strings.CutPrefix(ts, "export"); !ok {
+			d.private = d.private || !strings.HasPrefix(ts, "import")
+		} else if rest = strings.TrimPrefix(strings.TrimSpace(rest), "type "); strings.HasPrefix(rest, "{") || strings.HasPrefix(rest, "*") || strings.HasPrefix(rest, "=") {
+			d.scoped = true
+		}
+
+		d.line("%s", ts)
+	}
+}
+
+func (d *declarations) exportDeclaration(ed *javascript.ExportDeclaration) {
+	if ed.ExportClause != nil || ed.ExportFromClause != nil || ed.FromClause != nil {
+		d.scoped = true
+
+		d.statement(ed.Tokens)
+	} else if vs := ed.VariableStatement; vs != nil {
+		if vs.EnumDeclaration != nil {
+			d.exported[vs.EnumDeclaration.BindingIdentifier.Data] = struct{}{}
+		} else if vs.NamespaceDeclaration != nil {
+			d.exported[vs.NamespaceDeclaration.NamespaceName[0].Data] = struct{}{}
+		}
+
+		d.variableStatement(d.prefix(true), vs)
+	} else if ed.Declaration != nil {
+		for _, name := range localNames(&javascript.StatementListItem{Declaration: ed.Declaration}) {
+			d.exported[name.Data] = struct{}{}
+		}
+
+		d.declaration(ed.Declaration, true)
+	} else if ed.DefaultFunction != nil {
+		d.function("export default ", ed.DefaultFunction)
+	} else if ed.DefaultClass != nil {
+		d.class("export default ", ed.DefaultClass)
+	} else if ed.DefaultAssignmentExpression != nil {
+		d.scoped = true
+
+		if identifier(ed.DefaultAssignmentExpression) != "" {
+			d.line("export default %s;", ed.DefaultAssignmentExpression)
+		} else {
+			_, typ := literalType(ed.DefaultAssignmentExpression)
+
+			d.line("declare const _default: %s;", typ)
+			d.line("export default _default;")
+		}
+	}
+}
+
+// mergedDeclaration declares an enum or namespace that merges with an earlier
+// declaration of the same name, exporting it when that declaration was
+// exported.
+func (d *declarations) mergedDeclaration(s *javascript.Statement) {
+	var (
+		vs   = &javascript.VariableStatement{EnumDeclaration: s.EnumDeclaration, NamespaceDeclaration: s.NamespaceDeclaration}
+		name *javascript.Token
+	)
+
+	if s.EnumDeclaration != nil {
+		name = s.EnumDeclaration.BindingIdentifier
+	} else {
+		name = s.NamespaceDeclaration.NamespaceName[0]
+	}
+
+	if _, ok := d.exported[name.Data]; ok {
+		d.variableStatement(d.prefix(true), vs)
+	} else if !d.namespace && d.isDeclared(name) {
+		d.variableStatement(d.prefix(false), vs)
+	}
+}
+
+func (d *declarations) variableStatement(prefix string, vs *javascript.VariableStatement) {
+	if vs.EnumDeclaration != nil {
+		d.enum(prefix, vs.EnumDeclaration)
+	} else if vs.NamespaceDeclaration != nil {
+		d.namespaceDeclaration(prefix, vs.NamespaceDeclaration)
+	} else {
+		d.variables(prefix+"var", vs.VariableDeclarationList, false)
+	}
+}
+
+func (d *declarations) declaration(de *javascript.Declaration, export bool) {
+	prefix := d.prefix(export)
+
+	switch {
+	case de.TypeAliasDeclaration != nil:
+		d.exportType(de.TypeAliasDeclaration, export)
+	case de.InterfaceDeclaration != nil:
+		d.exportType(de.InterfaceDeclaration, export)
+	case de.NamespaceDeclaration != nil:
+		d.namespaceDeclaration(prefix, de.NamespaceDeclaration)
+	case de.FunctionDeclaration != nil:
+		if export || d.isDeclared(localNames(&javascript.StatementListItem{Declaration: de})...) {
+			d.function(prefix, de.FunctionDeclaration)
+		}
+	case de.ClassDeclaration != nil:
+		if export || d.isDeclared(localNames(&javascript.StatementListItem{Declaration: de})...) {
+			d.class(prefix, de.ClassDeclaration)
+		}
+	case de.LexicalDeclaration != nil:
+		ld := de.LexicalDeclaration
+
+		if !export && !d.isDeclared(localNames(&javascript.StatementListItem{Declaration: de})...) {
+			break
+		}
+
+		if ld.LetOrConst == javascript.Let {
+			d.variables(prefix+"let", ld.BindingList, false)
+		} else {
+			d.variables(prefix+"const", ld.BindingList, true)
+		}
+	}
+}
+
+func (d *declarations) exportType(t fmt.Formatter, export bool) {
+	if export {
+		d.WriteString("export ")
+	} else if !d.namespace {
+		d.private = true
+	}
+
+	d.line("%s", t)
+}
+
+func (d *declarations) variables(prefix string, bindings []javascript.LexicalBinding, constant bool) {
+	var list []string
+
+	for _, lb := range bindings {
+		if lb.BindingIdentifier != nil {
+			list = append(list, lb.BindingIdentifier.Data+valueType(lb.TypeAnnotation, lb.Initializer, constant))
+
+			continue
+		}
+
+		for _, name := range boundNames(lb) {
+			list = append(list, name.Data+": any")
+		}
+	}
+
+	if len(list) > 0 {
+		d.line("%s %s;", prefix, strings.Join(list, ", "))
+	}
+}
+
+func (d *declarations) enum(prefix string, ed *javascript.EnumDeclaration) {
+	d.WriteString(prefix)
+
+	if ed.Const {
+		d.WriteString("const ")
+	}
+
+	d.WriteString("enum ")
+	d.WriteString(ed.BindingIdentifier.Data)
+	d.WriteString(" {")
+
+	for n, em := range ed.EnumMembers {
+		if n > 0 {
+			d.WriteString(",")
+		}
+
+		d.WriteString("\n\t")
+
+		if unquoted, err := javascript.Unquote(em.Name.Data); err == nil && isIdentifier(unquoted) {
+			d.WriteString(unquoted)
+		} else {
+			d.WriteString(em.Name.Data)
+		}
+
+		if em.Value != nil {
+			fmt.Fprintf(d, " = %s", em.Value)
+		}
+	}
+
+	d.line("\n}")
+}
+
+func (d *declarations) namespaceDeclaration(prefix string, nd *javascript.NamespaceDeclaration) {
+	names := make([]string, len(nd.NamespaceName))
+
+	for n, tk := range nd.NamespaceName {
+		names[n] = tk.Data
+	}
+
+	body := declarations{declared: map[string]struct{}{}, exported: map[string]struct{}{}, namespace: true}
+
+	body.moduleItems(nd.ModuleListItems)
+
+	d.WriteString(prefix)
+	d.WriteString("namespace ")
+	d.WriteString(strings.Join(names, "."))
+	d.WriteString(" {")
+
+	if b := strings.TrimSuffix(body.String(), "\n"); b != "" {
+		d.WriteString("\n\t")
+		d.WriteString(strings.ReplaceAll(b, "\n", "\n\t"))
+	}
+
+	d.line("\n}")
+}
+
+// boundNames returns the names bound by the given LexicalBinding, including
+// those bound by a destructuring pattern.
+func boundNames(lb javascript.LexicalBinding) []*javascript.Token {
+	return appendBoundNames(nil, lb.BindingIdentifier, lb.ArrayBindingPattern, lb.ObjectBindingPattern)
+}
+
+func appendBoundNames(names []*javascript.Token, bi *javascript.Token, abp *javascript.ArrayBindingPattern, obp *javascript.ObjectBindingPattern) []*javascript.Token {
+	if bi != nil {
+		names = append(names, bi)
+	}
+
+	if abp != nil {
+		for _, be := range abp.BindingElementList {
+			names = appendBoundNames(names, be.SingleNameBinding, be.ArrayBindingPattern, be.ObjectBindingPattern)
+		}
+
+		if be := abp.BindingRestElement; be != nil {
+			names = appendBoundNames(names, be.SingleNameBinding, be.ArrayBindingPattern, be.ObjectBindingPattern)
+		}
+	}
+
+	if obp != nil {
+		for _, bp := range obp.BindingPropertyList {
+			names = appendBoundNames(names, bp.BindingElement.SingleNameBinding, bp.BindingElement.ArrayBindingPattern, bp.BindingElement.ObjectBindingPattern)
+		}
+
+		names = appendBoundNames(names, obp.BindingRestProperty, nil, nil)
+	}
+
+	return names
+}
+
+func isIdentifier(str string) bool {
+	for n, r := range str {
+		if (n == 0 && !internal.IsIDStart(r)) || (n > 0 && !internal.IsIDContinue(r)) {
+			return false
+		}
+	}
+
+	return str != ""
+}
+
+func (d *declarations) function(prefix string, fd *javascript.FunctionDeclaration) {
+	prefix += "function"
+
+	if fd.BindingIdentifier != nil {
+		prefix += " " + fd.BindingIdentifier.Data
+	}
+
+	for _, sig := range overloads(fd.Overloads) {
+		d.line("%s%s;", prefix, sig)
+	}
+
+	if len(fd.Overloads) == 0 {
+		d.line("%s%s;", prefix, signature(fd.TypeParameters, &fd.FormalParameters, fd.ReturnType, fd.Type == javascript.FunctionAsync, functionVoid(fd)))
+	}
+}
+
+func (d *declarations) class(prefix string, cd *javascript.ClassDeclaration) {
+	d.WriteString(prefix)
+
+	if cd.Abstract {
+		d.WriteString("abstract ")
+	}
+
+	d.WriteString("class")
+
+	if cd.BindingIdentifier != nil {
+		d.WriteString(" ")
+		d.WriteString(cd.BindingIdentifier.Data)
+	}
+
+	if cd.TypeParameters != nil {
+		fmt.Fprintf(d, "%s", cd.TypeParameters)
+	}
+
+	if cd.ClassHeritage != nil {
+		fmt.Fprintf(d, " extends %s", cd.ClassHeritage)
+
+		if cd.TypeArguments != nil {
+			fmt.Fprintf(d, "%s", cd.TypeArguments)
+		}
+	}
+
+	for n, tr := range cd.Implements {
+		if n == 0 {
+			d.WriteString(" implements ")
+		} else {
+			d.WriteString(", ")
+		}
+
+		fmt.Fprintf(d, "%s", tr)
+	}
+
+	d.WriteString(" {")
+
+	var (
+		members    []string
+		properties = map[string]struct{}{}
+		private    bool
+	)
+
+	for _, ce := range cd.ClassBody {
+		if ce.TypeMember != nil {
+			members = append(members, fmt.Sprintf("%s", ce.TypeMember))
+
+			continue
+		}
+
+		if md := ce.MethodDefinition; md != nil && !ce.Static && md.Type == javascript.MethodNormal && isConstructor(&md.ClassElementName) {
+			for _, be := range md.Params.FormalParameterList {
+				if be.SingleNameBinding == nil {
+					continue
+				}
+
+				access, readonly, ok := modifiers(be.Comments[0])
+				if !ok {
+					continue
+				}
+
+				name := be.SingleNameBinding.Data
+				properties[name] = struct{}{}
+
+				if access == "private " {
+					members = append(members, access+readonly+name)
+				} else {
+					members = append(members, access+readonly+name+valueType(be.TypeAnnotation, be.Initializer, false))
+				}
+			}
+
+			if signatures := overloads(md.ClassElementName.Overloads); len(signatures) > 0 {
+				for _, sig := range signatures {
+					members = append(members, "constructor"+sig)
+				}
+			} else {
+				members = append(members, "constructor"+parameters(&md.Params))
+			}
+
+			continue
+		}
+
+		if ce.ClassStaticBlock != nil || ce.MethodDefinition == nil && ce.FieldDefinition == nil {
+			continue
+		}
+
+		var cen *javascript.ClassElementName
+
+		if ce.MethodDefinition != nil {
+			cen = &ce.MethodDefinition.ClassElementName
+		} else {
+			cen = &ce.FieldDefinition.ClassElementName
+		}
+
+		if cen.PrivateIdentifier != nil {
+			private = true
+
+			continue
+		}
+
+		access, readonly, _ := modifiers(ce.Comments[0], ce.Comments[1], ce.Comments[2])
+		isPrivate := access == "private "
+		name := fmt.Sprintf("%s", cen.PropertyName)
+		mods := access
+
+		if ce.Static {
+			mods += "static "
+		}
+
+		if ce.Accessor {
+			mods += "accessor "
+		}
+
+		mods += readonly
+
+		if fd := ce.FieldDefinition; fd != nil {
+			if _, ok := properties[name]; ok && fd.TypeAnnotation == nil && fd.Initializer == nil && !ce.Static {
+				continue
+			}
+
+			if fd.Optional {
+				name += "?"
+			}
+
+			if isPrivate {
+				members = append(members, mods+name)
+			} else {
+				members = append(members, mods+name+valueType(fd.TypeAnnotation, fd.Initializer, readonly != ""))
+			}
+
+			continue
+		}
+
+		md := ce.MethodDefinition
+
+		switch md.Type {
+		case javascript.MethodGetter:
+			if isPrivate {
+				members = append(members, mods+"get "+name+"()")
+			} else {
+				members = append(members, mods+"get "+name+"()"+returnType(md.ReturnType, false, false))
+			}
+		case javascript.MethodSetter:
+			if isPrivate {
+				members = append(members, mods+"set "+name+"(value)")
+			} else {
+				members = append(members, mods+"set "+name+parameters(&md.Params))
+			}
+		default:
+			if isPrivate {
+				members = append(members, mods+name)
+			} else if signatures := overloads(cen.Overloads); len(signatures) > 0 {
+				for _, sig := range signatures {
+					members = append(members, mods+name+sig)
+				}
+			} else {
+				members = append(members, mods+name+signature(cen.TypeParameters, &md.Params, md.ReturnType, md.Type == javascript.MethodAsync, (md.Type == javascript.MethodNormal || md.Type == javascript.MethodAsync) && !returnsValue(&md.FunctionBody)))
+			}
+		}
+	}
+
+	if private {
+		members = append([]string{"#private"}, members...)
+	}
+
+	for _, m := range members {
+		d.WriteString("\n\t")
+		d.WriteString(strings.ReplaceAll(m, "\n", "\n\t"))
+		d.WriteString(";")
+	}
+
+	d.line("\n}")
+}
+
+func overloads(signatures []javascript.CallSignature) []string {
+	sigs := make([]string, len(signatures))
+
+	for n, cs := range signatures {
+		sigs[n] = fmt.Sprintf("%s", cs)
+	}
+
+	return sigs
+}
+
+func isConstructor(cen *javascript.ClassElementName) bool {
+	if cen.PropertyName == nil || cen.PropertyName.LiteralPropertyName == nil {
+		return false
+	}
+
+	name := cen.PropertyName.LiteralPropertyName.Data
+
+	return name == "constructor" || name == `"constructor"` || name == "'constructor'"
+}
+
+func modifiers(comments ...javascript.Comments) (string, string, bool) {
+	var (
+		access, readonly string
+		found            bool
+	)
+
+	for _, c := range comments {
+		for _, tk := range c {
+			if !tk.IsTypescript() {
+				continue
+			}
+
+			switch tk.Data {
+			case "private", "protected":
+				access = tk.Data + " "
+			case "readonly":
+				readonly = "readonly "
+			case "public", "override":
+			default:
+				continue
+			}
+
+			found = true
+		}
+	}
+
+	return access, readonly, found
+}
+
+func signature(tp *javascript.TypeParameters, params *javascript.FormalParameters, rt *javascript.TypeAnnotation, async, void bool) string {
+	var typeParams string
+
+	if tp != nil {
+		typeParams = fmt.Sprintf("%s", tp)
+	}
+
+	return typeParams + parameters(params) + returnType(rt, async, void)
+}
+
+func parameters(params *javascript.FormalParameters) string {
+	var (
+		list     []string
+		required = -1
+	)
+
+	for n, be := range params.FormalParameterList {
+		if !be.Optional && be.Initializer == nil {
+			required = n
+		}
+	}
+
+	for n, be := range params.FormalParameterList {
+		name := fmt.Sprintf("__%d", n)
+
+		if be.SingleNameBinding != nil {
+			name = be.SingleNameBinding.Data
+		}
+
+		if be.Optional || be.Initializer != nil && n > required {
+			name += "?"
+		}
+
+		list = append(list, name+valueType(be.TypeAnnotation, be.Initializer, false))
+	}
+
+	if params.BindingIdentifier != nil || params.ArrayBindingPattern != nil || params.ObjectBindingPattern != nil {
+		name := fmt.Sprintf("__%d", len(list))
+
+		if params.BindingIdentifier != nil {
+			name = params.BindingIdentifier.Data
+		}
+
+		if params.TypeAnnotation != nil {
+			list = append(list, fmt.Sprintf("...%s%s", name, params.TypeAnnotation))
+		} else {
+			list = append(list, "..."+name+": any[]")
+		}
+	}
+
+	return "(" + strings.Join(list, ", ") + ")"
+}
+
+func functionType(tp *javascript.TypeParameters, params *javascript.FormalParameters, rt *javascript.TypeAnnotation, async, void bool) string {
+	var typeParams string
+
+	if tp != nil {
+		typeParams = fmt.Sprintf("%s", tp)
+	}
+
+	return typeParams + parameters(params) + " => " + strings.TrimPrefix(returnType(rt, async, void), ": ")
+}
+
+// returnType returns the annotated return type, or, when there is no
+// annotation, void for a function that returns no value, and any otherwise.
+func returnType(rt *javascript.TypeAnnotation, async, void bool) string {
+	if rt != nil {
+		return fmt.Sprintf("%s", rt)
+	} else if void && async {
+		return ": Promise<void>"
+	} else if void {
+		return ": void"
+	} else if async {
+		return ": Promise<any>"
+	}
+
+	return ": any"
+}
+
+func valueType(ta *javascript.TypeAnnotation, initializer *javascript.AssignmentExpression, constant bool) string {
+	if ta != nil {
+		return fmt.Sprintf("%s", ta)
+	}
+
+	literal, typ := literalType(initializer)
+	if constant && literal != "" {
+		return " = " + literal
+	}
+
+	return ": " + typ
+}
+
+func functionVoid(fd *javascript.FunctionDeclaration) bool {
+	return (fd.Type == javascript.FunctionNormal || fd.Type == javascript.FunctionAsync) && !returnsValue(&fd.FunctionBody)
+}
+
+// returnsValue returns true when the function body contains a return
+// statement with a value, not including those of nested functions.
+func returnsValue(body *javascript.Block) bool {
+	var found bool
+
+	var h walk.HandlerFunc
+
+	h = func(t javascript.Type) error {
+		switch t := t.(type) {
+		case *javascript.FunctionDeclaration, *javascript.ArrowFunction, *javascript.ClassDeclaration, *javascript.MethodDefinition:
+			return nil
+		case *javascript.Statement:
+			if t.Type == javascript.StatementReturn && t.ExpressionStatement != nil {
+				found = true
+
+				return nil
+			}
+		}
+
+		if found {
+			return nil
+		}
+
+		return walk.Walk(t, h)
+	}
+
+	walk.Walk(body, h)
+
+	return found
+}
+
+func literalType(ae *javascript.AssignmentExpression) (string, string) {
+	if ae == nil {
+		return "", "any"
+	} else if af := ae.ArrowFunction; af != nil {
+		params := af.FormalParameters
+		if params == nil {
+			params = &javascript.FormalParameters{FormalParameterList: []javascript.BindingElement{{SingleNameBinding: af.BindingIdentifier}}}
+		}
+
+		return "", functionType(af.TypeParameters, params, af.ReturnType, af.Async, af.FunctionBody != nil && !returnsValue(af.FunctionBody))
+	} else if ae.ConditionalExpression == nil {
+		return "", "any"
+	}
+
+	switch pe := javascript.UnwrapConditional(ae.ConditionalExpression).(type) {
+	case *javascript.FunctionDeclaration:
+		return "", functionType(pe.TypeParameters, &pe.FormalParameters, pe.ReturnType, pe.Type == javascript.FunctionAsync, functionVoid(pe))
+	case *javascript.PrimaryExpression:
+		if pe.Literal != nil {
+			switch pe.Literal.Type {
+			case javascript.TokenStringLiteral:
+				return pe.Literal.Data, "string"
+			case javascript.TokenBooleanLiteral:
+				return pe.Literal.Data, "boolean"
+			case javascript.TokenNumericLiteral:
+				if strings.HasSuffix(pe.Literal.Data, "n") {
+					return pe.Literal.Data, "bigint"
+				}
+
+				return pe.Literal.Data, "number"
+			}
+		} else if pe.TemplateLiteral != nil && pe.TemplateLiteral.NoSubstitutionTemplate != nil {
+			return "", "string"
+		}
+	case *javascript.TemplateLiteral:
+		if pe.NoSubstitutionTemplate != nil {
+			return "", "string"
+		}
+	}
+
+	return "", "any"
+}
+
+func identifier(ae *javascript.AssignmentExpression) string {
+	if ae == nil || ae.ConditionalExpression == nil {
+		return ""
+	}
+
+	if pe, ok := javascript.UnwrapConditional(ae.ConditionalExpression).(*javascript.PrimaryExpression); ok && pe.IdentifierReference != nil {
+		return pe.IdentifierReference.Data
+	}
+
+	return ""
+}
+
+func source(tks javascript.Tokens, typescriptOnly bool) string {
+	var sb strings.Builder
+
+	for _, tk := range tks {
+		if !typescriptOnly || tk.IsTypescript() {
+			sb.WriteString(tk.Data)
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,140 @@
+package dts
+
+import (
+	"strings"
+	"testing"
+
+	"vimagination.zapto.org/javascript"
+	"vimagination.zapto.org/parser"
+)
+
+func TestWrite(t *testing.T) {
+	for n, test := range [...]struct {
+		Input, Output string
+	}{
+		{ // 1
+			"import {a, type B} from \"a\";\nimport \"side\";\nimport type {C} from \"c\";",
+			"import {a, type B} from \"a\";\nimport type {C} from \"c\";\n",
+		},
+		{ // 2
+			"type T = string | number;\nexport interface I<X> {\n\ta: X;\n}",
+			"type T = string | number;\nexport interface I<X> {\n\ta: X;\n}\nexport {};\n",
+		},
+		{ // 3
+			"export function f<T>(a: T, b = 1, {c}: any, ...d: string[]): T {\n\treturn a;\n}\nexport async function g(a?: number) {}\nexport function* h() {}",
+			"export declare function f<T>(a: T, b: number, __2: any, ...d: string[]): T;\nexport declare function g(a?: number): Promise<void>;\nexport declare function h(): any;\n",
+		},
+		{ // 4
+			"export function f(a = 1, b: string, c = 2, d?: number, ...e) {}",
+			"export declare function f(a: number, b: string, c?: number, d?: number, ...e: any[]): void;\n",
+		},
+		{ // 5
+			"export const a = 1, b: string = \"\", c = d();\nexport let e = 1n, f = `f`;\nexport var g = true;",
+			"export declare const a = 1, b: string, c: any;\nexport declare let e: bigint, f: string;\nexport declare var g: boolean;\n",
+		},
+		{ // 6
+			"function a(): void {}\nconst b = 1, c = 2;\nlet d: string;\nexport {a, b as e};\nexport * as f from \"f\";\nexport {g} from \"g\";",
+			"declare function a(): void;\ndeclare const b = 1, c = 2;\nexport {a, b as e};\nexport * as f from \"f\";\nexport {g} from \"g\";\n",
+		},
+		{ // 7
+			"export default function (a: string): number {\n\treturn 1;\n}",
+			"export default function(a: string): number;\n",
+		},
+		{ // 8
+			"export default class {}",
+			"export default class {\n}\n",
+		},
+		{ // 9
+			"const a = 1;\nexport default a;",
+			"declare const a = 1;\nexport default a;\n",
+		},
+		{ // 10
+			"export default [1, 2];",
+			"declare const _default: any;\nexport default _default;\n",
+		},
+		{ // 11
+			"export default \"a\";",
+			"declare const _default: string;\nexport default _default;\n",
+		},
+		{ // 12
+			"export class A<T> extends B {\n\tprivate a: number;\n\tprotected readonly b = 1;\n\tstatic readonly c: string;\n\t#d = 1;\n\te?: {f: number};\n\tconstructor(public g: string, private h, readonly i = 2, j: number) {\n\t\tsuper();\n\t}\n\tget k(): number {\n\t\treturn 1;\n\t}\n\tset k(v: number) {}\n\tasync l<U>(u: U) {}\n\tprivate m() {}\n\tstatic *n(): Generator<number> {}\n\tstatic {}\n}",
+			"export declare class A<T> extends B {\n\t#private;\n\tprivate a;\n\tprotected readonly b = 1;\n\tstatic readonly c: string;\n\te?: {\n\t\tf: number;\n\t};\n\tg: string;\n\tprivate h;\n\treadonly i: number;\n\tconstructor(g: string, h: any, i: number, j: number);\n\tget k(): number;\n\tset k(v: number);\n\tl<U>(u: U): Promise<void>;\n\tprivate m;\n\tstatic n(): Generator<number>;\n}\n",
+		},
+		{ // 13
+			"class A {\n\tconstructor(public a: number) {}\n}\nclass B {}\nexport {A};",
+			"declare class A {\n\ta: number;\n\tconstructor(a: number);\n}\nexport {A};\n",
+		},
+		{ // 14
+			"declare const a: number;\ndeclare function b(): void;\nexport type {C} from \"c\";",
+			"declare const a: number;\ndeclare function b(): void;\nexport type {C} from \"c\";\n",
+		},
+		{ // 15
+			"export enum A {\n\tB\n}\nenum C {\n\tD\n}\nexport namespace E {\n\texport const f = 1;\n}",
+			"export declare enum A {\n\tB = 0\n}\nexport declare namespace E {\n\texport const f = 1;\n}\n",
+		},
+		{ // 16
+			"export * from \"./u\";\nexport * as v from \"./v\";",
+			"export * from \"./u\";\nexport * as v from \"./v\";\n",
+		},
+		{ // 17
+			"export function f(a: string): string;\nexport function f(a: number): number;\nexport function f(a) {\n\treturn a;\n}\nfunction g(): void\nfunction g(a?) {}\nexport {g};",
+			"export declare function f(a: string): string;\nexport declare function f(a: number): number;\ndeclare function g(): void;\nexport {g};\n",
+		},
+		{ // 18
+			"export enum A {\n\tB,\n\tC = 3,\n\tD,\n\tE = \"e\",\n\t\"f-g\" = -1,\n\tH = h(),\n\tI = C << 1\n}\nconst enum J {\n\tK\n}\nexport {J};",
+			"export declare enum A {\n\tB = 0,\n\tC = 3,\n\tD = 4,\n\tE = \"e\",\n\t\"f-g\" = -1,\n\tH,\n\tI = 6\n}\ndeclare const enum J {\n\tK = 0\n}\nexport {J};\n",
+		},
+		{ // 19
+			"export const a = (b: number): string => \"\", c = async d => d, e = function <T>(f: T): T {\n\treturn f;\n};",
+			"export declare const a: (b: number) => string, c: (d: any) => Promise<any>, e: <T>(f: T) => T;\n",
+		},
+		{ // 20
+			"export abstract class A {\n\tabstract a: number;\n\tprotected abstract b(c: string): void;\n\td() {}\n\tstatic e(f: string): void;\n\tstatic e(f: number): void;\n\tstatic e(f) {}\n\tabstract g(): void\n}",
+			"export declare abstract class A {\n\tabstract a: number;\n\tprotected abstract b(c: string): void;\n\td(): void;\n\tstatic e(f: string): void;\n\tstatic e(f: number): void;\n\tabstract g(): void;\n}\n",
+		},
+		{ // 21
+			"export class A<T> extends B<T, string> implements C, D<T> {\n\t[k: string]: any;\n\treadonly [l: number]: T;\n\ta = 1;\n}",
+			"export declare class A<T> extends B<T, string> implements C, D<T> {\n\t[k: string]: any;\n\treadonly [l: number]: T;\n\ta: number;\n}\n",
+		},
+		{ // 22
+			"export namespace A.B {\n\texport const c = 1;\n\tconst d = 2;\n\texport function e(f: string): void {}\n\texport class G {}\n\texport enum H {\n\t\tI\n\t}\n\texport namespace J {\n\t\texport let k: number;\n\t}\n\texport type L = string;\n}\nnamespace M {\n\texport interface N {}\n}",
+			"export declare namespace A.B {\n\texport const c = 1;\n\texport function e(f: string): void;\n\texport class G {\n\t}\n\texport enum H {\n\t\tI = 0\n\t}\n\texport namespace J {\n\t\texport let k: number;\n\t}\n\texport type L = string;\n}\ndeclare namespace M {\n\texport interface N {}\n}\nexport {};\n",
+		},
+		{ // 23
+			"import {a} from \"a\"\nexport * from \"b\"\nexport * as c from \"c\"\nexport {d} from \"d\"\nexport {a}",
+			"import {a} from \"a\";\nexport * from \"b\";\nexport * as c from \"c\";\nexport {d} from \"d\";\nexport {a};\n",
+		},
+		{ // 24
+			"export class A {\n\tconstructor(a: string);\n\tconstructor(a: number);\n\tconstructor(a) {}\n\t#b(c: string): void;\n\t#b(c) {}\n}\nexport default function <T>(a: T): T;\nexport default function (a) {\n\treturn a;\n}",
+			"export declare class A {\n\t#private;\n\tconstructor(a: string);\n\tconstructor(a: number);\n}\nexport default function<T>(a: T): T;\n",
+		},
+		{ // 25
+			"export const {a, b: [c, ...d], ...e} = f;\nexport let [g, , {h = 1}] = i;\nconst {j}: {j: string} = k;\nexport {j};",
+			"export declare const a: any, c: any, d: any, e: any;\nexport declare let g: any, h: any;\ndeclare const j: any;\nexport {j};\n",
+		},
+		{ // 26
+			"class Impl extends Base<Opts> {\n\tx(): Other {\n\t\treturn new Other();\n\t}\n}\nclass Base<T> {}\ninterface Opts {}\nclass Other {}\nclass Unused {}\nconst v = 1;\nexport function make(): Impl {\n\tconst u: Unused = new Unused();\n\treturn new Impl();\n}\nexport let w: typeof v;",
+			"declare class Impl extends Base<Opts> {\n\tx(): Other;\n}\ndeclare class Base<T> {\n}\ninterface Opts {}\ndeclare class Other {\n}\ndeclare const v = 1;\nexport declare function make(): Impl;\nexport declare let w: typeof v;\nexport {};\n",
+		},
+		{ // 27
+			"export function a() {\n\tif (b) {\n\t\treturn;\n\t}\n\tconst c = () => 1;\n\tfunction d() {\n\t\treturn 2;\n\t}\n}\nexport function e() {\n\tfor (;;) {\n\t\treturn 1;\n\t}\n}\nexport const f = () => {}, g = async function () {};",
+			"export declare function a(): void;\nexport declare function e(): any;\nexport declare const f: () => void, g: () => Promise<void>;\n",
+		},
+		{ // 28
+			"export enum A {B}\nexport enum A {C = 2}\nexport class D {}\nexport namespace D {\n\texport const e = 1;\n}\nnamespace F {\n\texport const g = 1;\n}\nnamespace F {\n\texport const h = 2;\n}",
+			"export declare enum A {\n\tB = 0\n}\nexport declare enum A {\n\tC = 2\n}\nexport declare class D {\n}\nexport declare namespace D {\n\texport const e = 1;\n}\n",
+		},
+	} {
+		tk := parser.NewStringTokeniser(test.Input)
+
+		var sb strings.Builder
+
+		if m, err := javascript.ParseModule(javascript.AsTypescriptAST(&tk)); err != nil {
+			t.Errorf("test %d: unexpected error: %s", n+1, err)
+		} else if err = Write(&sb, m); err != nil {
+			t.Errorf("test %d: unexpected error: %s", n+1, err)
+		} else if str := sb.String(); str != test.Output {
+			t.Errorf("test %d: expecting output %q, got %q", n+1, test.Output, str)
+		}
+	}
+}
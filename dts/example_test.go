@@ -0,0 +1,52 @@
+package dts_test
+
+import (
+	"fmt"
+	"os"
+
+	"vimagination.zapto.org/javascript"
+	"vimagination.zapto.org/javascript/dts"
+	"vimagination.zapto.org/parser"
+)
+
+func Example() {
+	src := `export interface Named {
+	name: string;
+}
+
+export class Greeter implements Named {
+	constructor(public name: string) {}
+
+	greet(greeting = "Hello"): string {
+		return greeting + ", " + this.name;
+	}
+}
+
+export function greet(name: string) {
+	return new Greeter(name).greet();
+}`
+
+	tk := parser.NewStringTokeniser(src)
+
+	m, err := javascript.ParseModule(javascript.AsTypescriptAST(&tk))
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	if err := dts.Write(os.Stdout, m); err != nil {
+		fmt.Println(err)
+	}
+
+	// Output:
+	// export interface Named {
+	// 	name: string;
+	// }
+	// export declare class Greeter implements Named {
+	// 	name: string;
+	// 	constructor(name: string);
+	// 	greet(greeting?: string): string;
+	// }
+	// export declare function greet(name: string): any;
+}
@@ -0,0 +1,1770 @@
+package javascript
+
+// File automatically generated with format.sh.
+
+// Equal returns true if the two given JavaScript types are structurally
+// equal.
+//
+// The positions of tokens, the Tokens fields, and comments are ignored, so two
+// types that differ only in formatting are considered equal. String literals
+// are compared by their unquoted values, and numeric literals by the numbers
+// they represent. A pointer to a type is considered equal to the type itself.
+func Equal(a, b Type) bool {
+	switch a := a.(type) {
+	case Token:
+		return equalType(&a, b)
+	case *Token:
+		return equalType(a, b)
+	case Tokens:
+		bt, ok := b.(Tokens)
+
+		return ok && a.equal(bt)
+	case AdditiveExpression:
+		return equalType(&a, b)
+	case *AdditiveExpression:
+		return equalType(a, b)
+	case Argument:
+		return equalType(&a, b)
+	case *Argument:
+		return equalType(a, b)
+	case Arguments:
+		return equalType(&a, b)
+	case *Arguments:
+		return equalType(a, b)
+	case ArrayAssignmentPattern:
+		return equalType(&a, b)
+	case *ArrayAssignmentPattern:
+		return equalType(a, b)
+	case ArrayBindingPattern:
+		return equalType(&a, b)
+	case *ArrayBindingPattern:
+		return equalType(a, b)
+	case ArrayElement:
+		return equalType(&a, b)
+	case *ArrayElement:
+		return equalType(a, b)
+	case ArrayLiteral:
+		return equalType(&a, b)
+	case *ArrayLiteral:
+		return equalType(a, b)
+	case ArrowFunction:
+		return equalType(&a, b)
+	case *ArrowFunction:
+		return equalType(a, b)
+	case AssignmentElement:
+		return equalType(&a, b)
+	case *AssignmentElement:
+		return equalType(a, b)
+	case AssignmentExpression:
+		return equalType(&a, b)
+	case *AssignmentExpression:
+		return equalType(a, b)
+	case AssignmentPattern:
+		return equalType(&a, b)
+	case *AssignmentPattern:
+		return equalType(a, b)
+	case AssignmentProperty:
+		return equalType(&a, b)
+	case *AssignmentProperty:
+		return equalType(a, b)
+	case BindingElement:
+		return equalType(&a, b)
+	case *BindingElement:
+		return equalType(a, b)
+	case BindingProperty:
+		return equalType(&a, b)
+	case *BindingProperty:
+		return equalType(a, b)
+	case BitwiseANDExpression:
+		return equalType(&a, b)
+	case *BitwiseANDExpression:
+		return equalType(a, b)
+	case BitwiseORExpression:
+		return equalType(&a, b)
+	case *BitwiseORExpression:
+		return equalType(a, b)
+	case BitwiseXORExpression:
+		return equalType(&a, b)
+	case *BitwiseXORExpression:
+		return equalType(a, b)
+	case Block:
+		return equalType(&a, b)
+	case *Block:
+		return equalType(a, b)
+	case CallExpression:
+		return equalType(&a, b)
+	case *CallExpression:
+		return equalType(a, b)
+	case CallSignature:
+		return equalType(&a, b)
+	case *CallSignature:
+		return equalType(a, b)
+	case CaseClause:
+		return equalType(&a, b)
+	case *CaseClause:
+		return equalType(a, b)
+	case ClassDeclaration:
+		return equalType(&a, b)
+	case *ClassDeclaration:
+		return equalType(a, b)
+	case ClassElement:
+		return equalType(&a, b)
+	case *ClassElement:
+		return equalType(a, b)
+	case ClassElementName:
+		return equalType(&a, b)
+	case *ClassElementName:
+		return equalType(a, b)
+	case CoalesceExpression:
+		return equalType(&a, b)
+	case *CoalesceExpression:
+		return equalType(a, b)
+	case CommentsToken:
+		return equalType(&a, b)
+	case *CommentsToken:
+		return equalType(a, b)
+	case ConditionalExpression:
+		return equalType(&a, b)
+	case *ConditionalExpression:
+		return equalType(a, b)
+	case Declaration:
+		return equalType(&a, b)
+	case *Declaration:
+		return equalType(a, b)
+	case Decorator:
+		return equalType(&a, b)
+	case *Decorator:
+		return equalType(a, b)
+	case DecoratorMemberExpression:
+		return equalType(&a, b)
+	case *DecoratorMemberExpression:
+		return equalType(a, b)
+	case DestructuringAssignmentTarget:
+		return equalType(&a, b)
+	case *DestructuringAssignmentTarget:
+		return equalType(a, b)
+	case EnumDeclaration:
+		return equalType(&a, b)
+	case *EnumDeclaration:
+		return equalType(a, b)
+	case EnumMember:
+		return equalType(&a, b)
+	case *EnumMember:
+		return equalType(a, b)
+	case EqualityExpression:
+		return equalType(&a, b)
+	case *EqualityExpression:
+		return equalType(a, b)
+	case ExponentiationExpression:
+		return equalType(&a, b)
+	case *ExponentiationExpression:
+		return equalType(a, b)
+	case ExportClause:
+		return equalType(&a, b)
+	case *ExportClause:
+		return equalType(a, b)
+	case ExportDeclaration:
+		return equalType(&a, b)
+	case *ExportDeclaration:
+		return equalType(a, b)
+	case ExportSpecifier:
+		return equalType(&a, b)
+	case *ExportSpecifier:
+		return equalType(a, b)
+	case Expression:
+		return equalType(&a, b)
+	case *Expression:
+		return equalType(a, b)
+	case FieldDefinition:
+		return equalType(&a, b)
+	case *FieldDefinition:
+		return equalType(a, b)
+	case FormalParameters:
+		return equalType(&a, b)
+	case *FormalParameters:
+		return equalType(a, b)
+	case FromClause:
+		return equalType(&a, b)
+	case *FromClause:
+		return equalType(a, b)
+	case FunctionDeclaration:
+		return equalType(&a, b)
+	case *FunctionDeclaration:
+		return equalType(a, b)
+	case FunctionTypeExpression:
+		return equalType(&a, b)
+	case *FunctionTypeExpression:
+		return equalType(a, b)
+	case IfStatement:
+		return equalType(&a, b)
+	case *IfStatement:
+		return equalType(a, b)
+	case ImportClause:
+		return equalType(&a, b)
+	case *ImportClause:
+		return equalType(a, b)
+	case ImportDeclaration:
+		return equalType(&a, b)
+	case *ImportDeclaration:
+		return equalType(a, b)
+	case ImportSpecifier:
+		return equalType(&a, b)
+	case *ImportSpecifier:
+		return equalType(a, b)
+	case ImportType:
+		return equalType(&a, b)
+	case *ImportType:
+		return equalType(a, b)
+	case IndexSignature:
+		return equalType(&a, b)
+	case *IndexSignature:
+		return equalType(a, b)
+	case InterfaceDeclaration:
+		return equalType(&a, b)
+	case *InterfaceDeclaration:
+		return equalType(a, b)
+	case IntersectionType:
+		return equalType(&a, b)
+	case *IntersectionType:
+		return equalType(a, b)
+	case IterationStatementDo:
+		return equalType(&a, b)
+	case *IterationStatementDo:
+		return equalType(a, b)
+	case IterationStatementFor:
+		return equalType(&a, b)
+	case *IterationStatementFor:
+		return equalType(a, b)
+	case IterationStatementWhile:
+		return equalType(&a, b)
+	case *IterationStatementWhile:
+		return equalType(a, b)
+	case JSXAttribute:
+		return equalType(&a, b)
+	case *JSXAttribute:
+		return equalType(a, b)
+	case JSXChild:
+		return equalType(&a, b)
+	case *JSXChild:
+		return equalType(a, b)
+	case JSXElement:
+		return equalType(&a, b)
+	case *JSXElement:
+		return equalType(a, b)
+	case JSXElementName:
+		return equalType(&a, b)
+	case *JSXElementName:
+		return equalType(a, b)
+	case JSXFragment:
+		return equalType(&a, b)
+	case *JSXFragment:
+		return equalType(a, b)
+	case LeftHandSideExpression:
+		return equalType(&a, b)
+	case *LeftHandSideExpression:
+		return equalType(a, b)
+	case LexicalBinding:
+		return equalType(&a, b)
+	case *LexicalBinding:
+		return equalType(a, b)
+	case LexicalDeclaration:
+		return equalType(&a, b)
+	case *LexicalDeclaration:
+		return equalType(a, b)
+	case LiteralType:
+		return equalType(&a, b)
+	case *LiteralType:
+		return equalType(a, b)
+	case LogicalANDExpression:
+		return equalType(&a, b)
+	case *LogicalANDExpression:
+		return equalType(a, b)
+	case LogicalORExpression:
+		return equalType(&a, b)
+	case *LogicalORExpression:
+		return equalType(a, b)
+	case MappedType:
+		return equalType(&a, b)
+	case *MappedType:
+		return equalType(a, b)
+	case MemberExpression:
+		return equalType(&a, b)
+	case *MemberExpression:
+		return equalType(a, b)
+	case MethodDefinition:
+		return equalType(&a, b)
+	case *MethodDefinition:
+		return equalType(a, b)
+	case MethodSignature:
+		return equalType(&a, b)
+	case *MethodSignature:
+		return equalType(a, b)
+	case Module:
+		return equalType(&a, b)
+	case *Module:
+		return equalType(a, b)
+	case ModuleItem:
+		return equalType(&a, b)
+	case *ModuleItem:
+		return equalType(a, b)
+	case MultiplicativeExpression:
+		return equalType(&a, b)
+	case *MultiplicativeExpression:
+		return equalType(a, b)
+	case NamedImports:
+		return equalType(&a, b)
+	case *NamedImports:
+		return equalType(a, b)
+	case NamespaceDeclaration:
+		return equalType(&a, b)
+	case *NamespaceDeclaration:
+		return equalType(a, b)
+	case NewExpression:
+		return equalType(&a, b)
+	case *NewExpression:
+		return equalType(a, b)
+	case ObjectAssignmentPattern:
+		return equalType(&a, b)
+	case *ObjectAssignmentPattern:
+		return equalType(a, b)
+	case ObjectBindingPattern:
+		return equalType(&a, b)
+	case *ObjectBindingPattern:
+		return equalType(a, b)
+	case ObjectLiteral:
+		return equalType(&a, b)
+	case *ObjectLiteral:
+		return equalType(a, b)
+	case ObjectType:
+		return equalType(&a, b)
+	case *ObjectType:
+		return equalType(a, b)
+	case OptionalChain:
+		return equalType(&a, b)
+	case *OptionalChain:
+		return equalType(a, b)
+	case OptionalExpression:
+		return equalType(&a, b)
+	case *OptionalExpression:
+		return equalType(a, b)
+	case Parameter:
+		return equalType(&a, b)
+	case *Parameter:
+		return equalType(a, b)
+	case ParameterList:
+		return equalType(&a, b)
+	case *ParameterList:
+		return equalType(a, b)
+	case ParenthesizedExpression:
+		return equalType(&a, b)
+	case *ParenthesizedExpression:
+		return equalType(a, b)
+	case PostfixType:
+		return equalType(&a, b)
+	case *PostfixType:
+		return equalType(a, b)
+	case PrimaryExpression:
+		return equalType(&a, b)
+	case *PrimaryExpression:
+		return equalType(a, b)
+	case PrimaryType:
+		return equalType(&a, b)
+	case *PrimaryType:
+		return equalType(a, b)
+	case PropertyDefinition:
+		return equalType(&a, b)
+	case *PropertyDefinition:
+		return equalType(a, b)
+	case PropertyName:
+		return equalType(&a, b)
+	case *PropertyName:
+		return equalType(a, b)
+	case PropertySignature:
+		return equalType(&a, b)
+	case *PropertySignature:
+		return equalType(a, b)
+	case RelationalExpression:
+		return equalType(&a, b)
+	case *RelationalExpression:
+		return equalType(a, b)
+	case Script:
+		return equalType(&a, b)
+	case *Script:
+		return equalType(a, b)
+	case ShiftExpression:
+		return equalType(&a, b)
+	case *ShiftExpression:
+		return equalType(a, b)
+	case Statement:
+		return equalType(&a, b)
+	case *Statement:
+		return equalType(a, b)
+	case StatementListItem:
+		return equalType(&a, b)
+	case *StatementListItem:
+		return equalType(a, b)
+	case SwitchStatement:
+		return equalType(&a, b)
+	case *SwitchStatement:
+		return equalType(a, b)
+	case TemplateLiteral:
+		return equalType(&a, b)
+	case *TemplateLiteral:
+		return equalType(a, b)
+	case TemplateLiteralType:
+		return equalType(&a, b)
+	case *TemplateLiteralType:
+		return equalType(a, b)
+	case TryStatement:
+		return equalType(&a, b)
+	case *TryStatement:
+		return equalType(a, b)
+	case TupleElement:
+		return equalType(&a, b)
+	case *TupleElement:
+		return equalType(a, b)
+	case TupleType:
+		return equalType(&a, b)
+	case *TupleType:
+		return equalType(a, b)
+	case TypeAliasDeclaration:
+		return equalType(&a, b)
+	case *TypeAliasDeclaration:
+		return equalType(a, b)
+	case TypeAnnotation:
+		return equalType(&a, b)
+	case *TypeAnnotation:
+		return equalType(a, b)
+	case TypeArguments:
+		return equalType(&a, b)
+	case *TypeArguments:
+		return equalType(a, b)
+	case TypeExpression:
+		return equalType(&a, b)
+	case *TypeExpression:
+		return equalType(a, b)
+	case TypeMember:
+		return equalType(&a, b)
+	case *TypeMember:
+		return equalType(a, b)
+	case TypeOperator:
+		return equalType(&a, b)
+	case *TypeOperator:
+		return equalType(a, b)
+	case TypeParameter:
+		return equalType(&a, b)
+	case *TypeParameter:
+		return equalType(a, b)
+	case TypeParameters:
+		return equalType(&a, b)
+	case *TypeParameters:
+		return equalType(a, b)
+	case TypeQuery:
+		return equalType(&a, b)
+	case *TypeQuery:
+		return equalType(a, b)
+	case TypeReference:
+		return equalType(&a, b)
+	case *TypeReference:
+		return equalType(a, b)
+	case UnaryExpression:
+		return equalType(&a, b)
+	case *UnaryExpression:
+		return equalType(a, b)
+	case UnaryOperatorComments:
+		return equalType(&a, b)
+	case *UnaryOperatorComments:
+		return equalType(a, b)
+	case UnionType:
+		return equalType(&a, b)
+	case *UnionType:
+		return equalType(a, b)
+	case UpdateExpression:
+		return equalType(&a, b)
+	case *UpdateExpression:
+		return equalType(a, b)
+	case VariableStatement:
+		return equalType(&a, b)
+	case *VariableStatement:
+		return equalType(a, b)
+	case WithClause:
+		return equalType(&a, b)
+	case *WithClause:
+		return equalType(a, b)
+	case WithEntry:
+		return equalType(&a, b)
+	case *WithEntry:
+		return equalType(a, b)
+	case WithStatement:
+		return equalType(&a, b)
+	case *WithStatement:
+		return equalType(a, b)
+	}
+
+	return false
+}
+
+func (f *AdditiveExpression) equal(g *AdditiveExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.AdditiveExpression.equal(g.AdditiveExpression) &&
+		f.AdditiveOperator == g.AdditiveOperator &&
+		f.MultiplicativeExpression.equal(&g.MultiplicativeExpression)
+}
+
+func (f *Argument) equal(g *Argument) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.Spread == g.Spread &&
+		f.AssignmentExpression.equal(&g.AssignmentExpression)
+}
+
+func (f *Arguments) equal(g *Arguments) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.ArgumentList, g.ArgumentList)
+}
+
+func (f *ArrayAssignmentPattern) equal(g *ArrayAssignmentPattern) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.AssignmentElements, g.AssignmentElements) &&
+		f.AssignmentRestElement.equal(g.AssignmentRestElement)
+}
+
+func (f *ArrayBindingPattern) equal(g *ArrayBindingPattern) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.BindingElementList, g.BindingElementList) &&
+		f.BindingRestElement.equal(g.BindingRestElement)
+}
+
+func (f *ArrayElement) equal(g *ArrayElement) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.Spread == g.Spread &&
+		f.AssignmentExpression.equal(&g.AssignmentExpression)
+}
+
+func (f *ArrayLiteral) equal(g *ArrayLiteral) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.ElementList, g.ElementList)
+}
+
+func (f *ArrowFunction) equal(g *ArrowFunction) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.DirectivePrologue.equal(&g.DirectivePrologue) &&
+		f.Async == g.Async &&
+		f.TypeParameters.equal(g.TypeParameters) &&
+		f.BindingIdentifier.equal(g.BindingIdentifier) &&
+		f.FormalParameters.equal(g.FormalParameters) &&
+		f.ReturnType.equal(g.ReturnType) &&
+		f.AssignmentExpression.equal(g.AssignmentExpression) &&
+		f.FunctionBody.equal(g.FunctionBody)
+}
+
+func (f *AssignmentElement) equal(g *AssignmentElement) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.DestructuringAssignmentTarget.equal(&g.DestructuringAssignmentTarget) &&
+		f.Initializer.equal(g.Initializer)
+}
+
+func (f *AssignmentExpression) equal(g *AssignmentExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.ConditionalExpression.equal(g.ConditionalExpression) &&
+		f.ArrowFunction.equal(g.ArrowFunction) &&
+		f.LeftHandSideExpression.equal(g.LeftHandSideExpression) &&
+		f.AssignmentPattern.equal(g.AssignmentPattern) &&
+		f.Yield == g.Yield &&
+		f.Delegate == g.Delegate &&
+		f.AssignmentOperator == g.AssignmentOperator &&
+		f.AssignmentExpression.equal(g.AssignmentExpression)
+}
+
+func (f *AssignmentPattern) equal(g *AssignmentPattern) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.ObjectAssignmentPattern.equal(g.ObjectAssignmentPattern) &&
+		f.ArrayAssignmentPattern.equal(g.ArrayAssignmentPattern)
+}
+
+func (f *AssignmentProperty) equal(g *AssignmentProperty) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.PropertyName.equal(&g.PropertyName) &&
+		f.DestructuringAssignmentTarget.equal(g.DestructuringAssignmentTarget) &&
+		f.Initializer.equal(g.Initializer)
+}
+
+func (f *BindingElement) equal(g *BindingElement) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.Decorators, g.Decorators) &&
+		f.SingleNameBinding.equal(g.SingleNameBinding) &&
+		f.ArrayBindingPattern.equal(g.ArrayBindingPattern) &&
+		f.ObjectBindingPattern.equal(g.ObjectBindingPattern) &&
+		f.Optional == g.Optional &&
+		f.TypeAnnotation.equal(g.TypeAnnotation) &&
+		f.Initializer.equal(g.Initializer)
+}
+
+func (f *BindingProperty) equal(g *BindingProperty) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.PropertyName.equal(&g.PropertyName) &&
+		f.BindingElement.equal(&g.BindingElement)
+}
+
+func (f *BitwiseANDExpression) equal(g *BitwiseANDExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.BitwiseANDExpression.equal(g.BitwiseANDExpression) &&
+		f.EqualityExpression.equal(&g.EqualityExpression)
+}
+
+func (f *BitwiseORExpression) equal(g *BitwiseORExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.BitwiseORExpression.equal(g.BitwiseORExpression) &&
+		f.BitwiseXORExpression.equal(&g.BitwiseXORExpression)
+}
+
+func (f *BitwiseXORExpression) equal(g *BitwiseXORExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.BitwiseXORExpression.equal(g.BitwiseXORExpression) &&
+		f.BitwiseANDExpression.equal(&g.BitwiseANDExpression)
+}
+
+func (f *Block) equal(g *Block) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.StatementList, g.StatementList)
+}
+
+func (f *CallExpression) equal(g *CallExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.MemberExpression.equal(g.MemberExpression) &&
+		f.SuperCall == g.SuperCall &&
+		f.ImportCall.equal(g.ImportCall) &&
+		f.CallExpression.equal(g.CallExpression) &&
+		f.Arguments.equal(g.Arguments) &&
+		f.Expression.equal(g.Expression) &&
+		f.IdentifierName.equal(g.IdentifierName) &&
+		f.TemplateLiteral.equal(g.TemplateLiteral) &&
+		f.PrivateIdentifier.equal(g.PrivateIdentifier)
+}
+
+func (f *CallSignature) equal(g *CallSignature) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.TypeParameters.equal(g.TypeParameters) &&
+		f.ParameterList.equal(&g.ParameterList) &&
+		f.ReturnType.equal(g.ReturnType)
+}
+
+func (f *CaseClause) equal(g *CaseClause) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.Expression.equal(&g.Expression) &&
+		equalSlice(f.StatementList, g.StatementList)
+}
+
+func (f *ClassDeclaration) equal(g *ClassDeclaration) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.Decorators, g.Decorators) &&
+		f.Abstract == g.Abstract &&
+		f.BindingIdentifier.equal(g.BindingIdentifier) &&
+		f.TypeParameters.equal(g.TypeParameters) &&
+		f.ClassHeritage.equal(g.ClassHeritage) &&
+		f.TypeArguments.equal(g.TypeArguments) &&
+		equalSlice(f.Implements, g.Implements) &&
+		equalSlice(f.ClassBody, g.ClassBody)
+}
+
+func (f *ClassElement) equal(g *ClassElement) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.Decorators, g.Decorators) &&
+		f.Static == g.Static &&
+		f.Accessor == g.Accessor &&
+		f.MethodDefinition.equal(g.MethodDefinition) &&
+		f.FieldDefinition.equal(g.FieldDefinition) &&
+		f.ClassStaticBlock.equal(g.ClassStaticBlock) &&
+		f.TypeMember.equal(g.TypeMember)
+}
+
+func (f *ClassElementName) equal(g *ClassElementName) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.PropertyName.equal(g.PropertyName) &&
+		f.PrivateIdentifier.equal(g.PrivateIdentifier) &&
+		equalSlice(f.Overloads, g.Overloads) &&
+		f.TypeParameters.equal(g.TypeParameters)
+}
+
+func (f *CoalesceExpression) equal(g *CoalesceExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.CoalesceExpressionHead.equal(g.CoalesceExpressionHead) &&
+		f.BitwiseORExpression.equal(&g.BitwiseORExpression)
+}
+
+func (f *CommentsToken) equal(g *CommentsToken) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.Token.equal(g.Token)
+}
+
+func (f *ConditionalExpression) equal(g *ConditionalExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.LogicalORExpression.equal(g.LogicalORExpression) &&
+		f.CoalesceExpression.equal(g.CoalesceExpression) &&
+		f.True.equal(g.True) &&
+		f.False.equal(g.False)
+}
+
+func (f *Declaration) equal(g *Declaration) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.ClassDeclaration.equal(g.ClassDeclaration) &&
+		f.FunctionDeclaration.equal(g.FunctionDeclaration) &&
+		f.LexicalDeclaration.equal(g.LexicalDeclaration) &&
+		f.TypeAliasDeclaration.equal(g.TypeAliasDeclaration) &&
+		f.InterfaceDeclaration.equal(g.InterfaceDeclaration) &&
+		f.NamespaceDeclaration.equal(g.NamespaceDeclaration)
+}
+
+func (f *Decorator) equal(g *Decorator) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.DecoratorMemberExpression.equal(g.DecoratorMemberExpression) &&
+		f.DecoratorParenthesizedExpression.equal(g.DecoratorParenthesizedExpression) &&
+		f.Arguments.equal(g.Arguments)
+}
+
+func (f *DecoratorMemberExpression) equal(g *DecoratorMemberExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.DecoratorMemberExpression.equal(g.DecoratorMemberExpression) &&
+		f.IdentifierReference.equal(g.IdentifierReference) &&
+		f.IdentifierName.equal(g.IdentifierName) &&
+		f.PrivateIdentifier.equal(g.PrivateIdentifier)
+}
+
+func (f *DestructuringAssignmentTarget) equal(g *DestructuringAssignmentTarget) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.LeftHandSideExpression.equal(g.LeftHandSideExpression) &&
+		f.AssignmentPattern.equal(g.AssignmentPattern)
+}
+
+func (f *EnumDeclaration) equal(g *EnumDeclaration) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.Const == g.Const &&
+		f.BindingIdentifier.equal(g.BindingIdentifier) &&
+		equalSlice(f.EnumMembers, g.EnumMembers)
+}
+
+func (f *EnumMember) equal(g *EnumMember) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.Name.equal(g.Name) &&
+		f.Initializer.equal(g.Initializer) &&
+		f.Value.equal(g.Value)
+}
+
+func (f *EqualityExpression) equal(g *EqualityExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.EqualityExpression.equal(g.EqualityExpression) &&
+		f.EqualityOperator == g.EqualityOperator &&
+		f.RelationalExpression.equal(&g.RelationalExpression)
+}
+
+func (f *ExponentiationExpression) equal(g *ExponentiationExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.ExponentiationExpression.equal(g.ExponentiationExpression) &&
+		f.UnaryExpression.equal(&g.UnaryExpression)
+}
+
+func (f *ExportClause) equal(g *ExportClause) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.ExportList, g.ExportList)
+}
+
+func (f *ExportDeclaration) equal(g *ExportDeclaration) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.Decorators, g.Decorators) &&
+		f.ExportClause.equal(g.ExportClause) &&
+		f.ExportFromClause.equal(g.ExportFromClause) &&
+		f.FromClause.equal(g.FromClause) &&
+		f.VariableStatement.equal(g.VariableStatement) &&
+		f.Declaration.equal(g.Declaration) &&
+		f.DefaultFunction.equal(g.DefaultFunction) &&
+		f.DefaultClass.equal(g.DefaultClass) &&
+		f.DefaultAssignmentExpression.equal(g.DefaultAssignmentExpression)
+}
+
+func (f *ExportSpecifier) equal(g *ExportSpecifier) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.IdentifierName.equal(g.IdentifierName) &&
+		f.EIdentifierName.equal(g.EIdentifierName)
+}
+
+func (f *Expression) equal(g *Expression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.Expressions, g.Expressions)
+}
+
+func (f *FieldDefinition) equal(g *FieldDefinition) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.ClassElementName.equal(&g.ClassElementName) &&
+		f.Optional == g.Optional &&
+		f.TypeAnnotation.equal(g.TypeAnnotation) &&
+		f.Initializer.equal(g.Initializer)
+}
+
+func (f *FormalParameters) equal(g *FormalParameters) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.FormalParameterList, g.FormalParameterList) &&
+		f.BindingIdentifier.equal(g.BindingIdentifier) &&
+		f.ArrayBindingPattern.equal(g.ArrayBindingPattern) &&
+		f.ObjectBindingPattern.equal(g.ObjectBindingPattern) &&
+		f.TypeAnnotation.equal(g.TypeAnnotation)
+}
+
+func (f *FromClause) equal(g *FromClause) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.ModuleSpecifier.equal(g.ModuleSpecifier)
+}
+
+func (f *FunctionDeclaration) equal(g *FunctionDeclaration) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.DirectivePrologue.equal(&g.DirectivePrologue) &&
+		f.Type == g.Type &&
+		f.BindingIdentifier.equal(g.BindingIdentifier) &&
+		equalSlice(f.Overloads, g.Overloads) &&
+		f.TypeParameters.equal(g.TypeParameters) &&
+		f.FormalParameters.equal(&g.FormalParameters) &&
+		f.ReturnType.equal(g.ReturnType) &&
+		f.FunctionBody.equal(&g.FunctionBody)
+}
+
+func (f *FunctionTypeExpression) equal(g *FunctionTypeExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.New == g.New &&
+		f.TypeParameters.equal(g.TypeParameters) &&
+		f.ParameterList.equal(&g.ParameterList) &&
+		f.TypePredicate.equal(g.TypePredicate) &&
+		f.ReturnType.equal(&g.ReturnType)
+}
+
+func (f *IfStatement) equal(g *IfStatement) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.Expression.equal(&g.Expression) &&
+		f.Statement.equal(&g.Statement) &&
+		f.ElseStatement.equal(g.ElseStatement)
+}
+
+func (f *ImportClause) equal(g *ImportClause) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.ImportedDefaultBinding.equal(g.ImportedDefaultBinding) &&
+		f.NameSpaceImport.equal(g.NameSpaceImport) &&
+		f.NamedImports.equal(g.NamedImports)
+}
+
+func (f *ImportDeclaration) equal(g *ImportDeclaration) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.ImportClause.equal(g.ImportClause) &&
+		f.FromClause.equal(&g.FromClause) &&
+		f.WithClause.equal(g.WithClause)
+}
+
+func (f *ImportSpecifier) equal(g *ImportSpecifier) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.IdentifierName.equal(g.IdentifierName) &&
+		f.ImportedBinding.equal(g.ImportedBinding)
+}
+
+func (f *ImportType) equal(g *ImportType) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.Type.equal(&g.Type) &&
+		f.TypeReference.equal(g.TypeReference) &&
+		f.TypeArguments.equal(g.TypeArguments)
+}
+
+func (f *IndexSignature) equal(g *IndexSignature) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalTokens(f.Modifiers, g.Modifiers) &&
+		f.Identifier.equal(g.Identifier) &&
+		f.IndexType.equal(&g.IndexType) &&
+		f.Optional == g.Optional &&
+		f.TypeAnnotation.equal(g.TypeAnnotation)
+}
+
+func (f *InterfaceDeclaration) equal(g *InterfaceDeclaration) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.BindingIdentifier.equal(g.BindingIdentifier) &&
+		f.TypeParameters.equal(g.TypeParameters) &&
+		equalSlice(f.Extends, g.Extends) &&
+		f.ObjectType.equal(&g.ObjectType)
+}
+
+func (f *IntersectionType) equal(g *IntersectionType) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.TypeOperators, g.TypeOperators)
+}
+
+func (f *IterationStatementDo) equal(g *IterationStatementDo) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.Statement.equal(&g.Statement) &&
+		f.Expression.equal(&g.Expression)
+}
+
+func (f *IterationStatementFor) equal(g *IterationStatementFor) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.Type == g.Type &&
+		f.InitExpression.equal(g.InitExpression) &&
+		equalSlice(f.InitVar, g.InitVar) &&
+		f.InitLexical.equal(g.InitLexical) &&
+		f.Conditional.equal(g.Conditional) &&
+		f.Afterthought.equal(g.Afterthought) &&
+		f.LeftHandSideExpression.equal(g.LeftHandSideExpression) &&
+		f.ForBindingIdentifier.equal(g.ForBindingIdentifier) &&
+		f.ForBindingPatternObject.equal(g.ForBindingPatternObject) &&
+		f.ForBindingPatternArray.equal(g.ForBindingPatternArray) &&
+		f.In.equal(g.In) &&
+		f.Of.equal(g.Of) &&
+		f.Statement.equal(&g.Statement)
+}
+
+func (f *IterationStatementWhile) equal(g *IterationStatementWhile) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.Expression.equal(&g.Expression) &&
+		f.Statement.equal(&g.Statement)
+}
+
+func (f *JSXAttribute) equal(g *JSXAttribute) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.Namespace.equal(g.Namespace) &&
+		f.Identifier.equal(g.Identifier) &&
+		f.JSXString.equal(g.JSXString) &&
+		f.JSXFragment.equal(g.JSXFragment) &&
+		f.JSXElement.equal(g.JSXElement) &&
+		f.AssignmentExpression.equal(g.AssignmentExpression)
+}
+
+func (f *JSXChild) equal(g *JSXChild) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.JSXText.equal(g.JSXText) &&
+		f.JSXElement.equal(g.JSXElement) &&
+		f.JSXFragment.equal(g.JSXFragment) &&
+		f.Spread == g.Spread &&
+		f.JSXChildExpression.equal(g.JSXChildExpression)
+}
+
+func (f *JSXElement) equal(g *JSXElement) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.ElementName.equal(&g.ElementName) &&
+		equalSlice(f.Attributes, g.Attributes) &&
+		f.SelfClosing == g.SelfClosing &&
+		equalSlice(f.Children, g.Children)
+}
+
+func (f *JSXElementName) equal(g *JSXElementName) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.Namespace.equal(g.Namespace) &&
+		f.Identifier.equal(g.Identifier) &&
+		equalSlice(f.MemberExpression, g.MemberExpression)
+}
+
+func (f *JSXFragment) equal(g *JSXFragment) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.Children, g.Children)
+}
+
+func (f *LeftHandSideExpression) equal(g *LeftHandSideExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.NewExpression.equal(g.NewExpression) &&
+		f.CallExpression.equal(g.CallExpression) &&
+		f.OptionalExpression.equal(g.OptionalExpression)
+}
+
+func (f *LexicalBinding) equal(g *LexicalBinding) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.BindingIdentifier.equal(g.BindingIdentifier) &&
+		f.ArrayBindingPattern.equal(g.ArrayBindingPattern) &&
+		f.ObjectBindingPattern.equal(g.ObjectBindingPattern) &&
+		f.TypeAnnotation.equal(g.TypeAnnotation) &&
+		f.Initializer.equal(g.Initializer)
+}
+
+func (f *LexicalDeclaration) equal(g *LexicalDeclaration) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.LetOrConst == g.LetOrConst &&
+		equalSlice(f.BindingList, g.BindingList)
+}
+
+func (f *LiteralType) equal(g *LiteralType) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.Negative == g.Negative &&
+		f.Literal.equal(g.Literal)
+}
+
+func (f *LogicalANDExpression) equal(g *LogicalANDExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.LogicalANDExpression.equal(g.LogicalANDExpression) &&
+		f.BitwiseORExpression.equal(&g.BitwiseORExpression)
+}
+
+func (f *LogicalORExpression) equal(g *LogicalORExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.LogicalORExpression.equal(g.LogicalORExpression) &&
+		f.LogicalANDExpression.equal(&g.LogicalANDExpression)
+}
+
+func (f *MappedType) equal(g *MappedType) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.Readonly == g.Readonly &&
+		f.Identifier.equal(g.Identifier) &&
+		f.Constraint.equal(&g.Constraint) &&
+		f.NameType.equal(g.NameType) &&
+		f.Optional == g.Optional &&
+		f.TypeAnnotation.equal(g.TypeAnnotation)
+}
+
+func (f *MemberExpression) equal(g *MemberExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.MemberExpression.equal(g.MemberExpression) &&
+		f.PrimaryExpression.equal(g.PrimaryExpression) &&
+		f.Expression.equal(g.Expression) &&
+		f.IdentifierName.equal(g.IdentifierName) &&
+		f.TemplateLiteral.equal(g.TemplateLiteral) &&
+		f.SuperProperty == g.SuperProperty &&
+		f.NewTarget == g.NewTarget &&
+		f.ImportMeta == g.ImportMeta &&
+		f.Arguments.equal(g.Arguments) &&
+		f.PrivateIdentifier.equal(g.PrivateIdentifier)
+}
+
+func (f *MethodDefinition) equal(g *MethodDefinition) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.Type == g.Type &&
+		f.ClassElementName.equal(&g.ClassElementName) &&
+		f.Params.equal(&g.Params) &&
+		f.ReturnType.equal(g.ReturnType) &&
+		f.FunctionBody.equal(&g.FunctionBody)
+}
+
+func (f *MethodSignature) equal(g *MethodSignature) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalTokens(f.Modifiers, g.Modifiers) &&
+		f.Type == g.Type &&
+		f.PropertyName.equal(&g.PropertyName) &&
+		f.Optional == g.Optional &&
+		f.CallSignature.equal(&g.CallSignature)
+}
+
+func (f *Module) equal(g *Module) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.Hashbang.equal(g.Hashbang) &&
+		equalSlice(f.ModuleListItems, g.ModuleListItems)
+}
+
+func (f *ModuleItem) equal(g *ModuleItem) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.ImportDeclaration.equal(g.ImportDeclaration) &&
+		f.StatementListItem.equal(g.StatementListItem) &&
+		f.ExportDeclaration.equal(g.ExportDeclaration)
+}
+
+func (f *MultiplicativeExpression) equal(g *MultiplicativeExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.MultiplicativeExpression.equal(g.MultiplicativeExpression) &&
+		f.MultiplicativeOperator == g.MultiplicativeOperator &&
+		f.ExponentiationExpression.equal(&g.ExponentiationExpression)
+}
+
+func (f *NamedImports) equal(g *NamedImports) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.ImportList, g.ImportList)
+}
+
+func (f *NamespaceDeclaration) equal(g *NamespaceDeclaration) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalTokens(f.NamespaceName, g.NamespaceName) &&
+		equalSlice(f.ModuleListItems, g.ModuleListItems)
+}
+
+func (f *NewExpression) equal(g *NewExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.MemberExpression.equal(&g.MemberExpression)
+}
+
+func (f *ObjectAssignmentPattern) equal(g *ObjectAssignmentPattern) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.AssignmentPropertyList, g.AssignmentPropertyList) &&
+		f.AssignmentRestElement.equal(g.AssignmentRestElement)
+}
+
+func (f *ObjectBindingPattern) equal(g *ObjectBindingPattern) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.BindingPropertyList, g.BindingPropertyList) &&
+		f.BindingRestProperty.equal(g.BindingRestProperty)
+}
+
+func (f *ObjectLiteral) equal(g *ObjectLiteral) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.PropertyDefinitionList, g.PropertyDefinitionList)
+}
+
+func (f *ObjectType) equal(g *ObjectType) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.TypeMembers, g.TypeMembers)
+}
+
+func (f *OptionalChain) equal(g *OptionalChain) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.OptionalChain.equal(g.OptionalChain) &&
+		f.Arguments.equal(g.Arguments) &&
+		f.Expression.equal(g.Expression) &&
+		f.IdentifierName.equal(g.IdentifierName) &&
+		f.TemplateLiteral.equal(g.TemplateLiteral) &&
+		f.PrivateIdentifier.equal(g.PrivateIdentifier)
+}
+
+func (f *OptionalExpression) equal(g *OptionalExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.MemberExpression.equal(g.MemberExpression) &&
+		f.CallExpression.equal(g.CallExpression) &&
+		f.OptionalExpression.equal(g.OptionalExpression) &&
+		f.OptionalChain.equal(&g.OptionalChain)
+}
+
+func (f *Parameter) equal(g *Parameter) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.Accessibility.equal(g.Accessibility) &&
+		f.Identifier.equal(g.Identifier) &&
+		f.Optional == g.Optional &&
+		f.TypeAnnotation.equal(g.TypeAnnotation) &&
+		f.Initializer.equal(g.Initializer)
+}
+
+func (f *ParameterList) equal(g *ParameterList) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.Parameters, g.Parameters) &&
+		f.RestParameter.equal(g.RestParameter)
+}
+
+func (f *ParenthesizedExpression) equal(g *ParenthesizedExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.Expressions, g.Expressions)
+}
+
+func (f *PostfixType) equal(g *PostfixType) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.PrimaryType.equal(g.PrimaryType) &&
+		f.PostfixType.equal(g.PostfixType) &&
+		f.IndexType.equal(g.IndexType) &&
+		f.Array == g.Array &&
+		f.NonNull == g.NonNull
+}
+
+func (f *PrimaryExpression) equal(g *PrimaryExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.This.equal(g.This) &&
+		f.IdentifierReference.equal(g.IdentifierReference) &&
+		f.Literal.equal(g.Literal) &&
+		f.ArrayLiteral.equal(g.ArrayLiteral) &&
+		f.ObjectLiteral.equal(g.ObjectLiteral) &&
+		f.FunctionExpression.equal(g.FunctionExpression) &&
+		f.ClassExpression.equal(g.ClassExpression) &&
+		f.TemplateLiteral.equal(g.TemplateLiteral) &&
+		f.ParenthesizedExpression.equal(g.ParenthesizedExpression) &&
+		f.JSXElement.equal(g.JSXElement) &&
+		f.JSXFragment.equal(g.JSXFragment)
+}
+
+func (f *PrimaryType) equal(g *PrimaryType) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.LiteralType.equal(g.LiteralType) &&
+		f.TemplateLiteralType.equal(g.TemplateLiteralType) &&
+		f.ParenthesizedType.equal(g.ParenthesizedType) &&
+		f.PredefinedType.equal(g.PredefinedType) &&
+		f.ObjectType.equal(g.ObjectType) &&
+		f.MappedType.equal(g.MappedType) &&
+		f.TupleType.equal(g.TupleType) &&
+		f.This.equal(g.This) &&
+		f.ImportType.equal(g.ImportType) &&
+		f.TypeQuery.equal(g.TypeQuery) &&
+		f.TypeReference.equal(g.TypeReference)
+}
+
+func (f *PropertyDefinition) equal(g *PropertyDefinition) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.IsCoverInitializedName == g.IsCoverInitializedName &&
+		f.PropertyName.equal(g.PropertyName) &&
+		f.AssignmentExpression.equal(g.AssignmentExpression) &&
+		f.MethodDefinition.equal(g.MethodDefinition)
+}
+
+func (f *PropertyName) equal(g *PropertyName) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.LiteralPropertyName.equal(g.LiteralPropertyName) &&
+		f.ComputedPropertyName.equal(g.ComputedPropertyName)
+}
+
+func (f *PropertySignature) equal(g *PropertySignature) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalTokens(f.Modifiers, g.Modifiers) &&
+		f.PropertyName.equal(&g.PropertyName) &&
+		f.Optional == g.Optional &&
+		f.TypeAnnotation.equal(g.TypeAnnotation)
+}
+
+func (f *RelationalExpression) equal(g *RelationalExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.PrivateIdentifier.equal(g.PrivateIdentifier) &&
+		f.RelationalExpression.equal(g.RelationalExpression) &&
+		f.RelationshipOperator == g.RelationshipOperator &&
+		f.ShiftExpression.equal(&g.ShiftExpression)
+}
+
+func (f *Script) equal(g *Script) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.DirectivePrologue.equal(&g.DirectivePrologue) &&
+		f.Hashbang.equal(g.Hashbang) &&
+		equalSlice(f.StatementList, g.StatementList)
+}
+
+func (f *ShiftExpression) equal(g *ShiftExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.ShiftExpression.equal(g.ShiftExpression) &&
+		f.ShiftOperator == g.ShiftOperator &&
+		f.AdditiveExpression.equal(&g.AdditiveExpression)
+}
+
+func (f *Statement) equal(g *Statement) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.Type == g.Type &&
+		f.BlockStatement.equal(g.BlockStatement) &&
+		f.VariableStatement.equal(g.VariableStatement) &&
+		f.ExpressionStatement.equal(g.ExpressionStatement) &&
+		f.IfStatement.equal(g.IfStatement) &&
+		f.IterationStatementDo.equal(g.IterationStatementDo) &&
+		f.IterationStatementWhile.equal(g.IterationStatementWhile) &&
+		f.IterationStatementFor.equal(g.IterationStatementFor) &&
+		f.SwitchStatement.equal(g.SwitchStatement) &&
+		f.WithStatement.equal(g.WithStatement) &&
+		f.LabelIdentifier.equal(g.LabelIdentifier) &&
+		f.LabelledItemFunction.equal(g.LabelledItemFunction) &&
+		f.LabelledItemStatement.equal(g.LabelledItemStatement) &&
+		f.TryStatement.equal(g.TryStatement) &&
+		f.EnumDeclaration.equal(g.EnumDeclaration) &&
+		f.NamespaceDeclaration.equal(g.NamespaceDeclaration)
+}
+
+func (f *StatementListItem) equal(g *StatementListItem) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.Statement.equal(g.Statement) &&
+		f.Declaration.equal(g.Declaration)
+}
+
+func (f *SwitchStatement) equal(g *SwitchStatement) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.Expression.equal(&g.Expression) &&
+		equalSlice(f.CaseClauses, g.CaseClauses) &&
+		equalSlice(f.DefaultClause, g.DefaultClause) &&
+		equalSlice(f.PostDefaultCaseClauses, g.PostDefaultCaseClauses)
+}
+
+func (f *TemplateLiteral) equal(g *TemplateLiteral) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.NoSubstitutionTemplate.equal(g.NoSubstitutionTemplate) &&
+		f.TemplateHead.equal(g.TemplateHead) &&
+		equalSlice(f.Expressions, g.Expressions) &&
+		equalTokens(f.TemplateMiddleList, g.TemplateMiddleList) &&
+		f.TemplateTail.equal(g.TemplateTail)
+}
+
+func (f *TemplateLiteralType) equal(g *TemplateLiteralType) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.TemplateHead.equal(g.TemplateHead) &&
+		equalSlice(f.Types, g.Types) &&
+		equalTokens(f.TemplateMiddleList, g.TemplateMiddleList) &&
+		f.TemplateTail.equal(g.TemplateTail)
+}
+
+func (f *TryStatement) equal(g *TryStatement) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.TryBlock.equal(&g.TryBlock) &&
+		f.CatchParameterBindingIdentifier.equal(g.CatchParameterBindingIdentifier) &&
+		f.CatchParameterObjectBindingPattern.equal(g.CatchParameterObjectBindingPattern) &&
+		f.CatchParameterArrayBindingPattern.equal(g.CatchParameterArrayBindingPattern) &&
+		f.CatchBlock.equal(g.CatchBlock) &&
+		f.FinallyBlock.equal(g.FinallyBlock)
+}
+
+func (f *TupleElement) equal(g *TupleElement) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.Spread == g.Spread &&
+		f.Label.equal(g.Label) &&
+		f.Optional == g.Optional &&
+		f.Type.equal(&g.Type)
+}
+
+func (f *TupleType) equal(g *TupleType) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.TupleElements, g.TupleElements)
+}
+
+func (f *TypeAliasDeclaration) equal(g *TypeAliasDeclaration) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.BindingIdentifier.equal(g.BindingIdentifier) &&
+		f.TypeParameters.equal(g.TypeParameters) &&
+		f.Type.equal(&g.Type)
+}
+
+func (f *TypeAnnotation) equal(g *TypeAnnotation) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.TypePredicate.equal(g.TypePredicate) &&
+		f.Type.equal(&g.Type)
+}
+
+func (f *TypeArguments) equal(g *TypeArguments) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.TypeArguments, g.TypeArguments)
+}
+
+func (f *TypeExpression) equal(g *TypeExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.FunctionTypeExpression.equal(g.FunctionTypeExpression) &&
+		f.UnionType.equal(g.UnionType) &&
+		f.ExtendsType.equal(g.ExtendsType) &&
+		f.TrueType.equal(g.TrueType) &&
+		f.FalseType.equal(g.FalseType)
+}
+
+func (f *TypeMember) equal(g *TypeMember) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.CallSignature.equal(g.CallSignature) &&
+		f.ConstructSignature.equal(g.ConstructSignature) &&
+		f.PropertySignature.equal(g.PropertySignature) &&
+		f.MethodSignature.equal(g.MethodSignature) &&
+		f.IndexSignature.equal(g.IndexSignature)
+}
+
+func (f *TypeOperator) equal(g *TypeOperator) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.Operator == g.Operator &&
+		f.TypeOperator.equal(g.TypeOperator) &&
+		f.InferIdentifier.equal(g.InferIdentifier) &&
+		f.PostfixType.equal(g.PostfixType)
+}
+
+func (f *TypeParameter) equal(g *TypeParameter) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.Const == g.Const &&
+		f.Identifier.equal(g.Identifier) &&
+		f.Constraint.equal(g.Constraint) &&
+		f.Default.equal(g.Default)
+}
+
+func (f *TypeParameters) equal(g *TypeParameters) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.TypeParameters, g.TypeParameters)
+}
+
+func (f *TypeQuery) equal(g *TypeQuery) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalTokens(f.EntityName, g.EntityName) &&
+		f.TypeArguments.equal(g.TypeArguments)
+}
+
+func (f *TypeReference) equal(g *TypeReference) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalTokens(f.TypeName, g.TypeName) &&
+		f.TypeArguments.equal(g.TypeArguments)
+}
+
+func (f *UnaryExpression) equal(g *UnaryExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.UnaryOperators, g.UnaryOperators) &&
+		f.UpdateExpression.equal(&g.UpdateExpression)
+}
+
+func (f *UnaryOperatorComments) equal(g *UnaryOperatorComments) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.UnaryOperator == g.UnaryOperator
+}
+
+func (f *UnionType) equal(g *UnionType) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.IntersectionTypes, g.IntersectionTypes)
+}
+
+func (f *UpdateExpression) equal(g *UpdateExpression) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.LeftHandSideExpression.equal(g.LeftHandSideExpression) &&
+		f.UpdateOperator == g.UpdateOperator &&
+		f.UnaryExpression.equal(g.UnaryExpression)
+}
+
+func (f *VariableStatement) equal(g *VariableStatement) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.VariableDeclarationList, g.VariableDeclarationList) &&
+		f.EnumDeclaration.equal(g.EnumDeclaration) &&
+		f.NamespaceDeclaration.equal(g.NamespaceDeclaration)
+}
+
+func (f *WithClause) equal(g *WithClause) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return equalSlice(f.WithEntries, g.WithEntries)
+}
+
+func (f *WithEntry) equal(g *WithEntry) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.AttributeKey.equal(g.AttributeKey) &&
+		f.Value.equal(g.Value)
+}
+
+func (f *WithStatement) equal(g *WithStatement) bool {
+	if f == nil || g == nil {
+		return f == g
+	}
+
+	return f.Expression.equal(&g.Expression) &&
+		f.Statement.equal(&g.Statement)
+}
@@ -6,14 +6,18 @@ import "errors"
 var (
 	ErrBadRestElement                       = errors.New("bad rest element")
 	ErrDuplicateDefaultClause               = errors.New("duplicate default clause")
+	ErrInvalidAccessor                      = errors.New("invalid accessor")
 	ErrInvalidAssignment                    = errors.New("invalid assignment operator")
 	ErrInvalidAssignmentProperty            = errors.New("invalid assignment property")
 	ErrInvalidAsyncArrowFunction            = errors.New("invalid async arrow function")
 	ErrInvalidCallExpression                = errors.New("invalid CallExpression")
 	ErrInvalidCharacter                     = errors.New("invalid character")
 	ErrInvalidClassDeclaration              = errors.New("invalid class declaration")
+	ErrInvalidConditionalType               = errors.New("invalid conditional type")
 	ErrInvalidDeclaration                   = errors.New("invalid declaration")
+	ErrInvalidDecorator                     = errors.New("invalid decorator")
 	ErrInvalidDestructuringAssignmentTarget = errors.New("invalid DestructuringAssignmentTarget")
+	ErrInvalidEnumMember                    = errors.New("invalid enum member")
 	ErrInvalidEscapeSequence                = errors.New("invalid escape sequence")
 	ErrInvalidExportClause                  = errors.New("invalid export clause")
 	ErrInvalidExportDeclaration             = errors.New("invalid export declaration")
@@ -33,6 +37,7 @@ var (
 	ErrInvalidNamedImport                   = errors.New("invalid named import list")
 	ErrInvalidNumber                        = errors.New("invalid number")
 	ErrInvalidOptionalChain                 = errors.New("invalid OptionalChain")
+	ErrInvalidParameter                     = errors.New("invalid parameter")
 	ErrInvalidPropertyName                  = errors.New("invalid property name")
 	ErrInvalidQuoted                        = errors.New("invalid quoted string")
 	ErrInvalidRegexpCharacter               = errors.New("invalid regexp character")
@@ -45,6 +50,8 @@ var (
 	ErrInvalidClosingTag                    = errors.New("invalid closing tag")
 	ErrInvalidTemplate                      = errors.New("invalid template")
 	ErrInvalidTryStatement                  = errors.New("invalid try statement")
+	ErrInvalidType                          = errors.New("invalid type")
+	ErrInvalidTypeDeclaration               = errors.New("invalid type declaration")
 	ErrInvalidUnicode                       = errors.New("invalid unicode escape sequence")
 	ErrInvalidVariableStatement             = errors.New("invalid variable statement")
 	ErrInvalidWithStatement                 = errors.New("invalid with statement")
@@ -77,6 +84,10 @@ var (
 	ErrNoIdentifier                         = errors.New("missing identifier")
 	ErrNotSimple                            = errors.New("not a simple expression")
 	ErrReservedIdentifier                   = errors.New("reserved identifier")
+	ErrShadowedInitializer                  = errors.New("field initializer references a name declared in the constructor")
+	ErrStrictNonSimpleParameters            = errors.New("'use strict' directive in function with non-simple parameters")
+	ErrStrictOctal                          = errors.New("octal literal or escape sequence in strict mode code")
+	ErrStrictWith                           = errors.New("with statement in strict mode code")
 	ErrUnexpectedBackslash                  = errors.New("unexpected backslash")
 	ErrUnexpectedLineTerminator             = errors.New("line terminator in string")
 )
@@ -124,6 +124,12 @@ func (i *indentPrinter) WriteToken(tk *Token) {
 		return
 	}
 
+	if u, ok := i.Underlying().(*underlyingWriter); ok {
+		if sw := sourceMapper(u.Writer); sw != nil {
+			sw.mark(tk)
+		}
+	}
+
 	i.WriteStringWithType(tk.Data, tk.Type)
 }
 
@@ -169,6 +175,10 @@ func (u *underlyingWriter) WriteToken(tk *Token) {
 		return
 	}
 
+	if sw := sourceMapper(u.Writer); sw != nil {
+		sw.mark(tk)
+	}
+
 	u.WriteString(tk.Data)
 }
 
@@ -203,14 +213,21 @@ func (u *underlyingWriter) Printf(format string, args ...any) {
 	fmt.Fprintf(u, format, args...)
 }
 
-func (underlyingWriter) Start(_ Tokens) {}
-func (underlyingWriter) End()           {}
+func (u *underlyingWriter) Start(tks Tokens) {
+	if sw := sourceMapper(u.Writer); sw != nil {
+		sw.mark(firstToken(tks))
+	}
+}
+
+func (underlyingWriter) End() {}
 
 type originalWriter struct {
 	io.Writer
 	tokenStack              []Tokens
 	pos                     []int
 	pd                      []Token
+	pdMarks                 []*Token
+	mark                    *Token
 	pdColonSplit            *originalWriter
 	lastType                parser.TokenType
 	newline, semicolon, slc bool
@@ -227,9 +244,18 @@ func (o *originalWriter) WriteStringWithType(data string, typ parser.TokenType)
 			o.WriteStringWithType(",", TokenPunctuator)
 		}
 
+		return
+	case tokenTypeMemberSeparator:
+		if pos := o.findStringWithToken(";", TokenPunctuator, true); pos >= 0 {
+			o.WriteStringWithType(";", TokenPunctuator)
+		} else if pos := o.findStringWithToken(",", TokenPunctuator, true); pos >= 0 {
+			o.WriteStringWithType(",", TokenPunctuator)
+		}
+
 		return
 	case tokenColonSplit:
 		o.pd = []Token{}
+		o.pdMarks = []*Token{}
 
 		return
 	}
@@ -265,6 +291,9 @@ var (
 )
 
 func (o *originalWriter) writeTokenData(tk Token) {
+	mark := o.mark
+	o.mark = nil
+
 	if o.semicolon && last(o.tokenStack) == nil {
 		o.semicolon = false
 
@@ -279,10 +308,16 @@ func (o *originalWriter) writeTokenData(tk Token) {
 		o.writeTokenData(Token{Token: parser.Token{Type: TokenWhitespace, Data: " "}})
 	}
 
+	if tk.Type == TokenWhitespace || tk.Type == TokenLineTerminator {
+		o.mark = mark
+		mark = nil
+	}
+
 	if o.pd != nil {
 		o.pd = append(o.pd, tk)
+		o.pdMarks = append(o.pdMarks, mark)
 	} else {
-		io.WriteString(o.Writer, tk.Data)
+		o.writeData(tk.Data, mark)
 	}
 
 	o.lastType = tk.Type
@@ -303,14 +338,23 @@ func (o *originalWriter) unColon() {
 }
 
 func (o *originalWriter) writePDColon() {
-	for _, tk := range o.pd {
-		io.WriteString(o.Writer, tk.Data)
+	for n, tk := range o.pd {
+		o.writeData(tk.Data, o.pdMarks[n])
 	}
 
 	o.pd = nil
+	o.pdMarks = nil
 	o.pdColonSplit = nil
 }
 
+func (o *originalWriter) writeData(data string, mark *Token) {
+	if sw := sourceMapper(o.Writer); sw != nil {
+		sw.mark(mark)
+	}
+
+	io.WriteString(o.Writer, data)
+}
+
 func (o *originalWriter) WriteToken(tk *Token) {
 	if o.pdColonSplit != nil {
 		if o.pd[0] == *tk {
@@ -327,9 +371,11 @@ func (o *originalWriter) WriteToken(tk *Token) {
 		o.printWhitespaceBefore(pos)
 	}
 
+	o.mark = tk
+
 	o.writeTokenData(*tk)
 
-	o.slc = tk.Type == TokenSingleLineComment
+	o.slc = tk.Type == TokenSingleLineComment || tk.Type == TokenHashbang
 
 	if pos >= 0 {
 		o.setPos(o.printWhitespaceAfter(pos))
@@ -365,6 +411,10 @@ func (o *originalWriter) Start(tks Tokens) {
 		}
 	}
 
+	if sourceMapper(o.Writer) != nil {
+		o.mark = firstToken(tks)
+	}
+
 	push(&o.tokenStack, tks)
 	push(&o.pos, 0)
 }
@@ -534,6 +584,8 @@ func (t Token) printType(w io.Writer, v bool) {
 		typ += "NullLiteral"
 	case TokenFutureReservedWord:
 		typ += "FutureReservedWord"
+	case TokenHashbang:
+		typ += "Hashbang"
 	default:
 		typ = fmt.Sprintf("%d", t.Type)
 	}
@@ -693,6 +745,7 @@ const (
 	tokenStringAsComment
 	tokenColonSplit
 	tokenPossibleTrailingComma
+	tokenTypeMemberSeparator
 )
 
 func (cp *commentPrinter) print(w writer, c *Token, pos int, lastWasMulti bool) bool {
@@ -868,6 +921,14 @@ func (ft ForType) String() string {
 		return "ForAwaitOfLet"
 	case ForAwaitOfConst:
 		return "ForAwaitOfConst"
+	case ForOfUsing:
+		return "ForOfUsing"
+	case ForOfAwaitUsing:
+		return "ForOfAwaitUsing"
+	case ForAwaitOfUsing:
+		return "ForAwaitOfUsing"
+	case ForAwaitOfAwaitUsing:
+		return "ForAwaitOfAwaitUsing"
 	default:
 		return unknown
 	}
@@ -1057,11 +1118,18 @@ func (u UpdateOperator) printType(w writer, _ bool) {
 
 // String implements the fmt.Stringer interface
 func (l LetOrConst) String() string {
-	if l {
+	switch l {
+	case Let:
+		return "Let"
+	case Const:
 		return "Const"
+	case Using:
+		return "Using"
+	case AwaitUsing:
+		return "AwaitUsing"
+	default:
+		return unknown
 	}
-
-	return "Let"
 }
 
 func (l LetOrConst) printType(w writer, _ bool) {
@@ -1114,4 +1182,46 @@ func (a AssignmentOperator) printType(w writer, _ bool) {
 	w.WriteString(a.String())
 }
 
+// String implements the fmt.Stringer interface
+func (t TypeOperatorType) String() string {
+	switch t {
+	case TypeOperatorNone:
+		return "TypeOperatorNone"
+	case TypeOperatorKeyof:
+		return "TypeOperatorKeyof"
+	case TypeOperatorUnique:
+		return "TypeOperatorUnique"
+	case TypeOperatorReadonly:
+		return "TypeOperatorReadonly"
+	case TypeOperatorInfer:
+		return "TypeOperatorInfer"
+	default:
+		return unknown
+	}
+}
+
+func (t TypeOperatorType) printType(w writer, _ bool) {
+	w.WriteString(t.String())
+}
+
+// String implements the fmt.Stringer interface
+func (m MappedTypeModifier) String() string {
+	switch m {
+	case MappedTypeModifierNone:
+		return "MappedTypeModifierNone"
+	case MappedTypeModifierPresent:
+		return "MappedTypeModifierPresent"
+	case MappedTypeModifierAdd:
+		return "MappedTypeModifierAdd"
+	case MappedTypeModifierRemove:
+		return "MappedTypeModifierRemove"
+	default:
+		return unknown
+	}
+}
+
+func (m MappedTypeModifier) printType(w writer, _ bool) {
+	w.WriteString(m.String())
+}
+
 const unknown = "Unknown"
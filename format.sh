@@ -1,7 +1,7 @@
 #!/bin/bash
 
 types() {
-	for file in ast_class.go ast_conditional.go ast_expression.go ast_function.go ast.go ast_module.go ast_statement.go jsx.go; do
+	for file in ast_class.go ast_conditional.go ast_expression.go ast_function.go ast.go ast_module.go ast_statement.go ast_typescript.go jsx.go; do
 		while read type; do
 			echo "$type" "$file";
 		done < <(grep "type [A-Z].* struct {" "$file" | cut -d' ' -f2);
@@ -135,3 +135,234 @@ HEREDOC
 		echo -e "\nfunc ($type) javascriptType() {}";
 	done < <(types);
 ) > "types.go";
+
+declare -A astTypes;
+
+while read type _; do
+	astTypes[$type]=1;
+done < <(types);
+
+astTypes[DirectivePrologue]=1;
+
+fields() {
+	sed '/^type '$1' struct {$/,/^}$/!d;//d' "$2" | grep -v "^$" | while read fieldName fieldType; do
+		if [ -z "$fieldType" ]; then
+			fieldType="$fieldName";
+			fieldName="${fieldName/\*/}";
+		fi;
+
+		kind="value";
+		base="$fieldType";
+
+		if [ "${base:0:3}" = "[]*" ]; then
+			kind="slicePointer";
+			base="${base:3}";
+		elif [ "${base:0:2}" = "[]" ]; then
+			kind="slice";
+			base="${base:2}";
+		elif [ "${base:0:1}" = "[" ]; then
+			kind="array";
+			base="${base#*]}";
+		elif [ "${base:0:1}" = "*" ]; then
+			kind="pointer";
+			base="${base:1}";
+		fi;
+
+		if [ "$base" = "Token" ]; then
+			class="token";
+		elif [ "$base" = "Tokens" -o "$base" = "Comments" ]; then
+			class="list";
+		elif [ -n "${astTypes[$base]}" ]; then
+			class="type";
+		else
+			class="scalar";
+		fi;
+
+		echo "$fieldName $kind $base $class";
+	done;
+}
+
+(
+	cat <<HEREDOC
+package javascript
+
+// File automatically generated with format.sh.
+
+// Clone returns a deep copy of the given JavaScript type, which will be of the
+// same type as the given type.
+//
+// Token pointers that are shared within the given type, such as those of
+// Directives, will also be shared within the copy.
+func Clone(t Type, opts ...CloneOption) Type {
+	c := newCloner(opts)
+
+	switch t := t.(type) {
+	case Token:
+		return *t.clone(c)
+	case *Token:
+		return t.clone(c)
+	case Tokens:
+		return t.clone(c)
+HEREDOC
+
+	while read type _; do
+		echo "	case $type:";
+		echo "		return *t.clone(c)";
+		echo "	case *$type:";
+		echo "		return t.clone(c)";
+	done < <(types);
+
+	cat <<HEREDOC
+	}
+
+	return nil
+}
+HEREDOC
+
+	while read type file; do
+		echo -e "\n// Clone returns a deep copy of the $type.";
+		echo "func (f *$type) Clone(opts ...CloneOption) *$type {";
+		echo "	return f.clone(newCloner(opts))";
+		echo "}";
+		echo -e "\nfunc (f *$type) clone(c *cloner) *$type {";
+		echo "	if f == nil {";
+		echo "		return nil";
+		echo "	}";
+		echo;
+		echo "	g := *f";
+
+		while read fieldName kind base class; do
+			if [ "$class" = "scalar" ]; then
+				continue;
+			fi;
+
+			deref="";
+
+			if [ "$class" = "type" ]; then
+				deref="*";
+			fi;
+
+			case "$kind" in
+			"value")
+				echo "	g.$fieldName = $deref""f.$fieldName.clone(c)";;
+			"pointer")
+				echo "	g.$fieldName = f.$fieldName.clone(c)";;
+			"array")
+				echo;
+				echo "	for n := range f.$fieldName {";
+				echo "		g.$fieldName[n] = $deref""f.$fieldName[n].clone(c)";
+				echo "	}";
+				echo;;
+			"slice"|"slicePointer")
+				elemType="$base";
+
+				if [ "$kind" = "slicePointer" ]; then
+					elemType="*$base";
+					deref="";
+				fi;
+
+				echo;
+				echo "	if f.$fieldName != nil {";
+				echo "		g.$fieldName = make([]$elemType, len(f.$fieldName))";
+				echo;
+				echo "		for n := range f.$fieldName {";
+				echo "			g.$fieldName[n] = $deref""f.$fieldName[n].clone(c)";
+				echo "		}";
+				echo "	}";
+				echo;;
+			esac;
+		done < <(fields "$type" "$file");
+
+		echo;
+		echo "	return &g";
+		echo "}";
+	done < <(types);
+) | sed -e '/^$/N;/^\n$/D' > "clone_types.go";
+
+(
+	cat <<HEREDOC
+package javascript
+
+// File automatically generated with format.sh.
+
+// Equal returns true if the two given JavaScript types are structurally
+// equal.
+//
+// The positions of tokens, the Tokens fields, and comments are ignored, so two
+// types that differ only in formatting are considered equal. String literals
+// are compared by their unquoted values, and numeric literals by the numbers
+// they represent. A pointer to a type is considered equal to the type itself.
+func Equal(a, b Type) bool {
+	switch a := a.(type) {
+	case Token:
+		return equalType(&a, b)
+	case *Token:
+		return equalType(a, b)
+	case Tokens:
+		bt, ok := b.(Tokens)
+
+		return ok && a.equal(bt)
+HEREDOC
+
+	while read type _; do
+		echo "	case $type:";
+		echo "		return equalType(&a, b)";
+		echo "	case *$type:";
+		echo "		return equalType(a, b)";
+	done < <(types);
+
+	cat <<HEREDOC
+	}
+
+	return false
+}
+HEREDOC
+
+	while read type file; do
+		echo -e "\nfunc (f *$type) equal(g *$type) bool {";
+		echo "	if f == nil || g == nil {";
+		echo "		return f == g";
+		echo "	}";
+
+		conditions=();
+
+		while read fieldName kind base class; do
+			if [ "$base" = "Tokens" -o "$base" = "Comments" ]; then
+				continue;
+			fi;
+
+			case "$kind/$class" in
+			value/scalar)
+				conditions+=("f.$fieldName == g.$fieldName");;
+			value/type)
+				conditions+=("f.$fieldName.equal(&g.$fieldName)");;
+			pointer/*)
+				conditions+=("f.$fieldName.equal(g.$fieldName)");;
+			slicePointer/*)
+				conditions+=("equalTokens(f.$fieldName, g.$fieldName)");;
+			slice/*)
+				conditions+=("equalSlice(f.$fieldName, g.$fieldName)");;
+			*)
+				echo "unhandled field: $type.$fieldName" >&2;;
+			esac;
+		done < <(fields "$type" "$file");
+
+		echo;
+
+		if [ ${#conditions[@]} -eq 0 ]; then
+			echo "	return true";
+		else
+			for n in "${!conditions[@]}"; do
+				if [ $n -eq 0 ]; then
+					echo -n "	return ${conditions[$n]}";
+				else
+					echo -n " &&"$'\n'"		${conditions[$n]}";
+				fi;
+			done;
+
+			echo;
+		fi;
+
+		echo "}";
+	done < <(types);
+) > "equal_types.go";
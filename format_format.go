@@ -232,6 +232,18 @@ func (f CallExpression) Format(s fmt.State, v rune) {
 	}
 }
 
+// Format implements the fmt.Formatter interface
+func (f CallSignature) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = CallSignature
+		type CallSignature X
+
+		fmt.Fprintf(s, "%#v", CallSignature(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
 // Format implements the fmt.Formatter interface
 func (f CaseClause) Format(s fmt.State, v rune) {
 	if v == 'v' && s.Flag('#') {
@@ -328,6 +340,30 @@ func (f Declaration) Format(s fmt.State, v rune) {
 	}
 }
 
+// Format implements the fmt.Formatter interface
+func (f Decorator) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = Decorator
+		type Decorator X
+
+		fmt.Fprintf(s, "%#v", Decorator(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
+// Format implements the fmt.Formatter interface
+func (f DecoratorMemberExpression) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = DecoratorMemberExpression
+		type DecoratorMemberExpression X
+
+		fmt.Fprintf(s, "%#v", DecoratorMemberExpression(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
 // Format implements the fmt.Formatter interface
 func (f DestructuringAssignmentTarget) Format(s fmt.State, v rune) {
 	if v == 'v' && s.Flag('#') {
@@ -340,6 +376,30 @@ func (f DestructuringAssignmentTarget) Format(s fmt.State, v rune) {
 	}
 }
 
+// Format implements the fmt.Formatter interface
+func (f EnumDeclaration) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = EnumDeclaration
+		type EnumDeclaration X
+
+		fmt.Fprintf(s, "%#v", EnumDeclaration(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
+// Format implements the fmt.Formatter interface
+func (f EnumMember) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = EnumMember
+		type EnumMember X
+
+		fmt.Fprintf(s, "%#v", EnumMember(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
 // Format implements the fmt.Formatter interface
 func (f EqualityExpression) Format(s fmt.State, v rune) {
 	if v == 'v' && s.Flag('#') {
@@ -460,6 +520,18 @@ func (f FunctionDeclaration) Format(s fmt.State, v rune) {
 	}
 }
 
+// Format implements the fmt.Formatter interface
+func (f FunctionTypeExpression) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = FunctionTypeExpression
+		type FunctionTypeExpression X
+
+		fmt.Fprintf(s, "%#v", FunctionTypeExpression(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
 // Format implements the fmt.Formatter interface
 func (f IfStatement) Format(s fmt.State, v rune) {
 	if v == 'v' && s.Flag('#') {
@@ -508,6 +580,54 @@ func (f ImportSpecifier) Format(s fmt.State, v rune) {
 	}
 }
 
+// Format implements the fmt.Formatter interface
+func (f ImportType) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = ImportType
+		type ImportType X
+
+		fmt.Fprintf(s, "%#v", ImportType(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
+// Format implements the fmt.Formatter interface
+func (f IndexSignature) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = IndexSignature
+		type IndexSignature X
+
+		fmt.Fprintf(s, "%#v", IndexSignature(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
+// Format implements the fmt.Formatter interface
+func (f InterfaceDeclaration) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = InterfaceDeclaration
+		type InterfaceDeclaration X
+
+		fmt.Fprintf(s, "%#v", InterfaceDeclaration(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
+// Format implements the fmt.Formatter interface
+func (f IntersectionType) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = IntersectionType
+		type IntersectionType X
+
+		fmt.Fprintf(s, "%#v", IntersectionType(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
 // Format implements the fmt.Formatter interface
 func (f IterationStatementDo) Format(s fmt.State, v rune) {
 	if v == 'v' && s.Flag('#') {
@@ -640,6 +760,18 @@ func (f LexicalDeclaration) Format(s fmt.State, v rune) {
 	}
 }
 
+// Format implements the fmt.Formatter interface
+func (f LiteralType) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = LiteralType
+		type LiteralType X
+
+		fmt.Fprintf(s, "%#v", LiteralType(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
 // Format implements the fmt.Formatter interface
 func (f LogicalANDExpression) Format(s fmt.State, v rune) {
 	if v == 'v' && s.Flag('#') {
@@ -664,6 +796,18 @@ func (f LogicalORExpression) Format(s fmt.State, v rune) {
 	}
 }
 
+// Format implements the fmt.Formatter interface
+func (f MappedType) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = MappedType
+		type MappedType X
+
+		fmt.Fprintf(s, "%#v", MappedType(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
 // Format implements the fmt.Formatter interface
 func (f MemberExpression) Format(s fmt.State, v rune) {
 	if v == 'v' && s.Flag('#') {
@@ -688,6 +832,18 @@ func (f MethodDefinition) Format(s fmt.State, v rune) {
 	}
 }
 
+// Format implements the fmt.Formatter interface
+func (f MethodSignature) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = MethodSignature
+		type MethodSignature X
+
+		fmt.Fprintf(s, "%#v", MethodSignature(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
 // Format implements the fmt.Formatter interface
 func (f Module) Format(s fmt.State, v rune) {
 	if v == 'v' && s.Flag('#') {
@@ -736,6 +892,18 @@ func (f NamedImports) Format(s fmt.State, v rune) {
 	}
 }
 
+// Format implements the fmt.Formatter interface
+func (f NamespaceDeclaration) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = NamespaceDeclaration
+		type NamespaceDeclaration X
+
+		fmt.Fprintf(s, "%#v", NamespaceDeclaration(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
 // Format implements the fmt.Formatter interface
 func (f NewExpression) Format(s fmt.State, v rune) {
 	if v == 'v' && s.Flag('#') {
@@ -784,6 +952,18 @@ func (f ObjectLiteral) Format(s fmt.State, v rune) {
 	}
 }
 
+// Format implements the fmt.Formatter interface
+func (f ObjectType) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = ObjectType
+		type ObjectType X
+
+		fmt.Fprintf(s, "%#v", ObjectType(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
 // Format implements the fmt.Formatter interface
 func (f OptionalChain) Format(s fmt.State, v rune) {
 	if v == 'v' && s.Flag('#') {
@@ -808,6 +988,30 @@ func (f OptionalExpression) Format(s fmt.State, v rune) {
 	}
 }
 
+// Format implements the fmt.Formatter interface
+func (f Parameter) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = Parameter
+		type Parameter X
+
+		fmt.Fprintf(s, "%#v", Parameter(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
+// Format implements the fmt.Formatter interface
+func (f ParameterList) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = ParameterList
+		type ParameterList X
+
+		fmt.Fprintf(s, "%#v", ParameterList(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
 // Format implements the fmt.Formatter interface
 func (f ParenthesizedExpression) Format(s fmt.State, v rune) {
 	if v == 'v' && s.Flag('#') {
@@ -820,6 +1024,18 @@ func (f ParenthesizedExpression) Format(s fmt.State, v rune) {
 	}
 }
 
+// Format implements the fmt.Formatter interface
+func (f PostfixType) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = PostfixType
+		type PostfixType X
+
+		fmt.Fprintf(s, "%#v", PostfixType(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
 // Format implements the fmt.Formatter interface
 func (f PrimaryExpression) Format(s fmt.State, v rune) {
 	if v == 'v' && s.Flag('#') {
@@ -832,6 +1048,18 @@ func (f PrimaryExpression) Format(s fmt.State, v rune) {
 	}
 }
 
+// Format implements the fmt.Formatter interface
+func (f PrimaryType) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = PrimaryType
+		type PrimaryType X
+
+		fmt.Fprintf(s, "%#v", PrimaryType(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
 // Format implements the fmt.Formatter interface
 func (f PropertyDefinition) Format(s fmt.State, v rune) {
 	if v == 'v' && s.Flag('#') {
@@ -856,6 +1084,18 @@ func (f PropertyName) Format(s fmt.State, v rune) {
 	}
 }
 
+// Format implements the fmt.Formatter interface
+func (f PropertySignature) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = PropertySignature
+		type PropertySignature X
+
+		fmt.Fprintf(s, "%#v", PropertySignature(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
 // Format implements the fmt.Formatter interface
 func (f RelationalExpression) Format(s fmt.State, v rune) {
 	if v == 'v' && s.Flag('#') {
@@ -940,6 +1180,18 @@ func (f TemplateLiteral) Format(s fmt.State, v rune) {
 	}
 }
 
+// Format implements the fmt.Formatter interface
+func (f TemplateLiteralType) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = TemplateLiteralType
+		type TemplateLiteralType X
+
+		fmt.Fprintf(s, "%#v", TemplateLiteralType(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
 // Format implements the fmt.Formatter interface
 func (f TryStatement) Format(s fmt.State, v rune) {
 	if v == 'v' && s.Flag('#') {
@@ -952,6 +1204,150 @@ func (f TryStatement) Format(s fmt.State, v rune) {
 	}
 }
 
+// Format implements the fmt.Formatter interface
+func (f TupleElement) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = TupleElement
+		type TupleElement X
+
+		fmt.Fprintf(s, "%#v", TupleElement(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
+// Format implements the fmt.Formatter interface
+func (f TupleType) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = TupleType
+		type TupleType X
+
+		fmt.Fprintf(s, "%#v", TupleType(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
+// Format implements the fmt.Formatter interface
+func (f TypeAliasDeclaration) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = TypeAliasDeclaration
+		type TypeAliasDeclaration X
+
+		fmt.Fprintf(s, "%#v", TypeAliasDeclaration(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
+// Format implements the fmt.Formatter interface
+func (f TypeAnnotation) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = TypeAnnotation
+		type TypeAnnotation X
+
+		fmt.Fprintf(s, "%#v", TypeAnnotation(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
+// Format implements the fmt.Formatter interface
+func (f TypeArguments) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = TypeArguments
+		type TypeArguments X
+
+		fmt.Fprintf(s, "%#v", TypeArguments(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
+// Format implements the fmt.Formatter interface
+func (f TypeExpression) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = TypeExpression
+		type TypeExpression X
+
+		fmt.Fprintf(s, "%#v", TypeExpression(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
+// Format implements the fmt.Formatter interface
+func (f TypeMember) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = TypeMember
+		type TypeMember X
+
+		fmt.Fprintf(s, "%#v", TypeMember(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
+// Format implements the fmt.Formatter interface
+func (f TypeOperator) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = TypeOperator
+		type TypeOperator X
+
+		fmt.Fprintf(s, "%#v", TypeOperator(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
+// Format implements the fmt.Formatter interface
+func (f TypeParameter) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = TypeParameter
+		type TypeParameter X
+
+		fmt.Fprintf(s, "%#v", TypeParameter(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
+// Format implements the fmt.Formatter interface
+func (f TypeParameters) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = TypeParameters
+		type TypeParameters X
+
+		fmt.Fprintf(s, "%#v", TypeParameters(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
+// Format implements the fmt.Formatter interface
+func (f TypeQuery) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = TypeQuery
+		type TypeQuery X
+
+		fmt.Fprintf(s, "%#v", TypeQuery(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
+// Format implements the fmt.Formatter interface
+func (f TypeReference) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = TypeReference
+		type TypeReference X
+
+		fmt.Fprintf(s, "%#v", TypeReference(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
 // Format implements the fmt.Formatter interface
 func (f UnaryExpression) Format(s fmt.State, v rune) {
 	if v == 'v' && s.Flag('#') {
@@ -976,6 +1372,18 @@ func (f UnaryOperatorComments) Format(s fmt.State, v rune) {
 	}
 }
 
+// Format implements the fmt.Formatter interface
+func (f UnionType) Format(s fmt.State, v rune) {
+	if v == 'v' && s.Flag('#') {
+		type X = UnionType
+		type UnionType X
+
+		fmt.Fprintf(s, "%#v", UnionType(f))
+	} else {
+		format(&f, s, v)
+	}
+}
+
 // Format implements the fmt.Formatter interface
 func (f UpdateExpression) Format(s fmt.State, v rune) {
 	if v == 'v' && s.Flag('#') {
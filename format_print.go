@@ -4,6 +4,8 @@ func (s Script) printSource(w writer, v bool) {
 	w.Start(s.Tokens)
 	defer w.End()
 
+	printHashbang(w, s.Hashbang)
+
 	if v && len(s.Comments[0]) > 0 {
 		s.Comments[0].printSource(w, true, true)
 		w.WriteString("\n")
@@ -24,6 +26,13 @@ func (s Script) printSource(w writer, v bool) {
 	}
 }
 
+func printHashbang(w writer, hashbang *Token) {
+	if hashbang != nil {
+		w.WriteToken(hashbang)
+		w.WriteString("\n")
+	}
+}
+
 func (s StatementListItem) printSource(w writer, v bool) {
 	w.Start(s.Tokens)
 	defer w.End()
@@ -35,6 +44,10 @@ func (s StatementListItem) printSource(w writer, v bool) {
 	if s.Statement != nil {
 		s.Statement.printSource(w, v)
 	} else if s.Declaration != nil {
+		if v && s.Declaration.FunctionDeclaration != nil {
+			printFunctionOverloads(w, s.Declaration.FunctionDeclaration, false, false)
+		}
+
 		s.Declaration.printSource(w, v)
 	}
 
@@ -49,7 +62,11 @@ func (s Statement) printSource(w writer, v bool) {
 
 	switch s.Type {
 	case StatementNormal:
-		if s.BlockStatement != nil {
+		if v && s.EnumDeclaration != nil {
+			s.EnumDeclaration.printSource(w, v)
+		} else if v && s.NamespaceDeclaration != nil {
+			s.NamespaceDeclaration.printSource(w, v)
+		} else if s.BlockStatement != nil {
 			s.BlockStatement.printSource(w, v)
 		} else if s.VariableStatement != nil {
 			s.VariableStatement.printSource(w, v)
@@ -163,6 +180,14 @@ func (d Declaration) printSource(w writer, v bool) {
 		d.FunctionDeclaration.printSource(w, v)
 	} else if d.LexicalDeclaration != nil {
 		d.LexicalDeclaration.printSource(w, v)
+	} else if !v {
+		return
+	} else if d.TypeAliasDeclaration != nil {
+		d.TypeAliasDeclaration.printSource(w, v)
+	} else if d.InterfaceDeclaration != nil {
+		d.InterfaceDeclaration.printSource(w, v)
+	} else if d.NamespaceDeclaration != nil {
+		d.NamespaceDeclaration.printSource(w, v)
 	}
 }
 
@@ -199,7 +224,15 @@ func (vs VariableStatement) printSource(w writer, v bool) {
 	w.Start(vs.Tokens)
 	defer w.End()
 
-	if len(vs.VariableDeclarationList) == 0 {
+	if v && vs.EnumDeclaration != nil {
+		vs.EnumDeclaration.printSource(w, v)
+
+		return
+	} else if v && vs.NamespaceDeclaration != nil {
+		vs.NamespaceDeclaration.printSource(w, v)
+
+		return
+	} else if len(vs.VariableDeclarationList) == 0 {
 		return
 	}
 
@@ -468,6 +501,10 @@ func (i IterationStatementFor) printSource(w writer, v bool) {
 		if i.ForBindingIdentifier == nil && i.ForBindingPatternObject == nil && i.ForBindingPatternArray == nil {
 			return
 		}
+	case ForOfUsing, ForOfAwaitUsing, ForAwaitOfUsing, ForAwaitOfAwaitUsing:
+		if i.ForBindingIdentifier == nil {
+			return
+		}
 	default:
 		return
 	}
@@ -477,7 +514,7 @@ func (i IterationStatementFor) printSource(w writer, v bool) {
 		if i.In == nil {
 			return
 		}
-	case ForOfLeftHandSide, ForOfVar, ForOfLet, ForOfConst, ForAwaitOfLeftHandSide, ForAwaitOfVar, ForAwaitOfLet, ForAwaitOfConst:
+	case ForOfLeftHandSide, ForOfVar, ForOfLet, ForOfConst, ForOfUsing, ForOfAwaitUsing, ForAwaitOfLeftHandSide, ForAwaitOfVar, ForAwaitOfLet, ForAwaitOfConst, ForAwaitOfUsing, ForAwaitOfAwaitUsing:
 		if i.Of == nil {
 			return
 		}
@@ -491,7 +528,7 @@ func (i IterationStatementFor) printSource(w writer, v bool) {
 	}
 
 	switch i.Type {
-	case ForAwaitOfLeftHandSide, ForAwaitOfVar, ForAwaitOfLet, ForAwaitOfConst:
+	case ForAwaitOfLeftHandSide, ForAwaitOfVar, ForAwaitOfLet, ForAwaitOfConst, ForAwaitOfUsing, ForAwaitOfAwaitUsing:
 		w.WriteStringWithType("await", TokenKeyword)
 		w.WriteString(" ")
 
@@ -556,6 +593,14 @@ func (i IterationStatementFor) printSource(w writer, v bool) {
 		case ForInConst, ForOfConst, ForAwaitOfConst:
 			ip.WriteStringWithType("const", TokenKeyword)
 			ip.WriteString(" ")
+		case ForOfUsing, ForAwaitOfUsing:
+			ip.WriteStringWithType("using", TokenIdentifier)
+			ip.WriteString(" ")
+		case ForOfAwaitUsing, ForAwaitOfAwaitUsing:
+			ip.WriteStringWithType("await", TokenKeyword)
+			ip.WriteString(" ")
+			ip.WriteStringWithType("using", TokenIdentifier)
+			ip.WriteString(" ")
 		}
 
 		if v {
@@ -610,7 +655,7 @@ func (i IterationStatementFor) printSource(w writer, v bool) {
 		ip.WriteStringWithType("in", TokenKeyword)
 		ip.WriteString(" ")
 		i.In.printSource(ip, v)
-	case ForOfLeftHandSide, ForOfVar, ForOfLet, ForOfConst, ForAwaitOfLeftHandSide, ForAwaitOfVar, ForAwaitOfLet, ForAwaitOfConst:
+	case ForOfLeftHandSide, ForOfVar, ForOfLet, ForOfConst, ForOfUsing, ForOfAwaitUsing, ForAwaitOfLeftHandSide, ForAwaitOfVar, ForAwaitOfLet, ForAwaitOfConst, ForAwaitOfUsing, ForAwaitOfAwaitUsing:
 		if v && len(i.Comments[5]) > 0 {
 			i.Comments[5].printSource(ip, true, false)
 		} else {
@@ -853,10 +898,14 @@ func (f FunctionDeclaration) printSource(w writer, v bool) {
 	}
 
 	if v {
+		if f.TypeParameters != nil {
+			f.TypeParameters.printSource(w, v)
+		}
+
 		f.Comments[3].printSource(w, true, false)
 	}
 
-	f.FormalParameters.printSource(w, v)
+	printParamsAndReturnType(w, &f.FormalParameters, f.ReturnType, v)
 
 	if v {
 		f.Comments[4].printSource(w, true, false)
@@ -865,6 +914,31 @@ func (f FunctionDeclaration) printSource(w writer, v bool) {
 	f.FunctionBody.printSource(w, v)
 }
 
+func printFunctionOverloads(w writer, f *FunctionDeclaration, export, def bool) {
+	for _, o := range f.Overloads {
+		if export {
+			w.WriteStringWithType("export", TokenKeyword)
+			w.WriteString(" ")
+		}
+
+		if def {
+			w.WriteStringWithType("default", TokenKeyword)
+			w.WriteString(" ")
+		}
+
+		w.WriteStringWithType("function", TokenKeyword)
+		w.WriteString(" ")
+
+		if f.BindingIdentifier != nil {
+			w.WriteToken(f.BindingIdentifier)
+		}
+
+		o.printSource(w, true)
+		w.WriteStringWithType(";", TokenPunctuator)
+		w.WriteString("\n")
+	}
+}
+
 func (t TryStatement) printSource(w writer, v bool) {
 	w.Start(t.Tokens)
 	defer w.End()
@@ -965,6 +1039,13 @@ func (c ClassDeclaration) printSource(w writer, v bool) {
 	w.Start(c.Tokens)
 	defer w.End()
 
+	printDecorators(w, c.Decorators, v)
+
+	if v && c.Abstract {
+		w.WriteStringWithType("abstract", TokenIdentifier)
+		w.WriteString(" ")
+	}
+
 	w.WriteStringWithType("class", TokenKeyword)
 	w.WriteString(" ")
 
@@ -974,6 +1055,11 @@ func (c ClassDeclaration) printSource(w writer, v bool) {
 
 	if c.BindingIdentifier != nil {
 		w.WriteToken(c.BindingIdentifier)
+
+		if v && c.TypeParameters != nil {
+			c.TypeParameters.printSource(w, v)
+		}
+
 		w.WriteString(" ")
 
 		if v {
@@ -985,6 +1071,25 @@ func (c ClassDeclaration) printSource(w writer, v bool) {
 		w.WriteStringWithType("extends", TokenKeyword)
 		w.WriteString(" ")
 		c.ClassHeritage.printSource(w, v)
+
+		if v && c.TypeArguments != nil {
+			c.TypeArguments.printSource(w, v)
+		}
+
+		w.WriteString(" ")
+	}
+
+	if v && len(c.Implements) > 0 {
+		w.WriteStringWithType("implements", TokenIdentifier)
+		w.WriteString(" ")
+		c.Implements[0].printSource(w, v)
+
+		for _, tr := range c.Implements[1:] {
+			w.WriteStringWithType(",", TokenPunctuator)
+			w.WriteString(" ")
+			tr.printSource(w, v)
+		}
+
 		w.WriteString(" ")
 	}
 
@@ -1038,6 +1143,14 @@ func (l LexicalDeclaration) printSource(w writer, v bool) {
 	case Const:
 		w.WriteStringWithType("const", TokenKeyword)
 		w.WriteString(" ")
+	case Using:
+		w.WriteStringWithType("using", TokenIdentifier)
+		w.WriteString(" ")
+	case AwaitUsing:
+		w.WriteStringWithType("await", TokenKeyword)
+		w.WriteString(" ")
+		w.WriteStringWithType("using", TokenIdentifier)
+		w.WriteString(" ")
 	}
 
 	l.BindingList[0].printSource(w, v)
@@ -1076,6 +1189,10 @@ func (l LexicalBinding) printSource(w writer, v bool) {
 	}
 
 	if v {
+		if l.TypeAnnotation != nil {
+			l.TypeAnnotation.printSource(w, v)
+		}
+
 		l.Comments[1].printSource(w, false, false)
 	}
 
@@ -1498,6 +1615,11 @@ func (c CaseClause) printSource(w writer, v bool) {
 }
 
 func (f FormalParameters) printSource(w writer, v bool) {
+	f.printParams(w, v)
+	w.WriteString(" ")
+}
+
+func (f FormalParameters) printParams(w writer, v bool) {
 	w.Start(f.Tokens)
 	defer w.End()
 
@@ -1547,6 +1669,10 @@ func (f FormalParameters) printSource(w writer, v bool) {
 		ip.WriteToken(f.BindingIdentifier)
 
 		if v {
+			if f.TypeAnnotation != nil {
+				f.TypeAnnotation.printSource(ip, v)
+			}
+
 			f.Comments[3].printSource(ip, false, true)
 		}
 	} else if f.ArrayBindingPattern != nil {
@@ -1563,6 +1689,10 @@ func (f FormalParameters) printSource(w writer, v bool) {
 		f.ArrayBindingPattern.printSource(ip, v)
 
 		if v {
+			if f.TypeAnnotation != nil {
+				f.TypeAnnotation.printSource(ip, v)
+			}
+
 			f.Comments[3].printSource(ip, false, true)
 		}
 	} else if f.ObjectBindingPattern != nil {
@@ -1579,6 +1709,10 @@ func (f FormalParameters) printSource(w writer, v bool) {
 		f.ObjectBindingPattern.printSource(ip, v)
 
 		if v {
+			if f.TypeAnnotation != nil {
+				f.TypeAnnotation.printSource(ip, v)
+			}
+
 			f.Comments[3].printSource(ip, false, true)
 		}
 	}
@@ -1592,7 +1726,6 @@ func (f FormalParameters) printSource(w writer, v bool) {
 	}
 
 	w.WriteStringWithType(")", TokenPunctuator)
-	w.WriteString(" ")
 }
 
 func (m MethodDefinition) printSource(w writer, v bool) {
@@ -1648,7 +1781,7 @@ func (m MethodDefinition) printSource(w writer, v bool) {
 	}
 
 	m.ClassElementName.printSource(w, v)
-	m.Params.printSource(w, v)
+	printParamsAndReturnType(w, &m.Params, m.ReturnType, v)
 
 	if v {
 		m.Comments[2].printSource(w, true, false)
@@ -1665,6 +1798,11 @@ func (ce ClassElement) printSource(w writer, v bool) {
 	w.Start(ce.Tokens)
 	defer w.End()
 
+	if v && ce.MethodDefinition != nil {
+		printMethodOverloads(w, ce.Static, &ce.MethodDefinition.ClassElementName)
+	}
+
+	printDecorators(w, ce.Decorators, v)
 	if v {
 		ce.Comments[0].printSource(w, false, true)
 	}
@@ -1678,6 +1816,11 @@ func (ce ClassElement) printSource(w writer, v bool) {
 		ce.Comments[1].printSource(w, true, false)
 	}
 
+	if ce.Accessor {
+		w.WriteStringWithType("accessor", TokenIdentifier)
+		w.WriteString(" ")
+	}
+
 	if ce.MethodDefinition != nil {
 		ce.MethodDefinition.printSource(w, v)
 	} else if ce.FieldDefinition != nil {
@@ -1686,6 +1829,10 @@ func (ce ClassElement) printSource(w writer, v bool) {
 	} else if ce.ClassStaticBlock != nil {
 		ce.ClassStaticBlock.printSource(w, v)
 	} else if v {
+		if ce.TypeMember != nil {
+			ce.TypeMember.printSource(w, v)
+		}
+
 		w.WriteStringWithType(";", TokenPunctuator)
 	}
 
@@ -1694,6 +1841,25 @@ func (ce ClassElement) printSource(w writer, v bool) {
 	}
 }
 
+func printMethodOverloads(w writer, static bool, cen *ClassElementName) {
+	for _, o := range cen.Overloads {
+		if static {
+			w.WriteStringWithType("static", TokenIdentifier)
+			w.WriteString(" ")
+		}
+
+		if cen.PropertyName != nil {
+			cen.PropertyName.printSource(w, true)
+		} else if cen.PrivateIdentifier != nil {
+			w.WriteToken(cen.PrivateIdentifier)
+		}
+
+		o.printSource(w, true)
+		w.WriteStringWithType(";", TokenPunctuator)
+		w.WriteString("\n")
+	}
+}
+
 func (fd FieldDefinition) printSource(w writer, v bool) {
 	w.Start(fd.Tokens)
 	defer w.End()
@@ -1701,6 +1867,14 @@ func (fd FieldDefinition) printSource(w writer, v bool) {
 	fd.ClassElementName.printSource(w, v)
 
 	if v {
+		if fd.Optional {
+			w.WriteStringWithType("?", TokenPunctuator)
+		}
+
+		if fd.TypeAnnotation != nil {
+			fd.TypeAnnotation.printSource(w, v)
+		}
+
 		fd.Comments.printSource(w, false, false)
 	}
 
@@ -1730,6 +1904,10 @@ func (cen ClassElementName) printSource(w writer, v bool) {
 	}
 
 	if v {
+		if cen.TypeParameters != nil {
+			cen.TypeParameters.printSource(w, v)
+		}
+
 		cen.Comments[1].printSource(w, false, false)
 	}
 }
@@ -1781,6 +1959,10 @@ func (a ArrowFunction) printSource(w writer, v bool) {
 	}
 
 	if v {
+		if a.TypeParameters != nil {
+			a.TypeParameters.printSource(w, v)
+		}
+
 		a.Comments[1].printSource(w, true, false)
 	}
 
@@ -1788,7 +1970,7 @@ func (a ArrowFunction) printSource(w writer, v bool) {
 		w.WriteToken(a.BindingIdentifier)
 		w.WriteString(" ")
 	} else if a.FormalParameters != nil {
-		a.FormalParameters.printSource(w, v)
+		printParamsAndReturnType(w, a.FormalParameters, a.ReturnType, v)
 	}
 
 	if v {
@@ -1984,6 +2166,7 @@ func (b BindingElement) printSource(w writer, v bool) {
 	w.Start(b.Tokens)
 	defer w.End()
 
+	printDecorators(w, b.Decorators, v)
 	if v {
 		b.Comments[0].printSource(w, true, false)
 	}
@@ -1998,6 +2181,16 @@ func (b BindingElement) printSource(w writer, v bool) {
 		return
 	}
 
+	if v {
+		if b.Optional {
+			w.WriteStringWithType("?", TokenPunctuator)
+		}
+
+		if b.TypeAnnotation != nil {
+			b.TypeAnnotation.printSource(w, v)
+		}
+	}
+
 	if v && len(b.Comments[1]) > 0 {
 		b.Comments[1].printSource(w, b.Initializer != nil, false)
 	} else if b.Initializer != nil {
@@ -2452,6 +2645,14 @@ func (a ArrayElement) printSource(w writer, v bool) {
 	a.AssignmentExpression.printSource(w, v)
 }
 
+// isElision returns true when the element is a hole, which, as the last
+// element, requires a trailing comma to be kept.
+func (a ArrayElement) isElision() bool {
+	ae := a.AssignmentExpression
+
+	return !a.Spread && ae.ConditionalExpression == nil && ae.ArrowFunction == nil && ae.LeftHandSideExpression == nil && ae.AssignmentPattern == nil && !ae.Yield
+}
+
 func (a ArrayLiteral) printSource(w writer, v bool) {
 	w.Start(a.Tokens)
 	defer w.End()
@@ -2481,6 +2682,10 @@ func (a ArrayLiteral) printSource(w writer, v bool) {
 			ip.WriteString(sep)
 			ae.printSource(ip, v)
 		}
+
+		if a.ElementList[len(a.ElementList)-1].isElision() {
+			ip.WriteStringWithType(",", TokenPunctuator)
+		}
 	}
 
 	if v {
@@ -2850,6 +3055,8 @@ func (m Module) printSource(w writer, v bool) {
 	w.Start(m.Tokens)
 	defer w.End()
 
+	printHashbang(w, m.Hashbang)
+
 	if v && len(m.Comments[0]) > 0 {
 		m.Comments[0].printSource(w, false, true)
 		w.WriteString("\n")
@@ -3010,11 +3217,17 @@ func (e ExportDeclaration) printSource(w writer, v bool) {
 		}
 
 		e.VariableStatement.printSource(w, v)
-	} else if e.Declaration != nil {
+	} else if e.Declaration != nil && (v || !e.Declaration.isTypescript()) {
 		if v {
 			e.Comments[0].printSource(w, true, false)
+
+			if e.Declaration.FunctionDeclaration != nil {
+				printFunctionOverloads(w, e.Declaration.FunctionDeclaration, true, false)
+			}
 		}
 
+		printDecorators(w, e.Decorators, v)
+
 		w.WriteStringWithType("export", TokenKeyword)
 		w.WriteString(" ")
 
@@ -3026,6 +3239,7 @@ func (e ExportDeclaration) printSource(w writer, v bool) {
 	} else if e.DefaultFunction != nil {
 		if v {
 			e.Comments[0].printSource(w, true, false)
+			printFunctionOverloads(w, e.DefaultFunction, true, true)
 		}
 
 		w.WriteStringWithType("export", TokenKeyword)
@@ -3048,6 +3262,8 @@ func (e ExportDeclaration) printSource(w writer, v bool) {
 			e.Comments[0].printSource(w, true, false)
 		}
 
+		printDecorators(w, e.Decorators, v)
+
 		w.WriteStringWithType("export", TokenKeyword)
 		w.WriteString(" ")
 
@@ -3775,3 +3991,844 @@ func (jf *JSXFragment) printSource(w writer, v bool) {
 
 	w.WriteStringWithType(">", TokenJSXElementEnd)
 }
+
+func printDecorators(w writer, ds []Decorator, v bool) {
+	for _, d := range ds {
+		d.printSource(w, v)
+		w.WriteString(" ")
+	}
+}
+
+func (d Decorator) printSource(w writer, v bool) {
+	w.Start(d.Tokens)
+	defer w.End()
+
+	if v {
+		d.Comments[0].printSource(w, false, true)
+	}
+
+	if d.DecoratorMemberExpression != nil {
+		w.WriteStringWithType("@", TokenPunctuator)
+		d.DecoratorMemberExpression.printSource(w, v)
+
+		if d.Arguments != nil {
+			d.Arguments.printSource(w, v)
+		}
+	} else if d.DecoratorParenthesizedExpression != nil {
+		w.WriteStringWithType("@", TokenPunctuator)
+		d.DecoratorParenthesizedExpression.printSource(w, v)
+	} else {
+		return
+	}
+
+	if v && len(d.Comments[1]) > 0 {
+		w.WriteString(" ")
+		d.Comments[1].printSource(w, false, false)
+	}
+}
+
+func (d DecoratorMemberExpression) printSource(w writer, v bool) {
+	w.Start(d.Tokens)
+	defer w.End()
+
+	if d.DecoratorMemberExpression != nil {
+		d.DecoratorMemberExpression.printSource(w, v)
+		w.WriteStringWithType(".", TokenPunctuator)
+
+		if d.IdentifierName != nil {
+			w.WriteToken(d.IdentifierName)
+		} else if d.PrivateIdentifier != nil {
+			w.WriteToken(d.PrivateIdentifier)
+		}
+	} else if d.IdentifierReference != nil {
+		w.WriteToken(d.IdentifierReference)
+	}
+}
+
+func printParamsAndReturnType(w writer, fp *FormalParameters, rt *TypeAnnotation, v bool) {
+	if !v || rt == nil {
+		fp.printSource(w, v)
+
+		return
+	}
+
+	fp.printParams(w, v)
+	rt.printSource(w, v)
+	w.WriteString(" ")
+}
+
+func (ta TypeAliasDeclaration) printSource(w writer, v bool) {
+	w.Start(ta.Tokens)
+	defer w.End()
+
+	if ta.BindingIdentifier == nil {
+		return
+	}
+
+	w.WriteStringWithType("type", TokenIdentifier)
+	w.WriteString(" ")
+	w.WriteToken(ta.BindingIdentifier)
+
+	if ta.TypeParameters != nil {
+		ta.TypeParameters.printSource(w, v)
+	}
+
+	w.WriteString(" ")
+	w.WriteStringWithType("=", TokenPunctuator)
+	w.WriteString(" ")
+	ta.Type.printSource(w, v)
+	w.PrintSemiColon()
+}
+
+func (id InterfaceDeclaration) printSource(w writer, v bool) {
+	w.Start(id.Tokens)
+	defer w.End()
+
+	if id.BindingIdentifier == nil {
+		return
+	}
+
+	w.WriteStringWithType("interface", TokenIdentifier)
+	w.WriteString(" ")
+	w.WriteToken(id.BindingIdentifier)
+
+	if id.TypeParameters != nil {
+		id.TypeParameters.printSource(w, v)
+	}
+
+	w.WriteString(" ")
+
+	if len(id.Extends) > 0 {
+		w.WriteStringWithType("extends", TokenKeyword)
+		w.WriteString(" ")
+		id.Extends[0].printSource(w, v)
+
+		for _, tr := range id.Extends[1:] {
+			w.WriteStringWithType(",", TokenPunctuator)
+			w.WriteString(" ")
+			tr.printSource(w, v)
+		}
+
+		w.WriteString(" ")
+	}
+
+	id.ObjectType.printSource(w, v)
+}
+
+func (ed EnumDeclaration) printSource(w writer, v bool) {
+	w.Start(ed.Tokens)
+	defer w.End()
+
+	if ed.BindingIdentifier == nil {
+		return
+	}
+
+	if ed.Const {
+		w.WriteStringWithType("const", TokenKeyword)
+		w.WriteString(" ")
+	}
+
+	w.WriteStringWithType("enum", TokenFutureReservedWord)
+	w.WriteString(" ")
+	w.WriteToken(ed.BindingIdentifier)
+	w.WriteString(" ")
+	w.WriteStringWithType("{", TokenPunctuator)
+
+	if len(ed.EnumMembers) > 0 {
+		ip := w.Indent()
+
+		for n, em := range ed.EnumMembers {
+			if n > 0 {
+				ip.WriteStringWithType(",", TokenPunctuator)
+			}
+
+			ip.WriteString("\n")
+			em.printSource(ip, v)
+		}
+
+		w.WriteString("\n")
+	}
+
+	w.WriteStringWithType("}", TokenRightBracePunctuator)
+}
+
+func (em EnumMember) printSource(w writer, v bool) {
+	w.Start(em.Tokens)
+	defer w.End()
+
+	if em.Name == nil {
+		return
+	}
+
+	w.WriteToken(em.Name)
+
+	if em.Initializer != nil {
+		w.WriteString(" ")
+		w.WriteStringWithType("=", TokenPunctuator)
+		w.WriteString(" ")
+		em.Initializer.printSource(w, v)
+	}
+}
+
+func (nd NamespaceDeclaration) printSource(w writer, v bool) {
+	w.Start(nd.Tokens)
+	defer w.End()
+
+	if len(nd.NamespaceName) == 0 {
+		return
+	}
+
+	w.WriteStringWithType("namespace", TokenIdentifier)
+	w.WriteString(" ")
+	printEntityName(w, nd.NamespaceName)
+	w.WriteString(" ")
+	w.WriteStringWithType("{", TokenPunctuator)
+
+	if len(nd.ModuleListItems) > 0 {
+		ip := w.Indent()
+
+		for _, mi := range nd.ModuleListItems {
+			ip.WriteString("\n")
+			mi.printSource(ip, v)
+		}
+
+		w.WriteString("\n")
+	}
+
+	w.WriteStringWithType("}", TokenRightBracePunctuator)
+}
+
+func (tp TypeParameters) printSource(w writer, v bool) {
+	w.Start(tp.Tokens)
+	defer w.End()
+
+	w.WriteStringWithType("<", TokenPunctuator)
+
+	for n, p := range tp.TypeParameters {
+		if n > 0 {
+			w.WriteStringWithType(",", TokenPunctuator)
+			w.WriteString(" ")
+		}
+
+		p.printSource(w, v)
+	}
+
+	w.WriteStringWithType("", tokenPossibleTrailingComma)
+	w.WriteStringWithType(">", TokenPunctuator)
+}
+
+func (tp TypeParameter) printSource(w writer, v bool) {
+	w.Start(tp.Tokens)
+	defer w.End()
+
+	if tp.Identifier == nil {
+		return
+	}
+
+	if tp.Const {
+		w.WriteStringWithType("const", TokenKeyword)
+		w.WriteString(" ")
+	}
+
+	w.WriteToken(tp.Identifier)
+
+	if tp.Constraint != nil {
+		w.WriteString(" ")
+		w.WriteStringWithType("extends", TokenKeyword)
+		w.WriteString(" ")
+		tp.Constraint.printSource(w, v)
+	}
+
+	if tp.Default != nil {
+		w.WriteString(" ")
+		w.WriteStringWithType("=", TokenPunctuator)
+		w.WriteString(" ")
+		tp.Default.printSource(w, v)
+	}
+}
+
+func (ta TypeArguments) printSource(w writer, v bool) {
+	w.Start(ta.Tokens)
+	defer w.End()
+
+	w.WriteStringWithType("<", TokenPunctuator)
+
+	for n, t := range ta.TypeArguments {
+		if n > 0 {
+			w.WriteStringWithType(",", TokenPunctuator)
+			w.WriteString(" ")
+		}
+
+		t.printSource(w, v)
+	}
+
+	w.WriteStringWithType(">", TokenPunctuator)
+}
+
+func printTypePredicate(w writer, tp *Token) {
+	if tp != nil {
+		w.WriteToken(tp)
+		w.WriteString(" ")
+		w.WriteStringWithType("is", TokenIdentifier)
+		w.WriteString(" ")
+	}
+}
+
+func (ta TypeAnnotation) printSource(w writer, v bool) {
+	w.Start(ta.Tokens)
+	defer w.End()
+
+	w.WriteStringWithType(":", TokenPunctuator)
+	w.WriteString(" ")
+	printTypePredicate(w, ta.TypePredicate)
+	ta.Type.printSource(w, v)
+}
+
+func (te TypeExpression) printSource(w writer, v bool) {
+	w.Start(te.Tokens)
+	defer w.End()
+
+	if te.FunctionTypeExpression != nil {
+		te.FunctionTypeExpression.printSource(w, v)
+
+		return
+	} else if te.UnionType == nil {
+		return
+	}
+
+	te.UnionType.printSource(w, v)
+
+	if te.ExtendsType != nil && te.TrueType != nil && te.FalseType != nil {
+		w.WriteString(" ")
+		w.WriteStringWithType("extends", TokenKeyword)
+		w.WriteString(" ")
+		te.ExtendsType.printSource(w, v)
+		w.WriteString(" ")
+		w.WriteStringWithType("?", TokenPunctuator)
+		w.WriteString(" ")
+		te.TrueType.printSource(w, v)
+		w.WriteString(" ")
+		w.WriteStringWithType(":", TokenPunctuator)
+		w.WriteString(" ")
+		te.FalseType.printSource(w, v)
+	}
+}
+
+func (ft FunctionTypeExpression) printSource(w writer, v bool) {
+	w.Start(ft.Tokens)
+	defer w.End()
+
+	if ft.New {
+		w.WriteStringWithType("new", TokenKeyword)
+		w.WriteString(" ")
+	}
+
+	if ft.TypeParameters != nil {
+		ft.TypeParameters.printSource(w, v)
+	}
+
+	ft.ParameterList.printSource(w, v)
+	w.WriteString(" ")
+	w.WriteStringWithType("=>", TokenPunctuator)
+	w.WriteString(" ")
+	printTypePredicate(w, ft.TypePredicate)
+	ft.ReturnType.printSource(w, v)
+}
+
+func (ut UnionType) printSource(w writer, v bool) {
+	w.Start(ut.Tokens)
+	defer w.End()
+
+	for n, it := range ut.IntersectionTypes {
+		if n > 0 {
+			w.WriteString(" ")
+			w.WriteStringWithType("|", TokenPunctuator)
+			w.WriteString(" ")
+		}
+
+		it.printSource(w, v)
+	}
+}
+
+func (it IntersectionType) printSource(w writer, v bool) {
+	w.Start(it.Tokens)
+	defer w.End()
+
+	for n, to := range it.TypeOperators {
+		if n > 0 {
+			w.WriteString(" ")
+			w.WriteStringWithType("&", TokenPunctuator)
+			w.WriteString(" ")
+		}
+
+		to.printSource(w, v)
+	}
+}
+
+func (to TypeOperator) printSource(w writer, v bool) {
+	w.Start(to.Tokens)
+	defer w.End()
+
+	switch to.Operator {
+	case TypeOperatorNone:
+		if to.PostfixType != nil {
+			to.PostfixType.printSource(w, v)
+		}
+
+		return
+	case TypeOperatorKeyof:
+		w.WriteStringWithType("keyof", TokenIdentifier)
+	case TypeOperatorUnique:
+		w.WriteStringWithType("unique", TokenIdentifier)
+	case TypeOperatorReadonly:
+		w.WriteStringWithType("readonly", TokenIdentifier)
+	case TypeOperatorInfer:
+		if to.InferIdentifier != nil {
+			w.WriteStringWithType("infer", TokenIdentifier)
+			w.WriteString(" ")
+			w.WriteToken(to.InferIdentifier)
+		}
+
+		return
+	default:
+		return
+	}
+
+	if to.TypeOperator != nil {
+		w.WriteString(" ")
+		to.TypeOperator.printSource(w, v)
+	}
+}
+
+func (pt PostfixType) printSource(w writer, v bool) {
+	w.Start(pt.Tokens)
+	defer w.End()
+
+	if pt.PrimaryType != nil {
+		pt.PrimaryType.printSource(w, v)
+	} else if pt.PostfixType != nil {
+		pt.PostfixType.printSource(w, v)
+
+		if pt.IndexType != nil {
+			w.WriteStringWithType("[", TokenPunctuator)
+			pt.IndexType.printSource(w, v)
+			w.WriteStringWithType("]", TokenPunctuator)
+		} else if pt.Array {
+			w.WriteStringWithType("[", TokenPunctuator)
+			w.WriteStringWithType("]", TokenPunctuator)
+		} else if pt.NonNull {
+			w.WriteStringWithType("!", TokenPunctuator)
+		}
+	}
+}
+
+func (pt PrimaryType) printSource(w writer, v bool) {
+	w.Start(pt.Tokens)
+	defer w.End()
+
+	if pt.LiteralType != nil {
+		pt.LiteralType.printSource(w, v)
+	} else if pt.TemplateLiteralType != nil {
+		pt.TemplateLiteralType.printSource(w, v)
+	} else if pt.ParenthesizedType != nil {
+		w.WriteStringWithType("(", TokenPunctuator)
+		pt.ParenthesizedType.printSource(w, v)
+		w.WriteStringWithType(")", TokenPunctuator)
+	} else if pt.PredefinedType != nil {
+		w.WriteToken(pt.PredefinedType)
+	} else if pt.ObjectType != nil {
+		pt.ObjectType.printSource(w, v)
+	} else if pt.MappedType != nil {
+		pt.MappedType.printSource(w, v)
+	} else if pt.TupleType != nil {
+		pt.TupleType.printSource(w, v)
+	} else if pt.This != nil {
+		w.WriteToken(pt.This)
+	} else if pt.ImportType != nil {
+		pt.ImportType.printSource(w, v)
+	} else if pt.TypeQuery != nil {
+		pt.TypeQuery.printSource(w, v)
+	} else if pt.TypeReference != nil {
+		pt.TypeReference.printSource(w, v)
+	}
+}
+
+func (lt LiteralType) printSource(w writer, _ bool) {
+	w.Start(lt.Tokens)
+	defer w.End()
+
+	if lt.Literal == nil {
+		return
+	}
+
+	if lt.Negative {
+		w.WriteStringWithType("-", TokenPunctuator)
+	}
+
+	w.WriteToken(lt.Literal)
+}
+
+func (tl TemplateLiteralType) printSource(w writer, v bool) {
+	w.Start(tl.Tokens)
+	defer w.End()
+
+	if tl.TemplateHead == nil || tl.TemplateTail == nil || len(tl.Types) != len(tl.TemplateMiddleList)+1 {
+		return
+	}
+
+	w.WriteToken(tl.TemplateHead)
+	tl.Types[0].printSource(w, v)
+
+	for n, tk := range tl.TemplateMiddleList {
+		w.WriteToken(tk)
+		tl.Types[n+1].printSource(w, v)
+	}
+
+	w.WriteToken(tl.TemplateTail)
+}
+
+func (ot ObjectType) printSource(w writer, v bool) {
+	w.Start(ot.Tokens)
+	defer w.End()
+
+	w.WriteStringWithType("{", TokenPunctuator)
+
+	if len(ot.TypeMembers) > 0 {
+		ip := w.Indent()
+
+		for _, tm := range ot.TypeMembers {
+			ip.WriteString("\n")
+			tm.printSource(ip, v)
+			ip.WriteStringWithType(";", tokenTypeMemberSeparator)
+		}
+
+		w.WriteString("\n")
+	}
+
+	w.WriteStringWithType("}", TokenRightBracePunctuator)
+}
+
+func (tm TypeMember) printSource(w writer, v bool) {
+	w.Start(tm.Tokens)
+	defer w.End()
+
+	if tm.CallSignature != nil {
+		tm.CallSignature.printSource(w, v)
+	} else if tm.ConstructSignature != nil {
+		w.WriteStringWithType("new", TokenKeyword)
+		w.WriteString(" ")
+		tm.ConstructSignature.printSource(w, v)
+	} else if tm.PropertySignature != nil {
+		tm.PropertySignature.printSource(w, v)
+	} else if tm.MethodSignature != nil {
+		tm.MethodSignature.printSource(w, v)
+	} else if tm.IndexSignature != nil {
+		tm.IndexSignature.printSource(w, v)
+	}
+}
+
+func printTypeModifiers(w writer, modifiers []*Token) {
+	for _, m := range modifiers {
+		w.WriteToken(m)
+		w.WriteString(" ")
+	}
+}
+
+func (ps PropertySignature) printSource(w writer, v bool) {
+	w.Start(ps.Tokens)
+	defer w.End()
+
+	printTypeModifiers(w, ps.Modifiers)
+	ps.PropertyName.printSource(w, v)
+
+	if ps.Optional {
+		w.WriteStringWithType("?", TokenPunctuator)
+	}
+
+	if ps.TypeAnnotation != nil {
+		ps.TypeAnnotation.printSource(w, v)
+	}
+}
+
+func (ms MethodSignature) printSource(w writer, v bool) {
+	w.Start(ms.Tokens)
+	defer w.End()
+
+	printTypeModifiers(w, ms.Modifiers)
+
+	switch ms.Type {
+	case MethodGetter:
+		w.WriteStringWithType("get", TokenIdentifier)
+		w.WriteString(" ")
+	case MethodSetter:
+		w.WriteStringWithType("set", TokenIdentifier)
+		w.WriteString(" ")
+	}
+
+	ms.PropertyName.printSource(w, v)
+
+	if ms.Optional {
+		w.WriteStringWithType("?", TokenPunctuator)
+	}
+
+	ms.CallSignature.printSource(w, v)
+}
+
+func (cs CallSignature) printSource(w writer, v bool) {
+	w.Start(cs.Tokens)
+	defer w.End()
+
+	if cs.TypeParameters != nil {
+		cs.TypeParameters.printSource(w, v)
+	}
+
+	cs.ParameterList.printSource(w, v)
+
+	if cs.ReturnType != nil {
+		cs.ReturnType.printSource(w, v)
+	}
+}
+
+func (pl ParameterList) printSource(w writer, v bool) {
+	w.Start(pl.Tokens)
+	defer w.End()
+
+	w.WriteStringWithType("(", TokenPunctuator)
+
+	for n, p := range pl.Parameters {
+		if n > 0 {
+			w.WriteStringWithType(",", TokenPunctuator)
+			w.WriteString(" ")
+		}
+
+		p.printSource(w, v)
+	}
+
+	if pl.RestParameter != nil {
+		if len(pl.Parameters) > 0 {
+			w.WriteStringWithType(",", TokenPunctuator)
+			w.WriteString(" ")
+		}
+
+		w.WriteStringWithType("...", TokenPunctuator)
+		pl.RestParameter.printSource(w, v)
+	}
+
+	w.WriteStringWithType(")", TokenPunctuator)
+}
+
+func (p Parameter) printSource(w writer, v bool) {
+	w.Start(p.Tokens)
+	defer w.End()
+
+	if p.Identifier == nil {
+		return
+	}
+
+	if p.Accessibility != nil {
+		w.WriteToken(p.Accessibility)
+		w.WriteString(" ")
+	}
+
+	w.WriteToken(p.Identifier)
+
+	if p.Optional {
+		w.WriteStringWithType("?", TokenPunctuator)
+	}
+
+	if p.TypeAnnotation != nil {
+		p.TypeAnnotation.printSource(w, v)
+	}
+
+	if p.Initializer != nil {
+		w.WriteString(" ")
+		w.WriteStringWithType("=", TokenPunctuator)
+		w.WriteString(" ")
+		p.Initializer.printSource(w, v)
+	}
+}
+
+func (is IndexSignature) printSource(w writer, v bool) {
+	w.Start(is.Tokens)
+	defer w.End()
+
+	if is.Identifier == nil {
+		return
+	}
+
+	printTypeModifiers(w, is.Modifiers)
+	w.WriteStringWithType("[", TokenPunctuator)
+	w.WriteToken(is.Identifier)
+	is.IndexType.printSource(w, v)
+	w.WriteStringWithType("]", TokenPunctuator)
+
+	if is.Optional {
+		w.WriteStringWithType("?", TokenPunctuator)
+	}
+
+	if is.TypeAnnotation != nil {
+		is.TypeAnnotation.printSource(w, v)
+	}
+}
+
+func printMappedTypeModifier(w writer, m MappedTypeModifier, readonly bool) {
+	switch m {
+	case MappedTypeModifierPresent:
+	case MappedTypeModifierAdd:
+		w.WriteStringWithType("+", TokenPunctuator)
+	case MappedTypeModifierRemove:
+		w.WriteStringWithType("-", TokenPunctuator)
+	default:
+		return
+	}
+
+	if readonly {
+		w.WriteStringWithType("readonly", TokenIdentifier)
+	} else {
+		w.WriteStringWithType("?", TokenPunctuator)
+	}
+}
+
+func (mt MappedType) printSource(w writer, v bool) {
+	w.Start(mt.Tokens)
+	defer w.End()
+
+	if mt.Identifier == nil {
+		return
+	}
+
+	w.WriteStringWithType("{", TokenPunctuator)
+	w.WriteString(" ")
+
+	if mt.Readonly != MappedTypeModifierNone {
+		printMappedTypeModifier(w, mt.Readonly, true)
+		w.WriteString(" ")
+	}
+
+	w.WriteStringWithType("[", TokenPunctuator)
+	w.WriteToken(mt.Identifier)
+	w.WriteString(" ")
+	w.WriteStringWithType("in", TokenKeyword)
+	w.WriteString(" ")
+	mt.Constraint.printSource(w, v)
+
+	if mt.NameType != nil {
+		w.WriteString(" ")
+		w.WriteStringWithType("as", TokenIdentifier)
+		w.WriteString(" ")
+		mt.NameType.printSource(w, v)
+	}
+
+	w.WriteStringWithType("]", TokenPunctuator)
+	printMappedTypeModifier(w, mt.Optional, false)
+
+	if mt.TypeAnnotation != nil {
+		mt.TypeAnnotation.printSource(w, v)
+	}
+
+	w.WriteString(" ")
+	w.WriteStringWithType("}", TokenRightBracePunctuator)
+}
+
+func (tt TupleType) printSource(w writer, v bool) {
+	w.Start(tt.Tokens)
+	defer w.End()
+
+	w.WriteStringWithType("[", TokenPunctuator)
+
+	for n, te := range tt.TupleElements {
+		if n > 0 {
+			w.WriteStringWithType(",", TokenPunctuator)
+			w.WriteString(" ")
+		}
+
+		te.printSource(w, v)
+	}
+
+	w.WriteStringWithType("]", TokenPunctuator)
+}
+
+func (te TupleElement) printSource(w writer, v bool) {
+	w.Start(te.Tokens)
+	defer w.End()
+
+	if te.Spread {
+		w.WriteStringWithType("...", TokenPunctuator)
+	}
+
+	if te.Label != nil {
+		w.WriteToken(te.Label)
+
+		if te.Optional {
+			w.WriteStringWithType("?", TokenPunctuator)
+		}
+
+		w.WriteStringWithType(":", TokenPunctuator)
+		w.WriteString(" ")
+	}
+
+	te.Type.printSource(w, v)
+
+	if te.Label == nil && te.Optional {
+		w.WriteStringWithType("?", TokenPunctuator)
+	}
+}
+
+func (it ImportType) printSource(w writer, v bool) {
+	w.Start(it.Tokens)
+	defer w.End()
+
+	w.WriteStringWithType("import", TokenKeyword)
+	w.WriteStringWithType("(", TokenPunctuator)
+	it.Type.printSource(w, v)
+	w.WriteStringWithType(")", TokenPunctuator)
+
+	if it.TypeReference != nil {
+		w.WriteStringWithType(".", TokenPunctuator)
+		it.TypeReference.printSource(w, v)
+	} else if it.TypeArguments != nil {
+		it.TypeArguments.printSource(w, v)
+	}
+}
+
+func printEntityName(w writer, name []*Token) {
+	for n, tk := range name {
+		if n > 0 {
+			w.WriteStringWithType(".", TokenPunctuator)
+		}
+
+		w.WriteToken(tk)
+	}
+}
+
+func (tq TypeQuery) printSource(w writer, v bool) {
+	w.Start(tq.Tokens)
+	defer w.End()
+
+	if len(tq.EntityName) == 0 {
+		return
+	}
+
+	w.WriteStringWithType("typeof", TokenKeyword)
+	w.WriteString(" ")
+	printEntityName(w, tq.EntityName)
+
+	if tq.TypeArguments != nil {
+		tq.TypeArguments.printSource(w, v)
+	}
+}
+
+func (tr TypeReference) printSource(w writer, v bool) {
+	w.Start(tr.Tokens)
+	defer w.End()
+
+	if len(tr.TypeName) == 0 {
+		return
+	}
+
+	printEntityName(w, tr.TypeName)
+
+	if tr.TypeArguments != nil {
+		tr.TypeArguments.printSource(w, v)
+	}
+}
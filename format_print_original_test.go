@@ -83,6 +83,10 @@ func TestPrintingOriginal(t *testing.T) {
 			"for(const{a,b}in c){}",
 			"for(const{a,b}in c){}",
 		},
+		{ // 18
+			"#!/usr/bin/env node\na",
+			"#!/usr/bin/env node\na",
+		},
 	} {
 		tk := parser.NewStringTokeniser(test.Input)
 
@@ -2699,6 +2699,11 @@ func TestPrintingScript(t *testing.T) {
 			"class a {\n\tb;\n}",
 			"class a {\n\tb /* A */; // B\n}",
 		},
+		{ // 536
+			"[a,,];[,]",
+			"[a, ,];\n\n[,];",
+			"[a, ,];\n\n[,];",
+		},
 	} {
 		for m, in := range [2]string{test.Input, test.VerboseOutput} {
 			s, err := ParseScript(makeTokeniser(parser.NewStringTokeniser(in)))
@@ -3084,6 +3089,51 @@ func TestPrintingModule(t *testing.T) {
 			"import {a as b} from 'c';",
 			"import {a /* A */ as b} from 'c';",
 		},
+		{ // 69
+			"@a\nclass b {}",
+			"@a class b {}",
+			"@a class b {}",
+		},
+		{ // 70
+			"@a.b.#c() @(d) export class e {}",
+			"@a.b.#c() @(d) export class e {}",
+			"@a.b.#c() @(d) export class e {}",
+		},
+		{ // 71
+			"export default @a class {}",
+			"export default @a class {}",
+			"export default @a class {}",
+		},
+		{ // 72
+			"class a {\n@b\nc() {}\n@d static e;\n@f get g() {}\nh(@i j, @k.l() [m]) {}\n}",
+			"class a {\n\t@b c() {}\n\t@d static e;\n\t@f get g() {}\n\th(@i j, @k.l() [m]) {}\n}",
+			"class a {\n\t@b c() {}\n\t@d static e;\n\t@f get g() {}\n\th(@i j, @k.l() [m]) {}\n}",
+		},
+		{ // 73
+			"@a /* A */ class b {}",
+			"@a class b {}",
+			"@a /* A */ class b {}",
+		},
+		{ // 74
+			"using a = b\nawait   using c = d, e = f",
+			"using a = b;\n\nawait using c = d, e = f;",
+			"using a = b;\n\nawait using c = d,\ne = f;",
+		},
+		{ // 75
+			"for (using a of b);for await(await using c of d);",
+			"for (using a of b) ;\n\nfor await (await using c of d) ;",
+			"for (using a of b) ;\n\nfor await (await using c of d) ;",
+		},
+		{ // 76
+			"#!/usr/bin/env node\n\n// A\na",
+			"#!/usr/bin/env node\na;",
+			"#!/usr/bin/env node\n// A\n\na;",
+		},
+		{ // 77
+			"class a {\n@b accessor c;\nstatic accessor #d = 1;\naccessor\ne;\naccessor() {}\n}",
+			"class a {\n\t@b accessor c;\n\tstatic accessor #d = 1;\n\taccessor;\n\te;\n\taccessor() {}\n}",
+			"class a {\n\t@b accessor c;\n\tstatic accessor #d = 1;\n\taccessor;\n\te;\n\taccessor() {}\n}",
+		},
 	} {
 		for m, in := range [2]string{test.Input, test.VerboseOutput} {
 			s, err := ParseModule(makeTokeniser(parser.NewStringTokeniser(in)))
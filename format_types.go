@@ -243,10 +243,20 @@ func (f *ArrowFunction) printType(w writer, v bool) {
 
 	pp.WriteString("ArrowFunction {")
 
+	pp.WriteString("\nDirectivePrologue: ")
+	f.DirectivePrologue.printType(pp, v)
+
 	if f.Async || v {
 		pp.Printf("\nAsync: %v", f.Async)
 	}
 
+	if f.TypeParameters != nil {
+		pp.WriteString("\nTypeParameters: ")
+		f.TypeParameters.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeParameters: nil")
+	}
+
 	if f.BindingIdentifier != nil {
 		pp.WriteString("\nBindingIdentifier: ")
 		f.BindingIdentifier.printType(pp, v)
@@ -261,6 +271,13 @@ func (f *ArrowFunction) printType(w writer, v bool) {
 		pp.WriteString("\nFormalParameters: nil")
 	}
 
+	if f.ReturnType != nil {
+		pp.WriteString("\nReturnType: ")
+		f.ReturnType.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nReturnType: nil")
+	}
+
 	if f.AssignmentExpression != nil {
 		pp.WriteString("\nAssignmentExpression: ")
 		f.AssignmentExpression.printType(pp, v)
@@ -461,6 +478,23 @@ func (f *BindingElement) printType(w writer, v bool) {
 
 	pp.WriteString("BindingElement {")
 
+	if f.Decorators == nil {
+		pp.WriteString("\nDecorators: nil")
+	} else if len(f.Decorators) > 0 {
+		pp.WriteString("\nDecorators: [")
+
+		ipp := pp.Indent()
+
+		for n, e := range f.Decorators {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
+
+		pp.WriteString("\n]")
+	} else if v {
+		pp.WriteString("\nDecorators: []")
+	}
+
 	if f.SingleNameBinding != nil {
 		pp.WriteString("\nSingleNameBinding: ")
 		f.SingleNameBinding.printType(pp, v)
@@ -482,6 +516,17 @@ func (f *BindingElement) printType(w writer, v bool) {
 		pp.WriteString("\nObjectBindingPattern: nil")
 	}
 
+	if f.Optional || v {
+		pp.Printf("\nOptional: %v", f.Optional)
+	}
+
+	if f.TypeAnnotation != nil {
+		pp.WriteString("\nTypeAnnotation: ")
+		f.TypeAnnotation.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeAnnotation: nil")
+	}
+
 	if f.Initializer != nil {
 		pp.WriteString("\nInitializer: ")
 		f.Initializer.printType(pp, v)
@@ -718,6 +763,34 @@ func (f *CallExpression) printType(w writer, v bool) {
 	w.WriteString("\n}")
 }
 
+func (f *CallSignature) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("CallSignature {")
+
+	if f.TypeParameters != nil {
+		pp.WriteString("\nTypeParameters: ")
+		f.TypeParameters.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeParameters: nil")
+	}
+
+	pp.WriteString("\nParameterList: ")
+	f.ParameterList.printType(pp, v)
+
+	if f.ReturnType != nil {
+		pp.WriteString("\nReturnType: ")
+		f.ReturnType.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nReturnType: nil")
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
 func (f *CaseClause) printType(w writer, v bool) {
 	pp := w.Indent()
 
@@ -765,6 +838,27 @@ func (f *ClassDeclaration) printType(w writer, v bool) {
 
 	pp.WriteString("ClassDeclaration {")
 
+	if f.Decorators == nil {
+		pp.WriteString("\nDecorators: nil")
+	} else if len(f.Decorators) > 0 {
+		pp.WriteString("\nDecorators: [")
+
+		ipp := pp.Indent()
+
+		for n, e := range f.Decorators {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
+
+		pp.WriteString("\n]")
+	} else if v {
+		pp.WriteString("\nDecorators: []")
+	}
+
+	if f.Abstract || v {
+		pp.Printf("\nAbstract: %v", f.Abstract)
+	}
+
 	if f.BindingIdentifier != nil {
 		pp.WriteString("\nBindingIdentifier: ")
 		f.BindingIdentifier.printType(pp, v)
@@ -772,6 +866,13 @@ func (f *ClassDeclaration) printType(w writer, v bool) {
 		pp.WriteString("\nBindingIdentifier: nil")
 	}
 
+	if f.TypeParameters != nil {
+		pp.WriteString("\nTypeParameters: ")
+		f.TypeParameters.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeParameters: nil")
+	}
+
 	if f.ClassHeritage != nil {
 		pp.WriteString("\nClassHeritage: ")
 		f.ClassHeritage.printType(pp, v)
@@ -779,6 +880,30 @@ func (f *ClassDeclaration) printType(w writer, v bool) {
 		pp.WriteString("\nClassHeritage: nil")
 	}
 
+	if f.TypeArguments != nil {
+		pp.WriteString("\nTypeArguments: ")
+		f.TypeArguments.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeArguments: nil")
+	}
+
+	if f.Implements == nil {
+		pp.WriteString("\nImplements: nil")
+	} else if len(f.Implements) > 0 {
+		pp.WriteString("\nImplements: [")
+
+		ipp := pp.Indent()
+
+		for n, e := range f.Implements {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
+
+		pp.WriteString("\n]")
+	} else if v {
+		pp.WriteString("\nImplements: []")
+	}
+
 	if f.ClassBody == nil {
 		pp.WriteString("\nClassBody: nil")
 	} else if len(f.ClassBody) > 0 {
@@ -818,10 +943,31 @@ func (f *ClassElement) printType(w writer, v bool) {
 
 	pp.WriteString("ClassElement {")
 
+	if f.Decorators == nil {
+		pp.WriteString("\nDecorators: nil")
+	} else if len(f.Decorators) > 0 {
+		pp.WriteString("\nDecorators: [")
+
+		ipp := pp.Indent()
+
+		for n, e := range f.Decorators {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
+
+		pp.WriteString("\n]")
+	} else if v {
+		pp.WriteString("\nDecorators: []")
+	}
+
 	if f.Static || v {
 		pp.Printf("\nStatic: %v", f.Static)
 	}
 
+	if f.Accessor || v {
+		pp.Printf("\nAccessor: %v", f.Accessor)
+	}
+
 	if f.MethodDefinition != nil {
 		pp.WriteString("\nMethodDefinition: ")
 		f.MethodDefinition.printType(pp, v)
@@ -843,6 +989,13 @@ func (f *ClassElement) printType(w writer, v bool) {
 		pp.WriteString("\nClassStaticBlock: nil")
 	}
 
+	if f.TypeMember != nil {
+		pp.WriteString("\nTypeMember: ")
+		f.TypeMember.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeMember: nil")
+	}
+
 	pp.WriteString("\nComments: [")
 
 	ipp := pp.Indent()
@@ -879,6 +1032,30 @@ func (f *ClassElementName) printType(w writer, v bool) {
 		pp.WriteString("\nPrivateIdentifier: nil")
 	}
 
+	if f.Overloads == nil {
+		pp.WriteString("\nOverloads: nil")
+	} else if len(f.Overloads) > 0 {
+		pp.WriteString("\nOverloads: [")
+
+		ipp := pp.Indent()
+
+		for n, e := range f.Overloads {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
+
+		pp.WriteString("\n]")
+	} else if v {
+		pp.WriteString("\nOverloads: []")
+	}
+
+	if f.TypeParameters != nil {
+		pp.WriteString("\nTypeParameters: ")
+		f.TypeParameters.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeParameters: nil")
+	}
+
 	pp.WriteString("\nComments: [")
 
 	ipp := pp.Indent()
@@ -1008,6 +1185,27 @@ func (f *Declaration) printType(w writer, v bool) {
 		pp.WriteString("\nLexicalDeclaration: nil")
 	}
 
+	if f.TypeAliasDeclaration != nil {
+		pp.WriteString("\nTypeAliasDeclaration: ")
+		f.TypeAliasDeclaration.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeAliasDeclaration: nil")
+	}
+
+	if f.InterfaceDeclaration != nil {
+		pp.WriteString("\nInterfaceDeclaration: ")
+		f.InterfaceDeclaration.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nInterfaceDeclaration: nil")
+	}
+
+	if f.NamespaceDeclaration != nil {
+		pp.WriteString("\nNamespaceDeclaration: ")
+		f.NamespaceDeclaration.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nNamespaceDeclaration: nil")
+	}
+
 	pp.WriteString("\nComments: ")
 	f.Comments.printType(pp, v)
 
@@ -1017,6 +1215,88 @@ func (f *Declaration) printType(w writer, v bool) {
 	w.WriteString("\n}")
 }
 
+func (f *Decorator) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("Decorator {")
+
+	if f.DecoratorMemberExpression != nil {
+		pp.WriteString("\nDecoratorMemberExpression: ")
+		f.DecoratorMemberExpression.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nDecoratorMemberExpression: nil")
+	}
+
+	if f.DecoratorParenthesizedExpression != nil {
+		pp.WriteString("\nDecoratorParenthesizedExpression: ")
+		f.DecoratorParenthesizedExpression.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nDecoratorParenthesizedExpression: nil")
+	}
+
+	if f.Arguments != nil {
+		pp.WriteString("\nArguments: ")
+		f.Arguments.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nArguments: nil")
+	}
+
+	pp.WriteString("\nComments: [")
+
+	ipp := pp.Indent()
+
+	for n, e := range f.Comments {
+		ipp.Printf("\n%d: ", n)
+		e.printType(ipp, v)
+	}
+
+	pp.WriteString("\n]")
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
+func (f *DecoratorMemberExpression) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("DecoratorMemberExpression {")
+
+	if f.DecoratorMemberExpression != nil {
+		pp.WriteString("\nDecoratorMemberExpression: ")
+		f.DecoratorMemberExpression.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nDecoratorMemberExpression: nil")
+	}
+
+	if f.IdentifierReference != nil {
+		pp.WriteString("\nIdentifierReference: ")
+		f.IdentifierReference.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nIdentifierReference: nil")
+	}
+
+	if f.IdentifierName != nil {
+		pp.WriteString("\nIdentifierName: ")
+		f.IdentifierName.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nIdentifierName: nil")
+	}
+
+	if f.PrivateIdentifier != nil {
+		pp.WriteString("\nPrivateIdentifier: ")
+		f.PrivateIdentifier.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nPrivateIdentifier: nil")
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
 func (f *DestructuringAssignmentTarget) printType(w writer, v bool) {
 	pp := w.Indent()
 
@@ -1042,6 +1322,77 @@ func (f *DestructuringAssignmentTarget) printType(w writer, v bool) {
 	w.WriteString("\n}")
 }
 
+func (f *EnumDeclaration) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("EnumDeclaration {")
+
+	if f.Const || v {
+		pp.Printf("\nConst: %v", f.Const)
+	}
+
+	if f.BindingIdentifier != nil {
+		pp.WriteString("\nBindingIdentifier: ")
+		f.BindingIdentifier.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nBindingIdentifier: nil")
+	}
+
+	if f.EnumMembers == nil {
+		pp.WriteString("\nEnumMembers: nil")
+	} else if len(f.EnumMembers) > 0 {
+		pp.WriteString("\nEnumMembers: [")
+
+		ipp := pp.Indent()
+
+		for n, e := range f.EnumMembers {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
+
+		pp.WriteString("\n]")
+	} else if v {
+		pp.WriteString("\nEnumMembers: []")
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
+func (f *EnumMember) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("EnumMember {")
+
+	if f.Name != nil {
+		pp.WriteString("\nName: ")
+		f.Name.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nName: nil")
+	}
+
+	if f.Initializer != nil {
+		pp.WriteString("\nInitializer: ")
+		f.Initializer.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nInitializer: nil")
+	}
+
+	if f.Value != nil {
+		pp.WriteString("\nValue: ")
+		f.Value.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nValue: nil")
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
 func (f *EqualityExpression) printType(w writer, v bool) {
 	pp := w.Indent()
 
@@ -1131,6 +1482,23 @@ func (f *ExportDeclaration) printType(w writer, v bool) {
 
 	pp.WriteString("ExportDeclaration {")
 
+	if f.Decorators == nil {
+		pp.WriteString("\nDecorators: nil")
+	} else if len(f.Decorators) > 0 {
+		pp.WriteString("\nDecorators: [")
+
+		ipp := pp.Indent()
+
+		for n, e := range f.Decorators {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
+
+		pp.WriteString("\n]")
+	} else if v {
+		pp.WriteString("\nDecorators: []")
+	}
+
 	if f.ExportClause != nil {
 		pp.WriteString("\nExportClause: ")
 		f.ExportClause.printType(pp, v)
@@ -1276,6 +1644,17 @@ func (f *FieldDefinition) printType(w writer, v bool) {
 	pp.WriteString("\nClassElementName: ")
 	f.ClassElementName.printType(pp, v)
 
+	if f.Optional || v {
+		pp.Printf("\nOptional: %v", f.Optional)
+	}
+
+	if f.TypeAnnotation != nil {
+		pp.WriteString("\nTypeAnnotation: ")
+		f.TypeAnnotation.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeAnnotation: nil")
+	}
+
 	if f.Initializer != nil {
 		pp.WriteString("\nInitializer: ")
 		f.Initializer.printType(pp, v)
@@ -1335,6 +1714,13 @@ func (f *FormalParameters) printType(w writer, v bool) {
 		pp.WriteString("\nObjectBindingPattern: nil")
 	}
 
+	if f.TypeAnnotation != nil {
+		pp.WriteString("\nTypeAnnotation: ")
+		f.TypeAnnotation.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeAnnotation: nil")
+	}
+
 	pp.WriteString("\nComments: [")
 
 	ipp := pp.Indent()
@@ -1378,6 +1764,9 @@ func (f *FunctionDeclaration) printType(w writer, v bool) {
 
 	pp.WriteString("FunctionDeclaration {")
 
+	pp.WriteString("\nDirectivePrologue: ")
+	f.DirectivePrologue.printType(pp, v)
+
 	pp.WriteString("\nType: ")
 	f.Type.printType(pp, v)
 
@@ -1388,13 +1777,44 @@ func (f *FunctionDeclaration) printType(w writer, v bool) {
 		pp.WriteString("\nBindingIdentifier: nil")
 	}
 
-	pp.WriteString("\nFormalParameters: ")
-	f.FormalParameters.printType(pp, v)
-
-	pp.WriteString("\nFunctionBody: ")
-	f.FunctionBody.printType(pp, v)
+	if f.Overloads == nil {
+		pp.WriteString("\nOverloads: nil")
+	} else if len(f.Overloads) > 0 {
+		pp.WriteString("\nOverloads: [")
 
-	pp.WriteString("\nComments: [")
+		ipp := pp.Indent()
+
+		for n, e := range f.Overloads {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
+
+		pp.WriteString("\n]")
+	} else if v {
+		pp.WriteString("\nOverloads: []")
+	}
+
+	if f.TypeParameters != nil {
+		pp.WriteString("\nTypeParameters: ")
+		f.TypeParameters.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeParameters: nil")
+	}
+
+	pp.WriteString("\nFormalParameters: ")
+	f.FormalParameters.printType(pp, v)
+
+	if f.ReturnType != nil {
+		pp.WriteString("\nReturnType: ")
+		f.ReturnType.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nReturnType: nil")
+	}
+
+	pp.WriteString("\nFunctionBody: ")
+	f.FunctionBody.printType(pp, v)
+
+	pp.WriteString("\nComments: [")
 
 	ipp := pp.Indent()
 
@@ -1411,6 +1831,41 @@ func (f *FunctionDeclaration) printType(w writer, v bool) {
 	w.WriteString("\n}")
 }
 
+func (f *FunctionTypeExpression) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("FunctionTypeExpression {")
+
+	if f.New || v {
+		pp.Printf("\nNew: %v", f.New)
+	}
+
+	if f.TypeParameters != nil {
+		pp.WriteString("\nTypeParameters: ")
+		f.TypeParameters.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeParameters: nil")
+	}
+
+	pp.WriteString("\nParameterList: ")
+	f.ParameterList.printType(pp, v)
+
+	if f.TypePredicate != nil {
+		pp.WriteString("\nTypePredicate: ")
+		f.TypePredicate.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypePredicate: nil")
+	}
+
+	pp.WriteString("\nReturnType: ")
+	f.ReturnType.printType(pp, v)
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
 func (f *IfStatement) printType(w writer, v bool) {
 	pp := w.Indent()
 
@@ -1564,6 +2019,156 @@ func (f *ImportSpecifier) printType(w writer, v bool) {
 	w.WriteString("\n}")
 }
 
+func (f *ImportType) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("ImportType {")
+
+	pp.WriteString("\nType: ")
+	f.Type.printType(pp, v)
+
+	if f.TypeReference != nil {
+		pp.WriteString("\nTypeReference: ")
+		f.TypeReference.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeReference: nil")
+	}
+
+	if f.TypeArguments != nil {
+		pp.WriteString("\nTypeArguments: ")
+		f.TypeArguments.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeArguments: nil")
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
+func (f *IndexSignature) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("IndexSignature {")
+
+	if f.Modifiers == nil {
+		pp.WriteString("\nModifiers: nil")
+	} else if len(f.Modifiers) > 0 {
+		pp.WriteString("\nModifiers: [")
+
+		ipp := pp.Indent()
+
+		for n, e := range f.Modifiers {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
+
+		pp.WriteString("\n]")
+	} else if v {
+		pp.WriteString("\nModifiers: []")
+	}
+
+	if f.Identifier != nil {
+		pp.WriteString("\nIdentifier: ")
+		f.Identifier.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nIdentifier: nil")
+	}
+
+	pp.WriteString("\nIndexType: ")
+	f.IndexType.printType(pp, v)
+
+	if f.Optional || v {
+		pp.Printf("\nOptional: %v", f.Optional)
+	}
+
+	if f.TypeAnnotation != nil {
+		pp.WriteString("\nTypeAnnotation: ")
+		f.TypeAnnotation.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeAnnotation: nil")
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
+func (f *InterfaceDeclaration) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("InterfaceDeclaration {")
+
+	if f.BindingIdentifier != nil {
+		pp.WriteString("\nBindingIdentifier: ")
+		f.BindingIdentifier.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nBindingIdentifier: nil")
+	}
+
+	if f.TypeParameters != nil {
+		pp.WriteString("\nTypeParameters: ")
+		f.TypeParameters.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeParameters: nil")
+	}
+
+	if f.Extends == nil {
+		pp.WriteString("\nExtends: nil")
+	} else if len(f.Extends) > 0 {
+		pp.WriteString("\nExtends: [")
+
+		ipp := pp.Indent()
+
+		for n, e := range f.Extends {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
+
+		pp.WriteString("\n]")
+	} else if v {
+		pp.WriteString("\nExtends: []")
+	}
+
+	pp.WriteString("\nObjectType: ")
+	f.ObjectType.printType(pp, v)
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
+func (f *IntersectionType) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("IntersectionType {")
+
+	if f.TypeOperators == nil {
+		pp.WriteString("\nTypeOperators: nil")
+	} else if len(f.TypeOperators) > 0 {
+		pp.WriteString("\nTypeOperators: [")
+
+		ipp := pp.Indent()
+
+		for n, e := range f.TypeOperators {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
+
+		pp.WriteString("\n]")
+	} else if v {
+		pp.WriteString("\nTypeOperators: []")
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
 func (f *IterationStatementDo) printType(w writer, v bool) {
 	pp := w.Indent()
 
@@ -2061,6 +2666,13 @@ func (f *LexicalBinding) printType(w writer, v bool) {
 		pp.WriteString("\nObjectBindingPattern: nil")
 	}
 
+	if f.TypeAnnotation != nil {
+		pp.WriteString("\nTypeAnnotation: ")
+		f.TypeAnnotation.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeAnnotation: nil")
+	}
+
 	if f.Initializer != nil {
 		pp.WriteString("\nInitializer: ")
 		f.Initializer.printType(pp, v)
@@ -2116,6 +2728,28 @@ func (f *LexicalDeclaration) printType(w writer, v bool) {
 	w.WriteString("\n}")
 }
 
+func (f *LiteralType) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("LiteralType {")
+
+	if f.Negative || v {
+		pp.Printf("\nNegative: %v", f.Negative)
+	}
+
+	if f.Literal != nil {
+		pp.WriteString("\nLiteral: ")
+		f.Literal.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nLiteral: nil")
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
 func (f *LogicalANDExpression) printType(w writer, v bool) {
 	pp := w.Indent()
 
@@ -2158,6 +2792,47 @@ func (f *LogicalORExpression) printType(w writer, v bool) {
 	w.WriteString("\n}")
 }
 
+func (f *MappedType) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("MappedType {")
+
+	pp.WriteString("\nReadonly: ")
+	f.Readonly.printType(pp, v)
+
+	if f.Identifier != nil {
+		pp.WriteString("\nIdentifier: ")
+		f.Identifier.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nIdentifier: nil")
+	}
+
+	pp.WriteString("\nConstraint: ")
+	f.Constraint.printType(pp, v)
+
+	if f.NameType != nil {
+		pp.WriteString("\nNameType: ")
+		f.NameType.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nNameType: nil")
+	}
+
+	pp.WriteString("\nOptional: ")
+	f.Optional.printType(pp, v)
+
+	if f.TypeAnnotation != nil {
+		pp.WriteString("\nTypeAnnotation: ")
+		f.TypeAnnotation.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeAnnotation: nil")
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
 func (f *MemberExpression) printType(w writer, v bool) {
 	pp := w.Indent()
 
@@ -2255,6 +2930,13 @@ func (f *MethodDefinition) printType(w writer, v bool) {
 	pp.WriteString("\nParams: ")
 	f.Params.printType(pp, v)
 
+	if f.ReturnType != nil {
+		pp.WriteString("\nReturnType: ")
+		f.ReturnType.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nReturnType: nil")
+	}
+
 	pp.WriteString("\nFunctionBody: ")
 	f.FunctionBody.printType(pp, v)
 
@@ -2275,11 +2957,59 @@ func (f *MethodDefinition) printType(w writer, v bool) {
 	w.WriteString("\n}")
 }
 
+func (f *MethodSignature) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("MethodSignature {")
+
+	if f.Modifiers == nil {
+		pp.WriteString("\nModifiers: nil")
+	} else if len(f.Modifiers) > 0 {
+		pp.WriteString("\nModifiers: [")
+
+		ipp := pp.Indent()
+
+		for n, e := range f.Modifiers {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
+
+		pp.WriteString("\n]")
+	} else if v {
+		pp.WriteString("\nModifiers: []")
+	}
+
+	pp.WriteString("\nType: ")
+	f.Type.printType(pp, v)
+
+	pp.WriteString("\nPropertyName: ")
+	f.PropertyName.printType(pp, v)
+
+	if f.Optional || v {
+		pp.Printf("\nOptional: %v", f.Optional)
+	}
+
+	pp.WriteString("\nCallSignature: ")
+	f.CallSignature.printType(pp, v)
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
 func (f *Module) printType(w writer, v bool) {
 	pp := w.Indent()
 
 	pp.WriteString("Module {")
 
+	if f.Hashbang != nil {
+		pp.WriteString("\nHashbang: ")
+		f.Hashbang.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nHashbang: nil")
+	}
+
 	if f.ModuleListItems == nil {
 		pp.WriteString("\nModuleListItems: nil")
 	} else if len(f.ModuleListItems) > 0 {
@@ -2409,30 +3139,44 @@ func (f *NamedImports) printType(w writer, v bool) {
 	w.WriteString("\n}")
 }
 
-func (f *NewExpression) printType(w writer, v bool) {
+func (f *NamespaceDeclaration) printType(w writer, v bool) {
 	pp := w.Indent()
 
-	pp.WriteString("NewExpression {")
+	pp.WriteString("NamespaceDeclaration {")
 
-	if f.News == nil {
-		pp.WriteString("\nNews: nil")
-	} else if len(f.News) > 0 {
-		pp.WriteString("\nNews: [")
+	if f.NamespaceName == nil {
+		pp.WriteString("\nNamespaceName: nil")
+	} else if len(f.NamespaceName) > 0 {
+		pp.WriteString("\nNamespaceName: [")
 
 		ipp := pp.Indent()
 
-		for n, e := range f.News {
+		for n, e := range f.NamespaceName {
 			ipp.Printf("\n%d: ", n)
 			e.printType(ipp, v)
 		}
 
 		pp.WriteString("\n]")
 	} else if v {
-		pp.WriteString("\nNews: []")
+		pp.WriteString("\nNamespaceName: []")
 	}
 
-	pp.WriteString("\nMemberExpression: ")
-	f.MemberExpression.printType(pp, v)
+	if f.ModuleListItems == nil {
+		pp.WriteString("\nModuleListItems: nil")
+	} else if len(f.ModuleListItems) > 0 {
+		pp.WriteString("\nModuleListItems: [")
+
+		ipp := pp.Indent()
+
+		for n, e := range f.ModuleListItems {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
+
+		pp.WriteString("\n]")
+	} else if v {
+		pp.WriteString("\nModuleListItems: []")
+	}
 
 	pp.WriteString("\nTokens: ")
 	f.Tokens.printType(pp, v)
@@ -2440,13 +3184,44 @@ func (f *NewExpression) printType(w writer, v bool) {
 	w.WriteString("\n}")
 }
 
-func (f *ObjectAssignmentPattern) printType(w writer, v bool) {
+func (f *NewExpression) printType(w writer, v bool) {
 	pp := w.Indent()
 
-	pp.WriteString("ObjectAssignmentPattern {")
+	pp.WriteString("NewExpression {")
 
-	if f.AssignmentPropertyList == nil {
-		pp.WriteString("\nAssignmentPropertyList: nil")
+	if f.News == nil {
+		pp.WriteString("\nNews: nil")
+	} else if len(f.News) > 0 {
+		pp.WriteString("\nNews: [")
+
+		ipp := pp.Indent()
+
+		for n, e := range f.News {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
+
+		pp.WriteString("\n]")
+	} else if v {
+		pp.WriteString("\nNews: []")
+	}
+
+	pp.WriteString("\nMemberExpression: ")
+	f.MemberExpression.printType(pp, v)
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
+func (f *ObjectAssignmentPattern) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("ObjectAssignmentPattern {")
+
+	if f.AssignmentPropertyList == nil {
+		pp.WriteString("\nAssignmentPropertyList: nil")
 	} else if len(f.AssignmentPropertyList) > 0 {
 		pp.WriteString("\nAssignmentPropertyList: [")
 
@@ -2571,6 +3346,34 @@ func (f *ObjectLiteral) printType(w writer, v bool) {
 	w.WriteString("\n}")
 }
 
+func (f *ObjectType) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("ObjectType {")
+
+	if f.TypeMembers == nil {
+		pp.WriteString("\nTypeMembers: nil")
+	} else if len(f.TypeMembers) > 0 {
+		pp.WriteString("\nTypeMembers: [")
+
+		ipp := pp.Indent()
+
+		for n, e := range f.TypeMembers {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
+
+		pp.WriteString("\n]")
+	} else if v {
+		pp.WriteString("\nTypeMembers: []")
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
 func (f *OptionalChain) printType(w writer, v bool) {
 	pp := w.Indent()
 
@@ -2670,6 +3473,84 @@ func (f *OptionalExpression) printType(w writer, v bool) {
 	w.WriteString("\n}")
 }
 
+func (f *Parameter) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("Parameter {")
+
+	if f.Accessibility != nil {
+		pp.WriteString("\nAccessibility: ")
+		f.Accessibility.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nAccessibility: nil")
+	}
+
+	if f.Identifier != nil {
+		pp.WriteString("\nIdentifier: ")
+		f.Identifier.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nIdentifier: nil")
+	}
+
+	if f.Optional || v {
+		pp.Printf("\nOptional: %v", f.Optional)
+	}
+
+	if f.TypeAnnotation != nil {
+		pp.WriteString("\nTypeAnnotation: ")
+		f.TypeAnnotation.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeAnnotation: nil")
+	}
+
+	if f.Initializer != nil {
+		pp.WriteString("\nInitializer: ")
+		f.Initializer.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nInitializer: nil")
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
+func (f *ParameterList) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("ParameterList {")
+
+	if f.Parameters == nil {
+		pp.WriteString("\nParameters: nil")
+	} else if len(f.Parameters) > 0 {
+		pp.WriteString("\nParameters: [")
+
+		ipp := pp.Indent()
+
+		for n, e := range f.Parameters {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
+
+		pp.WriteString("\n]")
+	} else if v {
+		pp.WriteString("\nParameters: []")
+	}
+
+	if f.RestParameter != nil {
+		pp.WriteString("\nRestParameter: ")
+		f.RestParameter.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nRestParameter: nil")
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
 func (f *ParenthesizedExpression) printType(w writer, v bool) {
 	pp := w.Indent()
 
@@ -2709,6 +3590,46 @@ func (f *ParenthesizedExpression) printType(w writer, v bool) {
 	w.WriteString("\n}")
 }
 
+func (f *PostfixType) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("PostfixType {")
+
+	if f.PrimaryType != nil {
+		pp.WriteString("\nPrimaryType: ")
+		f.PrimaryType.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nPrimaryType: nil")
+	}
+
+	if f.PostfixType != nil {
+		pp.WriteString("\nPostfixType: ")
+		f.PostfixType.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nPostfixType: nil")
+	}
+
+	if f.IndexType != nil {
+		pp.WriteString("\nIndexType: ")
+		f.IndexType.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nIndexType: nil")
+	}
+
+	if f.Array || v {
+		pp.Printf("\nArray: %v", f.Array)
+	}
+
+	if f.NonNull || v {
+		pp.Printf("\nNonNull: %v", f.NonNull)
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
 func (f *PrimaryExpression) printType(w writer, v bool) {
 	pp := w.Indent()
 
@@ -2797,6 +3718,94 @@ func (f *PrimaryExpression) printType(w writer, v bool) {
 	w.WriteString("\n}")
 }
 
+func (f *PrimaryType) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("PrimaryType {")
+
+	if f.LiteralType != nil {
+		pp.WriteString("\nLiteralType: ")
+		f.LiteralType.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nLiteralType: nil")
+	}
+
+	if f.TemplateLiteralType != nil {
+		pp.WriteString("\nTemplateLiteralType: ")
+		f.TemplateLiteralType.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTemplateLiteralType: nil")
+	}
+
+	if f.ParenthesizedType != nil {
+		pp.WriteString("\nParenthesizedType: ")
+		f.ParenthesizedType.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nParenthesizedType: nil")
+	}
+
+	if f.PredefinedType != nil {
+		pp.WriteString("\nPredefinedType: ")
+		f.PredefinedType.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nPredefinedType: nil")
+	}
+
+	if f.ObjectType != nil {
+		pp.WriteString("\nObjectType: ")
+		f.ObjectType.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nObjectType: nil")
+	}
+
+	if f.MappedType != nil {
+		pp.WriteString("\nMappedType: ")
+		f.MappedType.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nMappedType: nil")
+	}
+
+	if f.TupleType != nil {
+		pp.WriteString("\nTupleType: ")
+		f.TupleType.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTupleType: nil")
+	}
+
+	if f.This != nil {
+		pp.WriteString("\nThis: ")
+		f.This.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nThis: nil")
+	}
+
+	if f.ImportType != nil {
+		pp.WriteString("\nImportType: ")
+		f.ImportType.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nImportType: nil")
+	}
+
+	if f.TypeQuery != nil {
+		pp.WriteString("\nTypeQuery: ")
+		f.TypeQuery.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeQuery: nil")
+	}
+
+	if f.TypeReference != nil {
+		pp.WriteString("\nTypeReference: ")
+		f.TypeReference.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeReference: nil")
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
 func (f *PropertyDefinition) printType(w writer, v bool) {
 	pp := w.Indent()
 
@@ -2880,6 +3889,48 @@ func (f *PropertyName) printType(w writer, v bool) {
 	w.WriteString("\n}")
 }
 
+func (f *PropertySignature) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("PropertySignature {")
+
+	if f.Modifiers == nil {
+		pp.WriteString("\nModifiers: nil")
+	} else if len(f.Modifiers) > 0 {
+		pp.WriteString("\nModifiers: [")
+
+		ipp := pp.Indent()
+
+		for n, e := range f.Modifiers {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
+
+		pp.WriteString("\n]")
+	} else if v {
+		pp.WriteString("\nModifiers: []")
+	}
+
+	pp.WriteString("\nPropertyName: ")
+	f.PropertyName.printType(pp, v)
+
+	if f.Optional || v {
+		pp.Printf("\nOptional: %v", f.Optional)
+	}
+
+	if f.TypeAnnotation != nil {
+		pp.WriteString("\nTypeAnnotation: ")
+		f.TypeAnnotation.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeAnnotation: nil")
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
 func (f *RelationalExpression) printType(w writer, v bool) {
 	pp := w.Indent()
 
@@ -2927,6 +3978,16 @@ func (f *Script) printType(w writer, v bool) {
 
 	pp.WriteString("Script {")
 
+	pp.WriteString("\nDirectivePrologue: ")
+	f.DirectivePrologue.printType(pp, v)
+
+	if f.Hashbang != nil {
+		pp.WriteString("\nHashbang: ")
+		f.Hashbang.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nHashbang: nil")
+	}
+
 	if f.StatementList == nil {
 		pp.WriteString("\nStatementList: nil")
 	} else if len(f.StatementList) > 0 {
@@ -3084,6 +4145,20 @@ func (f *Statement) printType(w writer, v bool) {
 		pp.WriteString("\nTryStatement: nil")
 	}
 
+	if f.EnumDeclaration != nil {
+		pp.WriteString("\nEnumDeclaration: ")
+		f.EnumDeclaration.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nEnumDeclaration: nil")
+	}
+
+	if f.NamespaceDeclaration != nil {
+		pp.WriteString("\nNamespaceDeclaration: ")
+		f.NamespaceDeclaration.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nNamespaceDeclaration: nil")
+	}
+
 	pp.WriteString("\nComments: [")
 
 	ipp := pp.Indent()
@@ -3279,52 +4354,111 @@ func (f *TemplateLiteral) printType(w writer, v bool) {
 	w.WriteString("\n}")
 }
 
-func (f *TryStatement) printType(w writer, v bool) {
+func (f *TemplateLiteralType) printType(w writer, v bool) {
 	pp := w.Indent()
 
-	pp.WriteString("TryStatement {")
-
-	pp.WriteString("\nTryBlock: ")
-	f.TryBlock.printType(pp, v)
+	pp.WriteString("TemplateLiteralType {")
 
-	if f.CatchParameterBindingIdentifier != nil {
-		pp.WriteString("\nCatchParameterBindingIdentifier: ")
-		f.CatchParameterBindingIdentifier.printType(pp, v)
+	if f.TemplateHead != nil {
+		pp.WriteString("\nTemplateHead: ")
+		f.TemplateHead.printType(pp, v)
 	} else if v {
-		pp.WriteString("\nCatchParameterBindingIdentifier: nil")
+		pp.WriteString("\nTemplateHead: nil")
 	}
 
-	if f.CatchParameterObjectBindingPattern != nil {
-		pp.WriteString("\nCatchParameterObjectBindingPattern: ")
-		f.CatchParameterObjectBindingPattern.printType(pp, v)
-	} else if v {
-		pp.WriteString("\nCatchParameterObjectBindingPattern: nil")
-	}
+	if f.Types == nil {
+		pp.WriteString("\nTypes: nil")
+	} else if len(f.Types) > 0 {
+		pp.WriteString("\nTypes: [")
 
-	if f.CatchParameterArrayBindingPattern != nil {
-		pp.WriteString("\nCatchParameterArrayBindingPattern: ")
-		f.CatchParameterArrayBindingPattern.printType(pp, v)
-	} else if v {
-		pp.WriteString("\nCatchParameterArrayBindingPattern: nil")
-	}
+		ipp := pp.Indent()
 
-	if f.CatchBlock != nil {
-		pp.WriteString("\nCatchBlock: ")
-		f.CatchBlock.printType(pp, v)
-	} else if v {
-		pp.WriteString("\nCatchBlock: nil")
-	}
+		for n, e := range f.Types {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
 
-	if f.FinallyBlock != nil {
-		pp.WriteString("\nFinallyBlock: ")
-		f.FinallyBlock.printType(pp, v)
+		pp.WriteString("\n]")
 	} else if v {
-		pp.WriteString("\nFinallyBlock: nil")
+		pp.WriteString("\nTypes: []")
 	}
 
-	pp.WriteString("\nComments: [")
-
-	ipp := pp.Indent()
+	if f.TemplateMiddleList == nil {
+		pp.WriteString("\nTemplateMiddleList: nil")
+	} else if len(f.TemplateMiddleList) > 0 {
+		pp.WriteString("\nTemplateMiddleList: [")
+
+		ipp := pp.Indent()
+
+		for n, e := range f.TemplateMiddleList {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
+
+		pp.WriteString("\n]")
+	} else if v {
+		pp.WriteString("\nTemplateMiddleList: []")
+	}
+
+	if f.TemplateTail != nil {
+		pp.WriteString("\nTemplateTail: ")
+		f.TemplateTail.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTemplateTail: nil")
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
+func (f *TryStatement) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("TryStatement {")
+
+	pp.WriteString("\nTryBlock: ")
+	f.TryBlock.printType(pp, v)
+
+	if f.CatchParameterBindingIdentifier != nil {
+		pp.WriteString("\nCatchParameterBindingIdentifier: ")
+		f.CatchParameterBindingIdentifier.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nCatchParameterBindingIdentifier: nil")
+	}
+
+	if f.CatchParameterObjectBindingPattern != nil {
+		pp.WriteString("\nCatchParameterObjectBindingPattern: ")
+		f.CatchParameterObjectBindingPattern.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nCatchParameterObjectBindingPattern: nil")
+	}
+
+	if f.CatchParameterArrayBindingPattern != nil {
+		pp.WriteString("\nCatchParameterArrayBindingPattern: ")
+		f.CatchParameterArrayBindingPattern.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nCatchParameterArrayBindingPattern: nil")
+	}
+
+	if f.CatchBlock != nil {
+		pp.WriteString("\nCatchBlock: ")
+		f.CatchBlock.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nCatchBlock: nil")
+	}
+
+	if f.FinallyBlock != nil {
+		pp.WriteString("\nFinallyBlock: ")
+		f.FinallyBlock.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nFinallyBlock: nil")
+	}
+
+	pp.WriteString("\nComments: [")
+
+	ipp := pp.Indent()
 
 	for n, e := range f.Comments {
 		ipp.Printf("\n%d: ", n)
@@ -3339,6 +4473,401 @@ func (f *TryStatement) printType(w writer, v bool) {
 	w.WriteString("\n}")
 }
 
+func (f *TupleElement) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("TupleElement {")
+
+	if f.Spread || v {
+		pp.Printf("\nSpread: %v", f.Spread)
+	}
+
+	if f.Label != nil {
+		pp.WriteString("\nLabel: ")
+		f.Label.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nLabel: nil")
+	}
+
+	if f.Optional || v {
+		pp.Printf("\nOptional: %v", f.Optional)
+	}
+
+	pp.WriteString("\nType: ")
+	f.Type.printType(pp, v)
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
+func (f *TupleType) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("TupleType {")
+
+	if f.TupleElements == nil {
+		pp.WriteString("\nTupleElements: nil")
+	} else if len(f.TupleElements) > 0 {
+		pp.WriteString("\nTupleElements: [")
+
+		ipp := pp.Indent()
+
+		for n, e := range f.TupleElements {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
+
+		pp.WriteString("\n]")
+	} else if v {
+		pp.WriteString("\nTupleElements: []")
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
+func (f *TypeAliasDeclaration) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("TypeAliasDeclaration {")
+
+	if f.BindingIdentifier != nil {
+		pp.WriteString("\nBindingIdentifier: ")
+		f.BindingIdentifier.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nBindingIdentifier: nil")
+	}
+
+	if f.TypeParameters != nil {
+		pp.WriteString("\nTypeParameters: ")
+		f.TypeParameters.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeParameters: nil")
+	}
+
+	pp.WriteString("\nType: ")
+	f.Type.printType(pp, v)
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
+func (f *TypeAnnotation) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("TypeAnnotation {")
+
+	if f.TypePredicate != nil {
+		pp.WriteString("\nTypePredicate: ")
+		f.TypePredicate.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypePredicate: nil")
+	}
+
+	pp.WriteString("\nType: ")
+	f.Type.printType(pp, v)
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
+func (f *TypeArguments) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("TypeArguments {")
+
+	if f.TypeArguments == nil {
+		pp.WriteString("\nTypeArguments: nil")
+	} else if len(f.TypeArguments) > 0 {
+		pp.WriteString("\nTypeArguments: [")
+
+		ipp := pp.Indent()
+
+		for n, e := range f.TypeArguments {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
+
+		pp.WriteString("\n]")
+	} else if v {
+		pp.WriteString("\nTypeArguments: []")
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
+func (f *TypeExpression) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("TypeExpression {")
+
+	if f.FunctionTypeExpression != nil {
+		pp.WriteString("\nFunctionTypeExpression: ")
+		f.FunctionTypeExpression.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nFunctionTypeExpression: nil")
+	}
+
+	if f.UnionType != nil {
+		pp.WriteString("\nUnionType: ")
+		f.UnionType.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nUnionType: nil")
+	}
+
+	if f.ExtendsType != nil {
+		pp.WriteString("\nExtendsType: ")
+		f.ExtendsType.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nExtendsType: nil")
+	}
+
+	if f.TrueType != nil {
+		pp.WriteString("\nTrueType: ")
+		f.TrueType.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTrueType: nil")
+	}
+
+	if f.FalseType != nil {
+		pp.WriteString("\nFalseType: ")
+		f.FalseType.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nFalseType: nil")
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
+func (f *TypeMember) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("TypeMember {")
+
+	if f.CallSignature != nil {
+		pp.WriteString("\nCallSignature: ")
+		f.CallSignature.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nCallSignature: nil")
+	}
+
+	if f.ConstructSignature != nil {
+		pp.WriteString("\nConstructSignature: ")
+		f.ConstructSignature.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nConstructSignature: nil")
+	}
+
+	if f.PropertySignature != nil {
+		pp.WriteString("\nPropertySignature: ")
+		f.PropertySignature.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nPropertySignature: nil")
+	}
+
+	if f.MethodSignature != nil {
+		pp.WriteString("\nMethodSignature: ")
+		f.MethodSignature.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nMethodSignature: nil")
+	}
+
+	if f.IndexSignature != nil {
+		pp.WriteString("\nIndexSignature: ")
+		f.IndexSignature.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nIndexSignature: nil")
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
+func (f *TypeOperator) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("TypeOperator {")
+
+	pp.WriteString("\nOperator: ")
+	f.Operator.printType(pp, v)
+
+	if f.TypeOperator != nil {
+		pp.WriteString("\nTypeOperator: ")
+		f.TypeOperator.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeOperator: nil")
+	}
+
+	if f.InferIdentifier != nil {
+		pp.WriteString("\nInferIdentifier: ")
+		f.InferIdentifier.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nInferIdentifier: nil")
+	}
+
+	if f.PostfixType != nil {
+		pp.WriteString("\nPostfixType: ")
+		f.PostfixType.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nPostfixType: nil")
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
+func (f *TypeParameter) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("TypeParameter {")
+
+	if f.Const || v {
+		pp.Printf("\nConst: %v", f.Const)
+	}
+
+	if f.Identifier != nil {
+		pp.WriteString("\nIdentifier: ")
+		f.Identifier.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nIdentifier: nil")
+	}
+
+	if f.Constraint != nil {
+		pp.WriteString("\nConstraint: ")
+		f.Constraint.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nConstraint: nil")
+	}
+
+	if f.Default != nil {
+		pp.WriteString("\nDefault: ")
+		f.Default.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nDefault: nil")
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
+func (f *TypeParameters) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("TypeParameters {")
+
+	if f.TypeParameters == nil {
+		pp.WriteString("\nTypeParameters: nil")
+	} else if len(f.TypeParameters) > 0 {
+		pp.WriteString("\nTypeParameters: [")
+
+		ipp := pp.Indent()
+
+		for n, e := range f.TypeParameters {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
+
+		pp.WriteString("\n]")
+	} else if v {
+		pp.WriteString("\nTypeParameters: []")
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
+func (f *TypeQuery) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("TypeQuery {")
+
+	if f.EntityName == nil {
+		pp.WriteString("\nEntityName: nil")
+	} else if len(f.EntityName) > 0 {
+		pp.WriteString("\nEntityName: [")
+
+		ipp := pp.Indent()
+
+		for n, e := range f.EntityName {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
+
+		pp.WriteString("\n]")
+	} else if v {
+		pp.WriteString("\nEntityName: []")
+	}
+
+	if f.TypeArguments != nil {
+		pp.WriteString("\nTypeArguments: ")
+		f.TypeArguments.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeArguments: nil")
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
+func (f *TypeReference) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("TypeReference {")
+
+	if f.TypeName == nil {
+		pp.WriteString("\nTypeName: nil")
+	} else if len(f.TypeName) > 0 {
+		pp.WriteString("\nTypeName: [")
+
+		ipp := pp.Indent()
+
+		for n, e := range f.TypeName {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
+
+		pp.WriteString("\n]")
+	} else if v {
+		pp.WriteString("\nTypeName: []")
+	}
+
+	if f.TypeArguments != nil {
+		pp.WriteString("\nTypeArguments: ")
+		f.TypeArguments.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nTypeArguments: nil")
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
 func (f *UnaryExpression) printType(w writer, v bool) {
 	pp := w.Indent()
 
@@ -3384,6 +4913,34 @@ func (f *UnaryOperatorComments) printType(w writer, v bool) {
 	w.WriteString("\n}")
 }
 
+func (f *UnionType) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("UnionType {")
+
+	if f.IntersectionTypes == nil {
+		pp.WriteString("\nIntersectionTypes: nil")
+	} else if len(f.IntersectionTypes) > 0 {
+		pp.WriteString("\nIntersectionTypes: [")
+
+		ipp := pp.Indent()
+
+		for n, e := range f.IntersectionTypes {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
+
+		pp.WriteString("\n]")
+	} else if v {
+		pp.WriteString("\nIntersectionTypes: []")
+	}
+
+	pp.WriteString("\nTokens: ")
+	f.Tokens.printType(pp, v)
+
+	w.WriteString("\n}")
+}
+
 func (f *UpdateExpression) printType(w writer, v bool) {
 	pp := w.Indent()
 
@@ -3437,6 +4994,20 @@ func (f *VariableStatement) printType(w writer, v bool) {
 		pp.WriteString("\nVariableDeclarationList: []")
 	}
 
+	if f.EnumDeclaration != nil {
+		pp.WriteString("\nEnumDeclaration: ")
+		f.EnumDeclaration.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nEnumDeclaration: nil")
+	}
+
+	if f.NamespaceDeclaration != nil {
+		pp.WriteString("\nNamespaceDeclaration: ")
+		f.NamespaceDeclaration.printType(pp, v)
+	} else if v {
+		pp.WriteString("\nNamespaceDeclaration: nil")
+	}
+
 	pp.WriteString("\nTokens: ")
 	f.Tokens.printType(pp, v)
 
@@ -0,0 +1,18 @@
+// Package tokens contains helpers for the Tokens of parsed JavaScript types.
+package tokens
+
+import "vimagination.zapto.org/javascript"
+
+// First returns the first of the given tokens that is not whitespace, a line
+// terminator, or a comment, or nil if there is no such token.
+func First(tks javascript.Tokens) *javascript.Token {
+	for n := range tks {
+		switch tks[n].Type {
+		case javascript.TokenWhitespace, javascript.TokenLineTerminator, javascript.TokenSingleLineComment, javascript.TokenMultiLineComment:
+		default:
+			return &tks[n]
+		}
+	}
+
+	return nil
+}
@@ -20,7 +20,7 @@ func AsJSX(t Tokeniser) Tokeniser {
 	ts, _ := tokeniserFlags(t)
 	jsx := &jsx{Tokeniser: t}
 
-	jsx.TokeniserState((&jsTokeniser{isTypescript: ts, isJSX: true}).inputElement)
+	jsx.TokeniserState((&jsTokeniser{isTypescript: ts, isJSX: true}).hashbang)
 
 	return jsx
 }
@@ -218,30 +218,6 @@ func (jn *JSXElementName) parse(j *jsParser) error {
 	return nil
 }
 
-func (jn *JSXElementName) equal(cn *JSXElementName) bool {
-	if !jn.Identifier.equal(cn.Identifier) || !jn.Namespace.equal(cn.Namespace) || len(jn.MemberExpression) != len(cn.MemberExpression) {
-		return false
-	}
-
-	for n := range jn.MemberExpression {
-		if !jn.MemberExpression[n].equal(cn.MemberExpression[n].Token) {
-			return false
-		}
-	}
-
-	return true
-}
-
-func (t *Token) equal(s *Token) bool {
-	if t == nil {
-		return s == nil
-	} else if s == nil {
-		return false
-	}
-
-	return t.Data == s.Data
-}
-
 type CommentsToken struct {
 	Comments [2]Comments
 	*Token
@@ -0,0 +1,721 @@
+package jsx
+
+import (
+	"html"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"vimagination.zapto.org/javascript"
+	"vimagination.zapto.org/javascript/walk"
+	"vimagination.zapto.org/parser"
+)
+
+const (
+	defaultImportSource = "react"
+	defaultFactory      = "React.createElement"
+	defaultFragment     = "React.Fragment"
+	jsxRuntime          = "/jsx-runtime"
+	jsxDevRuntime       = "/jsx-dev-runtime"
+)
+
+type options struct {
+	importSource      string
+	classic           bool
+	factory, fragment string
+	development       bool
+	fileName          string
+}
+
+// Option is an option for the JSX transformers.
+type Option func(*options)
+
+// ImportSource sets the module from which the runtime functions are imported.
+//
+// The default import source is "react", which imports the functions from
+// "react/jsx-runtime".
+//
+// This option is ignored by Process.
+func ImportSource(source string) Option {
+	return func(o *options) {
+		o.importSource = source
+	}
+}
+
+// Development enables the development transform, which adds the source
+// location of each element, using the given file name, and the value of
+// `this` at the element, for use by debugging tools.
+//
+// For the automatic runtime, the jsxDEV function is imported from the
+// jsx-dev-runtime module of the import source, and is called with the tag,
+// props, key, whether the children are static, the source location, and
+// `this`.
+//
+// For the classic runtime, and for Process, the props are given `__self` and
+// `__source` properties, with the source being an object containing the
+// fileName, lineNumber and columnNumber.
+//
+// Within the constructor of a derived class, where `this` cannot be accessed
+// before calling super, `this` is omitted.
+func Development(fileName string) Option {
+	return func(o *options) {
+		o.development = true
+		o.fileName = fileName
+	}
+}
+
+// Automatic transforms any JSX within the given parsed Module into calls to
+// the automatic JSX runtime, as used by React 17 and later.
+//
+// Elements are transformed into calls to the jsx function, or jsxs for
+// elements with multiple children, which are imported from the jsx-runtime
+// module of the import source. The children are passed in the props, with any
+// key being passed as a separate argument. Fragments use the imported Fragment
+// component.
+//
+// When a key follows a spread attribute, the createElement function from the
+// import source is used instead, to preserve the ordering of the props.
+//
+// A children attribute is dropped when the element has children, which take
+// its place. Spread children, such as <a>{...b}</a>, are not supported by
+// either runtime and return ErrSpreadChildren.
+//
+// The following pragmas in the leading comments of the Module override the
+// given options:
+//
+//	@jsxImportSource: Sets the import source.
+//	@jsxRuntime:      Set to 'classic' to use the classic runtime.
+//	@jsx:             Sets the factory function for the classic runtime; default: React.createElement.
+//	@jsxFrag:         Sets the fragment component for the classic runtime; default: React.Fragment.
+//
+// The classic runtime calls the factory function with the tag, the props, or
+// null, and then each child as a separate argument.
+//
+// Any required imports will be added to the Module, with import bindings
+// being potentially renamed on a clash.
+func Automatic(m *javascript.Module, opts ...Option) error {
+	j := &jsxTransformer{
+		options: options{
+			importSource: defaultImportSource,
+			factory:      defaultFactory,
+			fragment:     defaultFragment,
+		},
+		runtime: true,
+		imports: make(map[string]struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(&j.options)
+	}
+
+	j.pragmas(m.Comments[0])
+
+	if err := walk.Walk(m, j); err != nil {
+		return err
+	}
+
+	return j.resolveImports(m)
+}
+
+func (o *options) pragmas(comments javascript.Comments) {
+	for pragma, value := range pragmas(comments) {
+		switch pragma {
+		case "@jsxImportSource":
+			o.importSource = value
+		case "@jsxRuntime":
+			o.classic = value == "classic"
+		case "@jsx":
+			o.factory = value
+		case "@jsxFrag":
+			o.fragment = value
+		}
+	}
+}
+
+func pragmas(comments javascript.Comments) map[string]string {
+	values := make(map[string]string)
+
+	for _, c := range comments {
+		text := c.Data
+
+		if c.Type == javascript.TokenSingleLineComment {
+			text = strings.TrimPrefix(text, "//")
+		} else {
+			text = strings.TrimSuffix(strings.TrimPrefix(text, "/*"), "*/")
+		}
+
+		fields := strings.FieldsFunc(text, func(r rune) bool {
+			return r == '*' || unicode.IsSpace(r)
+		})
+
+		for n, field := range fields {
+			if strings.HasPrefix(field, "@jsx") && n+1 < len(fields) {
+				values[field] = fields[n+1]
+			}
+		}
+	}
+
+	return values
+}
+
+func (j *jsxTransformer) runtimeElement(e *javascript.JSXElement) (*javascript.CallExpression, error) {
+	if e.ElementName.Identifier == nil {
+		return nil, javascript.ErrMissingIdentifier
+	}
+
+	tag := elementTag(e.ElementName)
+	attrs := e.Attributes
+	spread := false
+
+	var key *javascript.AssignmentExpression
+
+	for n, attr := range e.Attributes {
+		if attr.Identifier == nil {
+			spread = true
+		} else if attr.Namespace == nil && attr.Identifier.Data == "key" && !spread && !j.classic {
+			ae, err := j.paramTo(attr)
+			if err != nil {
+				return nil, err
+			}
+
+			key = ae
+			attrs = append(attrs[:n:n], e.Attributes[n+1:]...)
+
+			break
+		}
+	}
+
+	children, err := j.runtimeChildren(e.Children, e.Tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(children) > 0 && !j.classic {
+		attrs = withoutChildren(attrs)
+	}
+
+	var props *javascript.ObjectLiteral
+
+	if len(attrs) > 0 || !j.classic || j.numDevProps(e.Tokens) > 0 {
+		if props, err = j.paramsToObject(attrs); err != nil {
+			return nil, err
+		}
+	}
+
+	if j.classic {
+		return j.classicCall(j.factoryExpression(), tag, j.addDevProps(props, e.Tokens), children), nil
+	} else if spread && hasKey(attrs) {
+		return j.classicCall(j.importedFunction("createElement", j.importSource), tag, j.addDevProps(props, e.Tokens), children), nil
+	}
+
+	return j.runtimeCall(tag, props, children, key, e.Tokens), nil
+}
+
+func hasKey(attrs []javascript.JSXAttribute) bool {
+	for _, attr := range attrs {
+		if attr.Identifier != nil && attr.Namespace == nil && attr.Identifier.Data == "key" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// withoutChildren removes any children attribute, which is overridden by the
+// children of the element.
+func withoutChildren(attrs []javascript.JSXAttribute) []javascript.JSXAttribute {
+	filtered := make([]javascript.JSXAttribute, 0, len(attrs))
+
+	for _, attr := range attrs {
+		if attr.Identifier == nil || attr.Namespace != nil || attr.Identifier.Data != "children" {
+			filtered = append(filtered, attr)
+		}
+	}
+
+	return filtered
+}
+
+func (j *jsxTransformer) runtimeFragment(children []javascript.JSXChild, tokens javascript.Tokens) (*javascript.CallExpression, error) {
+	elements, err := j.runtimeChildren(children, tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	if j.classic {
+		return j.classicCall(j.factoryExpression(), identifierExpression(j.fragment), nil, elements), nil
+	}
+
+	return j.runtimeCall(j.importedFunction("Fragment", j.runtimeSource()), new(javascript.ObjectLiteral), elements, nil, tokens), nil
+}
+
+func (j *jsxTransformer) runtimeSource() string {
+	if j.development {
+		return j.importSource + jsxDevRuntime
+	}
+
+	return j.importSource + jsxRuntime
+}
+
+func (j *jsxTransformer) factoryExpression() *javascript.MemberExpression {
+	return identifierExpression(j.factory)
+}
+
+func (j *jsxTransformer) importedFunction(name, from string) *javascript.MemberExpression {
+	j.imports[from] = struct{}{}
+
+	return identifierExpression("\x00" + name + "\x00" + from)
+}
+
+func (j *jsxTransformer) runtimeCall(tag *javascript.MemberExpression, props *javascript.ObjectLiteral, children []javascript.ArrayElement, key *javascript.AssignmentExpression, tokens javascript.Tokens) *javascript.CallExpression {
+	fn := "jsx"
+
+	switch len(children) {
+	case 0:
+	case 1:
+		props.PropertyDefinitionList = append(props.PropertyDefinitionList, property("children", children[0].AssignmentExpression))
+	default:
+		fn = "jsxs"
+
+		props.PropertyDefinitionList = append(props.PropertyDefinitionList, property("children", javascript.AssignmentExpression{
+			ConditionalExpression: javascript.WrapConditional(&javascript.ArrayLiteral{
+				ElementList: children,
+			}),
+		}))
+	}
+
+	args := []javascript.Argument{
+		{AssignmentExpression: memberToAssignment(tag)},
+		{AssignmentExpression: javascript.AssignmentExpression{ConditionalExpression: javascript.WrapConditional(props)}},
+	}
+
+	if j.development {
+		return j.runtimeDevCall(args, key, fn == "jsxs", tokens)
+	} else if key != nil {
+		args = append(args, javascript.Argument{AssignmentExpression: *key})
+	}
+
+	return &javascript.CallExpression{
+		MemberExpression: j.importedFunction(fn, j.runtimeSource()),
+		Arguments: &javascript.Arguments{
+			ArgumentList: args,
+		},
+	}
+}
+
+func (j *jsxTransformer) runtimeDevCall(args []javascript.Argument, key *javascript.AssignmentExpression, static bool, tokens javascript.Tokens) *javascript.CallExpression {
+	if key == nil {
+		void := javascript.WrapConditional(literalExpression(javascript.TokenNumericLiteral, "0"))
+		void.LogicalORExpression.LogicalANDExpression.BitwiseORExpression.BitwiseXORExpression.BitwiseANDExpression.EqualityExpression.RelationalExpression.ShiftExpression.AdditiveExpression.MultiplicativeExpression.ExponentiationExpression.UnaryExpression.UnaryOperators = []javascript.UnaryOperatorComments{{UnaryOperator: javascript.UnaryVoid}}
+		key = &javascript.AssignmentExpression{ConditionalExpression: void}
+	}
+
+	args = append(args, javascript.Argument{AssignmentExpression: *key}, javascript.Argument{AssignmentExpression: memberToAssignment(literalExpression(javascript.TokenBooleanLiteral, strconv.FormatBool(static)))})
+
+	if source := j.source(tokens); source != nil {
+		args = append(args, javascript.Argument{AssignmentExpression: javascript.AssignmentExpression{ConditionalExpression: javascript.WrapConditional(source)}})
+
+		if !j.noSelf {
+			args = append(args, javascript.Argument{AssignmentExpression: memberToAssignment(thisExpression())})
+		}
+	}
+
+	return &javascript.CallExpression{
+		MemberExpression: j.importedFunction("jsxDEV", j.runtimeSource()),
+		Arguments: &javascript.Arguments{
+			ArgumentList: args,
+		},
+	}
+}
+
+func (j *jsxTransformer) numDevProps(tokens javascript.Tokens) int {
+	if !j.development {
+		return 0
+	}
+
+	n := 0
+
+	if !j.noSelf {
+		n++
+	}
+
+	if len(tokens) > 0 {
+		n++
+	}
+
+	return n
+}
+
+func (j *jsxTransformer) addDevProps(props *javascript.ObjectLiteral, tokens javascript.Tokens) *javascript.ObjectLiteral {
+	if !j.development {
+		return props
+	}
+
+	if props == nil {
+		props = new(javascript.ObjectLiteral)
+	}
+
+	if !j.noSelf {
+		props.PropertyDefinitionList = append(props.PropertyDefinitionList, property("__self", memberToAssignment(thisExpression())))
+	}
+
+	if source := j.source(tokens); source != nil {
+		props.PropertyDefinitionList = append(props.PropertyDefinitionList, property("__source", javascript.AssignmentExpression{ConditionalExpression: javascript.WrapConditional(source)}))
+	}
+
+	return props
+}
+
+func (j *jsxTransformer) source(tokens javascript.Tokens) *javascript.ObjectLiteral {
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	return &javascript.ObjectLiteral{
+		PropertyDefinitionList: []javascript.PropertyDefinition{
+			property("fileName", memberToAssignment(literalExpression(javascript.TokenStringLiteral, strconv.Quote(j.fileName)))),
+			property("lineNumber", memberToAssignment(literalExpression(javascript.TokenNumericLiteral, strconv.FormatUint(tokens[0].Line+1, 10)))),
+			property("columnNumber", memberToAssignment(literalExpression(javascript.TokenNumericLiteral, strconv.FormatUint(tokens[0].LinePos+1, 10)))),
+		},
+	}
+}
+
+func (j *jsxTransformer) classicCall(factory, tag *javascript.MemberExpression, props *javascript.ObjectLiteral, children []javascript.ArrayElement) *javascript.CallExpression {
+	args := make([]javascript.Argument, 2, len(children)+2)
+	args[0] = javascript.Argument{AssignmentExpression: memberToAssignment(tag)}
+
+	if props == nil {
+		args[1] = javascript.Argument{AssignmentExpression: memberToAssignment(literalExpression(javascript.TokenNullLiteral, "null"))}
+	} else {
+		args[1] = javascript.Argument{AssignmentExpression: javascript.AssignmentExpression{ConditionalExpression: javascript.WrapConditional(props)}}
+	}
+
+	for _, child := range children {
+		args = append(args, javascript.Argument{
+			AssignmentExpression: child.AssignmentExpression,
+			Comments:             child.Comments,
+			Tokens:               child.Tokens,
+		})
+	}
+
+	return &javascript.CallExpression{
+		MemberExpression: factory,
+		Arguments: &javascript.Arguments{
+			ArgumentList: args,
+		},
+	}
+}
+
+func (j *jsxTransformer) runtimeChildren(children []javascript.JSXChild, tokens javascript.Tokens) ([]javascript.ArrayElement, error) {
+	children = mergeJSXText(children, tokens)
+	elements := make([]javascript.ArrayElement, 0, len(children))
+
+	for _, child := range children {
+		var ae javascript.AssignmentExpression
+
+		if child.Spread {
+			return nil, ErrSpreadChildren
+		} else if child.JSXText != nil {
+			text := cleanJSXText(child.JSXText.Data)
+			if text == "" {
+				continue
+			}
+
+			ae = memberToAssignment(&javascript.MemberExpression{
+				PrimaryExpression: &javascript.PrimaryExpression{
+					Literal: &javascript.Token{
+						Token: parser.Token{
+							Type: javascript.TokenStringLiteral,
+							Data: strconv.Quote(text),
+						},
+						Pos:     child.JSXText.Pos,
+						Line:    child.JSXText.Line,
+						LinePos: child.JSXText.LinePos,
+					},
+					Tokens: child.Tokens,
+				},
+				Tokens: child.Tokens,
+			})
+		} else if child.JSXElement != nil {
+			ce, err := j.runtimeElement(child.JSXElement)
+			if err != nil {
+				return nil, err
+			}
+
+			ae.ConditionalExpression = javascript.WrapConditional(ce)
+		} else if child.JSXFragment != nil {
+			ce, err := j.runtimeFragment(child.JSXFragment.Children, child.JSXFragment.Tokens)
+			if err != nil {
+				return nil, err
+			}
+
+			ae.ConditionalExpression = javascript.WrapConditional(ce)
+		} else if child.JSXChildExpression != nil {
+			ae = *child.JSXChildExpression
+		} else {
+			continue
+		}
+
+		ae.Tokens = child.Tokens
+
+		elements = append(elements, javascript.ArrayElement{
+			AssignmentExpression: ae,
+			Comments:             child.Comments,
+			Tokens:               child.Tokens,
+		})
+	}
+
+	return elements, nil
+}
+
+// mergeJSXText restores the whitespace, taken from the tokens of the parent
+// element, that surrounds the children, joining it with any adjacent JSXText
+// children so that the text can be cleaned as a whole.
+func mergeJSXText(children []javascript.JSXChild, tokens javascript.Tokens) []javascript.JSXChild {
+	merged := make([]javascript.JSXChild, 0, len(children))
+	end := -1
+
+	for _, child := range children {
+		if len(child.Tokens) == 0 {
+			merged = append(merged, child)
+
+			continue
+		}
+
+		if start := tokenPos(tokens, &child.Tokens[0]); start >= 0 {
+			ws := start
+
+			for ws > 0 && isJSXWhitespace(tokens[ws-1]) {
+				ws--
+			}
+
+			merged = appendJSXText(merged, whitespaceChild(tokens[ws:start]))
+			end = start + len(child.Tokens)
+		} else {
+			end = -1
+		}
+
+		if child.JSXText == nil {
+			merged = append(merged, child)
+		} else {
+			merged = appendJSXText(merged, child)
+		}
+	}
+
+	if end >= 0 {
+		ws := end
+
+		for ws < len(tokens) && isJSXWhitespace(tokens[ws]) {
+			ws++
+		}
+
+		merged = appendJSXText(merged, whitespaceChild(tokens[end:ws]))
+	}
+
+	return merged
+}
+
+func isJSXWhitespace(tk javascript.Token) bool {
+	return tk.Type == javascript.TokenWhitespace || tk.Type == javascript.TokenLineTerminator
+}
+
+func whitespaceChild(tokens javascript.Tokens) javascript.JSXChild {
+	if len(tokens) == 0 {
+		return javascript.JSXChild{}
+	}
+
+	var sb strings.Builder
+
+	for _, tk := range tokens {
+		sb.WriteString(tk.Data)
+	}
+
+	return javascript.JSXChild{
+		JSXText: &javascript.Token{
+			Token: parser.Token{
+				Type: javascript.TokenJSXText,
+				Data: sb.String(),
+			},
+			Pos:     tokens[0].Pos,
+			Line:    tokens[0].Line,
+			LinePos: tokens[0].LinePos,
+		},
+		Tokens: tokens,
+	}
+}
+
+func appendJSXText(children []javascript.JSXChild, child javascript.JSXChild) []javascript.JSXChild {
+	if child.JSXText == nil {
+		return children
+	}
+
+	last := len(children) - 1
+	if last < 0 || children[last].JSXText == nil {
+		return append(children, child)
+	}
+
+	text := *children[last].JSXText
+	text.Data += child.JSXText.Data
+	tokens := children[last].Tokens
+	children[last].JSXText = &text
+	children[last].Tokens = append(tokens[:len(tokens):len(tokens)], child.Tokens...)
+
+	return children
+}
+
+func cleanJSXText(text string) string {
+	lines := strings.Split(strings.ReplaceAll(strings.ReplaceAll(text, "\r\n", "\n"), "\r", "\n"), "\n")
+	lastNonEmpty := 0
+
+	for n, line := range lines {
+		if strings.Trim(line, " \t") != "" {
+			lastNonEmpty = n
+		}
+	}
+
+	var sb strings.Builder
+
+	for n, line := range lines {
+		line = strings.ReplaceAll(line, "\t", " ")
+
+		if n > 0 {
+			line = strings.TrimLeft(line, " ")
+		}
+
+		if n < len(lines)-1 {
+			line = strings.TrimRight(line, " ")
+		}
+
+		if line != "" {
+			sb.WriteString(line)
+
+			if n != lastNonEmpty {
+				sb.WriteString(" ")
+			}
+		}
+	}
+
+	return html.UnescapeString(sb.String())
+}
+
+func elementTag(name javascript.JSXElementName) *javascript.MemberExpression {
+	if name.Namespace != nil {
+		return stringExpression(name.Namespace.Data+":"+name.Identifier.Data, name.Tokens)
+	} else if len(name.MemberExpression) == 0 {
+		if first := name.Identifier.Data[0]; first >= 'a' && first <= 'z' || strings.ContainsRune(name.Identifier.Data, '-') {
+			return stringExpression(name.Identifier.Data, name.Tokens)
+		}
+	}
+
+	me := &javascript.MemberExpression{
+		PrimaryExpression: &javascript.PrimaryExpression{
+			IdentifierReference: name.Identifier,
+			Tokens:              name.Tokens,
+		},
+		Tokens: name.Tokens,
+	}
+
+	for _, ct := range name.MemberExpression {
+		me = &javascript.MemberExpression{
+			MemberExpression: me,
+			IdentifierName:   ct.Token,
+			Tokens:           name.Tokens,
+		}
+	}
+
+	return me
+}
+
+func literalExpression(typ parser.TokenType, data string) *javascript.MemberExpression {
+	return &javascript.MemberExpression{
+		PrimaryExpression: &javascript.PrimaryExpression{
+			Literal: &javascript.Token{
+				Token: parser.Token{
+					Type: typ,
+					Data: data,
+				},
+			},
+		},
+	}
+}
+
+func thisExpression() *javascript.MemberExpression {
+	return &javascript.MemberExpression{
+		PrimaryExpression: &javascript.PrimaryExpression{
+			This: &javascript.Token{
+				Token: parser.Token{
+					Type: javascript.TokenKeyword,
+					Data: "this",
+				},
+			},
+		},
+	}
+}
+
+func stringExpression(str string, tokens javascript.Tokens) *javascript.MemberExpression {
+	return &javascript.MemberExpression{
+		PrimaryExpression: &javascript.PrimaryExpression{
+			Literal: &javascript.Token{
+				Token: parser.Token{
+					Type: javascript.TokenStringLiteral,
+					Data: strconv.Quote(str),
+				},
+			},
+			Tokens: tokens,
+		},
+		Tokens: tokens,
+	}
+}
+
+func identifierExpression(name string) *javascript.MemberExpression {
+	var me *javascript.MemberExpression
+
+	if strings.HasPrefix(name, "\x00") {
+		return &javascript.MemberExpression{
+			PrimaryExpression: &javascript.PrimaryExpression{
+				IdentifierReference: identifierToken(name),
+			},
+		}
+	}
+
+	for _, part := range strings.Split(name, ".") {
+		if me == nil {
+			me = &javascript.MemberExpression{
+				PrimaryExpression: &javascript.PrimaryExpression{
+					IdentifierReference: identifierToken(part),
+				},
+			}
+		} else {
+			me = &javascript.MemberExpression{
+				MemberExpression: me,
+				IdentifierName:   identifierToken(part),
+			}
+		}
+	}
+
+	return me
+}
+
+func identifierToken(name string) *javascript.Token {
+	return &javascript.Token{
+		Token: parser.Token{
+			Type: javascript.TokenIdentifier,
+			Data: name,
+		},
+	}
+}
+
+func property(name string, ae javascript.AssignmentExpression) javascript.PropertyDefinition {
+	return javascript.PropertyDefinition{
+		PropertyName: &javascript.PropertyName{
+			LiteralPropertyName: identifierToken(name),
+		},
+		AssignmentExpression: &ae,
+	}
+}
+
+func memberToAssignment(me *javascript.MemberExpression) javascript.AssignmentExpression {
+	return javascript.AssignmentExpression{
+		ConditionalExpression: javascript.WrapConditional(me),
+		Tokens:                me.Tokens,
+	}
+}
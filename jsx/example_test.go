@@ -39,3 +39,123 @@ func Example() {
 	// 	return (createElement("div", {"id":"example"}, ["Hello, World"]))
 	// }
 }
+
+func ExampleAutomatic() {
+	js := `function MyElement() {
+	return <div id="example">Hello, <b>World</b></div>
+}`
+
+	tk := parser.NewStringTokeniser(js)
+
+	m, err := javascript.ParseModule(javascript.AsJSX(&tk))
+	if err != nil {
+		fmt.Println("unexpected error: ", err)
+
+		return
+	}
+
+	if err = jsx.Automatic(m); err != nil {
+		fmt.Println("unexpected error: ", err)
+
+		return
+	}
+
+	fmt.Printf("%s", m)
+
+	// Output:
+	// import {jsx, jsxs} from "react/jsx-runtime";
+	//
+	// function MyElement() {
+	// 	return (jsxs("div", {"id": "example", children: ["Hello, ", jsx("b", {children: "World"})]}));
+	// }
+}
+
+func ExampleHTML() {
+	js := `function Email(name) {
+	return <p>Hello, <b>{name}</b></p>
+}`
+
+	tk := parser.NewStringTokeniser(js)
+
+	m, err := javascript.ParseModule(javascript.AsJSX(&tk))
+	if err != nil {
+		fmt.Println("unexpected error: ", err)
+
+		return
+	}
+
+	if err = jsx.HTML(m); err != nil {
+		fmt.Println("unexpected error: ", err)
+
+		return
+	}
+
+	fmt.Printf("%s", m.ModuleListItems[len(m.ModuleListItems)-1])
+
+	// Output:
+	// function Email(name) {
+	// 	return (new RawHTML(`<p>Hello, <b>${escapeHTML(name)}</b></p>`));
+	// }
+}
+
+func ExampleHTML_component() {
+	js := `function Item(text) {
+	return <li>{text}</li>;
+}
+
+function List(items) {
+	return <ul>{items.map(Item)}</ul>;
+}`
+
+	tk := parser.NewStringTokeniser(js)
+
+	m, err := javascript.ParseModule(javascript.AsJSX(&tk))
+	if err != nil {
+		fmt.Println("unexpected error: ", err)
+
+		return
+	}
+
+	if err = jsx.HTML(m); err != nil {
+		fmt.Println("unexpected error: ", err)
+
+		return
+	}
+
+	for _, mi := range m.ModuleListItems[len(m.ModuleListItems)-2:] {
+		fmt.Printf("%s\n", mi)
+	}
+
+	// Output:
+	// function Item(text) {
+	// 	return (new RawHTML(`<li>${escapeHTML(text)}</li>`));
+	// }
+	// function List(items) {
+	// 	return (new RawHTML(`<ul>${escapeHTML(items.map(Item))}</ul>`));
+	// }
+}
+
+func ExampleValidate() {
+	js := `const a = <div id="a" id="b">
+	<svg:div />
+</div>`
+
+	tk := parser.NewStringTokeniser(js)
+
+	m, err := javascript.ParseModule(javascript.AsJSX(&tk))
+	if err != nil {
+		fmt.Println("unexpected error: ", err)
+
+		return
+	}
+
+	for _, err := range jsx.Validate(m) {
+		fmt.Println(err)
+	}
+
+	// Output:
+	// JSXAttribute: error at position 23 (1:23):
+	// duplicate attribute
+	// JSXElementName: error at position 37 (2:7):
+	// name does not match namespace
+}
@@ -0,0 +1,476 @@
+package jsx
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"vimagination.zapto.org/javascript"
+	"vimagination.zapto.org/javascript/scope"
+	"vimagination.zapto.org/javascript/walk"
+	"vimagination.zapto.org/parser"
+)
+
+const (
+	escapeHelper = "escapeHTML"
+	attrHelper   = "attrHTML"
+	innerHelper  = "innerHTML"
+	rawHelper    = "RawHTML"
+)
+
+type helper struct {
+	source string
+	uses   []string
+}
+
+var (
+	voidElements      = [...]string{"area", "base", "br", "col", "embed", "hr", "img", "input", "link", "meta", "param", "source", "track", "wbr"}
+	booleanAttributes = [...]string{"allowfullscreen", "async", "autofocus", "autoplay", "checked", "controls", "default", "defer", "disabled", "formnovalidate", "hidden", "inert", "ismap", "itemscope", "loop", "multiple", "muted", "nomodule", "novalidate", "open", "playsinline", "readonly", "required", "reversed", "selected"}
+	attributeNames    = map[string]string{
+		"className":       "class",
+		"htmlFor":         "for",
+		"acceptCharset":   "accept-charset",
+		"httpEquiv":       "http-equiv",
+		"allowFullScreen": "allowfullscreen",
+		"autoFocus":       "autofocus",
+		"autoPlay":        "autoplay",
+		"formNoValidate":  "formnovalidate",
+		"itemScope":       "itemscope",
+		"noModule":        "nomodule",
+		"noValidate":      "novalidate",
+		"playsInline":     "playsinline",
+		"readOnly":        "readonly",
+	}
+	helpers = map[string]helper{
+		escapeHelper: {"function %[1]s(v) {\n\treturn v == null || typeof v === \"boolean\" ? \"\" : Array.isArray(v) ? v.map(%[1]s).join(\"\") : v instanceof %[2]s ? v.html : String(v).replace(/[&<>\"']/g, c => \"&\" + {\"&\": \"amp\", \"<\": \"lt\", \">\": \"gt\", \"\\\"\": \"quot\", \"'\": \"#39\"}[c] + \";\");\n}", []string{rawHelper}},
+		attrHelper:   {"function %[1]s(name, v) {\n\tif (v == null || typeof v === \"function\" || typeof v === \"symbol\") {\n\t\treturn \"\";\n\t}\n\n\tif (name === \"style\" && typeof v === \"object\") {\n\t\tv = Object.entries(v).filter(([, s]) => s != null && typeof s !== \"boolean\" && s !== \"\").map(([p, s]) => (p.startsWith(\"--\") ? p : p.replace(/[A-Z]/g, c => \"-\" + c.toLowerCase()).replace(/^ms-/, \"-ms-\")) + \":\" + (typeof s === \"number\" && s !== 0 && !p.startsWith(\"--\") && !/^(animationIterationCount|aspectRatio|columnCount|columns|fillOpacity|flex|flexGrow|flexShrink|fontWeight|gridArea|gridColumn|gridRow|lineClamp|lineHeight|opacity|order|orphans|scale|stopOpacity|strokeOpacity|strokeWidth|tabSize|widows|zIndex|zoom)$/.test(p) ? s + \"px\" : s)).join(\";\");\n\n\t\tif (v === \"\") {\n\t\t\treturn \"\";\n\t\t}\n\t}\n\n\treturn \" \" + name + \"=\\\"\" + String(v).replace(/[&<>\"']/g, c => \"&\" + {\"&\": \"amp\", \"<\": \"lt\", \">\": \"gt\", \"\\\"\": \"quot\", \"'\": \"#39\"}[c] + \";\") + \"\\\"\";\n}", nil},
+		innerHelper:  {"function %[1]s(v) {\n\treturn v == null || v.__html == null ? \"\" : String(v.__html);\n}", nil},
+		rawHelper:    {"class %[1]s {\n\tconstructor(html) {\n\t\tthis.html = html;\n\t}\n\n\ttoString() {\n\t\treturn this.html;\n\t}\n}", nil},
+	}
+	htmlEscaper     = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\"", "&quot;")
+	templateEscaper = strings.NewReplacer("\\", "\\\\", "`", "\\`", "$", "\\$")
+)
+
+type htmlTransformer struct {
+	helpers map[string]struct{}
+}
+
+// HTML transforms any JSX within the given parsed Module into instances of a
+// RawHTML helper class, holding template literals that produce the HTML of the
+// elements, for use in server-side rendering.
+//
+// Only intrinsic elements, those with a lowercase or hyphenated name, can be
+// rendered; components and spread attributes will cause an error.
+//
+// Text and static attribute values are escaped during the transformation,
+// while expressions are escaped at runtime by an escapeHTML helper function,
+// which will be added to the Module when needed. The helper renders null,
+// undefined and boolean values as empty, and renders arrays as the
+// concatenation of their escaped elements.
+//
+// The RawHTML class, which is private to the Module, marks its HTML as already
+// escaped, so that JSX returned by one function, such as a component, is not
+// escaped again when included in another element. Its toString method returns
+// the HTML, so that it can otherwise be used as a string.
+//
+// The following attributes are handled specially:
+//
+//	className, htmlFor:      Renamed to class and for, respectively, along
+//	                         with acceptCharset and httpEquiv.
+//	key, ref:                Removed.
+//	dangerouslySetInnerHTML: The `__html` property of the value is used as the
+//	                         unescaped contents of the element.
+//	on*:                     Event handlers cannot be rendered, so cause an
+//	                         error when given an expression.
+//
+// Boolean attributes, such as disabled and checked, are rendered without a
+// value when their expression is truthy, and are omitted otherwise. Other
+// attributes with expression values are rendered by an attrHTML helper
+// function, which omits the attribute when the value is null, undefined, a
+// function, or a symbol, and which serialises a style object as CSS
+// declarations.
+//
+// Void elements, such as br and img, are rendered without a closing tag, and
+// cannot have children. Empty SVG and MathML elements are self-closed.
+func HTML(m *javascript.Module) error {
+	h := &htmlTransformer{helpers: make(map[string]struct{})}
+
+	if err := walk.Walk(m, h); err != nil {
+		return err
+	}
+
+	return h.addHelpers(m)
+}
+
+func (h *htmlTransformer) Handle(t javascript.Type) error {
+	if err := walk.Walk(t, h); err != nil {
+		return err
+	}
+
+	if me, ok := t.(*javascript.MemberExpression); ok && me.PrimaryExpression != nil && (me.PrimaryExpression.JSXElement != nil || me.PrimaryExpression.JSXFragment != nil) {
+		pe, err := h.render(me.PrimaryExpression)
+		if err != nil {
+			return err
+		}
+
+		me.PrimaryExpression = pe
+	}
+
+	return nil
+}
+
+func (h *htmlTransformer) render(pe *javascript.PrimaryExpression) (*javascript.PrimaryExpression, error) {
+	b := htmlBuilder{htmlTransformer: h}
+
+	if pe.JSXElement != nil {
+		if err := b.element(pe.JSXElement, false); err != nil {
+			return nil, err
+		}
+	} else if err := b.children(pe.JSXFragment.Children, pe.JSXFragment.Tokens, false); err != nil {
+		return nil, err
+	}
+
+	tl := b.templateLiteral(pe.Tokens)
+
+	return &javascript.PrimaryExpression{
+		ParenthesizedExpression: &javascript.ParenthesizedExpression{
+			Expressions: []javascript.AssignmentExpression{
+				memberToAssignment(&javascript.MemberExpression{
+					MemberExpression: h.helper(rawHelper),
+					Arguments: &javascript.Arguments{
+						ArgumentList: []javascript.Argument{
+							{AssignmentExpression: javascript.AssignmentExpression{ConditionalExpression: javascript.WrapConditional(tl)}},
+						},
+					},
+				}),
+			},
+			Tokens: pe.Tokens,
+		},
+		Tokens: pe.Tokens,
+	}, nil
+}
+
+func (h *htmlTransformer) helper(name string) *javascript.MemberExpression {
+	h.helpers[name] = struct{}{}
+
+	for _, use := range helpers[name].uses {
+		h.helper(use)
+	}
+
+	return identifierExpression("\x00" + name)
+}
+
+func (h *htmlTransformer) addHelpers(m *javascript.Module) error {
+	if len(h.helpers) == 0 {
+		return nil
+	}
+
+	s, err := scope.Build(m, nil)
+	if err != nil {
+		return fmt.Errorf("error building scope: %w", err)
+	}
+
+	pos := 0
+
+	for pos < len(m.ModuleListItems) && m.ModuleListItems[pos].ImportDeclaration != nil {
+		pos++
+	}
+
+	names := make(map[string]string, len(h.helpers))
+
+	for helper := range h.helpers {
+		num := 0
+		name := helper
+
+		for s.IdentifierInUse(name) {
+			num++
+			name = helper + "_" + strconv.Itoa(num)
+		}
+
+		s.Rename("\x00"+helper, name)
+
+		names[helper] = name
+	}
+
+	for _, helper := range slices.Sorted(maps.Keys(h.helpers)) {
+		args := []any{names[helper]}
+
+		for _, use := range helpers[helper].uses {
+			args = append(args, names[use])
+		}
+
+		tk := parser.NewStringTokeniser(fmt.Sprintf(helpers[helper].source, args...))
+
+		fn, err := javascript.ParseModule(&tk)
+		if err != nil {
+			return fmt.Errorf("error while parsing helper function: %w", err)
+		}
+
+		m.ModuleListItems = slices.Insert(m.ModuleListItems, pos, fn.ModuleListItems...)
+		pos += len(fn.ModuleListItems)
+	}
+
+	return nil
+}
+
+type htmlBuilder struct {
+	*htmlTransformer
+	sb     strings.Builder
+	quasis []string
+	exprs  []javascript.Expression
+}
+
+func (b *htmlBuilder) write(str string) {
+	templateEscaper.WriteString(&b.sb, str)
+}
+
+func (b *htmlBuilder) expression(ae javascript.AssignmentExpression) {
+	b.quasis = append(b.quasis, b.sb.String())
+	b.exprs = append(b.exprs, javascript.Expression{
+		Expressions: []javascript.AssignmentExpression{ae},
+	})
+
+	b.sb.Reset()
+}
+
+func (b *htmlBuilder) templateLiteral(tokens javascript.Tokens) *javascript.TemplateLiteral {
+	quasis := append(b.quasis, b.sb.String())
+
+	if len(b.exprs) == 0 {
+		return &javascript.TemplateLiteral{
+			NoSubstitutionTemplate: templateToken(javascript.TokenNoSubstitutionTemplate, "`"+quasis[0]+"`"),
+			Tokens:                 tokens,
+		}
+	}
+
+	tl := &javascript.TemplateLiteral{
+		TemplateHead: templateToken(javascript.TokenTemplateHead, "`"+quasis[0]+"${"),
+		Expressions:  b.exprs,
+		TemplateTail: templateToken(javascript.TokenTemplateTail, "}"+quasis[len(quasis)-1]+"`"),
+		Tokens:       tokens,
+	}
+
+	for _, quasi := range quasis[1 : len(quasis)-1] {
+		tl.TemplateMiddleList = append(tl.TemplateMiddleList, templateToken(javascript.TokenTemplateMiddle, "}"+quasi+"${"))
+	}
+
+	return tl
+}
+
+func templateToken(typ parser.TokenType, data string) *javascript.Token {
+	return &javascript.Token{
+		Token: parser.Token{
+			Type: typ,
+			Data: data,
+		},
+	}
+}
+
+func (b *htmlBuilder) element(e *javascript.JSXElement, foreign bool) error {
+	if e.ElementName.Identifier == nil {
+		return javascript.ErrMissingIdentifier
+	}
+
+	name := e.ElementName.Identifier.Data
+
+	if e.ElementName.Namespace != nil {
+		name = e.ElementName.Namespace.Data + ":" + name
+	} else if first := name[0]; len(e.ElementName.MemberExpression) > 0 || (first < 'a' || first > 'z') && !strings.ContainsRune(name, '-') {
+		return fmt.Errorf("%w: %s", ErrNotIntrinsic, name)
+	}
+
+	inHTML, inSVG, inMathML := nsIn(e.ElementName.Identifier)
+	foreign = foreign || e.ElementName.Namespace != nil || (inSVG || inMathML) && !inHTML
+
+	b.write("<" + name)
+
+	var inner *javascript.AssignmentExpression
+
+	for _, attr := range e.Attributes {
+		if ae, err := b.attribute(attr); err != nil {
+			return err
+		} else if ae != nil {
+			inner = ae
+		}
+	}
+
+	if !foreign && slices.Contains(voidElements[:], name) {
+		if inner != nil || hasChildren(e.Children) {
+			return fmt.Errorf("%w: %s", ErrVoidElementChildren, name)
+		}
+
+		b.write(">")
+
+		return nil
+	} else if foreign && inner == nil && !hasChildren(e.Children) {
+		b.write("/>")
+
+		return nil
+	}
+
+	b.write(">")
+
+	if inner != nil {
+		b.expression(b.call(innerHelper, *inner))
+	} else if err := b.children(e.Children, e.Tokens, foreign && name != "foreignObject"); err != nil {
+		return err
+	}
+
+	b.write("</" + name + ">")
+
+	return nil
+}
+
+func hasChildren(children []javascript.JSXChild) bool {
+	for _, child := range children {
+		if child.JSXElement != nil || child.JSXFragment != nil || child.JSXChildExpression != nil || child.JSXText != nil && cleanJSXText(child.JSXText.Data) != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (b *htmlBuilder) attribute(attr javascript.JSXAttribute) (*javascript.AssignmentExpression, error) {
+	if attr.Identifier == nil {
+		return nil, ErrSpreadAttribute
+	}
+
+	name := attr.Identifier.Data
+
+	if attr.Namespace != nil {
+		name = attr.Namespace.Data + ":" + name
+	} else if mapped, ok := attributeNames[name]; ok {
+		name = mapped
+	}
+
+	switch name {
+	case "key", "ref":
+		return nil, nil
+	case "dangerouslySetInnerHTML":
+		if attr.AssignmentExpression == nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidAttribute, name)
+		}
+
+		return attr.AssignmentExpression, nil
+	}
+
+	if attr.JSXElement != nil || attr.JSXFragment != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAttribute, name)
+	} else if attr.AssignmentExpression != nil && len(name) > 2 && strings.HasPrefix(strings.ToLower(name), "on") {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAttribute, name)
+	} else if attr.JSXString != nil {
+		str, err := javascript.UnescapeJSXString(attr.JSXString.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		b.staticAttribute(name, str)
+	} else if attr.AssignmentExpression == nil {
+		b.write(" " + name)
+	} else if value, typ := literalValue(*attr.AssignmentExpression); typ == javascript.TokenNullLiteral {
+	} else if typ == javascript.TokenBooleanLiteral && slices.Contains(booleanAttributes[:], name) {
+		if value == "true" {
+			b.write(" " + name)
+		}
+	} else if typ == javascript.TokenStringLiteral || typ == javascript.TokenNumericLiteral || typ == javascript.TokenBooleanLiteral {
+		b.staticAttribute(name, value)
+	} else if slices.Contains(booleanAttributes[:], name) {
+		b.expression(javascript.AssignmentExpression{
+			ConditionalExpression: &javascript.ConditionalExpression{
+				LogicalORExpression: logicalOR(*attr.AssignmentExpression),
+				True:                &javascript.AssignmentExpression{ConditionalExpression: javascript.WrapConditional(stringExpression(" "+name, nil))},
+				False:               &javascript.AssignmentExpression{ConditionalExpression: javascript.WrapConditional(stringExpression("", nil))},
+			},
+		})
+	} else {
+		b.expression(b.call(attrHelper, memberToAssignment(stringExpression(name, nil)), *attr.AssignmentExpression))
+	}
+
+	return nil, nil
+}
+
+func (b *htmlBuilder) staticAttribute(name, value string) {
+	b.write(" " + name + "=\"" + htmlEscaper.Replace(value) + "\"")
+}
+
+func logicalOR(ae javascript.AssignmentExpression) *javascript.LogicalORExpression {
+	if ae.AssignmentOperator == javascript.AssignmentNone && ae.ConditionalExpression != nil && ae.ConditionalExpression.True == nil && ae.ConditionalExpression.LogicalORExpression != nil {
+		return ae.ConditionalExpression.LogicalORExpression
+	}
+
+	return javascript.WrapConditional(&javascript.ParenthesizedExpression{
+		Expressions: []javascript.AssignmentExpression{ae},
+	}).LogicalORExpression
+}
+
+func (b *htmlBuilder) call(helper string, args ...javascript.AssignmentExpression) javascript.AssignmentExpression {
+	ce := &javascript.CallExpression{
+		MemberExpression: b.helper(helper),
+		Arguments:        new(javascript.Arguments),
+	}
+
+	for _, arg := range args {
+		ce.Arguments.ArgumentList = append(ce.Arguments.ArgumentList, javascript.Argument{AssignmentExpression: arg})
+	}
+
+	return javascript.AssignmentExpression{ConditionalExpression: javascript.WrapConditional(ce)}
+}
+
+func (b *htmlBuilder) escape(ae javascript.AssignmentExpression) javascript.AssignmentExpression {
+	return b.call(escapeHelper, ae)
+}
+
+func (b *htmlBuilder) children(children []javascript.JSXChild, tokens javascript.Tokens, foreign bool) error {
+	for _, child := range mergeJSXText(children, tokens) {
+		if child.JSXText != nil {
+			b.write(htmlEscaper.Replace(cleanJSXText(child.JSXText.Data)))
+		} else if child.JSXElement != nil {
+			if err := b.element(child.JSXElement, foreign); err != nil {
+				return err
+			}
+		} else if child.JSXFragment != nil {
+			if err := b.children(child.JSXFragment.Children, child.JSXFragment.Tokens, foreign); err != nil {
+				return err
+			}
+		} else if child.JSXChildExpression != nil {
+			b.childExpression(*child.JSXChildExpression)
+		}
+	}
+
+	return nil
+}
+
+func (b *htmlBuilder) childExpression(ae javascript.AssignmentExpression) {
+	switch value, typ := literalValue(ae); typ {
+	case javascript.TokenStringLiteral, javascript.TokenNumericLiteral:
+		b.write(htmlEscaper.Replace(value))
+	case javascript.TokenBooleanLiteral, javascript.TokenNullLiteral:
+	default:
+		b.expression(b.escape(ae))
+	}
+}
+
+func literalValue(ae javascript.AssignmentExpression) (string, parser.TokenType) {
+	pe, ok := javascript.UnwrapConditional(ae.ConditionalExpression).(*javascript.PrimaryExpression)
+	if !ok || ae.AssignmentOperator != javascript.AssignmentNone || pe.Literal == nil {
+		return "", parser.TokenError
+	}
+
+	switch pe.Literal.Type {
+	case javascript.TokenStringLiteral:
+		if str, err := javascript.Unquote(pe.Literal.Data); err == nil {
+			return str, javascript.TokenStringLiteral
+		}
+	case javascript.TokenNumericLiteral:
+		if strings.Trim(pe.Literal.Data, "0123456789") == "" && (pe.Literal.Data == "0" || pe.Literal.Data[0] != '0') {
+			return pe.Literal.Data, javascript.TokenNumericLiteral
+		}
+	case javascript.TokenBooleanLiteral, javascript.TokenNullLiteral:
+		return pe.Literal.Data, pe.Literal.Type
+	}
+
+	return "", parser.TokenError
+}
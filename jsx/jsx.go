@@ -24,7 +24,11 @@ const (
 )
 
 type jsxTransformer struct {
+	options
 	tmpl      *template.Template
+	runtime   bool
+	noSelf    bool
+	derived   bool
 	namespace string
 	imports   map[string]struct{}
 }
@@ -37,6 +41,12 @@ func (j *jsxTransformer) Handle(t javascript.Type) error {
 		}
 
 		defer j.setNamespace(t.ElementName)()
+	case *javascript.ClassDeclaration:
+		defer j.setDerived(t.ClassHeritage != nil)()
+	case *javascript.MethodDefinition:
+		defer j.setNoSelf(j.derived && t.Type == javascript.MethodNormal && t.ClassElementName.PropertyName != nil && t.ClassElementName.PropertyName.LiteralPropertyName != nil && t.ClassElementName.PropertyName.LiteralPropertyName.Data == "constructor")()
+	case *javascript.FunctionDeclaration:
+		defer j.setNoSelf(false)()
 	}
 
 	if err := walk.Walk(t, j); err != nil {
@@ -58,6 +68,20 @@ func (j *jsxTransformer) Handle(t javascript.Type) error {
 	return nil
 }
 
+func (j *jsxTransformer) setDerived(derived bool) func() {
+	d := j.derived
+	j.derived = derived
+
+	return func() { j.derived = d }
+}
+
+func (j *jsxTransformer) setNoSelf(noSelf bool) func() {
+	ns := j.noSelf
+	j.noSelf = noSelf
+
+	return func() { j.noSelf = ns }
+}
+
 func (j *jsxTransformer) setNamespace(name javascript.JSXElementName) func() {
 	ns := j.namespace
 
@@ -75,8 +99,33 @@ func (j *jsxTransformer) setNamespace(name javascript.JSXElementName) func() {
 }
 
 func (j *jsxTransformer) handlePrimaryExpression(t *javascript.PrimaryExpression) (*javascript.PrimaryExpression, error) {
-	if t.JSXElement != nil {
+	if j.runtime && (t.JSXElement != nil || t.JSXFragment != nil) {
+		var (
+			ce  *javascript.CallExpression
+			err error
+		)
+
+		if t.JSXElement != nil {
+			ce, err = j.runtimeElement(t.JSXElement)
+		} else {
+			ce, err = j.runtimeFragment(t.JSXFragment.Children, t.JSXFragment.Tokens)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &javascript.PrimaryExpression{
+			ParenthesizedExpression: &javascript.ParenthesizedExpression{
+				Expressions: []javascript.AssignmentExpression{{ConditionalExpression: javascript.WrapConditional(ce)}},
+				Tokens:      t.Tokens,
+			},
+			Tokens: t.Tokens,
+		}, nil
+	} else if t.JSXElement != nil {
 		return j.transform(t.JSXElement)
+	} else if t.JSXFragment != nil && j.fragment != "" {
+		return j.transform(j.fragmentElement(t.JSXFragment))
 	} else if t.JSXFragment != nil {
 		al, err := j.childrenToArray(t.JSXFragment.Children)
 		if err != nil {
@@ -127,6 +176,16 @@ func (j *jsxTransformer) childToAE(t javascript.JSXChild) (javascript.Assignment
 			return javascript.AssignmentExpression{}, err
 		}
 
+		return javascript.AssignmentExpression{
+			ConditionalExpression: javascript.WrapConditional(pe),
+			Tokens:                pe.Tokens,
+		}, nil
+	} else if t.JSXFragment != nil && j.fragment != "" {
+		pe, err := j.transform(j.fragmentElement(t.JSXFragment))
+		if err != nil {
+			return javascript.AssignmentExpression{}, err
+		}
+
 		return javascript.AssignmentExpression{
 			ConditionalExpression: javascript.WrapConditional(pe),
 			Tokens:                pe.Tokens,
@@ -184,6 +243,7 @@ func (j *jsxTransformer) transform(e *javascript.JSXElement) (*javascript.Primar
 	defer j.setNamespace(e.ElementName)()
 
 	inHTML, inSVG, inMathML := nsIn(e.ElementName.Identifier)
+	numParams := len(e.Attributes) + j.numDevProps(e.Tokens)
 
 	var sb strings.Builder
 
@@ -192,9 +252,9 @@ func (j *jsxTransformer) transform(e *javascript.JSXElement) (*javascript.Primar
 		InHTML:            inHTML,
 		InSVG:             inSVG,
 		InMathML:          inMathML,
-		HasParams:         len(e.Attributes) > 0,
+		HasParams:         numParams > 0,
 		HasChildren:       len(e.Children) > 0,
-		NumParams:         len(e.Attributes),
+		NumParams:         numParams,
 		NumChildren:       len(e.Children),
 		HasParamNamespace: hasParamNamespace(e.Attributes),
 	}); err != nil {
@@ -211,6 +271,24 @@ func (j *jsxTransformer) transform(e *javascript.JSXElement) (*javascript.Primar
 	return j.process(e, m)
 }
 
+func (j *jsxTransformer) fragmentElement(f *javascript.JSXFragment) *javascript.JSXElement {
+	parts := strings.Split(j.fragment, ".")
+	name := javascript.JSXElementName{
+		Identifier: identifierToken(parts[0]),
+	}
+
+	for _, part := range parts[1:] {
+		name.MemberExpression = append(name.MemberExpression, javascript.CommentsToken{Token: identifierToken(part)})
+	}
+
+	return &javascript.JSXElement{
+		ElementName: name,
+		Children:    f.Children,
+		Comments:    [4]javascript.Comments{f.Comments[0], nil, f.Comments[1], f.Comments[2]},
+		Tokens:      f.Tokens,
+	}
+}
+
 func nsIn(name *javascript.Token) (bool, bool, bool) {
 	return slices.Contains(htmlElements[:], name.Data), slices.Contains(svgElements[:], name.Data), slices.Contains(mathMLElement[:], name.Data)
 }
@@ -346,6 +424,8 @@ func (j *jsxTransformer) replaceParamsAndChildren(m *javascript.Module, e *javas
 						return err
 					}
 
+					j.addDevProps(ol, e.Tokens)
+
 					first := 0
 					last := len(e.Tokens) - 1
 
@@ -488,7 +568,27 @@ func (j *jsxTransformer) paramsToObject(attrs []javascript.JSXAttribute) (*javas
 }
 
 func (j *jsxTransformer) paramTo(t javascript.JSXAttribute) (*javascript.AssignmentExpression, error) {
-	if t.JSXElement != nil {
+	if j.runtime && (t.JSXElement != nil || t.JSXFragment != nil) {
+		var (
+			ce  *javascript.CallExpression
+			err error
+		)
+
+		if t.JSXElement != nil {
+			ce, err = j.runtimeElement(t.JSXElement)
+		} else {
+			ce, err = j.runtimeFragment(t.JSXFragment.Children, t.JSXFragment.Tokens)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &javascript.AssignmentExpression{
+			ConditionalExpression: javascript.WrapConditional(ce),
+			Tokens:                t.Tokens,
+		}, nil
+	} else if t.JSXElement != nil {
 		pe, err := j.transform(t.JSXElement)
 		if err != nil {
 			return nil, err
@@ -496,6 +596,18 @@ func (j *jsxTransformer) paramTo(t javascript.JSXAttribute) (*javascript.Assignm
 
 		ce := javascript.WrapConditional(pe)
 
+		return &javascript.AssignmentExpression{
+			ConditionalExpression: ce,
+			Tokens:                ce.Tokens,
+		}, nil
+	} else if t.JSXFragment != nil && j.fragment != "" {
+		pe, err := j.transform(j.fragmentElement(t.JSXFragment))
+		if err != nil {
+			return nil, err
+		}
+
+		ce := javascript.WrapConditional(pe)
+
 		return &javascript.AssignmentExpression{
 			ConditionalExpression: ce,
 			Tokens:                ce.Tokens,
@@ -845,16 +957,47 @@ type importData struct {
 //
 // Any import statement will be added to the Module, with import bindings being
 // potentially renamed on a clash.
-func Process(m *javascript.Module, tmpl *template.Template) error {
+//
+// The following pragmas in the leading comments of the Module override the
+// template:
+//
+//	@jsx:     Sets the factory function, which will be called with the tag, the props, or null, and then each child as a separate argument.
+//	@jsxFrag: Sets the fragment component, transforming fragments as elements with that name; when used with @jsx, the default is React.Fragment.
+//
+// Without a @jsxFrag pragma, fragments are transformed into an array of their
+// children.
+//
+// The Development Option adds source locations to the PARAMS of each element;
+// the ImportSource Option has no effect.
+func Process(m *javascript.Module, tmpl *template.Template, opts ...Option) error {
 	j := &jsxTransformer{
 		tmpl:    tmpl,
 		imports: make(map[string]struct{}),
 	}
 
+	for _, opt := range opts {
+		opt(&j.options)
+	}
+
+	p := pragmas(m.Comments[0])
+
+	if factory, ok := p["@jsx"]; ok {
+		j.runtime = true
+		j.classic = true
+		j.factory = factory
+		j.fragment = cmp.Or(p["@jsxFrag"], defaultFragment)
+	} else {
+		j.fragment = p["@jsxFrag"]
+	}
+
 	if err := walk.Walk(m, j); err != nil {
 		return err
 	}
 
+	return j.resolveImports(m)
+}
+
+func (j *jsxTransformer) resolveImports(m *javascript.Module) error {
 	imports, err := existingImports(m)
 	if err != nil {
 		return err
@@ -1056,4 +1199,14 @@ var (
 	ErrTooManyStatements     = errors.New("too many statements")
 	ErrMissingChild          = errors.New("missing JSX child")
 	ErrInvalidTagTemplate    = errors.New("cannot convert member expression JSX Element name to string")
+	ErrNotIntrinsic          = errors.New("cannot render non-intrinsic element to HTML")
+	ErrSpreadAttribute       = errors.New("cannot render spread attribute to HTML")
+	ErrInvalidAttribute      = errors.New("invalid attribute value")
+	ErrVoidElementChildren   = errors.New("void element cannot have children")
+	ErrDuplicateAttribute    = errors.New("duplicate attribute")
+	ErrKeyAfterSpread        = errors.New("key attribute after spread attribute")
+	ErrSpreadChildren        = errors.New("spread children are not supported")
+	ErrNamespaceMismatch     = errors.New("name does not match namespace")
+	ErrUnknownElement        = errors.New("unknown intrinsic element")
+	ErrUnknownAttribute      = errors.New("unknown attribute")
 )
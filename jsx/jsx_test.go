@@ -1,7 +1,9 @@
 package jsx
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"text/template"
 
@@ -263,6 +265,31 @@ func TestProcess(t *testing.T) {
 			`TAG_NAME(PARAMS)`,
 			"const a = (b({\"c\":/*A*/ d?.e // B\n}))",
 		},
+		{ // 51
+			"/** @jsx h */\nconst a = <b c=\"d\"><e />f</b>",
+			`tag('TAG_NAME', PARAMS, CHILDREN)`,
+			"/** @jsx h */\nconst a = (h(\"b\",{\"c\":\"d\"},h(\"e\",null),\"f\"))",
+		},
+		{ // 52
+			"/** @jsx h */\nconst a = <><B /></>",
+			`tag('TAG_NAME', PARAMS, CHILDREN)`,
+			"/** @jsx h */\nconst a = (h(React.Fragment,null,h(B,null)))",
+		},
+		{ // 53
+			"/**\n * @jsx preact.h\n * @jsxFrag preact.Fragment\n */\nconst a = <><b /></>",
+			`tag('TAG_NAME', PARAMS, CHILDREN)`,
+			"/**\n * @jsx preact.h\n * @jsxFrag preact.Fragment\n */\nconst a = (preact.h(preact.Fragment,null,preact.h(\"b\",null)))",
+		},
+		{ // 54
+			"// @jsxFrag Frag\nconst a = <><b /></>",
+			`TAG_NAME(PARAMS, CHILDREN)`,
+			"// @jsxFrag Frag\nconst a = (Frag({}, [(b({}, []))]))",
+		},
+		{ // 55
+			"// @jsxFrag Frag\nconst a = <b c=<></>>{<><d /></>}</b>",
+			`TAG_NAME(PARAMS, CHILDREN)`,
+			"// @jsxFrag Frag\nconst a = (b({\"c\":(Frag({}, []))}, [(Frag({}, [(d({}, []))]))]))",
+		},
 	} {
 		tk := parser.NewStringTokeniser(test.Input)
 
@@ -277,3 +304,450 @@ func TestProcess(t *testing.T) {
 		}
 	}
 }
+
+func TestProcessDevelopment(t *testing.T) {
+	for n, test := range [...]struct {
+		Input, Template, Output string
+	}{
+		{ // 1
+			"const a = <b c=\"d\" />",
+			`TAG_NAME(PARAMS, CHILDREN)`,
+			"const a = (b({\"c\":\"d\",__self:this,__source:{fileName:\"file.jsx\",lineNumber:1,columnNumber:11}}, []))",
+		},
+		{ // 2
+			"const a = <b />",
+			`tag('TAG_NAME'{{if .HasParams}}, PARAMS{{end}})`,
+			"const a = (tag(\"b\", {__self:this,__source:{fileName:\"file.jsx\",lineNumber:1,columnNumber:11}}))",
+		},
+		{ // 3
+			"/** @jsx h */\nconst a = <b>c</b>",
+			`TAG_NAME(PARAMS, CHILDREN)`,
+			"/** @jsx h */\nconst a = (h(\"b\",{__self:this,__source:{fileName:\"file.jsx\",lineNumber:2,columnNumber:11}},\"c\"))",
+		},
+		{ // 4
+			"class A extends B {\n\tconstructor() {\n\t\tsuper(<c />);\n\t}\n}",
+			`TAG_NAME(PARAMS, CHILDREN)`,
+			"class A extends B {\n\tconstructor() {\n\t\tsuper((c({__source:{fileName:\"file.jsx\",lineNumber:3,columnNumber:9}}, [])));\n\t}\n}",
+		},
+	} {
+		tk := parser.NewStringTokeniser(test.Input)
+
+		if m, err := javascript.ParseModule(javascript.AsJSX(&tk)); err != nil {
+			t.Errorf("test %d: unexpected error parsing input: %s", n+1, err)
+		} else if tmp, err := template.New("").Parse(test.Template); err != nil {
+			t.Errorf("test %d: unexpected error parsing template: %s", n+1, err)
+		} else if err := Process(m, tmp, Development("file.jsx")); err != nil {
+			t.Errorf("test %d: unexpected error processing: %s", n+1, err)
+		} else if output := fmt.Sprintf("%#s", m); output != test.Output {
+			t.Errorf("test %d: expecting output %q, got %q", n+1, test.Output, output)
+		}
+	}
+}
+
+func TestAutomatic(t *testing.T) {
+	for n, test := range [...]struct {
+		Input, Output string
+		Options       []Option
+		Err           error
+	}{
+		{ // 1
+			"const a = <b />",
+			"import {jsx} from \"react/jsx-runtime\";\n\nconst a = (jsx(\"b\", {}));",
+			nil,
+			nil,
+		},
+		{ // 2
+			"const a = <b c=\"1\" key=\"d\">e</b>",
+			"import {jsx} from \"react/jsx-runtime\";\n\nconst a = (jsx(\"b\", {\"c\": \"1\", children: \"e\"}, \"d\"));",
+			nil,
+			nil,
+		},
+		{ // 3
+			"const a = <b>c{d}<e /></b>",
+			"import {jsx, jsxs} from \"react/jsx-runtime\";\n\nconst a = (jsxs(\"b\", {children: [\"c\", d, jsx(\"e\", {})]}));",
+			nil,
+			nil,
+		},
+		{ // 4
+			"const a = <B.C><D /></B.C>",
+			"import {jsx} from \"react/jsx-runtime\";\n\nconst a = (jsx(B.C, {children: jsx(D, {})}));",
+			nil,
+			nil,
+		},
+		{ // 5
+			"const a = <><b /><my-el /></>",
+			"import {Fragment, jsx, jsxs} from \"react/jsx-runtime\";\n\nconst a = (jsxs(Fragment, {children: [jsx(\"b\", {}), jsx(\"my-el\", {})]}));",
+			nil,
+			nil,
+		},
+		{ // 6
+			"const a = <b>\n\tc   d\n\t{/* e */}\n\tf &amp; g\n</b>",
+			"import {jsxs} from \"react/jsx-runtime\";\n\nconst a = (jsxs(\"b\", {children: [\"c   d\", \"f & g\"]}));",
+			nil,
+			nil,
+		},
+		{ // 7
+			"const a = <b {...c} key=\"d\">e</b>",
+			"import {createElement} from \"react\";\n\nconst a = (createElement(\"b\", {...c, \"key\": \"d\"}, \"e\"));",
+			nil,
+			nil,
+		},
+		{ // 8
+			"const a = <b key=\"d\" {...c} />",
+			"import {jsx} from \"react/jsx-runtime\";\n\nconst a = (jsx(\"b\", {...c}, \"d\"));",
+			nil,
+			nil,
+		},
+		{ // 9
+			"const a = <b c=<d /> />",
+			"import {jsx} from \"preact/jsx-runtime\";\n\nconst a = (jsx(\"b\", {\"c\": jsx(\"d\", {})}));",
+			[]Option{ImportSource("preact")},
+			nil,
+		},
+		{ // 10
+			"/** @jsxImportSource preact */\nconst a = <b />",
+			"import {jsx} from \"preact/jsx-runtime\";\n\nconst a = (jsx(\"b\", {}));",
+			nil,
+			nil,
+		},
+		{ // 11
+			"import {jsx} from \"react/jsx-runtime\";\nconst a = <b />",
+			"import {jsx} from \"react/jsx-runtime\";\n\nconst a = (jsx(\"b\", {}));",
+			nil,
+			nil,
+		},
+		{ // 12
+			"const jsx = 1, a = <b />",
+			"import {jsx as jsx_1} from \"react/jsx-runtime\";\n\nconst jsx = 1, a = (jsx_1(\"b\", {}));",
+			nil,
+			nil,
+		},
+		{ // 13
+			"// @jsxRuntime classic\nconst a = <b c=\"1\"><d />e</b>, f = <><G /></>",
+			"const a = (React.createElement(\"b\", {\"c\": \"1\"}, React.createElement(\"d\", null), \"e\")), f = (React.createElement(React.Fragment, null, React.createElement(G, null)));",
+			nil,
+			nil,
+		},
+		{ // 14
+			"/**\n * @jsxRuntime classic\n * @jsx h\n * @jsxFrag Fragment\n */\nconst a = <b key=\"c\" />, d = <></>",
+			"const a = (h(\"b\", {\"key\": \"c\"})), d = (h(Fragment, null));",
+			nil,
+			nil,
+		},
+		{ // 15
+			"const a = <b c=\"1\" />,\n\td = <e key=\"f\">{g}{h}</e>",
+			"import {jsxDEV} from \"react/jsx-dev-runtime\";\n\nconst a = (jsxDEV(\"b\", {\"c\": \"1\"}, void 0, false, {fileName: \"file.jsx\", lineNumber: 1, columnNumber: 11}, this)), d = (jsxDEV(\"e\", {children: [g, h]}, \"f\", true, {fileName: \"file.jsx\", lineNumber: 2, columnNumber: 6}, this));",
+			[]Option{Development("file.jsx")},
+			nil,
+		},
+		{ // 16
+			"const a = <><b /></>",
+			"import {Fragment, jsxDEV} from \"react/jsx-dev-runtime\";\n\nconst a = (jsxDEV(Fragment, {children: jsxDEV(\"b\", {}, void 0, false, {fileName: \"file.jsx\", lineNumber: 1, columnNumber: 13}, this)}, void 0, false, {fileName: \"file.jsx\", lineNumber: 1, columnNumber: 11}, this));",
+			[]Option{Development("file.jsx")},
+			nil,
+		},
+		{ // 17
+			"class A extends B {\n\tconstructor() {\n\t\tsuper(<c />);\n\t}\n\td() {\n\t\treturn <e />;\n\t}\n}",
+			"import {jsxDEV} from \"react/jsx-dev-runtime\";\n\nclass A extends B {\n\tconstructor() {\n\t\tsuper((jsxDEV(\"c\", {}, void 0, false, {fileName: \"file.jsx\", lineNumber: 3, columnNumber: 9})));\n\t}\n\td() {\n\t\treturn (jsxDEV(\"e\", {}, void 0, false, {fileName: \"file.jsx\", lineNumber: 6, columnNumber: 10}, this));\n\t}\n}",
+			[]Option{Development("file.jsx")},
+			nil,
+		},
+		{ // 18
+			"// @jsxRuntime classic\nconst a = <b c=\"1\" />, d = <></>",
+			"const a = (React.createElement(\"b\", {\"c\": \"1\", __self: this, __source: {fileName: \"file.jsx\", lineNumber: 2, columnNumber: 11}})), d = (React.createElement(React.Fragment, null));",
+			[]Option{Development("file.jsx")},
+			nil,
+		},
+		{ // 19
+			"const a = <b {...c} key=\"d\" />",
+			"import {createElement} from \"react\";\n\nconst a = (createElement(\"b\", {...c, \"key\": \"d\", __self: this, __source: {fileName: \"file.jsx\", lineNumber: 1, columnNumber: 11}}));",
+			[]Option{Development("file.jsx")},
+			nil,
+		},
+		{ // 20
+			"const a = <b>\n\tline one\n\tline two\n</b>",
+			"import {jsx} from \"react/jsx-runtime\";\n\nconst a = (jsx(\"b\", {children: \"line one line two\"}));",
+			nil,
+			nil,
+		},
+		{ // 21
+			"const a = <b>{c} {d}\n\t<e /> f &amp; g</b>",
+			"import {jsx, jsxs} from \"react/jsx-runtime\";\n\nconst a = (jsxs(\"b\", {children: [c, \" \", d, jsx(\"e\", {}), \" f & g\"]}));",
+			nil,
+			nil,
+		},
+		{ // 22
+			"const a = <b children=\"c\">d</b>, e = <f children=\"g\" />",
+			"import {jsx} from \"react/jsx-runtime\";\n\nconst a = (jsx(\"b\", {children: \"d\"})), e = (jsx(\"f\", {\"children\": \"g\"}));",
+			nil,
+			nil,
+		},
+		{ // 23
+			"const a = <b>{...c}</b>",
+			"",
+			nil,
+			ErrSpreadChildren,
+		},
+		{ // 24
+			"// @jsxRuntime classic\nconst a = <b>{...c}</b>",
+			"",
+			nil,
+			ErrSpreadChildren,
+		},
+	} {
+		tk := parser.NewStringTokeniser(test.Input)
+
+		if m, err := javascript.ParseModule(javascript.AsJSX(&tk)); err != nil {
+			t.Errorf("test %d: unexpected error parsing input: %s", n+1, err)
+		} else if err := Automatic(m, test.Options...); !errors.Is(err, test.Err) {
+			t.Errorf("test %d: expecting error %v, got %v", n+1, test.Err, err)
+		} else if output := fmt.Sprintf("%s", m); test.Err == nil && output != test.Output {
+			t.Errorf("test %d: expecting output %q, got %q", n+1, test.Output, output)
+		}
+	}
+}
+
+func TestHTML(t *testing.T) {
+	const (
+		rawHTML    = "class RawHTML {\n\tconstructor(html) {\n\t\tthis.html = html;\n\t}\n\ttoString() {\n\t\treturn this.html;\n\t}\n}\n\n"
+		escapeHTML = rawHTML + "function escapeHTML(v) {\n\treturn v == null || typeof v === \"boolean\" ? \"\" : Array.isArray(v) ? v.map(escapeHTML).join(\"\") : v instanceof RawHTML ? v.html : String(v).replace(/[&<>\"']/g, c => \"&\" + {\"&\": \"amp\", \"<\": \"lt\", \">\": \"gt\", \"\\\"\": \"quot\", \"'\": \"#39\"}[c] + \";\");\n}\n\n"
+		attrHTML   = "function attrHTML(name, v) {\n\tif (v == null || typeof v === \"function\" || typeof v === \"symbol\") {\n\t\treturn \"\";\n\t}\n\tif (name === \"style\" && typeof v === \"object\") {\n\t\tv = Object.entries(v).filter(([, s]) => s != null && typeof s !== \"boolean\" && s !== \"\").map(([p, s]) => (p.startsWith(\"--\") ? p : p.replace(/[A-Z]/g, c => \"-\" + c.toLowerCase()).replace(/^ms-/, \"-ms-\")) + \":\" + (typeof s === \"number\" && s !== 0 && !p.startsWith(\"--\") && !/^(animationIterationCount|aspectRatio|columnCount|columns|fillOpacity|flex|flexGrow|flexShrink|fontWeight|gridArea|gridColumn|gridRow|lineClamp|lineHeight|opacity|order|orphans|scale|stopOpacity|strokeOpacity|strokeWidth|tabSize|widows|zIndex|zoom)$/.test(p) ? s + \"px\" : s)).join(\";\");\n\t\tif (v === \"\") {\n\t\t\treturn \"\";\n\t\t}\n\t}\n\treturn \" \" + name + \"=\\\"\" + String(v).replace(/[&<>\"']/g, c => \"&\" + {\"&\": \"amp\", \"<\": \"lt\", \">\": \"gt\", \"\\\"\": \"quot\", \"'\": \"#39\"}[c] + \";\") + \"\\\"\";\n}\n\n"
+		innerHTML  = "function innerHTML(v) {\n\treturn v == null || v.__html == null ? \"\" : String(v.__html);\n}\n\n"
+	)
+
+	for n, test := range [...]struct {
+		Input, Output string
+		Err           error
+	}{
+		{ // 1
+			"const a = <b />",
+			rawHTML + "const a = (new RawHTML(`<b></b>`));",
+			nil,
+		},
+		{ // 2
+			"const a = <p className=\"x\" title=\"a &amp; &quot;b&quot;\">1 &lt; 2 &amp;&amp; \"c\"</p>",
+			rawHTML + "const a = (new RawHTML(`<p class=\"x\" title=\"a &amp; &quot;b&quot;\">1 &lt; 2 &amp;&amp; &quot;c&quot;</p>`));",
+			nil,
+		},
+		{ // 3
+			"const a = <div><br /><img src=\"a.png\" alt=\"\" /><hr></hr></div>",
+			rawHTML + "const a = (new RawHTML(`<div><br><img src=\"a.png\" alt=\"\"><hr></div>`));",
+			nil,
+		},
+		{ // 4
+			"const a = <input type=\"checkbox\" checked disabled={false} readOnly={true} required={r} />",
+			rawHTML + "const a = (new RawHTML(`<input type=\"checkbox\" checked readonly${r ? \" required\" : \"\"}>`));",
+			nil,
+		},
+		{ // 5
+			"const a = <a href={url} title={null} tabIndex={0}>{text}</a>",
+			strings.Replace(escapeHTML, rawHTML, rawHTML+attrHTML, 1) + "const a = (new RawHTML(`<a${attrHTML(\"href\", url)} tabIndex=\"0\">${escapeHTML(text)}</a>`));",
+			nil,
+		},
+		{ // 6
+			"const a = <ul>{items.map(i => <li key={i}>{i}</li>)}</ul>",
+			escapeHTML + "const a = (new RawHTML(`<ul>${escapeHTML(items.map(i => (new RawHTML(`<li>${escapeHTML(i)}</li>`))))}</ul>`));",
+			nil,
+		},
+		{ // 7
+			"const a = <svg viewBox=\"0 0 1 1\"><path d=\"M0 0\" /><foreignObject><p /></foreignObject></svg>",
+			rawHTML + "const a = (new RawHTML(`<svg viewBox=\"0 0 1 1\"><path d=\"M0 0\"/><foreignObject><p></p></foreignObject></svg>`));",
+			nil,
+		},
+		{ // 8
+			"const a = <div dangerouslySetInnerHTML={{__html: html}} />",
+			rawHTML + innerHTML + "const a = (new RawHTML(`<div>${innerHTML({__html: html})}</div>`));",
+			nil,
+		},
+		{ // 9
+			"const a = <><b>c</b>{\" \"}<d />{1}{false}</>",
+			rawHTML + "const a = (new RawHTML(`<b>c</b> <d></d>1`));",
+			nil,
+		},
+		{ // 10
+			"import {escapeHTML} from \"x\";\nconst a = <b>{c}</b>",
+			"import {escapeHTML} from \"x\";\n\n" + strings.ReplaceAll(escapeHTML, "escapeHTML", "escapeHTML_1") + "const a = (new RawHTML(`<b>${escapeHTML_1(c)}</b>`));",
+			nil,
+		},
+		{ // 11
+			"const a = <pre>`${b}` \\</pre>",
+			escapeHTML + "const a = (new RawHTML(`<pre>\\`\\$${escapeHTML(b)}\\` \\\\</pre>`));",
+			nil,
+		},
+		{ // 12
+			"const a = <B />",
+			"",
+			ErrNotIntrinsic,
+		},
+		{ // 13
+			"const a = <b.c />",
+			"",
+			ErrNotIntrinsic,
+		},
+		{ // 14
+			"const a = <b {...c} />",
+			"",
+			ErrSpreadAttribute,
+		},
+		{ // 15
+			"const a = <br>c</br>",
+			"",
+			ErrVoidElementChildren,
+		},
+		{ // 16
+			"const a = <b c=<d /> />",
+			"",
+			ErrInvalidAttribute,
+		},
+		{ // 17
+			"const a = <p>\n\tline one\n\tline two {text} &amp; {more}\n\t<br />\n</p>",
+			escapeHTML + "const a = (new RawHTML(`<p>line one line two ${escapeHTML(text)} &amp; ${escapeHTML(more)}<br></p>`));",
+			nil,
+		},
+		{ // 18
+			"const a = <p>{user.bio}</p>",
+			escapeHTML + "const a = (new RawHTML(`<p>${escapeHTML(user.bio)}</p>`));",
+			nil,
+		},
+		{ // 19
+			"const a = <div style={{color: \"red\", fontSize: 12, lineHeight: 1.5}} />",
+			rawHTML + attrHTML + "const a = (new RawHTML(`<div${attrHTML(\"style\", {color: \"red\", fontSize: 12, lineHeight: 1.5})}></div>`));",
+			nil,
+		},
+		{ // 20
+			"const a = <button onClick={f} />",
+			"",
+			ErrInvalidAttribute,
+		},
+		{ // 21
+			"const a = <div aria-hidden={false} data-open={true} draggable={false} hidden={false} title={null} />",
+			rawHTML + "const a = (new RawHTML(`<div aria-hidden=\"false\" data-open=\"true\" draggable=\"false\"></div>`));",
+			nil,
+		},
+		{ // 22
+			"const item = <li>x</li>;\nconst a = <ul>{item}</ul>",
+			escapeHTML + "const item = (new RawHTML(`<li>x</li>`));\n\nconst a = (new RawHTML(`<ul>${escapeHTML(item)}</ul>`));",
+			nil,
+		},
+	} {
+		tk := parser.NewStringTokeniser(test.Input)
+
+		if m, err := javascript.ParseModule(javascript.AsJSX(&tk)); err != nil {
+			t.Errorf("test %d: unexpected error parsing input: %s", n+1, err)
+		} else if err := HTML(m); !errors.Is(err, test.Err) {
+			t.Errorf("test %d: expecting error %v, got %v", n+1, test.Err, err)
+		} else if output := fmt.Sprintf("%s", m); test.Err == nil && output != test.Output {
+			t.Errorf("test %d: expecting output %q, got %q", n+1, test.Output, output)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for n, test := range [...]struct {
+		Input  string
+		Errors []error
+		Lines  []uint64
+	}{
+		{ // 1
+			"const a = <div id=\"b\" className=\"c\" onClick={d} data-e=\"f\" aria-label=\"g\">h</div>",
+			nil,
+			nil,
+		},
+		{ // 2
+			"const a = <div\n\tid=\"b\"\n\tid=\"c\"\n/>",
+			[]error{ErrDuplicateAttribute},
+			[]uint64{2},
+		},
+		{ // 3
+			"const a = <B\n\tkey=\"c\"\n\t{...d}\n/>, e = <F\n\t{...g}\n\tkey=\"h\"\n/>",
+			[]error{ErrKeyAfterSpread},
+			[]uint64{5},
+		},
+		{ // 4
+			"const a = <b>\n\t{...c}\n</b>",
+			[]error{ErrSpreadChildren},
+			[]uint64{1},
+		},
+		{ // 5
+			"const a = <svg:div />,\n\tb = <svg:rect />,\n\tc = <math:mi />",
+			[]error{ErrNamespaceMismatch},
+			[]uint64{0},
+		},
+		{ // 6
+			"const a = <svg>\n\t<circle />\n\t<div />\n\t<foreignObject>\n\t\t<div />\n\t\t<circle />\n\t</foreignObject>\n</svg>",
+			[]error{ErrNamespaceMismatch, ErrNamespaceMismatch},
+			[]uint64{2, 5},
+		},
+		{ // 7
+			"const a = <math>\n\t<mi />\n\t<p />\n</math>",
+			[]error{ErrNamespaceMismatch},
+			[]uint64{2},
+		},
+		{ // 8
+			"const a = <circle />,\n\tb = <foo />,\n\tc = <my-el foo=\"d\" />,\n\te = <F foo=\"g\" />",
+			[]error{ErrUnknownElement},
+			[]uint64{1},
+		},
+		{ // 9
+			"const a = <div\n\tfoo=\"b\"\n\txml:lang=\"c\"\n\txlink:href=\"d\"\n\te:f=\"g\"\n/>",
+			[]error{ErrUnknownAttribute, ErrNamespaceMismatch, ErrUnknownAttribute},
+			[]uint64{1, 3, 4},
+		},
+		{ // 10
+			"const a = <svg>\n\t<use xlink:href=\"#b\" foo=\"c\" />\n</svg>",
+			nil,
+			nil,
+		},
+		{ // 11
+			"const a = <p>\n\t{b.map(c => <li\n\t\tkey={c}\n\t\tkey={c}\n\t/>)}\n</p>",
+			[]error{ErrDuplicateAttribute},
+			[]uint64{3},
+		},
+		{ // 12
+			"const a = <meta httpEquiv=\"refresh\" property=\"og:title\" />,\n\tb = <form acceptCharset=\"utf-8\" />,\n\tc = <video controlsList=\"nodownload\" disablePictureInPicture />",
+			nil,
+			nil,
+		},
+		{ // 13
+			"const a = <div\n\tclassName=\"b\"\n\tclass=\"c\"\n/>,\n\td = <label\n\tfor=\"e\"\n\thtmlFor=\"f\"\n/>",
+			[]error{ErrDuplicateAttribute, ErrDuplicateAttribute},
+			[]uint64{2, 6},
+		},
+		{ // 14
+			"const a = <B className=\"c\" class=\"d\" />,\n\te = <div classname=\"f\" />",
+			[]error{ErrUnknownAttribute},
+			[]uint64{1},
+		},
+	} {
+		tk := parser.NewStringTokeniser(test.Input)
+
+		m, err := javascript.ParseModule(javascript.AsJSX(&tk))
+		if err != nil {
+			t.Errorf("test %d: unexpected error: %s", n+1, err)
+
+			continue
+		}
+
+		errs := Validate(m)
+
+		if len(errs) != len(test.Errors) {
+			t.Errorf("test %d: expecting %d errors, got %d: %v", n+1, len(test.Errors), len(errs), errs)
+
+			continue
+		}
+
+		for m, err := range errs {
+			var e javascript.Error
+
+			if !errors.As(err, &e) {
+				t.Errorf("test %d.%d: expecting javascript.Error, got %T", n+1, m+1, err)
+			} else if !errors.Is(err, test.Errors[m]) {
+				t.Errorf("test %d.%d: expecting error %v, got %s", n+1, m+1, test.Errors[m], err)
+			}
+
+			if e.Token.Line != test.Lines[m] {
+				t.Errorf("test %d.%d: expecting error on line %d, got %d", n+1, m+1, test.Lines[m], e.Token.Line)
+			}
+		}
+	}
+}
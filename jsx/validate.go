@@ -0,0 +1,213 @@
+package jsx
+
+import (
+	"slices"
+	"strings"
+
+	"vimagination.zapto.org/javascript"
+	"vimagination.zapto.org/javascript/internal/tokens"
+	"vimagination.zapto.org/javascript/walk"
+)
+
+var (
+	htmlAttributes      = [...]string{"abbr", "accept", "accept-charset", "accesskey", "action", "align", "allow", "allowfullscreen", "alt", "as", "async", "autocapitalize", "autocomplete", "autocorrect", "autofocus", "autoplay", "background", "bgcolor", "blocking", "border", "capture", "cellpadding", "cellspacing", "charset", "checked", "children", "cite", "class", "clear", "color", "cols", "colspan", "content", "contenteditable", "controls", "controlslist", "coords", "crossorigin", "dangerouslysetinnerhtml", "data", "datetime", "decoding", "default", "defaultchecked", "defaultvalue", "defer", "dir", "dirname", "disabled", "disablepictureinpicture", "download", "draggable", "enctype", "enterkeyhint", "exportparts", "face", "fetchpriority", "for", "form", "formaction", "formenctype", "formmethod", "formnovalidate", "formtarget", "frameborder", "headers", "height", "hidden", "high", "href", "hreflang", "hspace", "http-equiv", "id", "inert", "inputmode", "integrity", "is", "ismap", "itemid", "itemprop", "itemref", "itemscope", "itemtype", "key", "kind", "label", "lang", "list", "loading", "loop", "low", "marginheight", "marginwidth", "max", "maxlength", "media", "method", "min", "minlength", "multiple", "muted", "name", "nomodule", "nonce", "novalidate", "nowrap", "open", "optimum", "part", "pattern", "ping", "placeholder", "playsinline", "popover", "popovertarget", "popovertargetaction", "poster", "preload", "property", "readonly", "ref", "referrerpolicy", "rel", "required", "reversed", "role", "rows", "rowspan", "sandbox", "scope", "scrolling", "selected", "shadowrootmode", "shape", "size", "sizes", "slot", "span", "spellcheck", "src", "srcdoc", "srclang", "srcset", "start", "step", "style", "summary", "suppresscontenteditablewarning", "suppresshydrationwarning", "tabindex", "target", "title", "translate", "type", "usemap", "valign", "value", "vspace", "width", "wrap", "writingsuggestions"}
+	attributeNamespaces = [...]string{"xlink", "xml", "xmlns"}
+)
+
+// Validate checks the JSX elements within the given JavaScript type,
+// returning any problems found.
+//
+// Each error is returned as a javascript.Error, with the Token set to the
+// position of the problem.
+//
+// The following are reported:
+//
+//	ErrDuplicateAttribute: An attribute that has already been set on the element.
+//	ErrKeyAfterSpread:     A key attribute that follows a spread attribute.
+//	ErrSpreadChildren:     A spread child, which is not supported by React.
+//	ErrNamespaceMismatch:  An element not in its given namespace, such as <svg:div>, or an HTML element within an SVG element; or an xlink attribute on an HTML element.
+//	ErrUnknownElement:     An intrinsic element that is not a known HTML, SVG, or MathML element, or custom element.
+//	ErrUnknownAttribute:   An attribute on an HTML element that is not a known HTML attribute, data-*, aria-*, or event handler; or an unknown attribute namespace.
+//
+// Elements whose names begin with an uppercase letter, or that are member
+// expressions, are components and are not checked against the element and
+// attribute tables. Custom elements, whose names contain a hyphen, may have any
+// attributes.
+func Validate(t javascript.Type) []error {
+	v := &validator{errs: new([]error)}
+
+	v.Handle(t)
+
+	return *v.errs
+}
+
+type validator struct {
+	errs      *[]error
+	namespace string
+}
+
+func (v *validator) error(err error, parsing string, tk *javascript.Token) {
+	e := javascript.Error{
+		Err:     err,
+		Parsing: parsing,
+	}
+
+	if tk != nil {
+		e.Token = *tk
+	}
+
+	*v.errs = append(*v.errs, e)
+}
+
+func (v *validator) Handle(t javascript.Type) error {
+	switch t := t.(type) {
+	case *javascript.JSXElement:
+		w := *v
+
+		w.namespace = v.validateElement(t)
+
+		return walk.Walk(t, &w)
+	case *javascript.JSXChild:
+		if t.Spread {
+			v.error(ErrSpreadChildren, "JSXChild", tokens.First(t.Tokens))
+		}
+	}
+
+	return walk.Walk(t, v)
+}
+
+func (v *validator) validateElement(e *javascript.JSXElement) string {
+	name := e.ElementName
+
+	if name.Identifier == nil || len(name.MemberExpression) > 0 {
+		v.validateAttributes(e.Attributes, false, false)
+
+		return v.namespace
+	} else if first := name.Identifier.Data[0]; name.Namespace == nil && (first < 'a' || first > 'z') && !strings.ContainsRune(name.Identifier.Data, '-') {
+		v.validateAttributes(e.Attributes, false, false)
+
+		return v.namespace
+	}
+
+	ns := v.elementNamespace(name)
+
+	v.validateAttributes(e.Attributes, true, ns == "html" && !strings.ContainsRune(name.Identifier.Data, '-'))
+
+	if ns == "svg" && name.Identifier.Data == "foreignObject" {
+		return "html"
+	}
+
+	return ns
+}
+
+func (v *validator) elementNamespace(name javascript.JSXElementName) string {
+	tag := name.Identifier.Data
+	inHTML, inSVG, inMathML := nsIn(name.Identifier)
+
+	if name.Namespace != nil {
+		ns := name.Namespace.Data
+
+		var ok bool
+
+		switch ns {
+		case "html":
+			ok = inHTML
+		case "svg":
+			ok = inSVG
+		case "math", "mathml":
+			ns = "mathml"
+			ok = inMathML
+		default:
+			return ns
+		}
+
+		if !ok {
+			v.error(ErrNamespaceMismatch, "JSXElementName", name.Identifier)
+		}
+
+		return ns
+	}
+
+	switch v.namespace {
+	case "svg":
+		if inSVG {
+			return "svg"
+		}
+	case "mathml":
+		if inMathML {
+			return "mathml"
+		}
+	default:
+		if inHTML || strings.ContainsRune(tag, '-') {
+			return "html"
+		} else if tag == "svg" || v.namespace == "" && inSVG {
+			return "svg"
+		} else if tag == "math" || v.namespace == "" && inMathML {
+			return "mathml"
+		}
+	}
+
+	if inHTML || inSVG || inMathML {
+		v.error(ErrNamespaceMismatch, "JSXElementName", name.Identifier)
+	} else {
+		v.error(ErrUnknownElement, "JSXElementName", name.Identifier)
+	}
+
+	return v.namespace
+}
+
+// validateAttributes checks the attributes of an element, with the React names
+// of the attributes of intrinsic elements, such as className, being checked as
+// the HTML attributes they are rendered as.
+func (v *validator) validateAttributes(attrs []javascript.JSXAttribute, intrinsic, html bool) {
+	seen := make(map[string]struct{}, len(attrs))
+	spread := false
+
+	for _, attr := range attrs {
+		if attr.Identifier == nil {
+			spread = true
+
+			continue
+		}
+
+		name := attr.Identifier.Data
+
+		if attr.Namespace != nil {
+			name = attr.Namespace.Data + ":" + name
+
+			if !slices.Contains(attributeNamespaces[:], attr.Namespace.Data) {
+				v.error(ErrUnknownAttribute, "JSXAttribute", attr.Namespace)
+			} else if html && attr.Namespace.Data == "xlink" {
+				v.error(ErrNamespaceMismatch, "JSXAttribute", attr.Namespace)
+			}
+		} else {
+			if mapped, ok := attributeNames[name]; ok && intrinsic {
+				name = mapped
+			}
+
+			if html && !isHTMLAttribute(name) {
+				v.error(ErrUnknownAttribute, "JSXAttribute", attr.Identifier)
+			}
+		}
+
+		if _, ok := seen[name]; ok {
+			v.error(ErrDuplicateAttribute, "JSXAttribute", attr.Identifier)
+		} else if spread && name == "key" {
+			v.error(ErrKeyAfterSpread, "JSXAttribute", attr.Identifier)
+		}
+
+		seen[name] = struct{}{}
+	}
+}
+
+func isHTMLAttribute(name string) bool {
+	name = strings.ToLower(name)
+
+	if strings.HasPrefix(name, "data-") || strings.HasPrefix(name, "aria-") {
+		return true
+	} else if event, ok := strings.CutPrefix(name, "on"); ok && event != "" && strings.Trim(event, "abcdefghijklmnopqrstuvwxyz") == "" {
+		return true
+	}
+
+	return slices.Contains(htmlAttributes[:], name)
+}
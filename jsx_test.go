@@ -96,7 +96,7 @@ func TestJSXElementName(t *testing.T) {
 	}, func(t *test) (Type, error) {
 		var jn JSXElementName
 
-		t.Tokens = t.Tokens[1:1]
+		t.Tokens.tokens = t.Tokens.tokens[1:1]
 
 		err := jn.parse(&t.Tokens)
 
@@ -482,7 +482,7 @@ func TestJSXAttribute(t *testing.T) {
 	}, func(t *test) (Type, error) {
 		var ja JSXAttribute
 
-		t.Tokens = t.Tokens[1:1]
+		t.Tokens.tokens = t.Tokens.tokens[1:1]
 
 		err := ja.parse(&t.Tokens, t.Yield, t.Await)
 
@@ -717,7 +717,7 @@ func TestJSXChild(t *testing.T) {
 	}, func(t *test) (Type, error) {
 		var jc JSXChild
 
-		t.Tokens = t.Tokens[2:2]
+		t.Tokens.tokens = t.Tokens.tokens[2:2]
 
 		err := jc.parse(&t.Tokens, t.Yield, t.Await)
 
@@ -24,7 +24,7 @@ func (p *processor) removeDeadCode(m *javascript.Module) {
 
 func (p *processor) clearSinglesFromScope(s *scope.Scope) {
 	for name, bindings := range s.Bindings {
-		if name == "this" || name == "arguments" || len(bindings) != 1 || bindings[0].BindingType == scope.BindingRef {
+		if name == "this" || name == "arguments" || len(bindings) != 1 || bindings[0].BindingType == scope.BindingRef || bindings[0].BindingType == scope.BindingLexicalUsing {
 			continue
 		}
 
@@ -293,6 +293,8 @@ const (
 	bindableNone bindable = iota
 	bindableConst
 	bindableLet
+	bindableUsing
+	bindableAwaitUsing
 	bindableVar
 	bindableClass
 	bindableFunction
@@ -303,8 +305,13 @@ func sliBindable(sli *javascript.StatementListItem) bindable {
 	if sli != nil {
 		if sli.Declaration != nil {
 			if sli.Declaration.LexicalDeclaration != nil {
-				if sli.Declaration.LexicalDeclaration.LetOrConst == javascript.Const {
+				switch sli.Declaration.LexicalDeclaration.LetOrConst {
+				case javascript.Const:
 					return bindableConst
+				case javascript.Using:
+					return bindableUsing
+				case javascript.AwaitUsing:
+					return bindableAwaitUsing
 				}
 
 				return bindableLet
@@ -761,7 +761,7 @@ func (p *processor) minifyLexical(jm *javascript.Module) bool {
 			next := sliBindable(jm.ModuleListItems[i].StatementListItem)
 			if last == next {
 				switch next {
-				case bindableConst, bindableLet:
+				case bindableConst, bindableLet, bindableUsing, bindableAwaitUsing:
 					ld := jm.ModuleListItems[i-1].StatementListItem.Declaration.LexicalDeclaration
 					ld.BindingList = append(ld.BindingList, jm.ModuleListItems[i].StatementListItem.Declaration.LexicalDeclaration.BindingList...)
 					jm.ModuleListItems = append(jm.ModuleListItems[:i], jm.ModuleListItems[i+1:]...)
@@ -6263,6 +6263,21 @@ func TestMinify(t *testing.T) {
 			"do 1\nwhile (a())",
 			"do;while(a())",
 		},
+		{
+			[]Option{MergeLexical},
+			"using a = b();\nusing c = d();\nconst e = 1;\nawait using f = g();\nawait using h = i();\nusing j = k();",
+			"using a=b(),c=d();const e=1;await using f=g(),h=i();using j=k()",
+		},
+		{
+			[]Option{RemoveDeadCode},
+			"using a = b();\nfor (using c of d);",
+			"using a=b();for(using c of d);",
+		},
+		{
+			[]Option{MergeLexical},
+			"#!/usr/bin/env node\n\nconst a = 1;\nconst b = 2;\n\nconsole.log(a, b);",
+			"#!/usr/bin/env node\nconst a=1,b=2;console.log(a,b)",
+		},
 	} {
 		tk := parser.NewStringTokeniser(test.Input)
 
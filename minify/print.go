@@ -6,10 +6,27 @@ import (
 	"reflect"
 
 	"vimagination.zapto.org/javascript"
+	"vimagination.zapto.org/javascript/sourcemap"
 	"vimagination.zapto.org/javascript/walk"
 )
 
 func Print(w io.Writer, m *javascript.Module) (int64, error) {
+	prepareForPrint(m)
+
+	n, err := fmt.Fprintf(w, "%#s", m)
+
+	return int64(n), err
+}
+
+func PrintWithSourceMap(w io.Writer, m *javascript.Module, opts javascript.SourceMapOptions) (int64, *sourcemap.SourceMap, error) {
+	prepareForPrint(m)
+
+	opts.Original = true
+
+	return javascript.WriteWithSourceMap(w, m, opts)
+}
+
+func prepareForPrint(m *javascript.Module) {
 	var h walk.Handler
 
 	h = walk.HandlerFunc(func(t javascript.Type) error {
@@ -77,8 +94,4 @@ func Print(w io.Writer, m *javascript.Module) (int64, error) {
 	})
 
 	h.Handle(m)
-
-	n, err := fmt.Fprintf(w, "%#s", m)
-
-	return int64(n), err
 }
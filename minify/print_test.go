@@ -1,6 +1,7 @@
 package minify
 
 import (
+	"reflect"
 	"strings"
 	"testing"
 
@@ -9,6 +10,10 @@ import (
 )
 
 func TestPrint(t *testing.T) {
+	// these tests use syntax that is not valid in strict mode code, so are
+	// parsed as scripts
+	sloppy := map[int]bool{94: true, 95: true, 249: true}
+
 	for n, test := range [...]struct {
 		Input, Output string
 	}{
@@ -1207,7 +1212,22 @@ func TestPrint(t *testing.T) {
 
 		tk := parser.NewStringTokeniser(test.Input)
 
-		if m, err := javascript.ParseModule(&tk); err != nil {
+		var (
+			m   *javascript.Module
+			err error
+		)
+
+		if sloppy[n+1] {
+			var s *javascript.Script
+
+			if s, err = javascript.ParseScript(&tk); err == nil {
+				m = javascript.ScriptToModule(s)
+			}
+		} else {
+			m, err = javascript.ParseModule(&tk)
+		}
+
+		if err != nil {
 			t.Errorf("test %d: unexpected error: %s", n+1, err)
 		} else if _, err := Print(&sb, m); err != nil {
 			t.Errorf("test %d: unexpected error: %s", n+1, err)
@@ -1216,3 +1236,28 @@ func TestPrint(t *testing.T) {
 		}
 	}
 }
+
+func TestPrintWithSourceMap(t *testing.T) {
+	const src = "function greet(name) {\n\tconsole.log(name);\n}"
+
+	tk := parser.NewStringTokeniser(src)
+
+	m, err := javascript.ParseModule(&tk)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	New(RenameIdentifiers).Process(m)
+
+	var sb strings.Builder
+
+	if _, sm, err := PrintWithSourceMap(&sb, m, javascript.SourceMapOptions{File: "out.js", Source: "in.js", Content: src}); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	} else if str, expected := sb.String(), "function _(_){console.log(_)}"; str != expected {
+		t.Errorf("expecting output %q, got %q", expected, str)
+	} else if expected := "SAASA,EAAMC,GACdC,QAAQC,IAAIF"; sm.Mappings != expected {
+		t.Errorf("expecting mappings %q, got %q", expected, sm.Mappings)
+	} else if expected := []string{"greet", "name", "console", "log"}; !reflect.DeepEqual(sm.Names, expected) {
+		t.Errorf("expecting names %q, got %q", expected, sm.Names)
+	}
+}
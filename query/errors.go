@@ -0,0 +1,37 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Error represents an error found while parsing a selector.
+//
+// Pos is the byte offset of the error within the parsed string.
+type Error struct {
+	Err error
+	Pos int
+}
+
+// Error returns the error string.
+func (e Error) Error() string {
+	return fmt.Sprintf("error at position %d:\n%s", e.Pos+1, e.Err)
+}
+
+// Unwrap returns the wrapped error.
+func (e Error) Unwrap() error {
+	return e.Err
+}
+
+// Errors.
+var (
+	ErrExpectedSelector     = errors.New("expected selector")
+	ErrInvalidArgument      = errors.New("invalid pseudo-class argument")
+	ErrInvalidAttribute     = errors.New("invalid attribute selector")
+	ErrInvalidRegexp        = errors.New("invalid regular expression")
+	ErrUnexpectedCharacter  = errors.New("unexpected character")
+	ErrUnknownPseudoClass   = errors.New("unknown pseudo-class")
+	ErrUnmatchedParenthesis = errors.New("missing ')'")
+	ErrUnterminatedRegexp   = errors.New("unterminated regular expression")
+	ErrUnterminatedString   = errors.New("unterminated string")
+)
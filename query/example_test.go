@@ -0,0 +1,42 @@
+package query_test
+
+import (
+	"fmt"
+
+	"vimagination.zapto.org/javascript"
+	"vimagination.zapto.org/javascript/query"
+	"vimagination.zapto.org/parser"
+)
+
+func Example() {
+	src := "function greet(name) {\n\tconsole.log(\"Hello, \" + name);\n}\n\nconsole.log(greet);"
+
+	tk := parser.NewStringTokeniser(src)
+
+	ast, err := javascript.ParseModule(&tk)
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	matches, err := query.Query(ast, "FunctionDeclaration CallExpression[MemberExpression=\"console.log\"]")
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	for _, m := range matches {
+		m.Node.(*javascript.CallExpression).MemberExpression.IdentifierName.Data = "debug"
+	}
+
+	fmt.Printf("%s", ast)
+
+	// Output:
+	// function greet(name) {
+	//	console.debug("Hello, " + name);
+	// }
+	//
+	// console.log(greet);
+}
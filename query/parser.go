@@ -0,0 +1,378 @@
+package query
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	whitespace  = " \t\n\r\f"
+	combinators = ">+~"
+	regexpFlags = "ims"
+)
+
+var attributeOperators = [...]string{"=", "!=", "^=", "$=", "*="}
+
+type selectorParser struct {
+	src string
+	pos int
+}
+
+func (p *selectorParser) error(err error, pos int) error {
+	return Error{Err: err, Pos: pos}
+}
+
+func (p *selectorParser) peek() byte {
+	if p.pos >= len(p.src) {
+		return 0
+	}
+
+	return p.src[p.pos]
+}
+
+func (p *selectorParser) accept(c byte) bool {
+	if p.peek() == c && c != 0 {
+		p.pos++
+
+		return true
+	}
+
+	return false
+}
+
+func (p *selectorParser) skipWhitespace() bool {
+	start := p.pos
+
+	for p.pos < len(p.src) && strings.IndexByte(whitespace, p.src[p.pos]) >= 0 {
+		p.pos++
+	}
+
+	return p.pos > start
+}
+
+func (p *selectorParser) name(extra string) string {
+	start := p.pos
+
+	for ; p.pos < len(p.src); p.pos++ {
+		if c := p.src[p.pos]; !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || strings.IndexByte(extra, c) >= 0) {
+			break
+		}
+	}
+
+	return p.src[start:p.pos]
+}
+
+func (p *selectorParser) parseList(relative bool) (selectorList, error) {
+	var l selectorList
+
+	for {
+		p.skipWhitespace()
+
+		c, err := p.parseComplex(relative)
+		if err != nil {
+			return nil, err
+		}
+
+		l = append(l, c)
+
+		p.skipWhitespace()
+
+		if !p.accept(',') {
+			return l, nil
+		}
+	}
+}
+
+func (p *selectorParser) combinator() byte {
+	if c := p.peek(); c != 0 && strings.IndexByte(combinators, c) >= 0 {
+		p.pos++
+
+		return c
+	}
+
+	return 0
+}
+
+func (p *selectorParser) parseComplex(relative bool) (complexSelector, error) {
+	var c complexSelector
+
+	if relative {
+		if c.lead = p.combinator(); c.lead == 0 {
+			c.lead = ' '
+		} else {
+			p.skipWhitespace()
+		}
+	}
+
+	for {
+		cp, err := p.parseCompound()
+		if err != nil {
+			return c, err
+		}
+
+		c.compounds = append(c.compounds, cp)
+
+		ws := p.skipWhitespace()
+		comb := p.combinator()
+
+		if comb == 0 {
+			if next := p.peek(); !ws || next == 0 || next == ',' || next == ')' {
+				return c, nil
+			}
+
+			comb = ' '
+		} else {
+			p.skipWhitespace()
+		}
+
+		c.combinators = append(c.combinators, comb)
+	}
+}
+
+func (p *selectorParser) parseCompound() (compound, error) {
+	var cp compound
+
+	start := p.pos
+
+	if !p.accept('*') {
+		cp.typ = p.name("")
+	}
+
+	for {
+		var (
+			f   filter
+			err error
+		)
+
+		switch p.peek() {
+		case '[':
+			f, err = p.parseAttribute()
+		case ':':
+			f, err = p.parsePseudoClass()
+		default:
+			if p.pos == start {
+				return cp, p.error(ErrExpectedSelector, p.pos)
+			}
+
+			return cp, nil
+		}
+
+		if err != nil {
+			return cp, err
+		}
+
+		cp.filters = append(cp.filters, f)
+	}
+}
+
+func (p *selectorParser) parseAttribute() (filter, error) {
+	var a attribute
+
+	p.pos++
+
+	p.skipWhitespace()
+
+	for {
+		name := p.name("")
+		if name == "" {
+			return nil, p.error(ErrInvalidAttribute, p.pos)
+		}
+
+		a.path = append(a.path, name)
+
+		if !p.accept('.') {
+			break
+		}
+	}
+
+	p.skipWhitespace()
+
+	if p.accept(']') {
+		return a, nil
+	}
+
+	for _, op := range attributeOperators {
+		if strings.HasPrefix(p.src[p.pos:], op) {
+			a.op = op
+			p.pos += len(op)
+
+			break
+		}
+	}
+
+	if a.op == "" {
+		return nil, p.error(ErrInvalidAttribute, p.pos)
+	}
+
+	p.skipWhitespace()
+
+	var err error
+
+	switch p.peek() {
+	case '"', '\'':
+		a.value, err = p.parseString()
+	case '/':
+		if a.op != "=" && a.op != "!=" {
+			return nil, p.error(ErrInvalidAttribute, p.pos)
+		}
+
+		a.regexp, err = p.parseRegexp()
+	default:
+		if a.value = p.name("-."); a.value == "" {
+			return nil, p.error(ErrInvalidAttribute, p.pos)
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipWhitespace()
+
+	if !p.accept(']') {
+		return nil, p.error(ErrInvalidAttribute, p.pos)
+	}
+
+	return a, nil
+}
+
+func (p *selectorParser) parseString() (string, error) {
+	var sb strings.Builder
+
+	start := p.pos
+	quote := p.src[p.pos]
+
+	for p.pos++; p.pos < len(p.src); p.pos++ {
+		switch c := p.src[p.pos]; c {
+		case quote:
+			p.pos++
+
+			return sb.String(), nil
+		case '\\':
+			if p.pos++; p.pos == len(p.src) {
+				return "", p.error(ErrUnterminatedString, start)
+			}
+
+			sb.WriteByte(p.src[p.pos])
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	return "", p.error(ErrUnterminatedString, start)
+}
+
+func (p *selectorParser) parseRegexp() (*regexp.Regexp, error) {
+	var sb strings.Builder
+
+	start := p.pos
+
+	for p.pos++; ; p.pos++ {
+		if p.pos >= len(p.src) {
+			return nil, p.error(ErrUnterminatedRegexp, start)
+		}
+
+		c := p.src[p.pos]
+
+		if c == '/' {
+			break
+		} else if c == '\\' && p.pos+1 < len(p.src) {
+			if p.pos++; p.src[p.pos] != '/' {
+				sb.WriteByte(c)
+			}
+
+			c = p.src[p.pos]
+		}
+
+		sb.WriteByte(c)
+	}
+
+	p.pos++
+
+	pattern := sb.String()
+
+	if flagStart := p.pos; p.name("") != "" {
+		flags := p.src[flagStart:p.pos]
+
+		if strings.Trim(flags, regexpFlags) != "" {
+			return nil, p.error(ErrInvalidRegexp, flagStart)
+		}
+
+		pattern = "(?" + flags + ")" + pattern
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, p.error(ErrInvalidRegexp, start)
+	}
+
+	return re, nil
+}
+
+func (p *selectorParser) parsePseudoClass() (filter, error) {
+	start := p.pos
+
+	p.pos++
+
+	switch name := p.name("-"); name {
+	case "first-child":
+		return nthChild{n: 1}, nil
+	case "last-child":
+		return nthChild{n: 1, last: true}, nil
+	case "nth-child", "nth-last-child":
+		n, err := p.parseArgument()
+		if err != nil {
+			return nil, err
+		}
+
+		return nthChild{n: n, last: name == "nth-last-child"}, nil
+	case "has", "is", "not":
+		if !p.accept('(') {
+			return nil, p.error(ErrInvalidArgument, p.pos)
+		}
+
+		l, err := p.parseList(name == "has")
+		if err != nil {
+			return nil, err
+		}
+
+		if !p.accept(')') {
+			return nil, p.error(ErrUnmatchedParenthesis, p.pos)
+		}
+
+		switch name {
+		case "has":
+			return has(l), nil
+		case "is":
+			return is(l), nil
+		default:
+			return not(l), nil
+		}
+	default:
+		return nil, p.error(ErrUnknownPseudoClass, start)
+	}
+}
+
+func (p *selectorParser) parseArgument() (int, error) {
+	if !p.accept('(') {
+		return 0, p.error(ErrInvalidArgument, p.pos)
+	}
+
+	p.skipWhitespace()
+
+	start := p.pos
+
+	n, err := strconv.Atoi(p.name(""))
+	if err != nil || n < 1 {
+		return 0, p.error(ErrInvalidArgument, start)
+	}
+
+	p.skipWhitespace()
+
+	if !p.accept(')') {
+		return 0, p.error(ErrUnmatchedParenthesis, p.pos)
+	}
+
+	return n, nil
+}
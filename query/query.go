@@ -0,0 +1,425 @@
+// Package query provides a selector language for finding nodes in a JavaScript AST.
+package query // import "vimagination.zapto.org/javascript/query"
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"vimagination.zapto.org/javascript"
+	"vimagination.zapto.org/javascript/walk"
+)
+
+// Selector is a compiled selector that can be matched against a JavaScript
+// AST.
+type Selector struct {
+	list selectorList
+}
+
+// Compile parses a selector, returning a Selector that can be used to match
+// against JavaScript types.
+//
+// The selector syntax is based on CSS, with the type names being the names of
+// the AST types, such as CallExpression or FunctionDeclaration, or '*' to match
+// any type. The nodes considered are those walked by walk.Walk, with the
+// children of each node being those nodes walked directly from it, in order.
+//
+// Type names can be combined with the following:
+//
+//	[Path]             Matches when the field at the given path is not the zero value, i.e. a non-nil pointer, non-empty slice, or true boolean.
+//	[Path="value"]     Matches when the value of the field at the given path equals the value.
+//	[Path!="value"]    Matches when the value of the field at the given path does not equal the value, or the path does not exist.
+//	[Path^="value"]    Matches when the value of the field at the given path begins with the value.
+//	[Path$="value"]    Matches when the value of the field at the given path ends with the value.
+//	[Path*="value"]    Matches when the value of the field at the given path contains the value.
+//	[Path=/regexp/]    Matches when the value of the field at the given path matches the regular expression; the 'i', 'm', and 's' flags are supported.
+//	:has(selector)     Matches when a node relative to the current node matches the selector. By default, descendants are checked, but the selector can begin with a combinator.
+//	:is(selector)      Matches when the current node matches the selector.
+//	:not(selector)     Matches when the current node does not match the selector.
+//	:first-child       Matches when the current node is the first child of its parent.
+//	:last-child        Matches when the current node is the last child of its parent.
+//	:nth-child(n)      Matches when the current node is the nth child of its parent, counting from 1.
+//	:nth-last-child(n) Matches when the current node is the nth child of its parent, counting back from the last child.
+//
+// A Path is a list of field names, separated by '.', with slice elements
+// selected by their index. Values can be quoted strings, or unquoted words and
+// numbers.
+//
+// Tokens are compared by their Data, operators by their String method, and
+// other AST types by their printed source, as by the %s verb.
+//
+// Selectors can be joined with the following combinators:
+//
+//	A B                Matches B when it is a descendant of A.
+//	A > B              Matches B when it is a child of A.
+//	A + B              Matches B when it immediately follows A as a child of the same parent.
+//	A ~ B              Matches B when it follows A as a child of the same parent.
+//	A, B               Matches either A or B.
+//
+// Unknown type and field names are not an error, but will not match.
+func Compile(selector string) (*Selector, error) {
+	p := selectorParser{src: selector}
+
+	l, err := p.parseList(false)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.pos < len(p.src) {
+		return nil, p.error(ErrUnexpectedCharacter, p.pos)
+	}
+
+	return &Selector{list: l}, nil
+}
+
+// Match represents a node matched by a Selector.
+type Match struct {
+	// Node is the matched node.
+	Node javascript.Type
+
+	// Path is the list of ancestors of the matched node, beginning with the
+	// root node and ending with the parent.
+	Path []javascript.Type
+}
+
+// Match returns all of the nodes within the given JavaScript type, including
+// the type itself, that match the Selector, in the order they are walked.
+//
+// For the matched nodes to be modifiable, the given type should be a pointer.
+func (s *Selector) Match(t javascript.Type) []Match {
+	root := &node{Type: t}
+
+	walk.Walk(t, root)
+
+	return s.list.collect(root, nil)
+}
+
+// Query compiles the given selector and returns all of the matching nodes
+// within the given JavaScript type.
+func Query(t javascript.Type, selector string) ([]Match, error) {
+	s, err := Compile(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Match(t), nil
+}
+
+type node struct {
+	javascript.Type
+	parent   *node
+	children []*node
+	index    int
+}
+
+func (n *node) Handle(t javascript.Type) error {
+	c := &node{Type: t, parent: n, index: len(n.children)}
+	n.children = append(n.children, c)
+
+	return walk.Walk(t, c)
+}
+
+func (n *node) path() []javascript.Type {
+	var path []javascript.Type
+
+	for p := n.parent; p != nil; p = p.parent {
+		path = append(path, p.Type)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path
+}
+
+func (n *node) any(fn func(*node) bool) bool {
+	for _, c := range n.children {
+		if fn(c) || c.any(fn) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type selectorList []complexSelector
+
+func (s selectorList) collect(n *node, matches []Match) []Match {
+	if s.match(n, nil) {
+		matches = append(matches, Match{Node: n.Type, Path: n.path()})
+	}
+
+	for _, c := range n.children {
+		matches = s.collect(c, matches)
+	}
+
+	return matches
+}
+
+func (s selectorList) match(n, scope *node) bool {
+	for _, c := range s {
+		if c.match(n, len(c.compounds)-1, scope) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type complexSelector struct {
+	lead        byte
+	compounds   []compound
+	combinators []byte
+}
+
+func (c complexSelector) match(n *node, i int, scope *node) bool {
+	if !c.compounds[i].match(n) {
+		return false
+	} else if i == 0 {
+		return scope == nil || related(scope, n, c.lead)
+	}
+
+	switch c.combinators[i-1] {
+	case ' ':
+		for p := n.parent; p != nil; p = p.parent {
+			if c.match(p, i-1, scope) {
+				return true
+			}
+		}
+	case '>':
+		return n.parent != nil && c.match(n.parent, i-1, scope)
+	case '+':
+		return n.index > 0 && c.match(n.parent.children[n.index-1], i-1, scope)
+	case '~':
+		for j := n.index - 1; j >= 0; j-- {
+			if c.match(n.parent.children[j], i-1, scope) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func related(scope, n *node, combinator byte) bool {
+	switch combinator {
+	case ' ':
+		for p := n.parent; p != nil; p = p.parent {
+			if p == scope {
+				return true
+			}
+		}
+	case '>':
+		return n.parent == scope
+	case '+':
+		return n.parent != nil && n.parent == scope.parent && n.index == scope.index+1
+	case '~':
+		return n.parent != nil && n.parent == scope.parent && n.index > scope.index
+	}
+
+	return false
+}
+
+type compound struct {
+	typ     string
+	filters []filter
+}
+
+func (c compound) match(n *node) bool {
+	if c.typ != "" && typeName(n.Type) != c.typ {
+		return false
+	}
+
+	for _, f := range c.filters {
+		if !f.match(n) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func typeName(t javascript.Type) string {
+	typ := reflect.TypeOf(t)
+
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	return typ.Name()
+}
+
+type filter interface {
+	match(*node) bool
+}
+
+type attribute struct {
+	path   []string
+	op     string
+	value  string
+	regexp *regexp.Regexp
+}
+
+func (a attribute) match(n *node) bool {
+	v, ok := resolve(reflect.ValueOf(n.Type), a.path)
+
+	if a.op == "" {
+		return ok && !v.IsZero()
+	} else if !ok {
+		return a.op == "!="
+	}
+
+	s, ok := stringValue(v)
+	if !ok {
+		return a.op == "!="
+	}
+
+	switch a.op {
+	case "=":
+		if a.regexp != nil {
+			return a.regexp.MatchString(s)
+		}
+
+		return s == a.value
+	case "!=":
+		if a.regexp != nil {
+			return !a.regexp.MatchString(s)
+		}
+
+		return s != a.value
+	case "^=":
+		return strings.HasPrefix(s, a.value)
+	case "$=":
+		return strings.HasSuffix(s, a.value)
+	case "*=":
+		return strings.Contains(s, a.value)
+	}
+
+	return false
+}
+
+func deref(v reflect.Value) (reflect.Value, bool) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return v, false
+		}
+
+		v = v.Elem()
+	}
+
+	return v, true
+}
+
+func resolve(v reflect.Value, path []string) (reflect.Value, bool) {
+	for _, name := range path {
+		var ok bool
+
+		if v, ok = deref(v); !ok {
+			return v, false
+		}
+
+		switch v.Kind() {
+		case reflect.Struct:
+			f, ok := v.Type().FieldByName(name)
+			if !ok || !f.IsExported() {
+				return v, false
+			}
+
+			var err error
+
+			if v, err = v.FieldByIndexErr(f.Index); err != nil {
+				return v, false
+			}
+		case reflect.Slice, reflect.Array:
+			i, err := strconv.Atoi(name)
+			if err != nil || i < 0 || i >= v.Len() {
+				return v, false
+			}
+
+			v = v.Index(i)
+		default:
+			return v, false
+		}
+	}
+
+	return v, true
+}
+
+func stringValue(v reflect.Value) (string, bool) {
+	v, ok := deref(v)
+	if !ok {
+		return "", false
+	}
+
+	switch t := v.Interface().(type) {
+	case javascript.Token:
+		return t.Data, true
+	case javascript.Type:
+		return fmt.Sprintf("%s", t), true
+	case fmt.Stringer:
+		return t.String(), true
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.String:
+		return v.String(), true
+	}
+
+	return "", false
+}
+
+type has selectorList
+
+func (h has) match(n *node) bool {
+	for _, c := range h {
+		base := n
+
+		if c.lead == '+' || c.lead == '~' {
+			if base = n.parent; base == nil {
+				continue
+			}
+		}
+
+		if base.any(func(d *node) bool { return c.match(d, len(c.compounds)-1, n) }) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type is selectorList
+
+func (i is) match(n *node) bool {
+	return selectorList(i).match(n, nil)
+}
+
+type not selectorList
+
+func (o not) match(n *node) bool {
+	return !selectorList(o).match(n, nil)
+}
+
+type nthChild struct {
+	n    int
+	last bool
+}
+
+func (c nthChild) match(n *node) bool {
+	if n.parent == nil {
+		return false
+	} else if c.last {
+		return len(n.parent.children)-n.index == c.n
+	}
+
+	return n.index+1 == c.n
+}
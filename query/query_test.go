@@ -0,0 +1,288 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"vimagination.zapto.org/javascript"
+	"vimagination.zapto.org/parser"
+)
+
+func TestCompile(t *testing.T) {
+	for n, test := range [...]struct {
+		Input string
+		Err   error
+		Pos   int
+	}{
+		{ // 1
+			Input: "CallExpression",
+		},
+		{ // 2
+			Input: " * , A > B + C ~ D E:has(> F):not(G, H):is(I)[J.K.0][L!='m'][N=/o\\/p/i]:first-child:nth-last-child( 2 ) ",
+		},
+		{ // 3
+			Input: "",
+			Err:   ErrExpectedSelector,
+			Pos:   0,
+		},
+		{ // 4
+			Input: "A >",
+			Err:   ErrExpectedSelector,
+			Pos:   3,
+		},
+		{ // 5
+			Input: "A,",
+			Err:   ErrExpectedSelector,
+			Pos:   2,
+		},
+		{ // 6
+			Input: "A[]",
+			Err:   ErrInvalidAttribute,
+			Pos:   2,
+		},
+		{ // 7
+			Input: "A[B<C]",
+			Err:   ErrInvalidAttribute,
+			Pos:   3,
+		},
+		{ // 8
+			Input: "A[B=]",
+			Err:   ErrInvalidAttribute,
+			Pos:   4,
+		},
+		{ // 9
+			Input: "A[B=C",
+			Err:   ErrInvalidAttribute,
+			Pos:   5,
+		},
+		{ // 10
+			Input: "A[B^=/c/]",
+			Err:   ErrInvalidAttribute,
+			Pos:   5,
+		},
+		{ // 11
+			Input: "A[B=\"c]",
+			Err:   ErrUnterminatedString,
+			Pos:   4,
+		},
+		{ // 12
+			Input: "A[B=/c]",
+			Err:   ErrUnterminatedRegexp,
+			Pos:   4,
+		},
+		{ // 13
+			Input: "A[B=/(/]",
+			Err:   ErrInvalidRegexp,
+			Pos:   4,
+		},
+		{ // 14
+			Input: "A[B=/c/g]",
+			Err:   ErrInvalidRegexp,
+			Pos:   7,
+		},
+		{ // 15
+			Input: "A:unknown",
+			Err:   ErrUnknownPseudoClass,
+			Pos:   1,
+		},
+		{ // 16
+			Input: "A:nth-child(0)",
+			Err:   ErrInvalidArgument,
+			Pos:   12,
+		},
+		{ // 17
+			Input: "A:has",
+			Err:   ErrInvalidArgument,
+			Pos:   5,
+		},
+		{ // 18
+			Input: "A:not(B",
+			Err:   ErrUnmatchedParenthesis,
+			Pos:   7,
+		},
+		{ // 19
+			Input: "A)",
+			Err:   ErrUnexpectedCharacter,
+			Pos:   1,
+		},
+		{ // 20
+			Input: "A > > B",
+			Err:   ErrExpectedSelector,
+			Pos:   4,
+		},
+	} {
+		_, err := Compile(test.Input)
+		if !errors.Is(err, test.Err) {
+			t.Errorf("test %d: expecting error %v, got %v", n+1, test.Err, err)
+		} else if test.Err != nil {
+			if e := err.(Error); e.Pos != test.Pos {
+				t.Errorf("test %d: expecting error at position %d, got %d", n+1, test.Pos, e.Pos)
+			}
+		}
+	}
+}
+
+func TestQuery(t *testing.T) {
+	for n, test := range [...]struct {
+		Input, Selector string
+		Output          []string
+	}{
+		{ // 1
+			"console.log(a);\nconsole.error(b);\nlog(c);",
+			"CallExpression[MemberExpression.IdentifierName=\"log\"]",
+			[]string{"console.log(a)"},
+		},
+		{ // 2
+			"console.log(a);\nconsole.error(b);\nlog(c);",
+			"CallExpression[MemberExpression^=console.]",
+			[]string{"console.log(a)", "console.error(b)"},
+		},
+		{ // 3
+			"function a(b, c) {}\nconst d = function (e) {};",
+			"FunctionDeclaration > FormalParameters",
+			[]string{"(b, c) ", "(e) "},
+		},
+		{ // 4
+			"function a(b, c) {}\nconst d = function (e) {};",
+			"LexicalDeclaration FormalParameters",
+			[]string{"(e) "},
+		},
+		{ // 5
+			"function a() {\n\treturn 1;\n}\nfunction b() {}",
+			"FunctionDeclaration:has(Statement[Type=StatementReturn])",
+			[]string{"function a() {\n\treturn 1;\n}"},
+		},
+		{ // 6
+			"function a() {\n\treturn 1;\n}\nfunction b() {}",
+			"FunctionDeclaration:not(:has(Statement[Type=StatementReturn]))",
+			[]string{"function b() {}"},
+		},
+		{ // 7
+			"a(b, c, d);",
+			"Argument:first-child, Argument:last-child",
+			[]string{"b", "d"},
+		},
+		{ // 8
+			"a(b, c, d);",
+			"Argument:nth-child(2), Argument:nth-last-child(3)",
+			[]string{"b", "c"},
+		},
+		{ // 9
+			"a(b, c, d);",
+			"Argument + Argument",
+			[]string{"c", "d"},
+		},
+		{ // 10
+			"a(b, c, d);",
+			"Argument:first-child ~ *",
+			[]string{"c", "d"},
+		},
+		{ // 11
+			"a(b, c, d);",
+			"Argument:has(+ Argument[AssignmentExpression=d])",
+			[]string{"c"},
+		},
+		{ // 12
+			"a + b - c;\nd * e;",
+			"AdditiveExpression[AdditiveOperator=\"-\"] > MultiplicativeExpression",
+			[]string{"c"},
+		},
+		{ // 13
+			"let a = 1;\nvar b = 2;\nlet c = \"3\";",
+			"LexicalDeclaration LexicalBinding[Initializer=/^\\d$/]",
+			[]string{"a = 1"},
+		},
+		{ // 14
+			"let a = 1;\nvar b = 2;\nlet c = \"3\";",
+			"LexicalDeclaration LexicalBinding[Initializer!=/^\\d$/], VariableStatement LexicalBinding[BindingIdentifier$=b]",
+			[]string{"b = 2", "c = \"3\""},
+		},
+		{ // 15
+			"async function a() {}\nfunction b() {}",
+			"FunctionDeclaration[Type=Async], FunctionDeclaration[BindingIdentifier.Data=/B/i]",
+			[]string{"async function a() {}", "function b() {}"},
+		},
+		{ // 16
+			"class A {\n\tstatic b() {}\n\tc() {}\n}",
+			"ClassElement[Static]",
+			[]string{"static b() {}"},
+		},
+		{ // 17
+			"a(b);",
+			"Arguments[ArgumentList.0=b], Arguments[ArgumentList.1], UnknownType, CallExpression[Unknown]",
+			[]string{"(b)"},
+		},
+		{ // 18
+			"a(b);",
+			":is(Module, ModuleItem)",
+			[]string{"a(b);", "a(b);"},
+		},
+	} {
+		tk := parser.NewStringTokeniser(test.Input)
+
+		m, err := javascript.ParseModule(&tk)
+		if err != nil {
+			t.Errorf("test %d: unexpected error: %s", n+1, err)
+
+			continue
+		}
+
+		matches, err := Query(m, test.Selector)
+		if err != nil {
+			t.Errorf("test %d: unexpected error: %s", n+1, err)
+
+			continue
+		}
+
+		output := make([]string, len(matches))
+
+		for i, match := range matches {
+			output[i] = fmt.Sprintf("%s", match.Node)
+		}
+
+		if !reflect.DeepEqual(output, test.Output) {
+			t.Errorf("test %d: expecting matches %q, got %q", n+1, test.Output, output)
+		}
+	}
+}
+
+func TestMatchPath(t *testing.T) {
+	tk := parser.NewStringTokeniser("a(b);")
+
+	m, err := javascript.ParseModule(&tk)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	s, err := Compile("Argument")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	matches := s.Match(m)
+	if len(matches) != 1 {
+		t.Fatalf("expecting 1 match, got %d", len(matches))
+	}
+
+	ce := javascript.UnwrapConditional(m.ModuleListItems[0].StatementListItem.Statement.ExpressionStatement.Expressions[0].ConditionalExpression).(*javascript.CallExpression)
+
+	if matches[0].Node != &ce.Arguments.ArgumentList[0] {
+		t.Errorf("expecting matched node to be the argument")
+	}
+
+	path := matches[0].Path
+
+	if len(path) < 4 {
+		t.Fatalf("expecting at least 4 ancestors, got %d", len(path))
+	} else if path[0] != m {
+		t.Errorf("expecting path to begin with the module, got %T", path[0])
+	} else if path[1] != &m.ModuleListItems[0] {
+		t.Errorf("expecting second ancestor to be the module item, got %T", path[1])
+	} else if path[len(path)-2] != ce {
+		t.Errorf("expecting grandparent to be the call expression, got %T", path[len(path)-2])
+	} else if path[len(path)-1] != ce.Arguments {
+		t.Errorf("expecting parent to be the arguments, got %T", path[len(path)-1])
+	}
+}
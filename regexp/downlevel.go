@@ -0,0 +1,534 @@
+package regexp
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"vimagination.zapto.org/javascript"
+	"vimagination.zapto.org/javascript/walk"
+)
+
+// Feature represents a regular expression feature that can be removed by
+// Downlevel.
+type Feature uint8
+
+// Features.
+const (
+	// FeatureNamedGroups replaces named capture groups with numbered ones, and
+	// named backreferences with numbered backreferences.
+	FeatureNamedGroups Feature = 1 << iota
+
+	// FeatureDotAll removes the 's' flag, replacing each '.' with '[^]'.
+	FeatureDotAll
+
+	// FeatureLookbehind replaces lookbehinds that only contain zero-width
+	// assertions with the equivalent lookahead.
+	FeatureLookbehind
+
+	// FeatureUnicodeSets replaces the 'v' flag with the 'u' flag, evaluating
+	// nested classes, set operations, and string disjunctions.
+	FeatureUnicodeSets
+
+	// FeatureUnicodeProperties expands Unicode property escapes into explicit
+	// ranges, using the tables in the unicode package.
+	FeatureUnicodeProperties
+
+	FeatureAll = FeatureNamedGroups | FeatureDotAll | FeatureLookbehind | FeatureUnicodeSets | FeatureUnicodeProperties
+)
+
+type downleveller struct {
+	features Feature
+	flags    Flags
+	groups   map[string][]int
+}
+
+// Downlevel rewrites the regular expression to remove the given features, so
+// that it can be run by older engines.
+//
+// The rewritten expression matches the same strings, though the names of
+// capture groups will no longer be available to the groups property of a
+// match.
+//
+// An error is returned if a feature cannot be removed, such as a lookbehind
+// that is not zero-width, or a property for which there is no table. When an
+// error is returned, the expression may have been partially rewritten.
+func (r *RegularExpression) Downlevel(features Feature) error {
+	d := downleveller{
+		features: features,
+		flags:    r.Flags,
+	}
+
+	if features&FeatureNamedGroups != 0 {
+		d.groups = make(map[string][]int)
+
+		d.numberGroups(r.Pattern, new(int))
+	}
+
+	if err := d.disjunction(r.Pattern); err != nil {
+		return err
+	}
+
+	if features&FeatureDotAll != 0 {
+		r.Flags &^= FlagDotAll
+	}
+
+	if features&FeatureUnicodeSets != 0 && r.Flags&FlagUnicodeSets != 0 {
+		r.Flags = r.Flags&^FlagUnicodeSets | FlagUnicode
+	}
+
+	return nil
+}
+
+func (d *downleveller) numberGroups(dj Disjunction, num *int) {
+	for _, a := range dj {
+		for _, t := range a {
+			if t.Assertion != nil {
+				d.numberGroups(t.Assertion.Disjunction, num)
+			} else if t.Atom != nil && t.Atom.Group != nil {
+				if t.Atom.Group.Capturing {
+					*num++
+
+					if t.Atom.Group.Name != "" {
+						d.groups[t.Atom.Group.Name] = append(d.groups[t.Atom.Group.Name], *num)
+					}
+				}
+
+				d.numberGroups(t.Atom.Group.Disjunction, num)
+			}
+		}
+	}
+}
+
+func (d *downleveller) disjunction(dj Disjunction) error {
+	for _, a := range dj {
+		for n := range a {
+			if err := d.term(&a[n]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (d *downleveller) term(t *Term) error {
+	if a := t.Assertion; a != nil {
+		if a.IsLookbehind() && d.features&FeatureLookbehind != 0 {
+			if !zeroWidth(a.Disjunction) {
+				return ErrUnsupportedLookbehind
+			}
+
+			a.Type -= AssertionLookbehind - AssertionLookahead
+		}
+
+		return d.disjunction(a.Disjunction)
+	} else if t.Atom == nil {
+		return nil
+	}
+
+	switch a := t.Atom; {
+	case a.Dot:
+		if d.features&FeatureDotAll != 0 && d.flags&FlagDotAll != 0 {
+			a.Dot = false
+			a.CharacterClass = &CharacterClass{Negated: true}
+		}
+	case a.Group != nil:
+		if d.features&FeatureNamedGroups != 0 {
+			a.Group.Name = ""
+		}
+
+		return d.disjunction(a.Group.Disjunction)
+	case a.Backreference != nil:
+		if d.features&FeatureNamedGroups != 0 && a.Backreference.Name != "" {
+			d.backreference(a)
+		}
+	case a.CharacterClassEscape != nil:
+		return d.escape(a)
+	case a.CharacterClass != nil:
+		return d.class(a)
+	}
+
+	return nil
+}
+
+func (d *downleveller) backreference(a *Atom) {
+	groups := d.groups[a.Backreference.Name]
+
+	if len(groups) == 1 {
+		a.Backreference = &Backreference{Number: groups[0]}
+
+		return
+	}
+
+	var alt Alternative
+
+	for _, g := range groups {
+		alt = append(alt, Term{Atom: &Atom{Backreference: &Backreference{Number: g}}})
+	}
+
+	a.Backreference = nil
+	a.Group = &Group{Disjunction: Disjunction{alt}}
+}
+
+// zeroWidth determines whether a Disjunction can only match the empty string.
+func zeroWidth(dj Disjunction) bool {
+	for _, a := range dj {
+		for _, t := range a {
+			if t.Atom != nil && (t.Atom.Group == nil || !zeroWidth(t.Atom.Group.Disjunction)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func (d *downleveller) escape(a *Atom) error {
+	e := a.CharacterClassEscape
+
+	if e.Type < ClassEscapeProperty {
+		return nil
+	}
+
+	if strs, _ := validProperty(e.PropertyName, e.PropertyValue, true); strs {
+		if d.unicodeSets() {
+			return ErrUnsupportedProperty
+		}
+
+		return nil
+	} else if d.features&FeatureUnicodeProperties == 0 {
+		return nil
+	}
+
+	// A negated class only matches characters whose case variants are all
+	// outside of the set, so, when ignoring case, the complement of the
+	// property is used instead.
+	typ, negated := ClassEscapeProperty, e.Type == ClassEscapeNotProperty
+
+	if d.flags&FlagIgnoreCase != 0 {
+		typ, negated = e.Type, false
+	}
+
+	set, err := d.escapeSet(&CharacterClassEscape{
+		Type:          typ,
+		PropertyName:  e.PropertyName,
+		PropertyValue: e.PropertyValue,
+	})
+	if err != nil {
+		return err
+	}
+
+	a.CharacterClassEscape = nil
+	a.CharacterClass = &CharacterClass{
+		Negated:  negated,
+		Contents: set.contents(),
+	}
+
+	return nil
+}
+
+// foldCase determines whether sets are case folded before they are combined,
+// which is the case when both the 'i' and 'v' flags are set.
+func (d *downleveller) foldCase() bool {
+	return d.flags&FlagIgnoreCase != 0 && d.flags&FlagUnicodeSets != 0
+}
+
+func (d *downleveller) unicodeSets() bool {
+	return d.features&FeatureUnicodeSets != 0 && d.flags&FlagUnicodeSets != 0
+}
+
+func (d *downleveller) escapeSet(e *CharacterClassEscape) (runeSet, error) {
+	var set runeSet
+
+	switch e.Type {
+	case ClassEscapeDigit, ClassEscapeNotDigit:
+		set = digitSet
+	case ClassEscapeSpace, ClassEscapeNotSpace:
+		set = spaceSet
+	case ClassEscapeWord, ClassEscapeNotWord:
+		if d.flags.UnicodeMode() && d.flags&FlagIgnoreCase != 0 {
+			set = unicodeIgnoreCaseWordSet
+		} else {
+			set = wordSet
+		}
+	default:
+		var ok bool
+
+		if set, ok = propertySet(e.PropertyName, e.PropertyValue); !ok {
+			return nil, ErrUnsupportedProperty
+		}
+	}
+
+	if d.foldCase() {
+		set = set.fold()
+	}
+
+	if e.Type&1 == 1 {
+		return set.invert(), nil
+	}
+
+	return set, nil
+}
+
+func (d *downleveller) class(a *Atom) error {
+	cc := a.CharacterClass
+
+	if !d.unicodeSets() || simpleClass(cc) {
+		if d.unicodeSets() {
+			// Escapes such as '\&' are only valid with the 'v' flag.
+			for n := range cc.Contents {
+				cc.Contents[n].Range.Source = ""
+			}
+		}
+
+		if d.features&FeatureUnicodeProperties != 0 {
+			return d.expandClassProperties(cc)
+		}
+
+		return nil
+	}
+
+	set, strs, err := d.evalClass(cc)
+	if err != nil {
+		return err
+	}
+
+	if len(strs) == 0 {
+		cc.Operator = ClassUnion
+		cc.Contents = set.contents()
+
+		return nil
+	}
+
+	slices.SortStableFunc(strs, func(a, b string) int {
+		return cmp.Compare(utf8.RuneCountInString(b), utf8.RuneCountInString(a))
+	})
+
+	var dj Disjunction
+
+	for _, str := range strs {
+		alt := Alternative{}
+
+		for _, c := range str {
+			alt = append(alt, Term{Atom: &Atom{Character: c}})
+		}
+
+		dj = append(dj, alt)
+	}
+
+	if len(set) > 0 {
+		dj = append(dj, Alternative{{Atom: &Atom{CharacterClass: &CharacterClass{Contents: set.contents()}}}})
+	}
+
+	a.CharacterClass = nil
+	a.Group = &Group{Disjunction: dj}
+
+	return nil
+}
+
+// simpleClass determines whether a CharacterClass has the same meaning with
+// the 'u' flag as it does with the 'v' flag.
+func simpleClass(cc *CharacterClass) bool {
+	if cc.Operator != ClassUnion {
+		return false
+	}
+
+	for _, c := range cc.Contents {
+		if c.NestedClass != nil || c.ClassStringDisjunction != nil {
+			return false
+		} else if e := c.CharacterClassEscape; e != nil && e.Type == ClassEscapeProperty {
+			if strs, _ := validProperty(e.PropertyName, e.PropertyValue, true); strs {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func (d *downleveller) expandClassProperties(cc *CharacterClass) error {
+	var contents []ClassContent
+
+	for _, c := range cc.Contents {
+		if e := c.CharacterClassEscape; e != nil && e.Type >= ClassEscapeProperty {
+			if strs, _ := validProperty(e.PropertyName, e.PropertyValue, true); !strs {
+				set, err := d.escapeSet(e)
+				if err != nil {
+					return err
+				}
+
+				if cc.Operator == ClassUnion {
+					contents = append(contents, set.contents()...)
+				} else {
+					contents = append(contents, ClassContent{NestedClass: &CharacterClass{Contents: set.contents()}})
+				}
+
+				continue
+			}
+		} else if c.NestedClass != nil {
+			if err := d.expandClassProperties(c.NestedClass); err != nil {
+				return err
+			}
+		}
+
+		contents = append(contents, c)
+	}
+
+	cc.Contents = contents
+
+	return nil
+}
+
+// evalClass evaluates the contents of a CharacterClass, ignoring any
+// negation, returning the matched characters and strings.
+//
+// When ignoring case, each operand is case folded before being combined, so
+// that, for example, [\p{Lu}--\p{Ll}] matches no cased letters.
+func (d *downleveller) evalClass(cc *CharacterClass) (runeSet, []string, error) {
+	var (
+		set  runeSet
+		strs []string
+	)
+
+	for n, c := range cc.Contents {
+		cset, cstrs, err := d.evalClassContent(c)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if n == 0 {
+			set, strs = cset, cstrs
+
+			continue
+		}
+
+		switch cc.Operator {
+		case ClassUnion:
+			set = set.union(cset)
+
+			for _, s := range cstrs {
+				if !d.containsString(strs, s) {
+					strs = append(strs, s)
+				}
+			}
+		case ClassIntersection:
+			set = set.intersect(cset)
+			strs = slices.DeleteFunc(strs, func(s string) bool {
+				return !d.containsString(cstrs, s)
+			})
+		case ClassSubtraction:
+			set = set.subtract(cset)
+			strs = slices.DeleteFunc(strs, func(s string) bool {
+				return d.containsString(cstrs, s)
+			})
+		}
+	}
+
+	return set, strs, nil
+}
+
+func (d *downleveller) evalClassContent(c ClassContent) (runeSet, []string, error) {
+	switch {
+	case c.CharacterClassEscape != nil:
+		if e := c.CharacterClassEscape; e.Type >= ClassEscapeProperty {
+			if strs, _ := validProperty(e.PropertyName, e.PropertyValue, true); strs {
+				return nil, nil, ErrUnsupportedProperty
+			}
+		}
+
+		set, err := d.escapeSet(c.CharacterClassEscape)
+
+		return set, nil, err
+	case c.NestedClass != nil:
+		set, strs, err := d.evalClass(c.NestedClass)
+		if err != nil {
+			return nil, nil, err
+		} else if c.NestedClass.Negated {
+			set = set.invert()
+		}
+
+		return set, strs, nil
+	case c.ClassStringDisjunction != nil:
+		var (
+			ranges []ClassRange
+			strs   []string
+		)
+
+		for _, s := range c.ClassStringDisjunction.Strings {
+			if r, size := utf8.DecodeRuneInString(s); size > 0 && size == len(s) {
+				ranges = append(ranges, ClassRange{From: r, To: r})
+			} else if !slices.Contains(strs, s) {
+				strs = append(strs, s)
+			}
+		}
+
+		return d.foldSet(newRuneSet(ranges)), strs, nil
+	}
+
+	return d.foldSet(runeSet{c.Range}), nil, nil
+}
+
+func (d *downleveller) foldSet(set runeSet) runeSet {
+	if d.foldCase() {
+		return set.fold()
+	}
+
+	return set
+}
+
+func (d *downleveller) containsString(strs []string, str string) bool {
+	if d.foldCase() {
+		return slices.ContainsFunc(strs, func(s string) bool {
+			return strings.EqualFold(s, str)
+		})
+	}
+
+	return slices.Contains(strs, str)
+}
+
+// DownlevelLiterals calls Downlevel with the given features on every
+// RegularExpressionLiteral in the given JavaScript type, replacing the
+// literal when it has been changed.
+//
+// Any error is returned as a javascript.Error, with the Token set to the
+// literal that could not be rewritten.
+func DownlevelLiterals(t javascript.Type, features Feature) error {
+	var h walk.Handler
+
+	h = walk.HandlerFunc(func(t javascript.Type) error {
+		if pe, ok := t.(*javascript.PrimaryExpression); ok && pe.Literal != nil && pe.Literal.Type == javascript.TokenRegularExpressionLiteral {
+			if err := downlevelLiteral(pe.Literal, features); err != nil {
+				return javascript.Error{
+					Err:     err,
+					Parsing: "PrimaryExpression",
+					Token:   *pe.Literal,
+				}
+			}
+		}
+
+		return walk.Walk(t, h)
+	})
+
+	return h.Handle(t)
+}
+
+func downlevelLiteral(tk *javascript.Token, features Feature) error {
+	r, err := Parse(tk.Data)
+	if err != nil {
+		return err
+	}
+
+	before := r.String()
+
+	if err := r.Downlevel(features); err != nil {
+		return err
+	}
+
+	if after := r.String(); after != before {
+		tk.Data = after
+	}
+
+	return nil
+}
@@ -0,0 +1,298 @@
+package regexp_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"unicode"
+
+	"vimagination.zapto.org/javascript"
+	"vimagination.zapto.org/javascript/regexp"
+	"vimagination.zapto.org/parser"
+)
+
+func TestDownlevel(t *testing.T) {
+	for n, test := range [...]struct {
+		Input    string
+		Features regexp.Feature
+		Output   string
+		Err      error
+	}{
+		{ // 1
+			Input:    "/(?<year>\\d{4})-(?<month>\\d\\d)\\k<year>/",
+			Features: regexp.FeatureNamedGroups,
+			Output:   "/(\\d{4})-(\\d\\d)\\1/",
+		},
+		{ // 2
+			Input:    "/(?<a>x)|(?<a>y)\\k<a>/",
+			Features: regexp.FeatureNamedGroups,
+			Output:   "/(x)|(y)(?:\\1\\2)/",
+		},
+		{ // 3
+			Input:    "/(?<a>x)(?:(y)(?<b>z))\\k<b>/",
+			Features: regexp.FeatureNamedGroups,
+			Output:   "/(x)(?:(y)(z))\\3/",
+		},
+		{ // 4
+			Input:    "/(?<a>x)\\k<a>/",
+			Features: regexp.FeatureDotAll,
+			Output:   "/(?<a>x)\\k<a>/",
+		},
+		{ // 5
+			Input:    "/a.b/gs",
+			Features: regexp.FeatureDotAll,
+			Output:   "/a[^]b/g",
+		},
+		{ // 6
+			Input:    "/a.b/g",
+			Features: regexp.FeatureDotAll,
+			Output:   "/a.b/g",
+		},
+		{ // 7
+			Input:    "/(?<=^|\\b)a(?<!$)/",
+			Features: regexp.FeatureLookbehind,
+			Output:   "/(?=^|\\b)a(?!$)/",
+		},
+		{ // 8
+			Input:    "/(?<=a)b/",
+			Features: regexp.FeatureLookbehind,
+			Err:      regexp.ErrUnsupportedLookbehind,
+		},
+		{ // 9
+			Input:    "/(?<=a)b/",
+			Features: regexp.FeatureDotAll,
+			Output:   "/(?<=a)b/",
+		},
+		{ // 10
+			Input:    "/[[a-z]--[aeiou]]/v",
+			Features: regexp.FeatureUnicodeSets,
+			Output:   "/[b-df-hj-np-tv-z]/u",
+		},
+		{ // 11
+			Input:    "/[\\w&&\\d]/gv",
+			Features: regexp.FeatureUnicodeSets,
+			Output:   "/[0-9]/gu",
+		},
+		{ // 12
+			Input:    "/[\\q{abc|d|ef}x]/v",
+			Features: regexp.FeatureUnicodeSets,
+			Output:   "/(?:abc|ef|[dx])/u",
+		},
+		{ // 13
+			Input:    "/[\\q{ab|cd}--\\q{cd}]/v",
+			Features: regexp.FeatureUnicodeSets,
+			Output:   "/(?:ab)/u",
+		},
+		{ // 14
+			Input:    "/[^[a-c][x-z]]/v",
+			Features: regexp.FeatureUnicodeSets,
+			Output:   "/[^a-cx-z]/u",
+		},
+		{ // 15
+			Input:    "/[a-z]\\p{ASCII}/v",
+			Features: regexp.FeatureUnicodeSets,
+			Output:   "/[a-z]\\p{ASCII}/u",
+		},
+		{ // 16
+			Input:    "/\\p{RGI_Emoji}/v",
+			Features: regexp.FeatureUnicodeSets,
+			Err:      regexp.ErrUnsupportedProperty,
+		},
+		{ // 17
+			Input:    "/\\p{RGI_Emoji}/v",
+			Features: regexp.FeatureUnicodeProperties,
+			Output:   "/\\p{RGI_Emoji}/v",
+		},
+		{ // 18
+			Input:    "/\\p{ASCII}\\P{ASCII}/u",
+			Features: regexp.FeatureUnicodeProperties,
+			Output:   "/[\\x00-\\x7f][^\\x00-\\x7f]/u",
+		},
+		{ // 19
+			Input:    "/[\\p{Script=Ogham}0-9]+/u",
+			Features: regexp.FeatureUnicodeProperties,
+			Output:   "/[\\u1680-\u169c0-9]+/u",
+		},
+		{ // 20
+			Input:    "/\\p{Cs}/u",
+			Features: regexp.FeatureUnicodeProperties,
+			Output:   "/[\\u{d800}-\\u{dfff}]/u",
+		},
+		{ // 21
+			Input:    "/[\\p{ASCII}--[\\0-\\x60\\x7b-\\x7f]]/v",
+			Features: regexp.FeatureUnicodeProperties,
+			Output:   "/[[\\x00-\\x7f]--[\\0-\\x60\\x7b-\\x7f]]/v",
+		},
+		{ // 22
+			Input:    "/[\\p{ASCII}--[\\0-\\x60\\x7b-\\x7f]]/v",
+			Features: regexp.FeatureAll,
+			Output:   "/[a-z]/u",
+		},
+		{ // 23
+			Input:    "/(?<a>.)\\k<a>(?<=\\b)[\\p{ASCII}&&\\d]/sv",
+			Features: regexp.FeatureAll,
+			Output:   "/([^])\\1(?=\\b)[0-9]/u",
+		},
+		{ // 24
+			Input:    "/[\\&\\x41-Z]\\x41{1,}/v",
+			Features: regexp.FeatureUnicodeSets,
+			Output:   "/[&A-Z]\\x41{1,}/u",
+		},
+	} {
+		r, err := regexp.Parse(test.Input)
+		if err != nil {
+			t.Errorf("test %d: unexpected parse error: %s", n+1, err)
+
+			continue
+		}
+
+		if err := r.Downlevel(test.Features); !errors.Is(err, test.Err) {
+			t.Errorf("test %d: expecting error %v, got %v", n+1, test.Err, err)
+		} else if test.Err == nil {
+			if output := r.String(); output != test.Output {
+				t.Errorf("test %d: expecting output %q, got %q", n+1, test.Output, output)
+			} else if _, err := regexp.Parse(output); err != nil {
+				t.Errorf("test %d: unexpected error reparsing output: %s", n+1, err)
+			}
+		}
+	}
+}
+
+func TestDownlevelIgnoreCase(t *testing.T) {
+	for n, test := range [...]struct {
+		Input    string
+		Features regexp.Feature
+		Match    string
+		NotMatch string
+	}{
+		{ // 1
+			Input:    "/\\P{Lu}/iu",
+			Features: regexp.FeatureUnicodeProperties,
+			Match:    "aAbB1",
+		},
+		{ // 2
+			Input:    "/\\P{Ll}/iu",
+			Features: regexp.FeatureUnicodeProperties,
+			Match:    "aAbB1",
+		},
+		{ // 3
+			Input:    "/\\P{Lu}/u",
+			Features: regexp.FeatureUnicodeProperties,
+			Match:    "ab1",
+			NotMatch: "AB",
+		},
+		{ // 4
+			Input:    "/[\\P{Ll}--\\p{Lu}]/vi",
+			Features: regexp.FeatureAll,
+			Match:    "1_",
+			NotMatch: "aAbB\u017f\u212a",
+		},
+		{ // 5
+			Input:    "/[\\p{Lu}&&[a-z]]/vi",
+			Features: regexp.FeatureAll,
+			Match:    "aAzZ\u212a",
+			NotMatch: "1",
+		},
+		{ // 6
+			Input:    "/[\\w--[k]]/vi",
+			Features: regexp.FeatureAll,
+			Match:    "aA_",
+			NotMatch: "kK\u212a",
+		},
+	} {
+		r, err := regexp.Parse(test.Input)
+		if err != nil {
+			t.Errorf("test %d: unexpected parse error: %s", n+1, err)
+
+			continue
+		}
+
+		if err := r.Downlevel(test.Features); err != nil {
+			t.Errorf("test %d: unexpected error: %s", n+1, err)
+
+			continue
+		}
+
+		ignoreCase := r.Flags.Has(regexp.FlagIgnoreCase)
+
+		cc := r.Pattern[0][0].Atom.CharacterClass
+		if cc == nil {
+			t.Errorf("test %d: expecting character class, got %s", n+1, r)
+
+			continue
+		}
+
+		for _, c := range test.Match {
+			if !classMatches(cc, c, ignoreCase) {
+				t.Errorf("test %d: expecting %q to match", n+1, c)
+			}
+		}
+
+		for _, c := range test.NotMatch {
+			if classMatches(cc, c, ignoreCase) {
+				t.Errorf("test %d: expecting %q not to match", n+1, c)
+			}
+		}
+	}
+}
+
+// classMatches determines whether a character, or, when ignoring case, any of
+// its case variants, is matched by a downlevelled CharacterClass.
+func classMatches(cc *regexp.CharacterClass, c rune, ignoreCase bool) bool {
+	contains := func(c rune) bool {
+		for _, cc := range cc.Contents {
+			if cc.Range.From <= c && c <= cc.Range.To {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	found := contains(c)
+
+	for f := unicode.SimpleFold(c); ignoreCase && f != c && !found; f = unicode.SimpleFold(f) {
+		found = contains(f)
+	}
+
+	return found != cc.Negated
+}
+
+func TestDownlevelLiterals(t *testing.T) {
+	for n, test := range [...]struct {
+		Input, Output string
+		Err           error
+	}{
+		{ // 1
+			Input:  "const a = /a/, b = /(?<b>.)\\k<b>/s;",
+			Output: "const a = /a/, b = /([^])\\1/;",
+		},
+		{ // 2
+			Input:  "a.replace(/[\\d--[0-4]]/gv, () => /(?<=^)x/);",
+			Output: "a.replace(/[5-9]/gu, () => /(?=^)x/);",
+		},
+		{ // 3
+			Input: "const a = /(?<=a)b/;",
+			Err:   regexp.ErrUnsupportedLookbehind,
+		},
+	} {
+		tk := parser.NewStringTokeniser(test.Input)
+
+		m, err := javascript.ParseModule(&tk)
+		if err != nil {
+			t.Errorf("test %d: unexpected parse error: %s", n+1, err)
+
+			continue
+		}
+
+		if err := regexp.DownlevelLiterals(m, regexp.FeatureAll); !errors.Is(err, test.Err) {
+			t.Errorf("test %d: expecting error %v, got %v", n+1, test.Err, err)
+		} else if test.Err != nil {
+			if e, ok := err.(javascript.Error); !ok || e.Token.Data != "/(?<=a)b/" {
+				t.Errorf("test %d: expecting error on literal token, got %v", n+1, err)
+			}
+		} else if output := fmt.Sprintf("%s", m); output != test.Output {
+			t.Errorf("test %d: expecting output %q, got %q", n+1, test.Output, output)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package regexp
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Error represents an error found while parsing a regular expression.
+//
+// Pos is the byte offset of the error within the parsed string.
+type Error struct {
+	Err error
+	Pos int
+}
+
+// Error returns the error string.
+func (e Error) Error() string {
+	return fmt.Sprintf("error at position %d:\n%s", e.Pos+1, e.Err)
+}
+
+// Unwrap returns the wrapped error.
+func (e Error) Unwrap() error {
+	return e.Err
+}
+
+// Errors.
+var (
+	ErrDuplicateFlag         = errors.New("duplicate flag")
+	ErrDuplicateGroupName    = errors.New("duplicate capture group name")
+	ErrInvalidBackreference  = errors.New("invalid backreference")
+	ErrInvalidCharacter      = errors.New("invalid character in character class")
+	ErrInvalidClassRange     = errors.New("invalid character class range")
+	ErrInvalidEscape         = errors.New("invalid escape")
+	ErrInvalidFlag           = errors.New("invalid flag")
+	ErrInvalidGroup          = errors.New("invalid group")
+	ErrInvalidGroupName      = errors.New("invalid capture group name")
+	ErrInvalidLiteral        = errors.New("invalid regular expression literal")
+	ErrInvalidNamedReference = errors.New("invalid named reference")
+	ErrInvalidProperty       = errors.New("invalid property name or value")
+	ErrLoneQuantifierBracket = errors.New("lone quantifier brackets")
+	ErrMixedClassOperators   = errors.New("mixed class set operators")
+	ErrNegatedStrings        = errors.New("negated character class may contain strings")
+	ErrNothingToRepeat       = errors.New("nothing to repeat")
+	ErrQuantifierOrder       = errors.New("numbers out of order in quantifier")
+	ErrRangeOrder            = errors.New("range out of order in character class")
+	ErrUnicodeAndUnicodeSets = errors.New("cannot combine 'u' and 'v' flags")
+	ErrUnsupportedLookbehind = errors.New("lookbehind cannot be downlevelled")
+	ErrUnsupportedProperty   = errors.New("property escape cannot be expanded")
+	ErrUnmatchedParenthesis  = errors.New("unmatched ')'")
+	ErrUnterminatedClass     = errors.New("unterminated character class")
+	ErrUnterminatedGroup     = errors.New("unterminated group")
+)
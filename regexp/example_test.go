@@ -0,0 +1,31 @@
+package regexp_test
+
+import (
+	"fmt"
+
+	"vimagination.zapto.org/javascript/regexp"
+)
+
+func Example() {
+	r, err := regexp.Parse(`/(?<year>\d{4})-(?<month>\d{2})|\p{Lu}{0,}/gu`)
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	fmt.Println(len(r.Pattern))
+	fmt.Println(r.Pattern[0][0].Atom.Group.Name)
+	fmt.Println(r)
+
+	_, err = regexp.Parse(`/(?<a>.)(?<a>.)/`)
+
+	fmt.Println(err)
+
+	// Output:
+	// 2
+	// year
+	// /(?<year>\d{4})-(?<month>\d{2})|\p{Lu}{0,}/gu
+	// error at position 12:
+	// duplicate capture group name
+}
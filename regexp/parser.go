@@ -0,0 +1,1055 @@
+package regexp
+
+import (
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"vimagination.zapto.org/javascript/internal"
+)
+
+const (
+	syntaxCharacters             = "^$\\.*+?()[]{}|"
+	classSetSyntaxCharacters     = "()[]{}/-\\|"
+	classSetReservedDouble       = "&!#$%*+,.:;<=>?@^`~"
+	classSetReservedPunctuator   = "&-!#%,:;<=>@`~"
+	hexDigits                    = "0123456789abcdefABCDEF"
+	maxQuantifier                = math.MaxInt32
+	maxCodePoint                 = 0x10ffff
+	leadSurrogateStart           = 0xd800
+	trailSurrogateStart          = 0xdc00
+	trailSurrogateEnd            = 0xdfff
+	surrogateOffset              = 0x10000
+	controlLetterMask            = 0x1f
+	backspace                    = 8
+	namedGroupPrefix             = "(?<"
+	lookbehindPrefix             = "(?<="
+	negativeLookbehindPrefix     = "(?<!"
+	classStringDisjunctionPrefix = "\\q{"
+)
+
+type namedReference struct {
+	name string
+	pos  int
+}
+
+type parser struct {
+	src         string
+	pos         int
+	flags       Flags
+	unicode     bool
+	unicodeSets bool
+	namedGroups bool
+	groups      int
+	names       map[string]struct{}
+	references  []namedReference
+}
+
+func (p *parser) parse() (Disjunction, error) {
+	p.unicode = p.flags.UnicodeMode()
+	p.unicodeSets = p.flags&FlagUnicodeSets != 0
+	p.groups, p.namedGroups = countGroups(p.src[p.pos:], p.unicodeSets)
+	p.names = make(map[string]struct{})
+
+	d, _, err := p.parseDisjunction()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.pos < len(p.src) {
+		return nil, p.error(ErrUnmatchedParenthesis, p.pos)
+	}
+
+	for _, ref := range p.references {
+		if _, ok := p.names[ref.name]; !ok {
+			return nil, p.error(ErrInvalidNamedReference, ref.pos)
+		}
+	}
+
+	return d, nil
+}
+
+// countGroups counts the capturing groups in a pattern, and determines whether
+// any of them are named.
+func countGroups(src string, unicodeSets bool) (int, bool) {
+	var (
+		groups, depth int
+		named         bool
+	)
+
+	for n := 0; n < len(src); n++ {
+		switch src[n] {
+		case '\\':
+			n++
+		case '[':
+			if depth == 0 || unicodeSets {
+				depth++
+			}
+		case ']':
+			if depth > 0 {
+				depth--
+			}
+		case '(':
+			if depth > 0 {
+				continue
+			}
+
+			if rest := src[n:]; strings.HasPrefix(rest, namedGroupPrefix) && !strings.HasPrefix(rest, lookbehindPrefix) && !strings.HasPrefix(rest, negativeLookbehindPrefix) {
+				groups++
+				named = true
+			} else if !strings.HasPrefix(rest, "(?") {
+				groups++
+			}
+		}
+	}
+
+	return groups, named
+}
+
+func (p *parser) error(err error, pos int) error {
+	return Error{Err: err, Pos: pos}
+}
+
+func (p *parser) peek() rune {
+	if p.pos >= len(p.src) {
+		return -1
+	}
+
+	c, _ := utf8.DecodeRuneInString(p.src[p.pos:])
+
+	return c
+}
+
+func (p *parser) next() rune {
+	if p.pos >= len(p.src) {
+		return -1
+	}
+
+	c, s := utf8.DecodeRuneInString(p.src[p.pos:])
+	p.pos += s
+
+	return c
+}
+
+// source returns the given source text when it differs from the text written
+// by the print func, and an empty string otherwise.
+func (p *parser) source(src string, print func(*printer)) string {
+	pr := printer{last: -1, unicode: p.unicode}
+
+	if print(&pr); pr.String() == src {
+		return ""
+	}
+
+	return src
+}
+
+func (p *parser) hasPrefix(prefix string) bool {
+	return strings.HasPrefix(p.src[p.pos:], prefix)
+}
+
+func (p *parser) accept(prefix string) bool {
+	if p.hasPrefix(prefix) {
+		p.pos += len(prefix)
+
+		return true
+	}
+
+	return false
+}
+
+func (p *parser) parseDisjunction() (Disjunction, map[string]int, error) {
+	var (
+		d     Disjunction
+		names map[string]int
+	)
+
+	for {
+		a, an, err := p.parseAlternative()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		d = append(d, a)
+
+		for name, pos := range an {
+			if names == nil {
+				names = make(map[string]int)
+			}
+
+			if _, ok := names[name]; !ok {
+				names[name] = pos
+			}
+		}
+
+		if !p.accept("|") {
+			return d, names, nil
+		}
+	}
+}
+
+func (p *parser) parseAlternative() (Alternative, map[string]int, error) {
+	var (
+		a     Alternative
+		names map[string]int
+	)
+
+	for p.pos < len(p.src) && p.src[p.pos] != '|' && p.src[p.pos] != ')' {
+		t, tn, err := p.parseTerm()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for name, pos := range tn {
+			if names == nil {
+				names = make(map[string]int)
+			}
+
+			if _, ok := names[name]; ok {
+				return nil, nil, p.error(ErrDuplicateGroupName, pos)
+			}
+
+			names[name] = pos
+		}
+
+		a = append(a, t)
+	}
+
+	return a, names, nil
+}
+
+func (p *parser) parseTerm() (Term, map[string]int, error) {
+	start := p.pos
+
+	var a *Assertion
+
+	switch {
+	case p.accept("^"):
+		a = &Assertion{Type: AssertionStart}
+	case p.accept("$"):
+		a = &Assertion{Type: AssertionEnd}
+	case p.accept("\\b"):
+		a = &Assertion{Type: AssertionWordBoundary}
+	case p.accept("\\B"):
+		a = &Assertion{Type: AssertionNotWordBoundary}
+	case p.accept("(?="):
+		a = &Assertion{Type: AssertionLookahead}
+	case p.accept("(?!"):
+		a = &Assertion{Type: AssertionNegativeLookahead}
+	case p.accept(lookbehindPrefix):
+		a = &Assertion{Type: AssertionLookbehind}
+	case p.accept(negativeLookbehindPrefix):
+		a = &Assertion{Type: AssertionNegativeLookbehind}
+	default:
+		atom, names, err := p.parseAtom()
+		if err != nil {
+			return Term{}, nil, err
+		}
+
+		if atom.isCharacter() {
+			atom.Source = p.source(p.src[start:p.pos], atom.print)
+		}
+
+		q, err := p.parseQuantifier()
+		if err != nil {
+			return Term{}, nil, err
+		}
+
+		return Term{Atom: atom, Quantifier: q}, names, nil
+	}
+
+	var names map[string]int
+
+	if a.IsLookaround() {
+		var err error
+
+		if a.Disjunction, names, err = p.parseDisjunction(); err != nil {
+			return Term{}, nil, err
+		} else if !p.accept(")") {
+			return Term{}, nil, p.error(ErrUnterminatedGroup, start)
+		}
+	}
+
+	qpos := p.pos
+
+	q, err := p.parseQuantifier()
+	if err != nil {
+		return Term{}, nil, err
+	} else if q != nil && (p.unicode || a.Type != AssertionLookahead && a.Type != AssertionNegativeLookahead) {
+		return Term{}, nil, p.error(ErrNothingToRepeat, qpos)
+	}
+
+	return Term{Assertion: a, Quantifier: q}, names, nil
+}
+
+func (p *parser) parseQuantifier() (*Quantifier, error) {
+	var (
+		q     *Quantifier
+		start = p.pos
+	)
+
+	switch p.peek() {
+	case '*':
+		q = &Quantifier{Min: 0, Max: Unbounded}
+	case '+':
+		q = &Quantifier{Min: 1, Max: Unbounded}
+	case '?':
+		q = &Quantifier{Min: 0, Max: 1}
+	case '{':
+		if !p.isBracedQuantifier() {
+			if p.unicode {
+				return nil, p.error(ErrLoneQuantifierBracket, p.pos)
+			}
+
+			return nil, nil
+		}
+
+		p.pos++
+		q = &Quantifier{Min: p.parseDecimal()}
+		q.Max = q.Min
+
+		if p.accept(",") {
+			if p.peek() == '}' {
+				q.Max = Unbounded
+			} else if q.Max = p.parseDecimal(); q.Max < q.Min {
+				return nil, p.error(ErrQuantifierOrder, start)
+			}
+		}
+	default:
+		return nil, nil
+	}
+
+	p.pos++
+	q.Lazy = p.accept("?")
+	q.Source = p.source(p.src[start:p.pos], q.print)
+
+	return q, nil
+}
+
+func (p *parser) isBracedQuantifier() bool {
+	s := p.src[p.pos:]
+
+	if !strings.HasPrefix(s, "{") {
+		return false
+	}
+
+	n := 1 + countDigits(s[1:])
+	if n == 1 {
+		return false
+	}
+
+	if n < len(s) && s[n] == ',' {
+		n++
+		n += countDigits(s[n:])
+	}
+
+	return n < len(s) && s[n] == '}'
+}
+
+func countDigits(s string) int {
+	for n := range len(s) {
+		if !isDigit(rune(s[n])) {
+			return n
+		}
+	}
+
+	return len(s)
+}
+
+func isDigit(c rune) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isOctalDigit(c rune) bool {
+	return c >= '0' && c <= '7'
+}
+
+func (p *parser) parseDecimal() int {
+	var n int
+
+	for p.pos < len(p.src) && isDigit(rune(p.src[p.pos])) {
+		n = min(n*10+int(p.src[p.pos]-'0'), maxQuantifier)
+		p.pos++
+	}
+
+	return n
+}
+
+func (p *parser) parseAtom() (*Atom, map[string]int, error) {
+	switch c := p.peek(); c {
+	case '.':
+		p.pos++
+
+		return &Atom{Dot: true}, nil, nil
+	case '(':
+		return p.parseGroup()
+	case '[':
+		cc, _, err := p.parseClass()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return &Atom{CharacterClass: cc}, nil, nil
+	case '\\':
+		atom, err := p.parseAtomEscape()
+
+		return atom, nil, err
+	case '*', '+', '?':
+		return nil, nil, p.error(ErrNothingToRepeat, p.pos)
+	case '{':
+		if p.isBracedQuantifier() {
+			return nil, nil, p.error(ErrNothingToRepeat, p.pos)
+		}
+
+		fallthrough
+	case '}', ']':
+		if p.unicode {
+			return nil, nil, p.error(ErrLoneQuantifierBracket, p.pos)
+		}
+	}
+
+	return &Atom{Character: p.next()}, nil, nil
+}
+
+func (p *parser) parseGroup() (*Atom, map[string]int, error) {
+	start := p.pos
+	p.pos++
+
+	var (
+		g       = &Group{Capturing: true}
+		namePos int
+	)
+
+	if p.accept("?:") {
+		g.Capturing = false
+	} else if p.accept("?<") {
+		namePos = p.pos
+
+		name, ok := p.parseGroupName()
+		if !ok {
+			return nil, nil, p.error(ErrInvalidGroupName, namePos)
+		}
+
+		g.Name = name
+	} else if p.hasPrefix("?") {
+		return nil, nil, p.error(ErrInvalidGroup, start)
+	}
+
+	d, names, err := p.parseDisjunction()
+	if err != nil {
+		return nil, nil, err
+	} else if !p.accept(")") {
+		return nil, nil, p.error(ErrUnterminatedGroup, start)
+	}
+
+	g.Disjunction = d
+
+	if g.Name != "" {
+		if _, ok := names[g.Name]; ok {
+			return nil, nil, p.error(ErrDuplicateGroupName, namePos)
+		} else if names == nil {
+			names = make(map[string]int)
+		}
+
+		names[g.Name] = namePos
+		p.names[g.Name] = struct{}{}
+	}
+
+	return &Atom{Group: g}, names, nil
+}
+
+// parseGroupName parses a RegExpIdentifierName, and the closing '>'.
+func (p *parser) parseGroupName() (string, bool) {
+	var sb strings.Builder
+
+	for !p.accept(">") {
+		var c rune
+
+		if p.accept("\\u") {
+			var ok bool
+
+			if c, ok = p.parseUnicodeEscape(true); !ok {
+				return "", false
+			}
+		} else {
+			c = p.next()
+		}
+
+		if c == -1 || c == '\\' || sb.Len() == 0 && !internal.IsIDStart(c) || !internal.IsIDContinue(c) {
+			return "", false
+		}
+
+		sb.WriteRune(c)
+	}
+
+	return sb.String(), sb.Len() > 0
+}
+
+// parseUnicodeEscape parses the remainder of a '\u' escape sequence, restoring
+// the position if it is invalid.
+func (p *parser) parseUnicodeEscape(unicode bool) (rune, bool) {
+	start := p.pos
+
+	if unicode && p.accept("{") {
+		end := strings.IndexByte(p.src[p.pos:], '}')
+		if end < 1 {
+			p.pos = start
+
+			return 0, false
+		}
+
+		var c rune
+
+		for _, h := range p.src[p.pos : p.pos+end] {
+			if !strings.ContainsRune(hexDigits, h) {
+				p.pos = start
+
+				return 0, false
+			}
+
+			if c = c<<4 | hexValue(h); c > maxCodePoint {
+				p.pos = start
+
+				return 0, false
+			}
+		}
+
+		p.pos += end + 1
+
+		return c, true
+	}
+
+	c, ok := p.parseHex(4)
+	if !ok {
+		return 0, false
+	}
+
+	if unicode && c >= leadSurrogateStart && c < trailSurrogateStart {
+		pos := p.pos
+
+		if p.accept("\\u") {
+			if t, ok := p.parseHex(4); ok && t >= trailSurrogateStart && t <= trailSurrogateEnd {
+				return (c-leadSurrogateStart)<<10 + (t - trailSurrogateStart) + surrogateOffset, true
+			}
+		}
+
+		p.pos = pos
+	}
+
+	return c, true
+}
+
+func (p *parser) parseHex(n int) (rune, bool) {
+	if len(p.src)-p.pos < n {
+		return 0, false
+	}
+
+	var c rune
+
+	for _, h := range p.src[p.pos : p.pos+n] {
+		if !strings.ContainsRune(hexDigits, h) {
+			return 0, false
+		}
+
+		c = c<<4 | hexValue(h)
+	}
+
+	p.pos += n
+
+	return c, true
+}
+
+func hexValue(h rune) rune {
+	switch {
+	case h >= 'a':
+		return h - 'a' + 10
+	case h >= 'A':
+		return h - 'A' + 10
+	}
+
+	return h - '0'
+}
+
+func (p *parser) parseAtomEscape() (*Atom, error) {
+	start := p.pos
+	p.pos++
+
+	switch c := p.peek(); {
+	case c >= '1' && c <= '9':
+		if n := p.parseDecimal(); n <= p.groups {
+			return &Atom{Backreference: &Backreference{Number: n}}, nil
+		} else if p.unicode {
+			return nil, p.error(ErrInvalidBackreference, start)
+		}
+
+		p.pos = start + 1
+
+		return &Atom{Character: p.parseLegacyOctal()}, nil
+	case c == 'k' && (p.unicode || p.namedGroups):
+		p.pos++
+
+		if !p.accept("<") {
+			return nil, p.error(ErrInvalidNamedReference, start)
+		}
+
+		name, ok := p.parseGroupName()
+		if !ok {
+			return nil, p.error(ErrInvalidNamedReference, start)
+		}
+
+		p.references = append(p.references, namedReference{name: name, pos: start})
+
+		return &Atom{Backreference: &Backreference{Name: name}}, nil
+	case p.isClassEscape(c):
+		e, _, err := p.parseClassEscape()
+		if err != nil {
+			return nil, err
+		}
+
+		return &Atom{CharacterClassEscape: e}, nil
+	}
+
+	c, err := p.parseCharacterEscape(false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Atom{Character: c}, nil
+}
+
+func (p *parser) isClassEscape(c rune) bool {
+	return (c != 'p' && c != 'P' || p.unicode) && c != -1 && strings.ContainsRune(classEscapeChars, c)
+}
+
+// parseCharacterEscape parses the CharacterEscape following a '\'.
+func (p *parser) parseCharacterEscape(inClass bool) (rune, error) {
+	start := p.pos - 1
+
+	switch c := p.next(); c {
+	case 'f':
+		return '\f', nil
+	case 'n':
+		return '\n', nil
+	case 'r':
+		return '\r', nil
+	case 't':
+		return '\t', nil
+	case 'v':
+		return '\v', nil
+	case 'c':
+		if l := p.peek(); l >= 'a' && l <= 'z' || l >= 'A' && l <= 'Z' || inClass && !p.unicode && (isDigit(l) || l == '_') {
+			p.pos++
+
+			return l & controlLetterMask, nil
+		} else if p.unicode {
+			return 0, p.error(ErrInvalidEscape, start)
+		}
+
+		p.pos--
+
+		return '\\', nil
+	case '0':
+		if !isDigit(p.peek()) {
+			return 0, nil
+		} else if p.unicode {
+			return 0, p.error(ErrInvalidEscape, start)
+		}
+
+		p.pos--
+
+		return p.parseLegacyOctal(), nil
+	case '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		if p.unicode {
+			return 0, p.error(ErrInvalidEscape, start)
+		}
+
+		p.pos--
+
+		return p.parseLegacyOctal(), nil
+	case 'x':
+		if h, ok := p.parseHex(2); ok {
+			return h, nil
+		} else if p.unicode {
+			return 0, p.error(ErrInvalidEscape, start)
+		}
+
+		return c, nil
+	case 'u':
+		if u, ok := p.parseUnicodeEscape(p.unicode); ok {
+			return u, nil
+		} else if p.unicode {
+			return 0, p.error(ErrInvalidEscape, start)
+		}
+
+		return c, nil
+	case -1:
+		return 0, p.error(ErrInvalidEscape, start)
+	default:
+		if p.unicode {
+			if c == '/' || inClass && c == '-' || strings.ContainsRune(syntaxCharacters, c) {
+				return c, nil
+			}
+
+			return 0, p.error(ErrInvalidEscape, start)
+		} else if c == 'k' && p.namedGroups {
+			return 0, p.error(ErrInvalidEscape, start)
+		}
+
+		return c, nil
+	}
+}
+
+// parseLegacyOctal parses a LegacyOctalEscapeSequence, or, for '8' and '9',
+// an IdentityEscape.
+func (p *parser) parseLegacyOctal() rune {
+	c := rune(p.src[p.pos])
+	p.pos++
+
+	if !isOctalDigit(c) {
+		return c
+	}
+
+	c -= '0'
+	digits := 2
+
+	if c >= 4 {
+		digits = 1
+	}
+
+	for range digits {
+		if p.pos >= len(p.src) || !isOctalDigit(rune(p.src[p.pos])) {
+			break
+		}
+
+		c = c<<3 | rune(p.src[p.pos]-'0')
+		p.pos++
+	}
+
+	return c
+}
+
+// parseClassEscape parses a CharacterClassEscape following a '\', returning
+// whether it may match strings.
+func (p *parser) parseClassEscape() (*CharacterClassEscape, bool, error) {
+	start := p.pos - 1
+	e := &CharacterClassEscape{Type: ClassEscape(strings.IndexRune(classEscapeChars, p.next()))}
+
+	if e.Type < ClassEscapeProperty {
+		return e, false, nil
+	}
+
+	if !p.accept("{") {
+		return nil, false, p.error(ErrInvalidProperty, start)
+	}
+
+	end := strings.IndexByte(p.src[p.pos:], '}')
+	if end == -1 {
+		return nil, false, p.error(ErrInvalidProperty, start)
+	}
+
+	body := p.src[p.pos : p.pos+end]
+	p.pos += end + 1
+
+	if name, value, ok := strings.Cut(body, "="); ok {
+		e.PropertyName, e.PropertyValue = name, value
+	} else {
+		e.PropertyValue = body
+	}
+
+	strs, ok := validProperty(e.PropertyName, e.PropertyValue, p.unicodeSets)
+	if !ok {
+		return nil, false, p.error(ErrInvalidProperty, start)
+	} else if strs && e.Type == ClassEscapeNotProperty {
+		return nil, false, p.error(ErrNegatedStrings, start)
+	}
+
+	return e, strs, nil
+}
+
+// parseClass parses a CharacterClass, returning whether it may match strings.
+func (p *parser) parseClass() (*CharacterClass, bool, error) {
+	start := p.pos
+	p.pos++
+	cc := &CharacterClass{Negated: p.accept("^")}
+
+	if p.unicodeSets {
+		strs, err := p.parseClassSet(cc, start)
+		if err != nil {
+			return nil, false, err
+		} else if strs && cc.Negated {
+			return nil, false, p.error(ErrNegatedStrings, start)
+		}
+
+		return cc, strs, nil
+	}
+
+	for !p.accept("]") {
+		if p.pos >= len(p.src) {
+			return nil, false, p.error(ErrUnterminatedClass, start)
+		}
+
+		atomStart := p.pos
+
+		a, err := p.parseClassAtom()
+		if err != nil {
+			return nil, false, err
+		}
+
+		if p.hasPrefix("-") && p.pos+1 < len(p.src) && p.src[p.pos+1] != ']' {
+			rangePos := p.pos
+			p.pos++
+
+			b, err := p.parseClassAtom()
+			if err != nil {
+				return nil, false, err
+			}
+
+			if a.CharacterClassEscape != nil || b.CharacterClassEscape != nil {
+				if p.unicode {
+					return nil, false, p.error(ErrInvalidClassRange, rangePos)
+				}
+
+				if a.CharacterClassEscape == nil {
+					a.Range.Source = p.source(p.src[atomStart:rangePos], a.Range.print)
+				}
+
+				if b.CharacterClassEscape == nil {
+					b.Range.Source = p.source(p.src[rangePos+1:p.pos], b.Range.print)
+				}
+
+				cc.Contents = append(cc.Contents, a, ClassContent{Range: ClassRange{From: '-', To: '-', Source: "-"}}, b)
+
+				continue
+			} else if a.Range.From > b.Range.From {
+				return nil, false, p.error(ErrRangeOrder, rangePos)
+			}
+
+			a.Range.To = b.Range.From
+		}
+
+		if a.CharacterClassEscape == nil {
+			a.Range.Source = p.source(p.src[atomStart:p.pos], a.Range.print)
+		}
+
+		cc.Contents = append(cc.Contents, a)
+	}
+
+	return cc, false, nil
+}
+
+func (p *parser) parseClassAtom() (ClassContent, error) {
+	if !p.accept("\\") {
+		c := p.next()
+
+		return ClassContent{Range: ClassRange{From: c, To: c}}, nil
+	}
+
+	switch c := p.peek(); {
+	case c == 'b':
+		p.pos++
+
+		return ClassContent{Range: ClassRange{From: backspace, To: backspace}}, nil
+	case p.isClassEscape(c):
+		e, _, err := p.parseClassEscape()
+
+		return ClassContent{CharacterClassEscape: e}, err
+	}
+
+	c, err := p.parseCharacterEscape(true)
+
+	return ClassContent{Range: ClassRange{From: c, To: c}}, err
+}
+
+// parseClassSet parses the ClassSetExpression of a CharacterClass when the 'v'
+// flag is set, returning whether it may match strings.
+func (p *parser) parseClassSet(cc *CharacterClass, start int) (bool, error) {
+	var strs bool
+
+	for n := 0; !p.accept("]"); n++ {
+		if p.pos >= len(p.src) {
+			return false, p.error(ErrUnterminatedClass, start)
+		}
+
+		if n > 0 {
+			switch cc.Operator {
+			case ClassUnion:
+				if p.hasPrefix("&&") || p.hasPrefix("--") {
+					return false, p.error(ErrMixedClassOperators, p.pos)
+				}
+			case ClassIntersection:
+				if !p.accept("&&") {
+					return false, p.error(ErrMixedClassOperators, p.pos)
+				} else if p.hasPrefix("&") {
+					return false, p.error(ErrInvalidCharacter, p.pos)
+				}
+			case ClassSubtraction:
+				if !p.accept("--") {
+					return false, p.error(ErrMixedClassOperators, p.pos)
+				}
+			}
+		}
+
+		operandPos := p.pos
+
+		c, s, isChar, err := p.parseClassSetOperand()
+		if err != nil {
+			return false, err
+		}
+
+		isRange := isChar && p.hasPrefix("-") && !p.hasPrefix("--")
+
+		if isRange {
+			if cc.Operator != ClassUnion {
+				return false, p.error(ErrInvalidCharacter, p.pos)
+			}
+
+			p.pos++
+
+			d, _, isChar, err := p.parseClassSetOperand()
+			if err != nil {
+				return false, err
+			} else if !isChar {
+				return false, p.error(ErrInvalidClassRange, operandPos)
+			} else if c.Range.From > d.Range.From {
+				return false, p.error(ErrRangeOrder, operandPos)
+			}
+
+			c.Range.To = d.Range.From
+		}
+
+		if isChar {
+			c.Range.Source = p.source(p.src[operandPos:p.pos], c.Range.print)
+		}
+
+		if n == 0 && !isRange {
+			if p.hasPrefix("&&") {
+				cc.Operator = ClassIntersection
+			} else if p.hasPrefix("--") {
+				cc.Operator = ClassSubtraction
+			}
+		}
+
+		switch cc.Operator {
+		case ClassUnion:
+			strs = strs || s
+		case ClassIntersection:
+			strs = s && (n == 0 || strs)
+		case ClassSubtraction:
+			if n == 0 {
+				strs = s
+			}
+		}
+
+		cc.Contents = append(cc.Contents, c)
+	}
+
+	return strs, nil
+}
+
+// parseClassSetOperand parses a single operand of a ClassSetExpression,
+// returning whether it may match strings and whether it is a single
+// character.
+func (p *parser) parseClassSetOperand() (ClassContent, bool, bool, error) {
+	switch {
+	case p.hasPrefix("["):
+		nc, strs, err := p.parseClass()
+
+		return ClassContent{NestedClass: nc}, strs, false, err
+	case p.accept(classStringDisjunctionPrefix):
+		csd, strs, err := p.parseClassStringDisjunction()
+
+		return ClassContent{ClassStringDisjunction: csd}, strs, false, err
+	case p.hasPrefix("\\") && p.pos+1 < len(p.src) && p.isClassEscape(rune(p.src[p.pos+1])):
+		p.pos++
+
+		e, strs, err := p.parseClassEscape()
+
+		return ClassContent{CharacterClassEscape: e}, strs, false, err
+	}
+
+	c, err := p.parseClassSetCharacter()
+
+	return ClassContent{Range: ClassRange{From: c, To: c}}, false, true, err
+}
+
+func (p *parser) parseClassStringDisjunction() (*ClassStringDisjunction, bool, error) {
+	var (
+		csd      = new(ClassStringDisjunction)
+		start    = p.pos - len(classStringDisjunctionPrefix)
+		strStart = p.pos
+		sb       strings.Builder
+		chars    int
+		strs     bool
+	)
+
+	for {
+		switch {
+		case p.pos >= len(p.src):
+			return nil, false, p.error(ErrUnterminatedClass, start)
+		case p.hasPrefix("}"), p.hasPrefix("|"):
+			str := sb.String()
+			src := p.source(p.src[strStart:p.pos], func(pr *printer) {
+				for _, c := range str {
+					pr.writeChar(c, true)
+				}
+			})
+
+			if src != "" && csd.Sources == nil {
+				csd.Sources = make([]string, len(csd.Strings))
+			}
+
+			if csd.Sources != nil {
+				csd.Sources = append(csd.Sources, src)
+			}
+
+			csd.Strings = append(csd.Strings, str)
+			strs = strs || chars != 1
+
+			sb.Reset()
+
+			chars = 0
+
+			if p.next() == '}' {
+				return csd, strs, nil
+			}
+
+			strStart = p.pos
+		default:
+			c, err := p.parseClassSetCharacter()
+			if err != nil {
+				return nil, false, err
+			}
+
+			sb.WriteRune(c)
+
+			chars++
+		}
+	}
+}
+
+func (p *parser) parseClassSetCharacter() (rune, error) {
+	start := p.pos
+
+	if p.accept("\\") {
+		if c := p.peek(); c == 'b' {
+			p.pos++
+
+			return backspace, nil
+		} else if c != -1 && strings.ContainsRune(classSetReservedPunctuator, c) {
+			p.pos++
+
+			return c, nil
+		}
+
+		return p.parseCharacterEscape(true)
+	}
+
+	c := p.next()
+
+	if c == -1 {
+		return 0, p.error(ErrUnterminatedClass, start)
+	} else if strings.ContainsRune(classSetSyntaxCharacters, c) || strings.ContainsRune(classSetReservedDouble, c) && p.peek() == c {
+		return 0, p.error(ErrInvalidCharacter, start)
+	}
+
+	return c, nil
+}
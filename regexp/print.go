@@ -0,0 +1,335 @@
+package regexp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type printer struct {
+	strings.Builder
+	last    rune
+	unicode bool
+}
+
+// String returns the regular expression as a RegularExpressionLiteral.
+//
+// Any escapes and quantifiers recorded in the Source fields by the parser are
+// printed as they were parsed, so that parsing and printing a literal
+// reproduces it.
+func (r RegularExpression) String() string {
+	p := printer{unicode: r.Flags.UnicodeMode()}
+
+	p.WriteByte('/')
+
+	if len(r.Pattern) == 0 || len(r.Pattern) == 1 && len(r.Pattern[0]) == 0 {
+		p.WriteString("(?:)")
+	} else {
+		r.Pattern.print(&p)
+	}
+
+	p.WriteByte('/')
+	p.WriteString(r.Flags.String())
+
+	return p.String()
+}
+
+// String returns the source of the pattern.
+//
+// Characters are escaped such that the output is valid with any set of flags,
+// except for those printed from a Source field.
+func (d Disjunction) String() string {
+	var p printer
+
+	d.print(&p)
+
+	return p.String()
+}
+
+func (d Disjunction) print(p *printer) {
+	for n, a := range d {
+		if n > 0 {
+			p.WriteByte('|')
+		}
+
+		a.print(p)
+	}
+}
+
+func (a Alternative) print(p *printer) {
+	for n, t := range a {
+		if t.Atom != nil && t.Atom.isCharacter() && n > 0 && isDigit(t.Atom.Character) {
+			if prev := a[n-1]; prev.Quantifier == nil && prev.Atom != nil && prev.Atom.Backreference != nil && prev.Atom.Backreference.Name == "" {
+				fmt.Fprintf(p, "\\x%02x", t.Atom.Character)
+
+				if t.Quantifier != nil {
+					t.Quantifier.print(p)
+				}
+
+				continue
+			}
+		}
+
+		t.print(p)
+	}
+}
+
+func (t Term) print(p *printer) {
+	if t.Assertion != nil {
+		t.Assertion.print(p)
+	} else if t.Atom != nil {
+		t.Atom.print(p)
+	}
+
+	if t.Quantifier != nil {
+		t.Quantifier.print(p)
+	}
+}
+
+var assertionPrefixes = [...]string{
+	AssertionStart:              "^",
+	AssertionEnd:                "$",
+	AssertionWordBoundary:       "\\b",
+	AssertionNotWordBoundary:    "\\B",
+	AssertionLookahead:          "(?=",
+	AssertionNegativeLookahead:  "(?!",
+	AssertionLookbehind:         "(?<=",
+	AssertionNegativeLookbehind: "(?<!",
+}
+
+func (a *Assertion) print(p *printer) {
+	if int(a.Type) >= len(assertionPrefixes) {
+		return
+	}
+
+	p.WriteString(assertionPrefixes[a.Type])
+
+	if a.IsLookaround() {
+		a.Disjunction.print(p)
+		p.WriteByte(')')
+	}
+}
+
+func (q *Quantifier) print(p *printer) {
+	if q.Source != "" {
+		p.WriteString(q.Source)
+
+		return
+	}
+
+	switch {
+	case q.Min == 0 && q.Max == Unbounded:
+		p.WriteByte('*')
+	case q.Min == 1 && q.Max == Unbounded:
+		p.WriteByte('+')
+	case q.Min == 0 && q.Max == 1:
+		p.WriteByte('?')
+	case q.Max == Unbounded:
+		fmt.Fprintf(p, "{%d,}", q.Min)
+	case q.Min == q.Max:
+		fmt.Fprintf(p, "{%d}", q.Min)
+	default:
+		fmt.Fprintf(p, "{%d,%d}", q.Min, q.Max)
+	}
+
+	if q.Lazy {
+		p.WriteByte('?')
+	}
+}
+
+func (a *Atom) isCharacter() bool {
+	return !a.Dot && a.CharacterClassEscape == nil && a.CharacterClass == nil && a.Backreference == nil && a.Group == nil
+}
+
+func (a *Atom) print(p *printer) {
+	switch {
+	case a.Dot:
+		p.WriteByte('.')
+	case a.CharacterClassEscape != nil:
+		a.CharacterClassEscape.print(p)
+	case a.CharacterClass != nil:
+		a.CharacterClass.print(p)
+	case a.Backreference != nil:
+		a.Backreference.print(p)
+	case a.Group != nil:
+		a.Group.print(p)
+	case a.Source != "":
+		p.WriteString(a.Source)
+	default:
+		p.writeChar(a.Character, false)
+	}
+}
+
+func (g *Group) print(p *printer) {
+	p.WriteByte('(')
+
+	if g.Name != "" {
+		p.WriteString("?<")
+		p.WriteString(g.Name)
+		p.WriteByte('>')
+	} else if !g.Capturing {
+		p.WriteString("?:")
+	}
+
+	g.Disjunction.print(p)
+	p.WriteByte(')')
+}
+
+func (b *Backreference) print(p *printer) {
+	if b.Name != "" {
+		p.WriteString("\\k<")
+		p.WriteString(b.Name)
+		p.WriteByte('>')
+	} else {
+		p.WriteByte('\\')
+		p.WriteString(strconv.Itoa(b.Number))
+	}
+}
+
+func (c *CharacterClassEscape) print(p *printer) {
+	if int(c.Type) >= len(classEscapeChars) {
+		return
+	}
+
+	p.WriteByte('\\')
+	p.WriteByte(classEscapeChars[c.Type])
+
+	if c.Type >= ClassEscapeProperty {
+		p.WriteByte('{')
+
+		if c.PropertyName != "" {
+			p.WriteString(c.PropertyName)
+			p.WriteByte('=')
+		}
+
+		p.WriteString(c.PropertyValue)
+		p.WriteByte('}')
+	}
+}
+
+var classSetOperators = [...]string{
+	ClassUnion:        "",
+	ClassIntersection: "&&",
+	ClassSubtraction:  "--",
+}
+
+func (c *CharacterClass) print(p *printer) {
+	p.WriteByte('[')
+
+	if c.Negated {
+		p.WriteByte('^')
+	}
+
+	p.last = -1
+
+	for n, cc := range c.Contents {
+		if n > 0 && int(c.Operator) < len(classSetOperators) && c.Operator != ClassUnion {
+			p.WriteString(classSetOperators[c.Operator])
+
+			p.last = -1
+		}
+
+		switch {
+		case cc.CharacterClassEscape != nil:
+			cc.CharacterClassEscape.print(p)
+		case cc.NestedClass != nil:
+			cc.NestedClass.print(p)
+		case cc.ClassStringDisjunction != nil:
+			cc.ClassStringDisjunction.print(p)
+		default:
+			cc.Range.print(p)
+
+			continue
+		}
+
+		p.last = -1
+	}
+
+	p.WriteByte(']')
+
+	p.last = -1
+}
+
+func (r ClassRange) print(p *printer) {
+	if r.Source != "" {
+		p.WriteString(r.Source)
+
+		if p.last = -1; strings.HasSuffix(r.Source, string(r.To)) {
+			p.last = r.To
+		}
+
+		return
+	}
+
+	p.writeChar(r.From, true)
+
+	if r.To != r.From {
+		p.WriteByte('-')
+		p.last = '-'
+		p.writeChar(r.To, true)
+	}
+}
+
+func (c *ClassStringDisjunction) print(p *printer) {
+	p.WriteString("\\q{")
+
+	for n, s := range c.Strings {
+		if n > 0 {
+			p.WriteByte('|')
+		}
+
+		p.last = -1
+
+		if n < len(c.Sources) && c.Sources[n] != "" {
+			p.WriteString(c.Sources[n])
+
+			continue
+		}
+
+		for _, r := range s {
+			p.writeChar(r, true)
+		}
+	}
+
+	p.WriteByte('}')
+}
+
+func (p *printer) writeChar(c rune, inClass bool) {
+	last := p.last
+	p.last = c
+
+	switch c {
+	case '\t':
+		p.WriteString("\\t")
+	case '\n':
+		p.WriteString("\\n")
+	case '\v':
+		p.WriteString("\\v")
+	case '\f':
+		p.WriteString("\\f")
+	case '\r':
+		p.WriteString("\\r")
+	default:
+		switch {
+		case c == '/' || inClass && c == '-' || strings.ContainsRune(syntaxCharacters, c):
+			p.WriteByte('\\')
+			p.WriteRune(c)
+		case inClass && c == last && strings.ContainsRune(classSetReservedDouble, c),
+			c <= 0xff && !unicode.IsPrint(c):
+			fmt.Fprintf(p, "\\x%02x", c)
+
+			p.last = -1
+		case p.unicode && (c >= leadSurrogateStart && c <= trailSurrogateEnd || c > 0xffff && !unicode.IsPrint(c)):
+			fmt.Fprintf(p, "\\u{%x}", c)
+
+			p.last = -1
+		case c <= 0xffff && !unicode.IsPrint(c):
+			fmt.Fprintf(p, "\\u%04x", c)
+
+			p.last = -1
+		default:
+			p.WriteRune(c)
+		}
+	}
+}
@@ -0,0 +1,304 @@
+// Package regexp parses the pattern and flags of a JavaScript
+// RegularExpressionLiteral into an AST.
+package regexp // import "vimagination.zapto.org/javascript/regexp"
+
+import "strings"
+
+// Flags represents the set of flags on a regular expression.
+type Flags uint8
+
+// Flags.
+const (
+	FlagHasIndices Flags = 1 << iota
+	FlagGlobal
+	FlagIgnoreCase
+	FlagMultiline
+	FlagDotAll
+	FlagUnicode
+	FlagUnicodeSets
+	FlagSticky
+)
+
+const flagChars = "dgimsuvy"
+
+// ParseFlags parses the flags of a regular expression, returning an error if
+// an unknown flag is used, a flag is repeated, or the 'u' and 'v' flags are
+// combined.
+func ParseFlags(flags string) (Flags, error) {
+	var f Flags
+
+	for n, c := range flags {
+		p := strings.IndexRune(flagChars, c)
+		if p == -1 {
+			return 0, Error{Err: ErrInvalidFlag, Pos: n}
+		}
+
+		flag := Flags(1) << p
+		if f&flag != 0 {
+			return 0, Error{Err: ErrDuplicateFlag, Pos: n}
+		}
+
+		f |= flag
+	}
+
+	if f&FlagUnicode != 0 && f&FlagUnicodeSets != 0 {
+		return 0, Error{Err: ErrUnicodeAndUnicodeSets, Pos: max(strings.IndexByte(flags, 'u'), strings.IndexByte(flags, 'v'))}
+	}
+
+	return f, nil
+}
+
+// Has returns true if all of the given flags are set.
+func (f Flags) Has(flags Flags) bool {
+	return f&flags == flags
+}
+
+// UnicodeMode returns true if either the 'u' or 'v' flag is set.
+func (f Flags) UnicodeMode() bool {
+	return f&(FlagUnicode|FlagUnicodeSets) != 0
+}
+
+// String returns the flags in their canonical order.
+func (f Flags) String() string {
+	var sb strings.Builder
+
+	for n := range len(flagChars) {
+		if f&(1<<n) != 0 {
+			sb.WriteByte(flagChars[n])
+		}
+	}
+
+	return sb.String()
+}
+
+// RegularExpression represents a parsed RegularExpressionLiteral.
+type RegularExpression struct {
+	Pattern Disjunction
+	Flags   Flags
+}
+
+// Parse parses a RegularExpressionLiteral, such as the Data of a
+// javascript.TokenRegularExpressionLiteral token.
+//
+// The position of any returned error is relative to the start of the literal.
+func Parse(literal string) (*RegularExpression, error) {
+	end := strings.LastIndexByte(literal, '/')
+	if len(literal) < 2 || literal[0] != '/' || end < 1 {
+		return nil, Error{Err: ErrInvalidLiteral}
+	}
+
+	flags, err := ParseFlags(literal[end+1:])
+	if err != nil {
+		e := err.(Error)
+		e.Pos += end + 1
+
+		return nil, e
+	}
+
+	p := parser{
+		src:   literal[:end],
+		pos:   1,
+		flags: flags,
+	}
+
+	d, err := p.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	return &RegularExpression{Pattern: d, Flags: flags}, nil
+}
+
+// ParsePattern parses the body of a regular expression, validating it against
+// the given flags.
+func ParsePattern(pattern string, flags Flags) (Disjunction, error) {
+	p := parser{
+		src:   pattern,
+		flags: flags,
+	}
+
+	return p.parse()
+}
+
+// Disjunction represents a list of alternatives, separated by '|'.
+type Disjunction []Alternative
+
+// Alternative represents a sequence of terms.
+type Alternative []Term
+
+// Term represents either an Assertion or an Atom, with an optional
+// Quantifier.
+//
+// A Quantifier on an Assertion is only allowed on a lookahead when not in
+// Unicode mode.
+type Term struct {
+	Assertion  *Assertion
+	Atom       *Atom
+	Quantifier *Quantifier
+}
+
+// AssertionType determines the type of an Assertion.
+type AssertionType uint8
+
+// Assertion types.
+const (
+	AssertionStart AssertionType = iota
+	AssertionEnd
+	AssertionWordBoundary
+	AssertionNotWordBoundary
+	AssertionLookahead
+	AssertionNegativeLookahead
+	AssertionLookbehind
+	AssertionNegativeLookbehind
+)
+
+// Assertion represents one of '^', '$', '\b', '\B' or a lookaround.
+//
+// Disjunction is only used by lookarounds.
+type Assertion struct {
+	Type        AssertionType
+	Disjunction Disjunction
+}
+
+// IsLookaround returns true if the Assertion is a lookahead or lookbehind.
+func (a *Assertion) IsLookaround() bool {
+	return a.Type >= AssertionLookahead
+}
+
+// IsLookbehind returns true if the Assertion is a lookbehind.
+func (a *Assertion) IsLookbehind() bool {
+	return a.Type >= AssertionLookbehind
+}
+
+// Unbounded is the Max value of a Quantifier with no upper bound.
+const Unbounded = -1
+
+// Quantifier represents one of '*', '+', '?', '{n}', '{n,}' or '{n,m}',
+// optionally followed by '?' to make it lazy.
+//
+// Max is set to Unbounded when there is no upper bound.
+//
+// Source is set by the parser to the text of the quantifier when it differs
+// from the form that would otherwise be printed, such as '{0,}' for '*', and
+// is printed in its place; it must be cleared when changing the quantifier.
+type Quantifier struct {
+	Min, Max int
+	Lazy     bool
+	Source   string
+}
+
+// Atom represents a single matchable item.
+//
+// Character is used when none of the other fields are set. When not in
+// Unicode mode, Character can be a lone surrogate.
+//
+// Source is set by the parser to the text of a Character when it differs from
+// the form that would otherwise be printed, such as an escape like '\x41', and
+// is printed in its place; it must be cleared when changing the Character.
+type Atom struct {
+	Character            rune
+	Source               string
+	Dot                  bool
+	CharacterClassEscape *CharacterClassEscape
+	CharacterClass       *CharacterClass
+	Backreference        *Backreference
+	Group                *Group
+}
+
+// Group represents a parenthesised group.
+//
+// A group with a Name is always Capturing.
+type Group struct {
+	Capturing   bool
+	Name        string
+	Disjunction Disjunction
+}
+
+// Backreference represents either a numbered ('\1') or named ('\k<name>')
+// reference to a capturing group.
+type Backreference struct {
+	Number int
+	Name   string
+}
+
+// ClassEscape determines the type of a CharacterClassEscape.
+type ClassEscape uint8
+
+// Class escapes.
+const (
+	ClassEscapeDigit ClassEscape = iota
+	ClassEscapeNotDigit
+	ClassEscapeSpace
+	ClassEscapeNotSpace
+	ClassEscapeWord
+	ClassEscapeNotWord
+	ClassEscapeProperty
+	ClassEscapeNotProperty
+)
+
+const classEscapeChars = "dDsSwWpP"
+
+// CharacterClassEscape represents one of '\d', '\D', '\s', '\S', '\w', '\W',
+// or a Unicode property escape, '\p{...}' or '\P{...}'.
+//
+// For a property escape of the form '\p{Name=Value}' both PropertyName and
+// PropertyValue are set; for the lone form, '\p{Value}', only PropertyValue is
+// set.
+type CharacterClassEscape struct {
+	Type          ClassEscape
+	PropertyName  string
+	PropertyValue string
+}
+
+// ClassSetOperator determines how the contents of a CharacterClass are
+// combined.
+type ClassSetOperator uint8
+
+// Class set operators.
+const (
+	ClassUnion ClassSetOperator = iota
+	ClassIntersection
+	ClassSubtraction
+)
+
+// CharacterClass represents a character class, '[...]' or '[^...]'.
+//
+// The Intersection and Subtraction operators, and the NestedClass and
+// ClassStringDisjunction contents, are only available with the 'v' flag.
+type CharacterClass struct {
+	Negated  bool
+	Operator ClassSetOperator
+	Contents []ClassContent
+}
+
+// ClassContent represents a single item in a CharacterClass.
+//
+// Range is used when none of the other fields are set.
+type ClassContent struct {
+	Range                  ClassRange
+	CharacterClassEscape   *CharacterClassEscape
+	NestedClass            *CharacterClass
+	ClassStringDisjunction *ClassStringDisjunction
+}
+
+// ClassRange represents a range of characters, inclusive; a single character
+// has From equal to To.
+//
+// Source is set by the parser to the text of the range when it differs from
+// the form that would otherwise be printed, and is printed in its place; it
+// must be cleared when changing the range.
+type ClassRange struct {
+	From, To rune
+	Source   string
+}
+
+// ClassStringDisjunction represents a '\q{...}' list of strings, separated by
+// '|'.
+//
+// Sources, when set by the parser, holds the text of each of the Strings that
+// differs from the form that would otherwise be printed, and an empty string
+// for the others; each non-empty source is printed in place of its string.
+type ClassStringDisjunction struct {
+	Strings []string
+	Sources []string
+}
@@ -0,0 +1,508 @@
+package regexp
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func char(c rune) Term {
+	return Term{Atom: &Atom{Character: c}}
+}
+
+func escaped(c rune, source string) Term {
+	return Term{Atom: &Atom{Character: c, Source: source}}
+}
+
+func single(c rune) ClassContent {
+	return ClassContent{Range: ClassRange{From: c, To: c}}
+}
+
+func TestParseFlags(t *testing.T) {
+	for n, test := range [...]struct {
+		Input  string
+		Output Flags
+		Err    error
+		Pos    int
+	}{
+		{ // 1
+			Input: "",
+		},
+		{ // 2
+			Input:  "gimsuyd",
+			Output: FlagHasIndices | FlagGlobal | FlagIgnoreCase | FlagMultiline | FlagDotAll | FlagUnicode | FlagSticky,
+		},
+		{ // 3
+			Input:  "v",
+			Output: FlagUnicodeSets,
+		},
+		{ // 4
+			Input: "gx",
+			Err:   ErrInvalidFlag,
+			Pos:   1,
+		},
+		{ // 5
+			Input: "gig",
+			Err:   ErrDuplicateFlag,
+			Pos:   2,
+		},
+		{ // 6
+			Input: "uiv",
+			Err:   ErrUnicodeAndUnicodeSets,
+			Pos:   2,
+		},
+	} {
+		f, err := ParseFlags(test.Input)
+		if !errors.Is(err, test.Err) {
+			t.Errorf("test %d: expecting error %v, got %v", n+1, test.Err, err)
+		} else if test.Err != nil {
+			if e := err.(Error); e.Pos != test.Pos {
+				t.Errorf("test %d: expecting error at position %d, got %d", n+1, test.Pos, e.Pos)
+			}
+		} else if f != test.Output {
+			t.Errorf("test %d: expecting flags %s, got %s", n+1, test.Output, f)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	for n, test := range [...]struct {
+		Input  string
+		Output *RegularExpression
+		Err    error
+		Pos    int
+	}{
+		{ // 1
+			Input: "//",
+			Output: &RegularExpression{
+				Pattern: Disjunction{nil},
+			},
+		},
+		{ // 2
+			Input: "/ab|c/g",
+			Output: &RegularExpression{
+				Pattern: Disjunction{
+					{char('a'), char('b')},
+					{char('c')},
+				},
+				Flags: FlagGlobal,
+			},
+		},
+		{ // 3
+			Input: "/^a*?b{2,}c{1,3}$/",
+			Output: &RegularExpression{
+				Pattern: Disjunction{
+					{
+						{Assertion: &Assertion{Type: AssertionStart}},
+						{Atom: &Atom{Character: 'a'}, Quantifier: &Quantifier{Max: Unbounded, Lazy: true}},
+						{Atom: &Atom{Character: 'b'}, Quantifier: &Quantifier{Min: 2, Max: Unbounded}},
+						{Atom: &Atom{Character: 'c'}, Quantifier: &Quantifier{Min: 1, Max: 3}},
+						{Assertion: &Assertion{Type: AssertionEnd}},
+					},
+				},
+			},
+		},
+		{ // 4
+			Input: "/(?<year>\\d{4})-(?:.)\\k<year>\\1/u",
+			Output: &RegularExpression{
+				Pattern: Disjunction{
+					{
+						{Atom: &Atom{Group: &Group{Capturing: true, Name: "year", Disjunction: Disjunction{{{Atom: &Atom{CharacterClassEscape: &CharacterClassEscape{Type: ClassEscapeDigit}}, Quantifier: &Quantifier{Min: 4, Max: 4}}}}}}},
+						char('-'),
+						{Atom: &Atom{Group: &Group{Disjunction: Disjunction{{{Atom: &Atom{Dot: true}}}}}}},
+						{Atom: &Atom{Backreference: &Backreference{Name: "year"}}},
+						{Atom: &Atom{Backreference: &Backreference{Number: 1}}},
+					},
+				},
+				Flags: FlagUnicode,
+			},
+		},
+		{ // 5
+			Input: "/(?<=a)(?<!b)(?=c)(?!d)\\b\\B/",
+			Output: &RegularExpression{
+				Pattern: Disjunction{
+					{
+						{Assertion: &Assertion{Type: AssertionLookbehind, Disjunction: Disjunction{{char('a')}}}},
+						{Assertion: &Assertion{Type: AssertionNegativeLookbehind, Disjunction: Disjunction{{char('b')}}}},
+						{Assertion: &Assertion{Type: AssertionLookahead, Disjunction: Disjunction{{char('c')}}}},
+						{Assertion: &Assertion{Type: AssertionNegativeLookahead, Disjunction: Disjunction{{char('d')}}}},
+						{Assertion: &Assertion{Type: AssertionWordBoundary}},
+						{Assertion: &Assertion{Type: AssertionNotWordBoundary}},
+					},
+				},
+			},
+		},
+		{ // 6
+			Input: "/[^a-z\\d_]/",
+			Output: &RegularExpression{
+				Pattern: Disjunction{
+					{
+						{Atom: &Atom{CharacterClass: &CharacterClass{
+							Negated: true,
+							Contents: []ClassContent{
+								{Range: ClassRange{From: 'a', To: 'z'}},
+								{CharacterClassEscape: &CharacterClassEscape{Type: ClassEscapeDigit}},
+								single('_'),
+							},
+						}}},
+					},
+				},
+			},
+		},
+		{ // 7
+			Input: "/\\p{Lu}\\P{Script=Greek}/u",
+			Output: &RegularExpression{
+				Pattern: Disjunction{
+					{
+						{Atom: &Atom{CharacterClassEscape: &CharacterClassEscape{Type: ClassEscapeProperty, PropertyValue: "Lu"}}},
+						{Atom: &Atom{CharacterClassEscape: &CharacterClassEscape{Type: ClassEscapeNotProperty, PropertyName: "Script", PropertyValue: "Greek"}}},
+					},
+				},
+				Flags: FlagUnicode,
+			},
+		},
+		{ // 8
+			Input: "/[\\p{L}--[a-z]][\\w&&\\q{a|bc}]/v",
+			Output: &RegularExpression{
+				Pattern: Disjunction{
+					{
+						{Atom: &Atom{CharacterClass: &CharacterClass{
+							Operator: ClassSubtraction,
+							Contents: []ClassContent{
+								{CharacterClassEscape: &CharacterClassEscape{Type: ClassEscapeProperty, PropertyValue: "L"}},
+								{NestedClass: &CharacterClass{Contents: []ClassContent{{Range: ClassRange{From: 'a', To: 'z'}}}}},
+							},
+						}}},
+						{Atom: &Atom{CharacterClass: &CharacterClass{
+							Operator: ClassIntersection,
+							Contents: []ClassContent{
+								{CharacterClassEscape: &CharacterClassEscape{Type: ClassEscapeWord}},
+								{ClassStringDisjunction: &ClassStringDisjunction{Strings: []string{"a", "bc"}}},
+							},
+						}}},
+					},
+				},
+				Flags: FlagUnicodeSets,
+			},
+		},
+		{ // 9
+			Input: "/\\cJ\\x41\\u0042\\u{1F600}\\uD83D\\uDE00\\//u",
+			Output: &RegularExpression{
+				Pattern: Disjunction{
+					{escaped('\n', "\\cJ"), escaped('A', "\\x41"), escaped('B', "\\u0042"), escaped(0x1f600, "\\u{1F600}"), escaped(0x1f600, "\\uD83D\\uDE00"), char('/')},
+				},
+				Flags: FlagUnicode,
+			},
+		},
+		{ // 10
+			Input: "/\\k\\c\\p{\\8\\12(a)]}/",
+			Output: &RegularExpression{
+				Pattern: Disjunction{
+					{
+						escaped('k', "\\k"),
+						escaped('\\', "\\"),
+						char('c'),
+						escaped('p', "\\p"),
+						escaped('{', "{"),
+						escaped('8', "\\8"),
+						escaped('\n', "\\12"),
+						{Atom: &Atom{Group: &Group{Capturing: true, Disjunction: Disjunction{{char('a')}}}}},
+						escaped(']', "]"),
+						escaped('}', "}"),
+					},
+				},
+			},
+		},
+		{ // 11
+			Input: "/(?=a)*[\\d-z]/",
+			Output: &RegularExpression{
+				Pattern: Disjunction{
+					{
+						{Assertion: &Assertion{Type: AssertionLookahead, Disjunction: Disjunction{{char('a')}}}, Quantifier: &Quantifier{Max: Unbounded}},
+						{Atom: &Atom{CharacterClass: &CharacterClass{
+							Contents: []ClassContent{
+								{CharacterClassEscape: &CharacterClassEscape{Type: ClassEscapeDigit}},
+								{Range: ClassRange{From: '-', To: '-', Source: "-"}},
+								single('z'),
+							},
+						}}},
+					},
+				},
+			},
+		},
+		{ // 12
+			Input: "/(?<a>x)|(?<a>y)/",
+			Output: &RegularExpression{
+				Pattern: Disjunction{
+					{{Atom: &Atom{Group: &Group{Capturing: true, Name: "a", Disjunction: Disjunction{{char('x')}}}}}},
+					{{Atom: &Atom{Group: &Group{Capturing: true, Name: "a", Disjunction: Disjunction{{char('y')}}}}}},
+				},
+			},
+		},
+		{ // 13
+			Input: "/(?<a>x)(?<a>y)/",
+			Err:   ErrDuplicateGroupName,
+			Pos:   11,
+		},
+		{ // 14
+			Input: "/(?<a>x)((?<a>y)|z)/",
+			Err:   ErrDuplicateGroupName,
+			Pos:   12,
+		},
+		{ // 15
+			Input: "/(?<a>(?<a>x))/",
+			Err:   ErrDuplicateGroupName,
+			Pos:   4,
+		},
+		{ // 16
+			Input: "/\\k<b>(?<a>)/",
+			Err:   ErrInvalidNamedReference,
+			Pos:   1,
+		},
+		{ // 17
+			Input: "/\\k/u",
+			Err:   ErrInvalidNamedReference,
+			Pos:   1,
+		},
+		{ // 18
+			Input: "/(a)\\2/u",
+			Err:   ErrInvalidBackreference,
+			Pos:   4,
+		},
+		{ // 19
+			Input: "/a{2,1}/",
+			Err:   ErrQuantifierOrder,
+			Pos:   2,
+		},
+		{ // 20
+			Input: "/*/",
+			Err:   ErrNothingToRepeat,
+			Pos:   1,
+		},
+		{ // 21
+			Input: "/a{1}{2}/",
+			Err:   ErrNothingToRepeat,
+			Pos:   5,
+		},
+		{ // 22
+			Input: "/^*/",
+			Err:   ErrNothingToRepeat,
+			Pos:   2,
+		},
+		{ // 23
+			Input: "/(?=a)*/u",
+			Err:   ErrNothingToRepeat,
+			Pos:   6,
+		},
+		{ // 24
+			Input: "/(?<=a)?/",
+			Err:   ErrNothingToRepeat,
+			Pos:   7,
+		},
+		{ // 25
+			Input: "/a{/u",
+			Err:   ErrLoneQuantifierBracket,
+			Pos:   2,
+		},
+		{ // 26
+			Input: "/]/u",
+			Err:   ErrLoneQuantifierBracket,
+			Pos:   1,
+		},
+		{ // 27
+			Input: "/[z-a]/",
+			Err:   ErrRangeOrder,
+			Pos:   3,
+		},
+		{ // 28
+			Input: "/[\\d-z]/u",
+			Err:   ErrInvalidClassRange,
+			Pos:   4,
+		},
+		{ // 29
+			Input: "/\\a/u",
+			Err:   ErrInvalidEscape,
+			Pos:   1,
+		},
+		{ // 30
+			Input: "/\\u{110000}/u",
+			Err:   ErrInvalidEscape,
+			Pos:   1,
+		},
+		{ // 31
+			Input: "/\\p{Foo}/u",
+			Err:   ErrInvalidProperty,
+			Pos:   1,
+		},
+		{ // 32
+			Input: "/\\p{Script=Lu}/u",
+			Err:   ErrInvalidProperty,
+			Pos:   1,
+		},
+		{ // 33
+			Input: "/\\p{RGI_Emoji}/u",
+			Err:   ErrInvalidProperty,
+			Pos:   1,
+		},
+		{ // 34
+			Input: "/[^\\p{RGI_Emoji}]/v",
+			Err:   ErrNegatedStrings,
+			Pos:   1,
+		},
+		{ // 35
+			Input: "/[^\\q{ab}]/v",
+			Err:   ErrNegatedStrings,
+			Pos:   1,
+		},
+		{ // 36
+			Input: "/[a-z&&b]/v",
+			Err:   ErrMixedClassOperators,
+			Pos:   5,
+		},
+		{ // 37
+			Input: "/[a&&b--c]/v",
+			Err:   ErrMixedClassOperators,
+			Pos:   6,
+		},
+		{ // 38
+			Input: "/[(]/v",
+			Err:   ErrInvalidCharacter,
+			Pos:   2,
+		},
+		{ // 39
+			Input: "/[a!!]/v",
+			Err:   ErrInvalidCharacter,
+			Pos:   3,
+		},
+		{ // 40
+			Input: "/(?x)/",
+			Err:   ErrInvalidGroup,
+			Pos:   1,
+		},
+		{ // 41
+			Input: "/(?<1>)/",
+			Err:   ErrInvalidGroupName,
+			Pos:   4,
+		},
+		{ // 42
+			Input: "/(a/",
+			Err:   ErrUnterminatedGroup,
+			Pos:   1,
+		},
+		{ // 43
+			Input: "/a)/",
+			Err:   ErrUnmatchedParenthesis,
+			Pos:   2,
+		},
+		{ // 44
+			Input: "/[a/",
+			Err:   ErrUnterminatedClass,
+			Pos:   1,
+		},
+		{ // 45
+			Input: "/a/gg",
+			Err:   ErrDuplicateFlag,
+			Pos:   4,
+		},
+		{ // 46
+			Input: "a",
+			Err:   ErrInvalidLiteral,
+		},
+	} {
+		r, err := Parse(test.Input)
+		if !errors.Is(err, test.Err) {
+			t.Errorf("test %d: expecting error %v, got %v", n+1, test.Err, err)
+		} else if test.Err != nil {
+			if e := err.(Error); e.Pos != test.Pos {
+				t.Errorf("test %d: expecting error at position %d, got %d", n+1, test.Pos, e.Pos)
+			}
+		} else if !reflect.DeepEqual(r, test.Output) {
+			t.Errorf("test %d: expecting\n%v\n...got...\n%v", n+1, test.Output, r)
+		}
+	}
+}
+
+func TestPrint(t *testing.T) {
+	for n, test := range [...]struct {
+		Input, Output string
+	}{
+		{ // 1
+			"//",
+			"/(?:)/",
+		},
+		{ // 2
+			"/a|b(c)\\1/gi",
+			"/a|b(c)\\1/gi",
+		},
+		{ // 3
+			"/a{0,}b{1,}?c{0,1}d{2}e{2,}f{2,3}g{1,1}h*i+?j?/",
+			"/a{0,}b{1,}?c{0,1}d{2}e{2,}f{2,3}g{1,1}h*i+?j?/",
+		},
+		{ // 4
+			"/(?<a>.)(?:b)\\k<a>(?=c)(?!d)(?<=e)(?<!f)^$\\b\\B/",
+			"/(?<a>.)(?:b)\\k<a>(?=c)(?!d)(?<=e)(?<!f)^$\\b\\B/",
+		},
+		{ // 5
+			"/\\d\\D\\s\\S\\w\\W\\p{L}\\P{sc=Latn}/u",
+			"/\\d\\D\\s\\S\\w\\W\\p{L}\\P{sc=Latn}/u",
+		},
+		{ // 6
+			"/\\/\\.\\*\\+\\?\\(\\)\\[\\]\\{\\}\\|\\^\\$\\\\/",
+			"/\\/\\.\\*\\+\\?\\(\\)\\[\\]\\{\\}\\|\\^\\$\\\\/",
+		},
+		{ // 7
+			"/\\t\\n\\v\\f\\r\\0\\cA\\u2028\\x7f\\u200b/",
+			"/\\t\\n\\v\\f\\r\\0\\cA\\u2028\\x7f\\u200b/",
+		},
+		{ // 8
+			"/(a)\\1\\x30/",
+			"/(a)\\1\\x30/",
+		},
+		{ // 9
+			"/[^a-z\\-\\]\\b]/",
+			"/[^a-z\\-\\]\\b]/",
+		},
+		{ // 10
+			"/[\\p{L}--[a-z]][\\w&&\\q{a|b\\|c}][\\&\\&]/v",
+			"/[\\p{L}--[a-z]][\\w&&\\q{a|b\\|c}][\\&\\&]/v",
+		},
+		{ // 11
+			"/\\uD83D\\uDE00😀/u",
+			"/\\uD83D\\uDE00😀/u",
+		},
+		{ // 12
+			"/\\uD83D/",
+			"/\\uD83D/",
+		},
+		{ // 13
+			"/\\x41\\u{42}\\cC\\u0044+[\\x41-\\u005a\\d\\x2e]{1,}/u",
+			"/\\x41\\u{42}\\cC\\u0044+[\\x41-\\u005a\\d\\x2e]{1,}/u",
+		},
+		{ // 14
+			"/[\\x41-Z\\q{\\x61b|c|\\u{64}}]/v",
+			"/[\\x41-Z\\q{\\x61b|c|\\u{64}}]/v",
+		},
+		{ // 15
+			"/[\\d-\\x41]\\1(a)/",
+			"/[\\d-\\x41]\\1(a)/",
+		},
+	} {
+		r, err := Parse(test.Input)
+		if err != nil {
+			t.Errorf("test %d.1: unexpected error: %s", n+1, err)
+
+			continue
+		}
+
+		out := r.String()
+		if out != test.Output {
+			t.Errorf("test %d.2: expecting output %q, got %q", n+1, test.Output, out)
+		}
+
+		if s, err := Parse(out); err != nil {
+			t.Errorf("test %d.3: unexpected error: %s", n+1, err)
+		} else if !reflect.DeepEqual(s, r) && len(r.Pattern[0]) > 0 {
+			t.Errorf("test %d.3: reparsed output does not match", n+1)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package regexp
+
+import (
+	"slices"
+	"unicode"
+)
+
+// runeSet is a sorted list of non-overlapping, non-adjacent ranges.
+type runeSet []ClassRange
+
+var (
+	digitSet = runeSet{{From: '0', To: '9'}}
+	spaceSet = runeSet{
+		{From: '\t', To: '\r'},
+		{From: ' ', To: ' '},
+		{From: 0xa0, To: 0xa0},
+		{From: 0x1680, To: 0x1680},
+		{From: 0x2000, To: 0x200a},
+		{From: 0x2028, To: 0x2029},
+		{From: 0x202f, To: 0x202f},
+		{From: 0x205f, To: 0x205f},
+		{From: 0x3000, To: 0x3000},
+		{From: 0xfeff, To: 0xfeff},
+	}
+	wordSet = runeSet{
+		{From: '0', To: '9'},
+		{From: 'A', To: 'Z'},
+		{From: '_', To: '_'},
+		{From: 'a', To: 'z'},
+	}
+	unicodeIgnoreCaseWordSet = newRuneSet(append(slices.Clone(wordSet), ClassRange{From: 0x17f, To: 0x17f}, ClassRange{From: 0x212a, To: 0x212a}))
+)
+
+func newRuneSet(ranges []ClassRange) runeSet {
+	slices.SortFunc(ranges, func(a, b ClassRange) int {
+		return int(a.From - b.From)
+	})
+
+	var r runeSet
+
+	for _, cr := range ranges {
+		if l := len(r) - 1; l >= 0 && cr.From <= r[l].To+1 {
+			r[l].To = max(r[l].To, cr.To)
+		} else {
+			r = append(r, cr)
+		}
+	}
+
+	return r
+}
+
+func tableSet(tables ...*unicode.RangeTable) runeSet {
+	var ranges []ClassRange
+
+	for _, t := range tables {
+		for _, r := range t.R16 {
+			ranges = appendStride(ranges, rune(r.Lo), rune(r.Hi), rune(r.Stride))
+		}
+
+		for _, r := range t.R32 {
+			ranges = appendStride(ranges, rune(r.Lo), rune(r.Hi), rune(r.Stride))
+		}
+	}
+
+	return newRuneSet(ranges)
+}
+
+func appendStride(ranges []ClassRange, lo, hi, stride rune) []ClassRange {
+	if stride == 1 {
+		return append(ranges, ClassRange{From: lo, To: hi})
+	}
+
+	for c := lo; c <= hi; c += stride {
+		ranges = append(ranges, ClassRange{From: c, To: c})
+	}
+
+	return ranges
+}
+
+func (r runeSet) union(s runeSet) runeSet {
+	return newRuneSet(append(slices.Clone(r), s...))
+}
+
+func (r runeSet) invert() runeSet {
+	var (
+		i    runeSet
+		next rune
+	)
+
+	for _, cr := range r {
+		if cr.From > next {
+			i = append(i, ClassRange{From: next, To: cr.From - 1})
+		}
+
+		next = cr.To + 1
+	}
+
+	if next <= maxCodePoint {
+		i = append(i, ClassRange{From: next, To: maxCodePoint})
+	}
+
+	return i
+}
+
+func (r runeSet) contains(c rune) bool {
+	_, found := slices.BinarySearchFunc(r, c, func(cr ClassRange, c rune) int {
+		if cr.To < c {
+			return -1
+		} else if cr.From > c {
+			return 1
+		}
+
+		return 0
+	})
+
+	return found
+}
+
+// fold returns the set with every character added that is equivalent, under
+// simple case folding, to a character in the set.
+func (r runeSet) fold() runeSet {
+	var ranges []ClassRange
+
+	for _, cr := range unicode.CaseRanges {
+		for c := rune(cr.Lo); c <= rune(cr.Hi); c++ {
+			if r.contains(c) {
+				for f := unicode.SimpleFold(c); f != c; f = unicode.SimpleFold(f) {
+					ranges = append(ranges, ClassRange{From: f, To: f})
+				}
+			}
+		}
+	}
+
+	if len(ranges) == 0 {
+		return r
+	}
+
+	return r.union(ranges)
+}
+
+func (r runeSet) intersect(s runeSet) runeSet {
+	return r.invert().union(s.invert()).invert()
+}
+
+func (r runeSet) subtract(s runeSet) runeSet {
+	return r.intersect(s.invert())
+}
+
+func (r runeSet) contents() []ClassContent {
+	contents := make([]ClassContent, len(r))
+
+	for n, cr := range r {
+		contents[n].Range = cr
+	}
+
+	return contents
+}
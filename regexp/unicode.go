@@ -0,0 +1,426 @@
+package regexp
+
+import "unicode"
+
+var (
+	// generalCategories maps each General_Category value and alias to its
+	// short name.
+	generalCategories = map[string]string{
+		"C": "C", "Other": "C",
+		"Cc": "Cc", "Control": "Cc", "cntrl": "Cc",
+		"Cf": "Cf", "Format": "Cf",
+		"Cn": "Cn", "Unassigned": "Cn",
+		"Co": "Co", "Private_Use": "Co",
+		"Cs": "Cs", "Surrogate": "Cs",
+		"L": "L", "Letter": "L",
+		"LC": "LC", "Cased_Letter": "LC",
+		"Ll": "Ll", "Lowercase_Letter": "Ll",
+		"Lm": "Lm", "Modifier_Letter": "Lm",
+		"Lo": "Lo", "Other_Letter": "Lo",
+		"Lt": "Lt", "Titlecase_Letter": "Lt",
+		"Lu": "Lu", "Uppercase_Letter": "Lu",
+		"M": "M", "Mark": "M", "Combining_Mark": "M",
+		"Mc": "Mc", "Spacing_Mark": "Mc",
+		"Me": "Me", "Enclosing_Mark": "Me",
+		"Mn": "Mn", "Nonspacing_Mark": "Mn",
+		"N": "N", "Number": "N",
+		"Nd": "Nd", "Decimal_Number": "Nd", "digit": "Nd",
+		"Nl": "Nl", "Letter_Number": "Nl",
+		"No": "No", "Other_Number": "No",
+		"P": "P", "Punctuation": "P", "punct": "P",
+		"Pc": "Pc", "Connector_Punctuation": "Pc",
+		"Pd": "Pd", "Dash_Punctuation": "Pd",
+		"Pe": "Pe", "Close_Punctuation": "Pe",
+		"Pf": "Pf", "Final_Punctuation": "Pf",
+		"Pi": "Pi", "Initial_Punctuation": "Pi",
+		"Po": "Po", "Other_Punctuation": "Po",
+		"Ps": "Ps", "Open_Punctuation": "Ps",
+		"S": "S", "Symbol": "S",
+		"Sc": "Sc", "Currency_Symbol": "Sc",
+		"Sk": "Sk", "Modifier_Symbol": "Sk",
+		"Sm": "Sm", "Math_Symbol": "Sm",
+		"So": "So", "Other_Symbol": "So",
+		"Z": "Z", "Separator": "Z",
+		"Zl": "Zl", "Line_Separator": "Zl",
+		"Zp": "Zp", "Paragraph_Separator": "Zp",
+		"Zs": "Zs", "Space_Separator": "Zs",
+	}
+
+	// scripts maps each Script value and alias to its long name.
+	scripts = map[string]string{
+		"Adlam": "Adlam", "Adlm": "Adlam",
+		"Ahom":                  "Ahom",
+		"Anatolian_Hieroglyphs": "Anatolian_Hieroglyphs", "Hluw": "Anatolian_Hieroglyphs",
+		"Arabic": "Arabic", "Arab": "Arabic",
+		"Armenian": "Armenian", "Armn": "Armenian",
+		"Avestan": "Avestan", "Avst": "Avestan",
+		"Balinese": "Balinese", "Bali": "Balinese",
+		"Bamum": "Bamum", "Bamu": "Bamum",
+		"Bassa_Vah": "Bassa_Vah", "Bass": "Bassa_Vah",
+		"Batak": "Batak", "Batk": "Batak",
+		"Bengali": "Bengali", "Beng": "Bengali",
+		"Beria_Erfe": "Beria_Erfe", "Berf": "Beria_Erfe",
+		"Bhaiksuki": "Bhaiksuki", "Bhks": "Bhaiksuki",
+		"Bopomofo": "Bopomofo", "Bopo": "Bopomofo",
+		"Brahmi": "Brahmi", "Brah": "Brahmi",
+		"Braille": "Braille", "Brai": "Braille",
+		"Buginese": "Buginese", "Bugi": "Buginese",
+		"Buhid": "Buhid", "Buhd": "Buhid",
+		"Canadian_Aboriginal": "Canadian_Aboriginal", "Cans": "Canadian_Aboriginal",
+		"Carian": "Carian", "Cari": "Carian",
+		"Caucasian_Albanian": "Caucasian_Albanian", "Aghb": "Caucasian_Albanian",
+		"Chakma": "Chakma", "Cakm": "Chakma",
+		"Cham":     "Cham",
+		"Cherokee": "Cherokee", "Cher": "Cherokee",
+		"Chorasmian": "Chorasmian", "Chrs": "Chorasmian",
+		"Common": "Common", "Zyyy": "Common",
+		"Coptic": "Coptic", "Copt": "Coptic", "Qaac": "Coptic",
+		"Cuneiform": "Cuneiform", "Xsux": "Cuneiform",
+		"Cypriot": "Cypriot", "Cprt": "Cypriot",
+		"Cypro_Minoan": "Cypro_Minoan", "Cpmn": "Cypro_Minoan",
+		"Cyrillic": "Cyrillic", "Cyrl": "Cyrillic",
+		"Deseret": "Deseret", "Dsrt": "Deseret",
+		"Devanagari": "Devanagari", "Deva": "Devanagari",
+		"Dives_Akuru": "Dives_Akuru", "Diak": "Dives_Akuru",
+		"Dogra": "Dogra", "Dogr": "Dogra",
+		"Duployan": "Duployan", "Dupl": "Duployan",
+		"Egyptian_Hieroglyphs": "Egyptian_Hieroglyphs", "Egyp": "Egyptian_Hieroglyphs",
+		"Elbasan": "Elbasan", "Elba": "Elbasan",
+		"Elymaic": "Elymaic", "Elym": "Elymaic",
+		"Ethiopic": "Ethiopic", "Ethi": "Ethiopic",
+		"Garay": "Garay", "Gara": "Garay",
+		"Georgian": "Georgian", "Geor": "Georgian",
+		"Glagolitic": "Glagolitic", "Glag": "Glagolitic",
+		"Gothic": "Gothic", "Goth": "Gothic",
+		"Grantha": "Grantha", "Gran": "Grantha",
+		"Greek": "Greek", "Grek": "Greek",
+		"Gujarati": "Gujarati", "Gujr": "Gujarati",
+		"Gunjala_Gondi": "Gunjala_Gondi", "Gong": "Gunjala_Gondi",
+		"Gurmukhi": "Gurmukhi", "Guru": "Gurmukhi",
+		"Gurung_Khema": "Gurung_Khema", "Gukh": "Gurung_Khema",
+		"Han": "Han", "Hani": "Han",
+		"Hangul": "Hangul", "Hang": "Hangul",
+		"Hanifi_Rohingya": "Hanifi_Rohingya", "Rohg": "Hanifi_Rohingya",
+		"Hanunoo": "Hanunoo", "Hano": "Hanunoo",
+		"Hatran": "Hatran", "Hatr": "Hatran",
+		"Hebrew": "Hebrew", "Hebr": "Hebrew",
+		"Hiragana": "Hiragana", "Hira": "Hiragana",
+		"Imperial_Aramaic": "Imperial_Aramaic", "Armi": "Imperial_Aramaic",
+		"Inherited": "Inherited", "Zinh": "Inherited", "Qaai": "Inherited",
+		"Inscriptional_Pahlavi": "Inscriptional_Pahlavi", "Phli": "Inscriptional_Pahlavi",
+		"Inscriptional_Parthian": "Inscriptional_Parthian", "Prti": "Inscriptional_Parthian",
+		"Javanese": "Javanese", "Java": "Javanese",
+		"Kaithi": "Kaithi", "Kthi": "Kaithi",
+		"Kannada": "Kannada", "Knda": "Kannada",
+		"Katakana": "Katakana", "Kana": "Katakana",
+		"Katakana_Or_Hiragana": "Katakana_Or_Hiragana", "Hrkt": "Katakana_Or_Hiragana",
+		"Kawi":     "Kawi",
+		"Kayah_Li": "Kayah_Li", "Kali": "Kayah_Li",
+		"Kharoshthi": "Kharoshthi", "Khar": "Kharoshthi",
+		"Khitan_Small_Script": "Khitan_Small_Script", "Kits": "Khitan_Small_Script",
+		"Khmer": "Khmer", "Khmr": "Khmer",
+		"Khojki": "Khojki", "Khoj": "Khojki",
+		"Khudawadi": "Khudawadi", "Sind": "Khudawadi",
+		"Kirat_Rai": "Kirat_Rai", "Krai": "Kirat_Rai",
+		"Lao": "Lao", "Laoo": "Lao",
+		"Latin": "Latin", "Latn": "Latin",
+		"Lepcha": "Lepcha", "Lepc": "Lepcha",
+		"Limbu": "Limbu", "Limb": "Limbu",
+		"Linear_A": "Linear_A", "Lina": "Linear_A",
+		"Linear_B": "Linear_B", "Linb": "Linear_B",
+		"Lisu":   "Lisu",
+		"Lycian": "Lycian", "Lyci": "Lycian",
+		"Lydian": "Lydian", "Lydi": "Lydian",
+		"Mahajani": "Mahajani", "Mahj": "Mahajani",
+		"Makasar": "Makasar", "Maka": "Makasar",
+		"Malayalam": "Malayalam", "Mlym": "Malayalam",
+		"Mandaic": "Mandaic", "Mand": "Mandaic",
+		"Manichaean": "Manichaean", "Mani": "Manichaean",
+		"Marchen": "Marchen", "Marc": "Marchen",
+		"Masaram_Gondi": "Masaram_Gondi", "Gonm": "Masaram_Gondi",
+		"Medefaidrin": "Medefaidrin", "Medf": "Medefaidrin",
+		"Meetei_Mayek": "Meetei_Mayek", "Mtei": "Meetei_Mayek",
+		"Mende_Kikakui": "Mende_Kikakui", "Mend": "Mende_Kikakui",
+		"Meroitic_Cursive": "Meroitic_Cursive", "Merc": "Meroitic_Cursive",
+		"Meroitic_Hieroglyphs": "Meroitic_Hieroglyphs", "Mero": "Meroitic_Hieroglyphs",
+		"Miao": "Miao", "Plrd": "Miao",
+		"Modi":      "Modi",
+		"Mongolian": "Mongolian", "Mong": "Mongolian",
+		"Mro": "Mro", "Mroo": "Mro",
+		"Multani": "Multani", "Mult": "Multani",
+		"Myanmar": "Myanmar", "Mymr": "Myanmar",
+		"Nabataean": "Nabataean", "Nbat": "Nabataean",
+		"Nag_Mundari": "Nag_Mundari", "Nagm": "Nag_Mundari",
+		"Nandinagari": "Nandinagari", "Nand": "Nandinagari",
+		"New_Tai_Lue": "New_Tai_Lue", "Talu": "New_Tai_Lue",
+		"Newa": "Newa",
+		"Nko":  "Nko", "Nkoo": "Nko",
+		"Nushu": "Nushu", "Nshu": "Nushu",
+		"Nyiakeng_Puachue_Hmong": "Nyiakeng_Puachue_Hmong", "Hmnp": "Nyiakeng_Puachue_Hmong",
+		"Ogham": "Ogham", "Ogam": "Ogham",
+		"Ol_Chiki": "Ol_Chiki", "Olck": "Ol_Chiki",
+		"Ol_Onal": "Ol_Onal", "Onao": "Ol_Onal",
+		"Old_Hungarian": "Old_Hungarian", "Hung": "Old_Hungarian",
+		"Old_Italic": "Old_Italic", "Ital": "Old_Italic",
+		"Old_North_Arabian": "Old_North_Arabian", "Narb": "Old_North_Arabian",
+		"Old_Permic": "Old_Permic", "Perm": "Old_Permic",
+		"Old_Persian": "Old_Persian", "Xpeo": "Old_Persian",
+		"Old_Sogdian": "Old_Sogdian", "Sogo": "Old_Sogdian",
+		"Old_South_Arabian": "Old_South_Arabian", "Sarb": "Old_South_Arabian",
+		"Old_Turkic": "Old_Turkic", "Orkh": "Old_Turkic",
+		"Old_Uyghur": "Old_Uyghur", "Ougr": "Old_Uyghur",
+		"Oriya": "Oriya", "Orya": "Oriya",
+		"Osage": "Osage", "Osge": "Osage",
+		"Osmanya": "Osmanya", "Osma": "Osmanya",
+		"Pahawh_Hmong": "Pahawh_Hmong", "Hmng": "Pahawh_Hmong",
+		"Palmyrene": "Palmyrene", "Palm": "Palmyrene",
+		"Pau_Cin_Hau": "Pau_Cin_Hau", "Pauc": "Pau_Cin_Hau",
+		"Phags_Pa": "Phags_Pa", "Phag": "Phags_Pa",
+		"Phoenician": "Phoenician", "Phnx": "Phoenician",
+		"Psalter_Pahlavi": "Psalter_Pahlavi", "Phlp": "Psalter_Pahlavi",
+		"Rejang": "Rejang", "Rjng": "Rejang",
+		"Runic": "Runic", "Runr": "Runic",
+		"Samaritan": "Samaritan", "Samr": "Samaritan",
+		"Saurashtra": "Saurashtra", "Saur": "Saurashtra",
+		"Sharada": "Sharada", "Shrd": "Sharada",
+		"Shavian": "Shavian", "Shaw": "Shavian",
+		"Siddham": "Siddham", "Sidd": "Siddham",
+		"Sidetic": "Sidetic", "Sidt": "Sidetic",
+		"SignWriting": "SignWriting", "Sgnw": "SignWriting",
+		"Sinhala": "Sinhala", "Sinh": "Sinhala",
+		"Sogdian": "Sogdian", "Sogd": "Sogdian",
+		"Sora_Sompeng": "Sora_Sompeng", "Sora": "Sora_Sompeng",
+		"Soyombo": "Soyombo", "Soyo": "Soyombo",
+		"Sundanese": "Sundanese", "Sund": "Sundanese",
+		"Sunuwar": "Sunuwar", "Sunu": "Sunuwar",
+		"Syloti_Nagri": "Syloti_Nagri", "Sylo": "Syloti_Nagri",
+		"Syriac": "Syriac", "Syrc": "Syriac",
+		"Tagalog": "Tagalog", "Tglg": "Tagalog",
+		"Tagbanwa": "Tagbanwa", "Tagb": "Tagbanwa",
+		"Tai_Le": "Tai_Le", "Tale": "Tai_Le",
+		"Tai_Tham": "Tai_Tham", "Lana": "Tai_Tham",
+		"Tai_Viet": "Tai_Viet", "Tavt": "Tai_Viet",
+		"Tai_Yo": "Tai_Yo", "Tayo": "Tai_Yo",
+		"Takri": "Takri", "Takr": "Takri",
+		"Tamil": "Tamil", "Taml": "Tamil",
+		"Tangsa": "Tangsa", "Tnsa": "Tangsa",
+		"Tangut": "Tangut", "Tang": "Tangut",
+		"Telugu": "Telugu", "Telu": "Telugu",
+		"Thaana": "Thaana", "Thaa": "Thaana",
+		"Thai":    "Thai",
+		"Tibetan": "Tibetan", "Tibt": "Tibetan",
+		"Tifinagh": "Tifinagh", "Tfng": "Tifinagh",
+		"Tirhuta": "Tirhuta", "Tirh": "Tirhuta",
+		"Todhri": "Todhri", "Todr": "Todhri",
+		"Tolong_Siki": "Tolong_Siki", "Tols": "Tolong_Siki",
+		"Toto":          "Toto",
+		"Tulu_Tigalari": "Tulu_Tigalari", "Tutg": "Tulu_Tigalari",
+		"Ugaritic": "Ugaritic", "Ugar": "Ugaritic",
+		"Unknown": "Unknown", "Zzzz": "Unknown",
+		"Vai": "Vai", "Vaii": "Vai",
+		"Vithkuqi": "Vithkuqi", "Vith": "Vithkuqi",
+		"Wancho": "Wancho", "Wcho": "Wancho",
+		"Warang_Citi": "Warang_Citi", "Wara": "Warang_Citi",
+		"Yezidi": "Yezidi", "Yezi": "Yezidi",
+		"Yi": "Yi", "Yiii": "Yi",
+		"Zanabazar_Square": "Zanabazar_Square", "Zanb": "Zanabazar_Square",
+	}
+
+	// binaryProperties maps each binary property name and alias to its long
+	// name.
+	binaryProperties = map[string]string{
+		"ASCII":           "ASCII",
+		"ASCII_Hex_Digit": "ASCII_Hex_Digit", "AHex": "ASCII_Hex_Digit",
+		"Alphabetic": "Alphabetic", "Alpha": "Alphabetic",
+		"Any":          "Any",
+		"Assigned":     "Assigned",
+		"Bidi_Control": "Bidi_Control", "Bidi_C": "Bidi_Control",
+		"Bidi_Mirrored": "Bidi_Mirrored", "Bidi_M": "Bidi_Mirrored",
+		"Case_Ignorable": "Case_Ignorable", "CI": "Case_Ignorable",
+		"Cased":                   "Cased",
+		"Changes_When_Casefolded": "Changes_When_Casefolded", "CWCF": "Changes_When_Casefolded",
+		"Changes_When_Casemapped": "Changes_When_Casemapped", "CWCM": "Changes_When_Casemapped",
+		"Changes_When_Lowercased": "Changes_When_Lowercased", "CWL": "Changes_When_Lowercased",
+		"Changes_When_NFKC_Casefolded": "Changes_When_NFKC_Casefolded", "CWKCF": "Changes_When_NFKC_Casefolded",
+		"Changes_When_Titlecased": "Changes_When_Titlecased", "CWT": "Changes_When_Titlecased",
+		"Changes_When_Uppercased": "Changes_When_Uppercased", "CWU": "Changes_When_Uppercased",
+		"Dash":                         "Dash",
+		"Default_Ignorable_Code_Point": "Default_Ignorable_Code_Point", "DI": "Default_Ignorable_Code_Point",
+		"Deprecated": "Deprecated", "Dep": "Deprecated",
+		"Diacritic": "Diacritic", "Dia": "Diacritic",
+		"Emoji":           "Emoji",
+		"Emoji_Component": "Emoji_Component", "EComp": "Emoji_Component",
+		"Emoji_Modifier": "Emoji_Modifier", "EMod": "Emoji_Modifier",
+		"Emoji_Modifier_Base": "Emoji_Modifier_Base", "EBase": "Emoji_Modifier_Base",
+		"Emoji_Presentation": "Emoji_Presentation", "EPres": "Emoji_Presentation",
+		"Extended_Pictographic": "Extended_Pictographic", "ExtPict": "Extended_Pictographic",
+		"Extender": "Extender", "Ext": "Extender",
+		"Grapheme_Base": "Grapheme_Base", "Gr_Base": "Grapheme_Base",
+		"Grapheme_Extend": "Grapheme_Extend", "Gr_Ext": "Grapheme_Extend",
+		"Hex_Digit": "Hex_Digit", "Hex": "Hex_Digit",
+		"IDS_Binary_Operator": "IDS_Binary_Operator", "IDSB": "IDS_Binary_Operator",
+		"IDS_Trinary_Operator": "IDS_Trinary_Operator", "IDST": "IDS_Trinary_Operator",
+		"ID_Continue": "ID_Continue", "IDC": "ID_Continue",
+		"ID_Start": "ID_Start", "IDS": "ID_Start",
+		"Ideographic": "Ideographic", "Ideo": "Ideographic",
+		"Join_Control": "Join_Control", "Join_C": "Join_Control",
+		"Logical_Order_Exception": "Logical_Order_Exception", "LOE": "Logical_Order_Exception",
+		"Lowercase": "Lowercase", "Lower": "Lowercase",
+		"Math":                    "Math",
+		"Noncharacter_Code_Point": "Noncharacter_Code_Point", "NChar": "Noncharacter_Code_Point",
+		"Pattern_Syntax": "Pattern_Syntax", "Pat_Syn": "Pattern_Syntax",
+		"Pattern_White_Space": "Pattern_White_Space", "Pat_WS": "Pattern_White_Space",
+		"Quotation_Mark": "Quotation_Mark", "QMark": "Quotation_Mark",
+		"Radical":            "Radical",
+		"Regional_Indicator": "Regional_Indicator", "RI": "Regional_Indicator",
+		"Sentence_Terminal": "Sentence_Terminal", "STerm": "Sentence_Terminal",
+		"Soft_Dotted": "Soft_Dotted", "SD": "Soft_Dotted",
+		"Terminal_Punctuation": "Terminal_Punctuation", "Term": "Terminal_Punctuation",
+		"Unified_Ideograph": "Unified_Ideograph", "UIdeo": "Unified_Ideograph",
+		"Uppercase": "Uppercase", "Upper": "Uppercase",
+		"Variation_Selector": "Variation_Selector", "VS": "Variation_Selector",
+		"White_Space": "White_Space", "space": "White_Space",
+		"XID_Continue": "XID_Continue", "XIDC": "XID_Continue",
+		"XID_Start": "XID_Start", "XIDS": "XID_Start",
+	}
+
+	// stringProperties contains the properties of strings, which are only
+	// available with the 'v' flag.
+	stringProperties = map[string]struct{}{
+		"Basic_Emoji":                 {},
+		"Emoji_Keycap_Sequence":       {},
+		"RGI_Emoji":                   {},
+		"RGI_Emoji_Flag_Sequence":     {},
+		"RGI_Emoji_Modifier_Sequence": {},
+		"RGI_Emoji_Tag_Sequence":      {},
+		"RGI_Emoji_ZWJ_Sequence":      {},
+	}
+)
+
+// validProperty determines whether the given property name and value are
+// valid in a Unicode property escape, and whether the property may match
+// strings.
+func validProperty(name, value string, unicodeSets bool) (bool, bool) {
+	var ok bool
+
+	switch name {
+	case "":
+		if _, ok = stringProperties[value]; ok {
+			return unicodeSets, unicodeSets
+		}
+
+		_, ok = generalCategories[value]
+
+		if !ok {
+			_, ok = binaryProperties[value]
+		}
+	case "General_Category", "gc":
+		_, ok = generalCategories[value]
+	case "Script", "sc", "Script_Extensions", "scx":
+		_, ok = scripts[value]
+	}
+
+	return false, ok
+}
+
+// propertySet returns the set of characters matched by the given property,
+// using the tables in the unicode package.
+func propertySet(name, value string) (runeSet, bool) {
+	switch name {
+	case "":
+		if gc, ok := generalCategories[value]; ok {
+			return categorySet(gc), true
+		} else if bp, ok := binaryProperties[value]; ok {
+			return binarySet(bp)
+		}
+	case "General_Category", "gc":
+		if gc, ok := generalCategories[value]; ok {
+			return categorySet(gc), true
+		}
+	case "Script", "sc":
+		if sc, ok := scripts[value]; ok {
+			return scriptSet(sc)
+		}
+	}
+
+	return nil, false
+}
+
+func assignedSet() runeSet {
+	var tables []*unicode.RangeTable
+
+	for gc, t := range unicode.Categories {
+		if len(gc) == 2 && gc != "Cn" {
+			tables = append(tables, t)
+		}
+	}
+
+	return tableSet(tables...)
+}
+
+func categorySet(gc string) runeSet {
+	switch gc {
+	case "C":
+		return tableSet(unicode.Cc, unicode.Cf, unicode.Co, unicode.Cs).union(categorySet("Cn"))
+	case "Cn":
+		return assignedSet().invert()
+	case "LC":
+		return tableSet(unicode.Lu, unicode.Ll, unicode.Lt)
+	}
+
+	return tableSet(unicode.Categories[gc])
+}
+
+func scriptSet(sc string) (runeSet, bool) {
+	switch sc {
+	case "Katakana_Or_Hiragana":
+		return runeSet{}, true
+	case "Unknown":
+		var tables []*unicode.RangeTable
+
+		for _, t := range unicode.Scripts {
+			tables = append(tables, t)
+		}
+
+		return tableSet(tables...).invert(), true
+	}
+
+	t, ok := unicode.Scripts[sc]
+	if !ok {
+		return nil, false
+	}
+
+	return tableSet(t), true
+}
+
+func binarySet(bp string) (runeSet, bool) {
+	notID := tableSet(unicode.Pattern_Syntax, unicode.Pattern_White_Space)
+
+	switch bp {
+	case "ASCII":
+		return runeSet{{From: 0, To: 0x7f}}, true
+	case "Any":
+		return runeSet{{From: 0, To: maxCodePoint}}, true
+	case "Assigned":
+		return assignedSet(), true
+	case "Alphabetic":
+		return tableSet(unicode.Lu, unicode.Ll, unicode.Lt, unicode.Lm, unicode.Lo, unicode.Nl, unicode.Other_Alphabetic), true
+	case "Lowercase":
+		return tableSet(unicode.Ll, unicode.Other_Lowercase), true
+	case "Uppercase":
+		return tableSet(unicode.Lu, unicode.Other_Uppercase), true
+	case "Math":
+		return tableSet(unicode.Sm, unicode.Other_Math), true
+	case "ID_Start":
+		return tableSet(unicode.L, unicode.Nl, unicode.Other_ID_Start).subtract(notID), true
+	case "ID_Continue":
+		return tableSet(unicode.L, unicode.Nl, unicode.Other_ID_Start, unicode.Mn, unicode.Mc, unicode.Nd, unicode.Pc, unicode.Other_ID_Continue).subtract(notID), true
+	}
+
+	t, ok := unicode.Properties[bp]
+	if !ok {
+		return nil, false
+	}
+
+	return tableSet(t), true
+}
@@ -0,0 +1,130 @@
+package scope
+
+import (
+	"slices"
+
+	"vimagination.zapto.org/javascript"
+)
+
+// ImportElision determines which import and export specifiers are removed by
+// ElideImports.
+type ImportElision uint8
+
+// Valid ImportElision values.
+const (
+	// IsolatedModules removes any imported binding that is not referenced as
+	// a value, removing the entire import declaration when no bindings
+	// remain, matching the Typescript isolatedModules option.
+	IsolatedModules ImportElision = iota
+	// VerbatimModuleSyntax retains all imported bindings that have not been
+	// explicitly marked as types, matching the Typescript
+	// verbatimModuleSyntax option.
+	VerbatimModuleSyntax
+)
+
+// ElideImports removes the import and export specifiers from a Module, parsed
+// from Typescript, that are only used as types, and so do not exist at
+// runtime.
+//
+// References that come from Typescript tokens are not considered to be
+// value references.
+//
+// With either ImportElision mode, local export specifiers that do not refer to
+// a value declared in the module, such as those exporting an interface, are
+// removed, and export declarations with no remaining specifiers are removed.
+func ElideImports(m *javascript.Module, mode ImportElision) error {
+	s, err := Build(m, nil)
+	if err != nil {
+		return err
+	}
+
+	reexports := map[*javascript.Token]struct{}{}
+
+	for _, mi := range m.ModuleListItems {
+		if ed := mi.ExportDeclaration; ed != nil && ed.ExportClause != nil && ed.FromClause != nil {
+			for _, es := range ed.ExportClause.ExportList {
+				reexports[es.IdentifierName] = struct{}{}
+			}
+		}
+	}
+
+	isValue := func(tk *javascript.Token) bool {
+		if tk == nil {
+			return false
+		}
+
+		for _, b := range s.Bindings[tk.Data] {
+			if _, ok := reexports[b.Token]; b.BindingType == BindingRef && !b.IsTypescript() && !ok {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	m.ModuleListItems = slices.DeleteFunc(m.ModuleListItems, func(mi javascript.ModuleItem) bool {
+		if mi.ImportDeclaration != nil && mode == IsolatedModules {
+			return elideImport(mi.ImportDeclaration, isValue)
+		} else if mi.ExportDeclaration != nil {
+			return elideExport(mi.ExportDeclaration, s)
+		}
+
+		return false
+	})
+
+	return nil
+}
+
+func elideImport(id *javascript.ImportDeclaration, isValue func(*javascript.Token) bool) bool {
+	if id.ImportClause == nil {
+		return false
+	}
+
+	hadBindings := id.ImportedDefaultBinding != nil || id.NameSpaceImport != nil
+
+	if !isValue(id.ImportedDefaultBinding) {
+		id.ImportedDefaultBinding = nil
+	}
+
+	if !isValue(id.NameSpaceImport) {
+		id.NameSpaceImport = nil
+	}
+
+	if ni := id.NamedImports; ni != nil {
+		hadBindings = hadBindings || len(ni.ImportList) > 0 || hasTypescript(ni.Tokens)
+
+		ni.ImportList = slices.DeleteFunc(ni.ImportList, func(is javascript.ImportSpecifier) bool {
+			return !isValue(is.ImportedBinding)
+		})
+
+		if len(ni.ImportList) == 0 && (id.ImportedDefaultBinding != nil || id.NameSpaceImport != nil) {
+			id.NamedImports = nil
+		}
+	}
+
+	return hadBindings && id.ImportedDefaultBinding == nil && id.NameSpaceImport == nil && (id.NamedImports == nil || len(id.NamedImports.ImportList) == 0)
+}
+
+func hasTypescript(tks javascript.Tokens) bool {
+	for _, tk := range tks {
+		if tk.IsTypescript() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func elideExport(ed *javascript.ExportDeclaration, s *Scope) bool {
+	if ed.ExportClause == nil || ed.FromClause != nil || len(ed.ExportClause.ExportList) == 0 {
+		return false
+	}
+
+	ed.ExportClause.ExportList = slices.DeleteFunc(ed.ExportClause.ExportList, func(es javascript.ExportSpecifier) bool {
+		b := s.Bindings[es.IdentifierName.Data]
+
+		return len(b) == 0 || b[0].BindingType == BindingRef || b[0].BindingType == BindingBare
+	})
+
+	return len(ed.ExportClause.ExportList) == 0
+}
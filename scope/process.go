@@ -9,6 +9,7 @@ type scoper struct {
 	bt    BindingType
 	scope *Scope
 	set   bool
+	errs  *[]error
 }
 
 func (s *scoper) newFunctionScope(t javascript.Type) *scoper {
@@ -20,6 +21,7 @@ func (s *scoper) newScoper(t *Scope, bt BindingType) *scoper {
 		bt:    bt,
 		scope: t,
 		set:   s.set,
+		errs:  s.errs,
 	}
 }
 
@@ -31,6 +33,17 @@ func (s *scoper) newLexicalScope(t javascript.Type) *scoper {
 	return s.newScoper(s.scope.newLexicalScope(t), s.bt)
 }
 
+func (s *scoper) setBinding(t *javascript.Token, bindingType BindingType) error {
+	err := s.scope.setBinding(t, bindingType)
+	if err != nil && s.errs != nil {
+		*s.errs = append(*s.errs, err)
+
+		return nil
+	}
+
+	return err
+}
+
 func (s *scoper) setBindingType(bt BindingType) *scoper {
 	return s.newScoper(s.scope, bt)
 }
@@ -83,8 +96,14 @@ func (s *scoper) Handle(t javascript.Type) error {
 		return s.processBindingElement(t)
 	case *javascript.PrimaryExpression:
 		return s.processPrimaryExpression(t)
+	case *javascript.DecoratorMemberExpression:
+		return s.processDecoratorMemberExpression(t)
 	case *javascript.JSXElement:
 		return s.processJSXElement(t)
+	case *javascript.EnumDeclaration, *javascript.NamespaceDeclaration:
+		// Typescript view of the lowered VariableStatement, or a type-only
+		// namespace; neither holds bindings of its own.
+		return nil
 	case *javascript.Block:
 		if s.set {
 			if err := s.processBlock(t.StatementList); err != nil {
@@ -136,12 +155,12 @@ func (s *scoper) processModule(ml []javascript.ModuleItem) error {
 
 func (s *scoper) processStatementListItem(sli *javascript.StatementListItem) error {
 	if sli.Statement != nil && sli.Statement.LabelledItemFunction != nil && sli.Statement.LabelledItemFunction.BindingIdentifier != nil {
-		return s.scope.setBinding(sli.Statement.LabelledItemFunction.BindingIdentifier, BindingHoistable)
+		return s.setBinding(sli.Statement.LabelledItemFunction.BindingIdentifier, BindingHoistable)
 	} else if sli.Declaration != nil {
 		if sli.Declaration.FunctionDeclaration != nil && sli.Declaration.FunctionDeclaration.BindingIdentifier != nil {
-			return s.scope.setBinding(sli.Declaration.FunctionDeclaration.BindingIdentifier, BindingHoistable)
+			return s.setBinding(sli.Declaration.FunctionDeclaration.BindingIdentifier, BindingHoistable)
 		} else if sli.Declaration.ClassDeclaration != nil && sli.Declaration.ClassDeclaration.BindingIdentifier != nil {
-			return s.scope.setBinding(sli.Declaration.ClassDeclaration.BindingIdentifier, BindingHoistable)
+			return s.setBinding(sli.Declaration.ClassDeclaration.BindingIdentifier, BindingHoistable)
 		}
 	}
 
@@ -150,13 +169,13 @@ func (s *scoper) processStatementListItem(sli *javascript.StatementListItem) err
 
 func (s *scoper) processExports(ed *javascript.ExportDeclaration) error {
 	if ed.DefaultFunction != nil && ed.DefaultFunction.BindingIdentifier != nil {
-		return s.scope.setBinding(ed.DefaultFunction.BindingIdentifier, BindingHoistable)
+		return s.setBinding(ed.DefaultFunction.BindingIdentifier, BindingHoistable)
 	} else if ed.DefaultClass != nil && ed.DefaultClass.BindingIdentifier != nil {
-		return s.scope.setBinding(ed.DefaultClass.BindingIdentifier, BindingHoistable)
+		return s.setBinding(ed.DefaultClass.BindingIdentifier, BindingHoistable)
 	} else if ed.Declaration != nil && ed.Declaration.FunctionDeclaration != nil && ed.Declaration.FunctionDeclaration.BindingIdentifier != nil {
-		return s.scope.setBinding(ed.Declaration.FunctionDeclaration.BindingIdentifier, BindingHoistable)
+		return s.setBinding(ed.Declaration.FunctionDeclaration.BindingIdentifier, BindingHoistable)
 	} else if ed.Declaration != nil && ed.Declaration.ClassDeclaration != nil && ed.Declaration.ClassDeclaration.BindingIdentifier != nil {
-		return s.scope.setBinding(ed.Declaration.ClassDeclaration.BindingIdentifier, BindingHoistable)
+		return s.setBinding(ed.Declaration.ClassDeclaration.BindingIdentifier, BindingHoistable)
 	}
 
 	return nil
@@ -168,13 +187,13 @@ func (s *scoper) processImportDeclaration(t *javascript.ImportDeclaration) error
 	}
 
 	if t.ImportedDefaultBinding != nil {
-		if err := s.scope.setBinding(t.ImportedDefaultBinding, BindingImport); err != nil {
+		if err := s.setBinding(t.ImportedDefaultBinding, BindingImport); err != nil {
 			return err
 		}
 	}
 
 	if t.NameSpaceImport != nil {
-		if err := s.scope.setBinding(t.NameSpaceImport, BindingImport); err != nil {
+		if err := s.setBinding(t.NameSpaceImport, BindingImport); err != nil {
 			return err
 		}
 	}
@@ -182,7 +201,7 @@ func (s *scoper) processImportDeclaration(t *javascript.ImportDeclaration) error
 	if t.NamedImports != nil {
 		for _, is := range t.NamedImports.ImportList {
 			if is.ImportedBinding != nil {
-				if err := s.scope.setBinding(is.ImportedBinding, BindingImport); err != nil {
+				if err := s.setBinding(is.ImportedBinding, BindingImport); err != nil {
 					return err
 				}
 			}
@@ -234,12 +253,14 @@ func (s *scoper) processIterationStatementFor(t *javascript.IterationStatementFo
 		s = s.setBindingType(BindingLexicalLet)
 	case javascript.ForInConst, javascript.ForOfConst, javascript.ForAwaitOfConst:
 		s = s.setBindingType(BindingLexicalConst)
+	case javascript.ForOfUsing, javascript.ForOfAwaitUsing, javascript.ForAwaitOfUsing, javascript.ForAwaitOfAwaitUsing:
+		s = s.setBindingType(BindingLexicalUsing)
 	case javascript.ForNormalVar, javascript.ForInVar, javascript.ForOfVar, javascript.ForAwaitOfVar:
 		s = s.setBindingType(BindingVar)
 	}
 
 	if s.set && t.ForBindingIdentifier != nil {
-		if err := s.scope.setBinding(t.ForBindingIdentifier, s.bt); err != nil {
+		if err := s.setBinding(t.ForBindingIdentifier, s.bt); err != nil {
 			return err
 		}
 	}
@@ -296,7 +317,7 @@ func (s *scoper) processTryStatement(t *javascript.TryStatement) error {
 				return err
 			}
 		} else if s.set && t.CatchParameterBindingIdentifier != nil {
-			s.scope.setBinding(t.CatchParameterBindingIdentifier, BindingCatch)
+			s.setBinding(t.CatchParameterBindingIdentifier, BindingCatch)
 		}
 
 		if err := walk.Walk(t.CatchBlock, s); err != nil {
@@ -326,8 +347,11 @@ func (s *scoper) processClassElement(t *javascript.ClassElement) error {
 func (s *scoper) processLexicalDeclaration(t *javascript.LexicalDeclaration) error {
 	typ := BindingLexicalLet
 
-	if t.LetOrConst == javascript.Const {
+	switch t.LetOrConst {
+	case javascript.Const:
 		typ = BindingLexicalConst
+	case javascript.Using, javascript.AwaitUsing:
+		typ = BindingLexicalUsing
 	}
 
 	return walk.Walk(t, s.setBindingType(typ))
@@ -339,7 +363,7 @@ func (s *scoper) processVariableStatment(t *javascript.VariableStatement) error
 
 func (s *scoper) processLexicalBinding(t *javascript.LexicalBinding) error {
 	if s.set && t.BindingIdentifier != nil {
-		if err := s.scope.setBinding(t.BindingIdentifier, s.bt); err != nil {
+		if err := s.setBinding(t.BindingIdentifier, s.bt); err != nil {
 			return err
 		}
 	}
@@ -401,7 +425,7 @@ func (s *scoper) processObjectBindingPattern(t *javascript.ObjectBindingPattern)
 	if err := walk.Walk(t, s); err != nil {
 		return err
 	} else if s.set && t.BindingRestProperty != nil {
-		return s.scope.setBinding(t.BindingRestProperty, s.bt)
+		return s.setBinding(t.BindingRestProperty, s.bt)
 	}
 
 	return nil
@@ -411,7 +435,7 @@ func (s *scoper) processFormalParameters(t *javascript.FormalParameters) error {
 	if err := walk.Walk(t, s.setBindingType(BindingFunctionParam)); err != nil {
 		return err
 	} else if s.set && t.BindingIdentifier != nil {
-		return s.scope.setBinding(t.BindingIdentifier, BindingFunctionParam)
+		return s.setBinding(t.BindingIdentifier, BindingFunctionParam)
 	}
 
 	return nil
@@ -435,7 +459,7 @@ func (s *scoper) processArrowFunction(t *javascript.ArrowFunction) error {
 	s = s.newArrowFunctionScope(t)
 
 	if s.set && t.BindingIdentifier != nil {
-		s.scope.setBinding(t.BindingIdentifier, BindingFunctionParam)
+		s.setBinding(t.BindingIdentifier, BindingFunctionParam)
 	}
 
 	return walk.Walk(t, s)
@@ -443,7 +467,7 @@ func (s *scoper) processArrowFunction(t *javascript.ArrowFunction) error {
 
 func (s *scoper) processBindingElement(t *javascript.BindingElement) error {
 	if s.set && t.SingleNameBinding != nil {
-		if err := s.scope.setBinding(t.SingleNameBinding, s.bt); err != nil {
+		if err := s.setBinding(t.SingleNameBinding, s.bt); err != nil {
 			return err
 		}
 	}
@@ -467,6 +491,18 @@ func (s *scoper) processPrimaryExpression(t *javascript.PrimaryExpression) error
 	return nil
 }
 
+func (s *scoper) processDecoratorMemberExpression(t *javascript.DecoratorMemberExpression) error {
+	if t.IdentifierReference != nil {
+		if !s.set {
+			s.scope.addBinding(t.IdentifierReference, BindingRef)
+		}
+
+		return nil
+	}
+
+	return walk.Walk(t, s)
+}
+
 func (s *scoper) processJSXElement(t *javascript.JSXElement) error {
 	if !s.set && t.ElementName.Identifier != nil {
 		s.scope.addBinding(t.ElementName.Identifier, BindingRef)
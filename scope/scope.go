@@ -29,6 +29,7 @@ const (
 	BindingImport
 	BindingFunctionParam
 	BindingCatch
+	BindingLexicalUsing
 )
 
 // Binding represents a single instance of a bound name.
@@ -50,8 +51,8 @@ func (s *Scope) setBinding(t *javascript.Token, bindingType BindingType) error {
 	name := t.Data
 	binding := Binding{BindingType: bindingType, Token: t, Scope: s}
 
-	if b, ok := s.Bindings[name]; ok {
-		if bindingType == BindingVar && len(b) > 0 && (b[0].BindingType == BindingVar || b[0].BindingType == BindingCatch || b[0].BindingType == BindingFunctionParam || b[0].BindingType == BindingHoistable && s.Parent == nil) {
+	if b, ok := s.Bindings[name]; ok && len(b) > 0 {
+		if bindingType == BindingVar && (b[0].BindingType == BindingVar || b[0].BindingType == BindingCatch || b[0].BindingType == BindingFunctionParam || b[0].BindingType == BindingHoistable && s.Parent == nil) {
 			s.Bindings[name] = append(b, binding)
 
 			if b[0].BindingType == BindingCatch && bindingType == BindingVar {
@@ -218,6 +219,24 @@ func Build(t javascript.Type, global *Scope) (*Scope, error) {
 	return global, nil
 }
 
+// BuildWithErrors generates a scope tree for the given JavaScript tree, as
+// with Build, but continues past any duplicate declarations, returning an
+// error for each one found.
+func BuildWithErrors(t javascript.Type, global *Scope) (*Scope, []error) {
+	if global == nil {
+		global = NewScope()
+	}
+
+	var errs []error
+
+	s := scoper{scope: global, set: true, errs: &errs}
+
+	s.Handle(t)
+	walk.Walk(t, &scoper{scope: global})
+
+	return global, errs
+}
+
 // ScriptScope parses out the scope tree for a JavaScript script.
 //
 // Deprecated: Use the Build function instead.
@@ -3337,6 +3337,10 @@ func TestScriptScope(t *testing.T) {
 }
 
 func TestModuleScope(t *testing.T) {
+	// these tests use syntax that is not valid in strict mode code, so are
+	// parsed as scripts
+	sloppy := map[int]bool{34: true, 35: true, 36: true}
+
 	for n, test := range [...]struct {
 		Input  string
 		Output func(*javascript.Module) (*Scope, error)
@@ -6137,13 +6141,82 @@ func TestModuleScope(t *testing.T) {
 					},
 				}
 
+				return scope, nil
+			},
+		},
+		{ // 174
+			"import a from './a.js';@a.b class c {}",
+			func(m *javascript.Module) (*Scope, error) {
+				scope := NewScope()
+				scope.Bindings["a"] = []Binding{
+					{
+						BindingType: BindingImport,
+						Scope:       scope,
+						Token:       m.ModuleListItems[0].ImportDeclaration.ImportedDefaultBinding,
+					},
+					{
+						BindingType: BindingRef,
+						Scope:       scope,
+						Token:       m.ModuleListItems[1].StatementListItem.Declaration.ClassDeclaration.Decorators[0].DecoratorMemberExpression.DecoratorMemberExpression.IdentifierReference,
+					},
+				}
+				scope.Bindings["c"] = []Binding{
+					{
+						BindingType: BindingHoistable,
+						Scope:       scope,
+						Token:       m.ModuleListItems[1].StatementListItem.Declaration.ClassDeclaration.BindingIdentifier,
+					},
+				}
+
+				return scope, nil
+			},
+		},
+		{ // 175
+			"using a = b;a",
+			func(m *javascript.Module) (*Scope, error) {
+				scope := NewScope()
+				scope.Bindings["a"] = []Binding{
+					{
+						BindingType: BindingLexicalUsing,
+						Scope:       scope,
+						Token:       m.ModuleListItems[0].StatementListItem.Declaration.LexicalDeclaration.BindingList[0].BindingIdentifier,
+					},
+					{
+						BindingType: BindingRef,
+						Scope:       scope,
+						Token:       javascript.UnwrapConditional(m.ModuleListItems[1].StatementListItem.Statement.ExpressionStatement.Expressions[0].ConditionalExpression).(*javascript.PrimaryExpression).IdentifierReference,
+					},
+				}
+				scope.Bindings["b"] = []Binding{
+					{
+						BindingType: BindingRef,
+						Scope:       scope,
+						Token:       javascript.UnwrapConditional(m.ModuleListItems[0].StatementListItem.Declaration.LexicalDeclaration.BindingList[0].Initializer.ConditionalExpression).(*javascript.PrimaryExpression).IdentifierReference,
+					},
+				}
+
 				return scope, nil
 			},
 		},
 	} {
 		tk := parser.NewStringTokeniser(test.Input)
 
-		if source, err := javascript.ParseModule(&tk); err != nil {
+		var (
+			source *javascript.Module
+			err    error
+		)
+
+		if sloppy[n+1] {
+			var s *javascript.Script
+
+			if s, err = javascript.ParseScript(&tk); err == nil {
+				source = javascript.ScriptToModule(s)
+			}
+		} else {
+			source, err = javascript.ParseModule(&tk)
+		}
+
+		if err != nil {
 			t.Errorf("test %d: unexpected error parsing script: %s", n+1, err)
 		} else {
 			tscope, terr := test.Output(source)
@@ -6164,6 +6237,36 @@ func TestModuleScope(t *testing.T) {
 	}
 }
 
+func TestBuildWithErrors(t *testing.T) {
+	tk := parser.NewStringTokeniser("let a;\nlet a;\nfunction b(c, c) {\n\tconst d = 1;\n\tvar d;\n}")
+
+	source, err := javascript.ParseModule(&tk)
+	if err != nil {
+		t.Fatalf("unexpected error parsing module: %s", err)
+	}
+
+	scope, errs := BuildWithErrors(source, nil)
+	if scope == nil {
+		t.Fatalf("expecting scope")
+	} else if len(errs) != 3 {
+		t.Fatalf("expecting 3 errors, got %d: %v", len(errs), errs)
+	}
+
+	for n, line := range [...]uint64{1, 2, 4} {
+		var dd ErrDuplicateDeclaration
+
+		if !errors.As(errs[n], &dd) {
+			t.Errorf("test %d: expecting duplicate declaration error, got %v", n+1, errs[n])
+		} else if dd.Duplicate.Line != line {
+			t.Errorf("test %d: expecting duplicate on line %d, got %d", n+1, line, dd.Duplicate.Line)
+		}
+	}
+
+	if len(scope.Bindings["b"]) == 0 {
+		t.Errorf("expecting binding for function declared after error")
+	}
+}
+
 func TestFindIdentifier(t *testing.T) {
 	tk := parser.NewStringTokeniser(`const a; {let b}`)
 
@@ -6339,3 +6442,77 @@ func TestIdentifierInUse(t *testing.T) {
 		}
 	}
 }
+
+func TestElideImports(t *testing.T) {
+	for n, test := range [...]struct {
+		Input  string
+		Mode   ImportElision
+		Output string
+	}{
+		{ // 1
+			Input:  `import {A, B} from "a"; let b: A = B;`,
+			Output: "import {B} from \"a\";\n\nlet b = B;",
+		},
+		{ // 2
+			Input:  `import {A, B} from "a"; let b: A = B;`,
+			Mode:   VerbatimModuleSyntax,
+			Output: "import {A, B} from \"a\";\n\nlet b = B;",
+		},
+		{ // 3
+			Input:  `import {A} from "a"; let b: A;`,
+			Output: "let b;",
+		},
+		{ // 4
+			Input:  `import {type A} from "a"; import B, {type C} from "b"; B;`,
+			Output: "import B from \"b\";\n\nB;",
+		},
+		{ // 5
+			Input:  `import {type A} from "a"; import B, {type C} from "b"; B;`,
+			Mode:   VerbatimModuleSyntax,
+			Output: "import {} from \"a\";\n\nimport B, {} from \"b\";\n\nB;",
+		},
+		{ // 6
+			Input:  `import A, * as B from "a"; let c = x as A; B.d;`,
+			Output: "import * as B from \"a\";\n\nlet c = x;\n\nB.d;",
+		},
+		{ // 7
+			Input:  `import {A} from "a"; function f(A) { return A; }`,
+			Output: "function f(A) {\n\treturn A;\n}",
+		},
+		{ // 8
+			Input:  `import {A} from "a"; function f() { return A; }`,
+			Output: "import {A} from \"a\";\n\nfunction f() {\n\treturn A;\n}",
+		},
+		{ // 9
+			Input:  `import {} from "a"; import "b";`,
+			Output: "import {} from \"a\";\n\nimport \"b\";",
+		},
+		{ // 10
+			Input:  `import {A} from "a"; export {A};`,
+			Output: "import {A} from \"a\";\n\nexport {A};",
+		},
+		{ // 11
+			Input:  `import {A} from "a"; export {A} from "b";`,
+			Output: "export {A} from \"b\";",
+		},
+		{ // 12
+			Input:  `interface I {} const a = 1; export {I, a as b};`,
+			Output: "\n\nconst a = 1;\n\nexport {a as b};",
+		},
+		{ // 13
+			Input:  `type T = number; export {T};`,
+			Mode:   VerbatimModuleSyntax,
+			Output: "",
+		},
+	} {
+		tk := parser.NewStringTokeniser(test.Input)
+
+		if m, err := javascript.ParseModule(javascript.AsTypescript(&tk)); err != nil {
+			t.Errorf("test %d: unexpected error parsing module: %s", n+1, err)
+		} else if err := ElideImports(m, test.Mode); err != nil {
+			t.Errorf("test %d: unexpected error eliding imports: %s", n+1, err)
+		} else if str := fmt.Sprintf("%s", m); str != test.Output {
+			t.Errorf("test %d: expecting output %q, got %q", n+1, test.Output, str)
+		}
+	}
+}
@@ -0,0 +1,395 @@
+package javascript
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"vimagination.zapto.org/javascript/internal"
+	"vimagination.zapto.org/javascript/sourcemap"
+	"vimagination.zapto.org/parser"
+)
+
+// SourceMapOptions contains the settings used by WriteWithSourceMap.
+type SourceMapOptions struct {
+	// File is the name of the generated file.
+	File string
+	// Source is the name of the original source file.
+	Source string
+	// Content is the text of the original source file. When set, it is
+	// stored in the sourcesContent field, used to calculate UTF-16 columns,
+	// and used to recover the original names of renamed identifiers.
+	Content string
+	// Verbose prints comments, as with the %+s formatting verb.
+	Verbose bool
+	// Original prints using the original whitespace, as with the %#s
+	// formatting verb.
+	Original bool
+	// Input is the tokeniser that was used to parse the Type. When it
+	// loaded a Source Map, the produced mappings point to the sources of
+	// that Source Map instead of Source, and Content is only used to
+	// recover the original names of renamed identifiers.
+	Input *SourceMapTokeniser
+}
+
+// WriteWithSourceMap writes the JavaScript source of the given Type to w and
+// returns a Source Map (v3) that maps the written tokens back to their
+// position in the original source.
+//
+// Mappings are created for every token from the original source, and for the
+// start of every AST node that retains its Tokens.
+func WriteWithSourceMap(w io.Writer, t Type, opts SourceMapOptions) (int64, *sourcemap.SourceMap, error) {
+	f, ok := t.(formatter)
+	if !ok {
+		return 0, nil, ErrInvalidSourceMapType
+	}
+
+	g := sourcemap.NewGenerator(opts.File)
+	sw := &sourceMapWriter{
+		Writer:    w,
+		Generator: g,
+		content:   opts.Content,
+	}
+
+	if opts.Input != nil && opts.Input.sourceMap != nil {
+		sw.input = opts.Input
+	} else {
+		sw.source = g.AddSource(opts.Source, opts.Content)
+	}
+
+	if opts.Original {
+		f.printSource(&originalWriter{Writer: sw}, true)
+	} else {
+		f.printSource(&underlyingWriter{Writer: sw}, opts.Verbose)
+	}
+
+	return sw.n, g.SourceMap(), sw.err
+}
+
+// ErrInvalidSourceMapType is returned from WriteWithSourceMap when given a
+// type that cannot be printed as JavaScript source.
+var ErrInvalidSourceMapType = errors.New("type cannot be printed with a source map")
+
+type sourceMapWriter struct {
+	io.Writer
+	*sourcemap.Generator
+	content      string
+	source       int
+	input        *SourceMapTokeniser
+	pending      *Token
+	line, column uint64
+	lastCR       bool
+	n            int64
+	err          error
+}
+
+func (s *sourceMapWriter) Write(p []byte) (int, error) {
+	for pos := 0; pos < len(p); {
+		c, size := utf8.DecodeRune(p[pos:])
+
+		if s.pending != nil && !strings.ContainsRune(combinedWhitespace, c) {
+			s.addMapping(s.pending)
+
+			s.pending = nil
+		}
+
+		switch c {
+		case '\n':
+			if !s.lastCR {
+				s.line++
+			}
+
+			s.column = 0
+		case '\r', '\u2028', '\u2029':
+			s.line++
+			s.column = 0
+		default:
+			s.column += utf16Len(c)
+		}
+
+		s.lastCR = c == '\r'
+		pos += size
+	}
+
+	n, err := s.Writer.Write(p)
+	s.n += int64(n)
+
+	if err != nil && s.err == nil {
+		s.err = err
+	}
+
+	return n, err
+}
+
+func (s *sourceMapWriter) mark(tk *Token) {
+	if tk != nil && strings.TrimLeft(tk.Data, combinedWhitespace) != "" {
+		s.pending = tk
+	}
+}
+
+func firstToken(tks Tokens) *Token {
+	for n := range tks {
+		switch tks[n].Type {
+		case TokenWhitespace, TokenLineTerminator, TokenSingleLineComment, TokenMultiLineComment:
+		default:
+			return &tks[n]
+		}
+	}
+
+	return nil
+}
+
+func (s *sourceMapWriter) addMapping(tk *Token) {
+	if s.input != nil {
+		s.addInputMapping(tk)
+
+		return
+	}
+
+	m := sourcemap.Mapping{
+		GeneratedLine:   s.line,
+		GeneratedColumn: s.column,
+		Source:          s.source,
+		OriginalLine:    tk.Line,
+		OriginalColumn:  s.originalColumn(tk),
+		Name:            -1,
+	}
+
+	if tk.Type == TokenIdentifier || tk.Type == TokenPrivateIdentifier {
+		m.Name = s.AddName(s.originalName(tk))
+	}
+
+	s.AddMapping(m)
+}
+
+func (s *sourceMapWriter) addInputMapping(tk *Token) {
+	m, ok := s.input.positions[tk.Pos]
+	if !ok {
+		return
+	}
+
+	sm := s.input.sourceMap
+	name := ""
+
+	if m.Name >= 0 {
+		name = sm.Names[m.Name]
+	} else if tk.Type == TokenIdentifier || tk.Type == TokenPrivateIdentifier {
+		name = s.originalName(tk)
+	}
+
+	var content string
+
+	if m.Source < len(sm.SourcesContent) {
+		content = sm.SourcesContent[m.Source]
+	}
+
+	m.GeneratedLine = s.line
+	m.GeneratedColumn = s.column
+	m.Source = s.AddSource(sm.Source(m.Source), content)
+	m.Name = -1
+
+	if name != "" {
+		m.Name = s.AddName(name)
+	}
+
+	s.AddMapping(m)
+}
+
+func (s *sourceMapWriter) originalColumn(tk *Token) uint64 {
+	if tk.Pos > uint64(len(s.content)) || tk.LinePos > tk.Pos {
+		return tk.LinePos
+	}
+
+	return utf16Column(s.content[tk.Pos-tk.LinePos : tk.Pos])
+}
+
+func (s *sourceMapWriter) originalName(tk *Token) string {
+	if tk.Pos >= uint64(len(s.content)) {
+		return tk.Data
+	}
+
+	src := s.content[tk.Pos:]
+	start := 0
+
+	if tk.Type == TokenPrivateIdentifier && strings.HasPrefix(src, "#") {
+		start = 1
+	}
+
+	end := start
+
+	for n, c := range src[start:] {
+		if n == 0 && !internal.IsIDStart(c) || !internal.IsIDContinue(c) {
+			break
+		}
+
+		end = start + n + utf8.RuneLen(c)
+	}
+
+	if end == start {
+		return tk.Data
+	}
+
+	return src[:end]
+}
+
+func utf16Len(c rune) uint64 {
+	if c >= 0x10000 {
+		return 2
+	}
+
+	return 1
+}
+
+func sourceMapper(w io.Writer) *sourceMapWriter {
+	sw, _ := w.(*sourceMapWriter)
+
+	return sw
+}
+
+// SourceMapTokeniser is a Tokeniser that loads the Source Map referenced by a
+// sourceMappingURL comment at the end of the parsed source, and rebases the
+// positions of the parsed Tokens on it.
+type SourceMapTokeniser struct {
+	Tokeniser
+	load      func(url string) ([]byte, error)
+	sourceMap *sourcemap.SourceMap
+	positions map[uint64]sourcemap.Mapping
+}
+
+// WithSourceMap wraps a Tokeniser so that, when used with ParseScript or
+// ParseModule, the Line and LinePos of each Token are rebased to the original
+// position given by an existing Source Map.
+//
+// Inline Source Maps, stored as data URIs, are decoded directly; any other URL
+// is passed to the load func, which may be nil to ignore such Source Maps.
+//
+// The Pos of each Token retains its offset in the parsed source. The LinePos
+// of a rebased Token is measured in UTF-16 code units, as in the Source Map.
+//
+// The returned SourceMapTokeniser can be set as the Input in
+// SourceMapOptions to produce a Source Map that points at the original
+// sources.
+//
+// Can be combined with AsTypescript and AsJSX.
+func WithSourceMap(t Tokeniser, load func(url string) ([]byte, error)) *SourceMapTokeniser {
+	return &SourceMapTokeniser{Tokeniser: t, load: load}
+}
+
+func (s *SourceMapTokeniser) hasFlags() (bool, bool) {
+	return tokeniserFlags(s.Tokeniser)
+}
+
+// SourceMap returns the Source Map that was loaded during parsing, or nil if
+// none was found.
+func (s *SourceMapTokeniser) SourceMap() *sourcemap.SourceMap {
+	return s.sourceMap
+}
+
+func tokeniserSourceMap(t Tokeniser) *SourceMapTokeniser {
+	switch t := t.(type) {
+	case *SourceMapTokeniser:
+		return t
+	case *jsx:
+		return tokeniserSourceMap(t.Tokeniser)
+	case *typescript:
+		return tokeniserSourceMap(t.Tokeniser)
+	}
+
+	return nil
+}
+
+func (s *SourceMapTokeniser) rebase(tokens Tokens) error {
+	comment := sourceMappingURLComment(tokens)
+	if comment == nil {
+		return nil
+	}
+
+	url, _ := sourcemap.URLFromComment(comment.Data)
+
+	var (
+		sm  *sourcemap.SourceMap
+		err error
+	)
+
+	if strings.HasPrefix(url, "data:") {
+		sm, err = sourcemap.ParseDataURI(url)
+	} else if s.load == nil {
+		return nil
+	} else if data, lerr := s.load(url); lerr != nil {
+		err = lerr
+	} else {
+		sm, err = sourcemap.Parse(data)
+	}
+
+	if err != nil {
+		return Error{Err: err, Parsing: "SourceMap", Token: *comment}
+	}
+
+	c, err := sourcemap.NewConsumer(sm)
+	if err != nil {
+		return Error{Err: err, Parsing: "SourceMap", Token: *comment}
+	}
+
+	var src strings.Builder
+
+	for _, tk := range tokens {
+		src.WriteString(tk.Data)
+	}
+
+	s.sourceMap = sm
+	s.positions = make(map[uint64]sourcemap.Mapping)
+	text := src.String()
+
+	for n := range tokens {
+		tk := &tokens[n]
+
+		if tk.Data == "" {
+			continue
+		}
+
+		column := utf16Column(text[tk.Pos-tk.LinePos : tk.Pos])
+
+		m, ok := c.Lookup(tk.Line, column)
+		if !ok {
+			continue
+		}
+
+		if m.GeneratedColumn != column {
+			m.OriginalColumn += column - m.GeneratedColumn
+			m.Name = -1
+		}
+
+		s.positions[tk.Pos] = m
+		tk.Line = m.OriginalLine
+		tk.LinePos = m.OriginalColumn
+	}
+
+	return nil
+}
+
+func sourceMappingURLComment(tokens Tokens) *Token {
+	for n := len(tokens) - 1; n >= 0; n-- {
+		switch tokens[n].Type {
+		case TokenWhitespace, TokenLineTerminator, parser.TokenDone:
+		case TokenSingleLineComment, TokenMultiLineComment:
+			if _, ok := sourcemap.URLFromComment(tokens[n].Data); ok {
+				return &tokens[n]
+			}
+		default:
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func utf16Column(line string) uint64 {
+	var column uint64
+
+	for _, c := range line {
+		column += utf16Len(c)
+	}
+
+	return column
+}
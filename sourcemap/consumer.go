@@ -0,0 +1,287 @@
+package sourcemap
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// Parse decodes a JSON encoded Source Map (v3) document.
+//
+// The optional ")]}'" prefix, used to prevent XSSI, is ignored.
+func Parse(data []byte) (*SourceMap, error) {
+	if bytes.HasPrefix(data, []byte(")]}'")) {
+		if n := bytes.IndexByte(data, '\n'); n >= 0 {
+			data = data[n+1:]
+		} else {
+			data = nil
+		}
+	}
+
+	s := new(SourceMap)
+
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, err
+	}
+
+	if s.Version != Version {
+		return nil, ErrInvalidVersion
+	}
+
+	return s, nil
+}
+
+// ParseDataURI decodes a Source Map that is stored in a data URI, such as
+// those created by tools that inline their Source Maps.
+func ParseDataURI(uri string) (*SourceMap, error) {
+	rest, ok := strings.CutPrefix(uri, "data:")
+	if !ok {
+		return nil, ErrInvalidDataURI
+	}
+
+	params, data, ok := strings.Cut(rest, ",")
+	if !ok {
+		return nil, ErrInvalidDataURI
+	}
+
+	var (
+		decoded []byte
+		err     error
+	)
+
+	if strings.HasSuffix(params, ";base64") {
+		decoded, err = base64.StdEncoding.DecodeString(data)
+	} else {
+		var str string
+
+		str, err = url.PathUnescape(data)
+		decoded = []byte(str)
+	}
+
+	if err != nil {
+		return nil, ErrInvalidDataURI
+	}
+
+	return Parse(decoded)
+}
+
+// URLFromComment returns the URL from a sourceMappingURL comment.
+//
+// Both single-line (//# and //@) and multi-line (/*# and /*@) forms are
+// accepted.
+func URLFromComment(comment string) (string, bool) {
+	var text string
+
+	if c, ok := strings.CutPrefix(comment, "//"); ok {
+		text = c
+	} else if c, ok := strings.CutPrefix(comment, "/*"); ok {
+		if text, ok = strings.CutSuffix(c, "*/"); !ok {
+			return "", false
+		}
+	} else {
+		return "", false
+	}
+
+	if len(text) == 0 || text[0] != '#' && text[0] != '@' {
+		return "", false
+	}
+
+	text, ok := strings.CutPrefix(strings.TrimLeft(text[1:], " \t"), "sourceMappingURL=")
+	if !ok {
+		return "", false
+	}
+
+	if text = strings.TrimSpace(text); text == "" || strings.ContainsAny(text, " \t") {
+		return "", false
+	}
+
+	return text, true
+}
+
+// Source returns the name of the source at the given index, prefixed with the
+// SourceRoot.
+func (s *SourceMap) Source(n int) string {
+	if n < 0 || n >= len(s.Sources) {
+		return ""
+	}
+
+	if s.SourceRoot == "" || strings.Contains(s.Sources[n], "://") || strings.HasPrefix(s.Sources[n], "/") {
+		return s.Sources[n]
+	}
+
+	return strings.TrimSuffix(s.SourceRoot, "/") + "/" + s.Sources[n]
+}
+
+func (s *SourceMap) content(n int) string {
+	if n < 0 || n >= len(s.SourcesContent) {
+		return ""
+	}
+
+	return s.SourcesContent[n]
+}
+
+// Consumer allows the lookup of original positions from generated positions.
+type Consumer struct {
+	*SourceMap
+	lines [][]Mapping
+}
+
+// NewConsumer decodes the mappings of the given SourceMap, returning a
+// Consumer that can be used to look up original positions.
+func NewConsumer(s *SourceMap) (*Consumer, error) {
+	ms, err := Decode(s.Mappings)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Consumer{SourceMap: s}
+
+	for _, m := range ms {
+		if m.Source >= len(s.Sources) {
+			return nil, ErrInvalidSource
+		} else if m.Name >= len(s.Names) {
+			return nil, ErrInvalidName
+		}
+
+		for uint64(len(c.lines)) <= m.GeneratedLine {
+			c.lines = append(c.lines, nil)
+		}
+
+		c.lines[m.GeneratedLine] = append(c.lines[m.GeneratedLine], m)
+	}
+
+	for _, line := range c.lines {
+		slices.SortStableFunc(line, func(a, b Mapping) int {
+			return cmp.Compare(a.GeneratedColumn, b.GeneratedColumn)
+		})
+	}
+
+	return c, nil
+}
+
+// Mappings returns all of the decoded mappings, in generated order.
+func (c *Consumer) Mappings() []Mapping {
+	var ms []Mapping
+
+	for _, line := range c.lines {
+		ms = append(ms, line...)
+	}
+
+	return ms
+}
+
+// Lookup finds the mapping that covers the given generated position; that is,
+// the mapping on the same line with the greatest column that is not greater
+// than the given column.
+//
+// The boolean will be false if no such mapping exists, or if the found mapping
+// has no original source.
+func (c *Consumer) Lookup(line, column uint64) (Mapping, bool) {
+	if line >= uint64(len(c.lines)) {
+		return Mapping{}, false
+	}
+
+	ms := c.lines[line]
+	n, found := slices.BinarySearchFunc(ms, column, func(m Mapping, column uint64) int {
+		return cmp.Compare(m.GeneratedColumn, column)
+	})
+
+	if found {
+		for n+1 < len(ms) && ms[n+1].GeneratedColumn == column {
+			n++
+		}
+	} else if n == 0 {
+		return Mapping{}, false
+	} else {
+		n--
+	}
+
+	if ms[n].Source < 0 {
+		return ms[n], false
+	}
+
+	return ms[n], true
+}
+
+// Compose combines two SourceMaps, where inner maps an intermediate file to
+// its original sources and outer maps the generated file to the intermediate
+// file, returning a SourceMap that maps the generated file directly to the
+// original sources.
+//
+// The intermediate source in outer is found by matching the File field of
+// inner; if inner has no File, then outer must have exactly one source.
+// Mappings to other sources in outer are retained unchanged.
+func Compose(outer, inner *SourceMap) (*SourceMap, error) {
+	o, err := NewConsumer(outer)
+	if err != nil {
+		return nil, err
+	}
+
+	i, err := NewConsumer(inner)
+	if err != nil {
+		return nil, err
+	}
+
+	intermediate := -1
+
+	if inner.File != "" {
+		intermediate = slices.Index(outer.Sources, inner.File)
+	}
+
+	if intermediate < 0 && len(outer.Sources) == 1 {
+		intermediate = 0
+	}
+
+	if intermediate < 0 {
+		return nil, ErrUnmatchedSource
+	}
+
+	g := NewGenerator(outer.File)
+
+	for _, m := range o.Mappings() {
+		if m.Source < 0 {
+			g.AddMapping(m)
+
+			continue
+		}
+
+		var name string
+
+		if m.Name >= 0 {
+			name = outer.Names[m.Name]
+		}
+
+		if m.Source == intermediate {
+			im, ok := i.Lookup(m.OriginalLine, m.OriginalColumn)
+			if !ok {
+				g.AddMapping(Mapping{GeneratedLine: m.GeneratedLine, GeneratedColumn: m.GeneratedColumn, Source: -1, Name: -1})
+
+				continue
+			}
+
+			if im.Name >= 0 && im.GeneratedColumn == m.OriginalColumn {
+				name = inner.Names[im.Name]
+			}
+
+			m.Source = g.AddSource(inner.Source(im.Source), inner.content(im.Source))
+			m.OriginalLine = im.OriginalLine
+			m.OriginalColumn = im.OriginalColumn
+		} else {
+			m.Source = g.AddSource(outer.Source(m.Source), outer.content(m.Source))
+		}
+
+		if name != "" {
+			m.Name = g.AddName(name)
+		} else {
+			m.Name = -1
+		}
+
+		g.AddMapping(m)
+	}
+
+	return g.SourceMap(), nil
+}
@@ -0,0 +1,377 @@
+package sourcemap
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	for n, test := range [...]struct {
+		Input  string
+		Output []Mapping
+		Err    error
+	}{
+		{ // 1
+			"",
+			nil,
+			nil,
+		},
+		{ // 2
+			"AAAA",
+			[]Mapping{
+				{Source: 0, Name: -1},
+			},
+			nil,
+		},
+		{ // 3
+			"AAAA,SAASA;CACRC",
+			[]Mapping{
+				{Source: 0, Name: -1},
+				{GeneratedColumn: 9, Source: 0, OriginalColumn: 9, Name: 0},
+				{GeneratedLine: 1, GeneratedColumn: 1, Source: 0, OriginalLine: 1, OriginalColumn: 1, Name: 1},
+			},
+			nil,
+		},
+		{ // 4
+			"E,GAUG",
+			[]Mapping{
+				{GeneratedColumn: 2, Source: -1, Name: -1},
+				{GeneratedColumn: 5, Source: 0, OriginalLine: 10, OriginalColumn: 3, Name: -1},
+			},
+			nil,
+		},
+		{ // 5
+			";;CCEAG,EDEK",
+			[]Mapping{
+				{GeneratedLine: 2, GeneratedColumn: 1, Source: 1, OriginalLine: 2, OriginalColumn: 0, Name: 3},
+				{GeneratedLine: 2, GeneratedColumn: 3, Source: 0, OriginalLine: 4, OriginalColumn: 5, Name: -1},
+			},
+			nil,
+		},
+		{ // 6
+			"AA",
+			nil,
+			ErrInvalidSegment,
+		},
+		{ // 7
+			"AAAAAA",
+			nil,
+			ErrInvalidSegment,
+		},
+		{ // 8
+			"g",
+			nil,
+			ErrInvalidVLQ,
+		},
+		{ // 9
+			"A!",
+			nil,
+			ErrInvalidVLQ,
+		},
+		{ // 10
+			"D",
+			nil,
+			ErrInvalidSegment,
+		},
+	} {
+		if out, err := Decode(test.Input); !errors.Is(err, test.Err) {
+			t.Errorf("test %d: expecting error %v, got %v", n+1, test.Err, err)
+		} else if !reflect.DeepEqual(out, test.Output) {
+			t.Errorf("test %d: expecting output %v, got %v", n+1, test.Output, out)
+		} else if test.Err == nil {
+			if enc := Encode(out); enc != test.Input {
+				t.Errorf("test %d: expecting re-encoding to produce %q, got %q", n+1, test.Input, enc)
+			}
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	expected := &SourceMap{
+		Version:  3,
+		File:     "out.js",
+		Sources:  []string{"in.js"},
+		Names:    []string{},
+		Mappings: "AAAA",
+	}
+
+	for n, test := range [...]struct {
+		Input  string
+		Output *SourceMap
+		Err    error
+	}{
+		{ // 1
+			`{"version":3,"file":"out.js","sources":["in.js"],"names":[],"mappings":"AAAA"}`,
+			expected,
+			nil,
+		},
+		{ // 2
+			")]}'\n{\"version\":3,\"file\":\"out.js\",\"sources\":[\"in.js\"],\"names\":[],\"mappings\":\"AAAA\"}",
+			expected,
+			nil,
+		},
+		{ // 3
+			`{"version":2,"sources":[],"names":[],"mappings":""}`,
+			nil,
+			ErrInvalidVersion,
+		},
+	} {
+		if sm, err := Parse([]byte(test.Input)); !errors.Is(err, test.Err) {
+			t.Errorf("test %d: expecting error %v, got %v", n+1, test.Err, err)
+		} else if !reflect.DeepEqual(sm, test.Output) {
+			t.Errorf("test %d: expecting source map %v, got %v", n+1, test.Output, sm)
+		}
+	}
+}
+
+func TestParseDataURI(t *testing.T) {
+	expected := &SourceMap{
+		Version:  3,
+		Sources:  []string{"a.js"},
+		Names:    []string{},
+		Mappings: "AAAA",
+	}
+
+	for n, test := range [...]struct {
+		Input  string
+		Output *SourceMap
+		Err    error
+	}{
+		{ // 1
+			"data:application/json;base64,eyJ2ZXJzaW9uIjozLCJzb3VyY2VzIjpbImEuanMiXSwibmFtZXMiOltdLCJtYXBwaW5ncyI6IkFBQUEifQ==",
+			expected,
+			nil,
+		},
+		{ // 2
+			"data:application/json;charset=utf-8;base64,eyJ2ZXJzaW9uIjozLCJzb3VyY2VzIjpbImEuanMiXSwibmFtZXMiOltdLCJtYXBwaW5ncyI6IkFBQUEifQ==",
+			expected,
+			nil,
+		},
+		{ // 3
+			`data:application/json,%7B%22version%22:3,%22sources%22:%5B%22a.js%22%5D,%22names%22:%5B%5D,%22mappings%22:%22AAAA%22%7D`,
+			expected,
+			nil,
+		},
+		{ // 4
+			"a.js.map",
+			nil,
+			ErrInvalidDataURI,
+		},
+		{ // 5
+			"data:application/json;base64",
+			nil,
+			ErrInvalidDataURI,
+		},
+		{ // 6
+			"data:application/json;base64,!!!",
+			nil,
+			ErrInvalidDataURI,
+		},
+	} {
+		if sm, err := ParseDataURI(test.Input); !errors.Is(err, test.Err) {
+			t.Errorf("test %d: expecting error %v, got %v", n+1, test.Err, err)
+		} else if !reflect.DeepEqual(sm, test.Output) {
+			t.Errorf("test %d: expecting source map %v, got %v", n+1, test.Output, sm)
+		}
+	}
+}
+
+func TestURLFromComment(t *testing.T) {
+	for n, test := range [...]struct {
+		Input string
+		URL   string
+		OK    bool
+	}{
+		{ // 1
+			"//# sourceMappingURL=a.js.map",
+			"a.js.map",
+			true,
+		},
+		{ // 2
+			"//@ sourceMappingURL=a.js.map",
+			"a.js.map",
+			true,
+		},
+		{ // 3
+			"/*# sourceMappingURL=a.js.map */",
+			"a.js.map",
+			true,
+		},
+		{ // 4
+			"//#sourceMappingURL=a.js.map ",
+			"a.js.map",
+			true,
+		},
+		{ // 5
+			"// sourceMappingURL=a.js.map",
+			"",
+			false,
+		},
+		{ // 6
+			"//# sourceURL=a.js",
+			"",
+			false,
+		},
+		{ // 7
+			"//# sourceMappingURL=",
+			"",
+			false,
+		},
+		{ // 8
+			"/*# sourceMappingURL=a.js.map",
+			"",
+			false,
+		},
+	} {
+		if url, ok := URLFromComment(test.Input); ok != test.OK {
+			t.Errorf("test %d: expecting ok %v, got %v", n+1, test.OK, ok)
+		} else if url != test.URL {
+			t.Errorf("test %d: expecting URL %q, got %q", n+1, test.URL, url)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	c, err := NewConsumer(&SourceMap{
+		Version:  3,
+		Sources:  []string{"a.js"},
+		Names:    []string{},
+		Mappings: "E,GAUG,EACC;;AAAA",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for n, test := range [...]struct {
+		Line, Column uint64
+		Output       Mapping
+		OK           bool
+	}{
+		{ // 1
+			0, 0,
+			Mapping{},
+			false,
+		},
+		{ // 2
+			0, 2,
+			Mapping{GeneratedColumn: 2, Source: -1, Name: -1},
+			false,
+		},
+		{ // 3
+			0, 5,
+			Mapping{GeneratedColumn: 5, Source: 0, OriginalLine: 10, OriginalColumn: 3, Name: -1},
+			true,
+		},
+		{ // 4
+			0, 6,
+			Mapping{GeneratedColumn: 5, Source: 0, OriginalLine: 10, OriginalColumn: 3, Name: -1},
+			true,
+		},
+		{ // 5
+			0, 100,
+			Mapping{GeneratedColumn: 7, Source: 0, OriginalLine: 11, OriginalColumn: 4, Name: -1},
+			true,
+		},
+		{ // 6
+			1, 0,
+			Mapping{},
+			false,
+		},
+		{ // 7
+			2, 3,
+			Mapping{GeneratedLine: 2, Source: 0, OriginalLine: 11, OriginalColumn: 4, Name: -1},
+			true,
+		},
+		{ // 8
+			3, 0,
+			Mapping{},
+			false,
+		},
+	} {
+		if m, ok := c.Lookup(test.Line, test.Column); ok != test.OK {
+			t.Errorf("test %d: expecting ok %v, got %v", n+1, test.OK, ok)
+		} else if m != test.Output {
+			t.Errorf("test %d: expecting mapping %v, got %v", n+1, test.Output, m)
+		}
+	}
+
+	if _, err := NewConsumer(&SourceMap{Mappings: "AAAA"}); !errors.Is(err, ErrInvalidSource) {
+		t.Errorf("expecting error %v, got %v", ErrInvalidSource, err)
+	}
+
+	if _, err := NewConsumer(&SourceMap{Sources: []string{"a.js"}, Mappings: "AAAAA"}); !errors.Is(err, ErrInvalidName) {
+		t.Errorf("expecting error %v, got %v", ErrInvalidName, err)
+	}
+}
+
+func TestCompose(t *testing.T) {
+	inner := &SourceMap{
+		Version:        3,
+		File:           "mid.js",
+		SourceRoot:     "src",
+		Sources:        []string{"a.ts"},
+		SourcesContent: []string{"let longName = 1;"},
+		Names:          []string{"longName"},
+		Mappings:       "AAAA,IAAIA",
+	}
+
+	for n, test := range [...]struct {
+		Outer  *SourceMap
+		Output *SourceMap
+		Err    error
+	}{
+		{ // 1
+			&SourceMap{
+				Version:  3,
+				File:     "out.js",
+				Sources:  []string{"mid.js"},
+				Names:    []string{"a"},
+				Mappings: "AAAA,IAAIA,GAAG",
+			},
+			&SourceMap{
+				Version:        3,
+				File:           "out.js",
+				Sources:        []string{"src/a.ts"},
+				SourcesContent: []string{"let longName = 1;"},
+				Names:          []string{"longName"},
+				Mappings:       "AAAA,IAAIA,GAAA",
+			},
+			nil,
+		},
+		{ // 2
+			&SourceMap{
+				Version:  3,
+				File:     "out.js",
+				Sources:  []string{"other.js", "mid.js"},
+				Names:    []string{"x"},
+				Mappings: "AAAAA,ECAA;AACA",
+			},
+			&SourceMap{
+				Version:        3,
+				File:           "out.js",
+				Sources:        []string{"other.js", "src/a.ts"},
+				SourcesContent: []string{"", "let longName = 1;"},
+				Names:          []string{"x"},
+				Mappings:       "AAAAA,ECAA;A",
+			},
+			nil,
+		},
+		{ // 3
+			&SourceMap{
+				Version:  3,
+				Sources:  []string{"a.js", "b.js"},
+				Names:    []string{},
+				Mappings: "AAAA",
+			},
+			nil,
+			ErrUnmatchedSource,
+		},
+	} {
+		if sm, err := Compose(test.Outer, inner); !errors.Is(err, test.Err) {
+			t.Errorf("test %d: expecting error %v, got %v", n+1, test.Err, err)
+		} else if !reflect.DeepEqual(sm, test.Output) {
+			t.Errorf("test %d: expecting source map %v, got %v", n+1, test.Output, sm)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+package sourcemap
+
+import "errors"
+
+// Errors.
+var (
+	ErrInvalidVLQ      = errors.New("invalid VLQ")
+	ErrInvalidSegment  = errors.New("invalid mapping segment")
+	ErrInvalidVersion  = errors.New("invalid source map version")
+	ErrInvalidDataURI  = errors.New("invalid data URI")
+	ErrInvalidSource   = errors.New("invalid source index")
+	ErrInvalidName     = errors.New("invalid name index")
+	ErrUnmatchedSource = errors.New("no source matches the inner source map")
+)
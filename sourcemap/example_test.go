@@ -0,0 +1,22 @@
+package sourcemap_test
+
+import (
+	"os"
+
+	"vimagination.zapto.org/javascript/sourcemap"
+)
+
+func Example() {
+	g := sourcemap.NewGenerator("out.js")
+
+	src := g.AddSource("in.js", "")
+	name := g.AddName("greet")
+
+	g.AddMapping(sourcemap.Mapping{Source: src, Name: -1})
+	g.AddMapping(sourcemap.Mapping{GeneratedColumn: 9, Source: src, OriginalLine: 1, OriginalColumn: 2, Name: name})
+
+	g.SourceMap().WriteTo(os.Stdout)
+
+	// Output:
+	// {"version":3,"file":"out.js","sources":["in.js"],"names":["greet"],"mappings":"AAAA,SACEA"}
+}
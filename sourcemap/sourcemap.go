@@ -0,0 +1,273 @@
+// Package sourcemap provides types to generate, consume and compose Source Map
+// (v3) documents.
+package sourcemap // import "vimagination.zapto.org/javascript/sourcemap"
+
+import (
+	"cmp"
+	"encoding/json"
+	"io"
+	"slices"
+)
+
+// Version is the Source Map version produced by this package.
+const Version = 3
+
+// SourceMap represents a Source Map (v3) document.
+//
+// https://tc39.es/source-map/
+type SourceMap struct {
+	Version        int      `json:"version"`
+	File           string   `json:"file,omitempty"`
+	SourceRoot     string   `json:"sourceRoot,omitempty"`
+	Sources        []string `json:"sources"`
+	SourcesContent []string `json:"sourcesContent,omitempty"`
+	Names          []string `json:"names"`
+	Mappings       string   `json:"mappings"`
+}
+
+// WriteTo writes the JSON encoded Source Map to the given Writer.
+func (s *SourceMap) WriteTo(w io.Writer) (int64, error) {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := w.Write(data)
+
+	return int64(n), err
+}
+
+// Mapping represents a single link between a position in generated code and
+// a position in an original source.
+//
+// All lines and columns are zero-indexed, with columns measured in UTF-16 code
+// units.
+//
+// A Source of -1 indicates a generated position that has no original source,
+// and a Name of -1 indicates that no name is associated with the mapping.
+type Mapping struct {
+	GeneratedLine, GeneratedColumn uint64
+	Source                         int
+	OriginalLine, OriginalColumn   uint64
+	Name                           int
+}
+
+// Generator collects sources, names and mappings, and combines them into a
+// SourceMap.
+type Generator struct {
+	file     string
+	sources  []string
+	contents []string
+	names    []string
+	mappings []Mapping
+}
+
+// NewGenerator creates a new Generator for the generated file with the given
+// name.
+func NewGenerator(file string) *Generator {
+	return &Generator{file: file}
+}
+
+// AddSource adds an original source, returning its index for use in a
+// Mapping.
+//
+// Adding a source with a name that has already been added returns the
+// existing index, setting the content if it was previously empty.
+func (g *Generator) AddSource(name, content string) int {
+	if n := slices.Index(g.sources, name); n >= 0 {
+		if g.contents[n] == "" {
+			g.contents[n] = content
+		}
+
+		return n
+	}
+
+	g.sources = append(g.sources, name)
+	g.contents = append(g.contents, content)
+
+	return len(g.sources) - 1
+}
+
+// AddName adds a symbol name, returning its index for use in a Mapping.
+func (g *Generator) AddName(name string) int {
+	if n := slices.Index(g.names, name); n >= 0 {
+		return n
+	}
+
+	g.names = append(g.names, name)
+
+	return len(g.names) - 1
+}
+
+// AddMapping adds a Mapping to the Generator.
+func (g *Generator) AddMapping(m Mapping) {
+	g.mappings = append(g.mappings, m)
+}
+
+// Mappings returns the mappings that have been added to the Generator.
+func (g *Generator) Mappings() []Mapping {
+	return g.mappings
+}
+
+// SourceMap creates a SourceMap from the data collected by the Generator.
+//
+// The sourcesContent field will only be set when at least one source was added
+// with content.
+func (g *Generator) SourceMap() *SourceMap {
+	s := &SourceMap{
+		Version:  Version,
+		File:     g.file,
+		Sources:  slices.Clone(g.sources),
+		Names:    slices.Clone(g.names),
+		Mappings: Encode(g.mappings),
+	}
+
+	if s.Sources == nil {
+		s.Sources = []string{}
+	}
+
+	if s.Names == nil {
+		s.Names = []string{}
+	}
+
+	for _, content := range g.contents {
+		if content != "" {
+			s.SourcesContent = slices.Clone(g.contents)
+
+			break
+		}
+	}
+
+	return s
+}
+
+// Encode converts a list of Mappings into the Base64 VLQ form used in the
+// mappings field of a SourceMap.
+//
+// The Mappings will be sorted by their generated position; the original order
+// is retained for mappings with the same generated position.
+func Encode(mappings []Mapping) string {
+	mappings = slices.Clone(mappings)
+
+	slices.SortStableFunc(mappings, func(a, b Mapping) int {
+		if c := cmp.Compare(a.GeneratedLine, b.GeneratedLine); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.GeneratedColumn, b.GeneratedColumn)
+	})
+
+	var (
+		buf                                                []byte
+		line                                               uint64
+		column, source, originalLine, originalColumn, name int64
+		first                                              = true
+	)
+
+	for _, m := range mappings {
+		if m.GeneratedLine != line {
+			for ; line < m.GeneratedLine; line++ {
+				buf = append(buf, ';')
+			}
+
+			column = 0
+			first = true
+		}
+
+		if !first {
+			buf = append(buf, ',')
+		}
+
+		first = false
+		buf = appendVLQ(buf, int64(m.GeneratedColumn)-column)
+		column = int64(m.GeneratedColumn)
+
+		if m.Source < 0 {
+			continue
+		}
+
+		buf = appendVLQ(buf, int64(m.Source)-source)
+		buf = appendVLQ(buf, int64(m.OriginalLine)-originalLine)
+		buf = appendVLQ(buf, int64(m.OriginalColumn)-originalColumn)
+		source = int64(m.Source)
+		originalLine = int64(m.OriginalLine)
+		originalColumn = int64(m.OriginalColumn)
+
+		if m.Name >= 0 {
+			buf = appendVLQ(buf, int64(m.Name)-name)
+			name = int64(m.Name)
+		}
+	}
+
+	return string(buf)
+}
+
+// Decode converts the Base64 VLQ form used in the mappings field of a
+// SourceMap into a list of Mappings.
+func Decode(mappings string) ([]Mapping, error) {
+	var (
+		ms                                                 []Mapping
+		line                                               uint64
+		column, source, originalLine, originalColumn, name int64
+		err                                                error
+	)
+
+	for len(mappings) > 0 {
+		switch mappings[0] {
+		case ';':
+			line++
+			column = 0
+			mappings = mappings[1:]
+
+			continue
+		case ',':
+			mappings = mappings[1:]
+
+			continue
+		}
+
+		var fields [5]int64
+
+		n := 0
+
+		for ; n < len(fields) && len(mappings) > 0 && mappings[0] != ',' && mappings[0] != ';'; n++ {
+			if fields[n], mappings, err = readVLQ(mappings); err != nil {
+				return nil, err
+			}
+		}
+
+		if n == 2 || n == 3 || len(mappings) > 0 && mappings[0] != ',' && mappings[0] != ';' {
+			return nil, ErrInvalidSegment
+		}
+
+		column += fields[0]
+		m := Mapping{
+			GeneratedLine:   line,
+			GeneratedColumn: uint64(column),
+			Source:          -1,
+			Name:            -1,
+		}
+
+		if n > 1 {
+			source += fields[1]
+			originalLine += fields[2]
+			originalColumn += fields[3]
+			m.Source = int(source)
+			m.OriginalLine = uint64(originalLine)
+			m.OriginalColumn = uint64(originalColumn)
+
+			if n > 4 {
+				name += fields[4]
+				m.Name = int(name)
+			}
+		}
+
+		if column < 0 || source < 0 || originalLine < 0 || originalColumn < 0 || name < 0 {
+			return nil, ErrInvalidSegment
+		}
+
+		ms = append(ms, m)
+	}
+
+	return ms, nil
+}
@@ -0,0 +1,152 @@
+package sourcemap
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAppendVLQ(t *testing.T) {
+	for n, test := range [...]struct {
+		Input  int64
+		Output string
+	}{
+		{ // 1
+			0,
+			"A",
+		},
+		{ // 2
+			1,
+			"C",
+		},
+		{ // 3
+			-1,
+			"D",
+		},
+		{ // 4
+			15,
+			"e",
+		},
+		{ // 5
+			16,
+			"gB",
+		},
+		{ // 6
+			-16,
+			"hB",
+		},
+		{ // 7
+			511,
+			"+f",
+		},
+		{ // 8
+			1000,
+			"w+B",
+		},
+		{ // 9
+			-123456,
+			"hkxH",
+		},
+	} {
+		if out := string(appendVLQ(nil, test.Input)); out != test.Output {
+			t.Errorf("test %d: expecting output %q, got %q", n+1, test.Output, out)
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	for n, test := range [...]struct {
+		Input  []Mapping
+		Output string
+	}{
+		{ // 1
+			nil,
+			"",
+		},
+		{ // 2
+			[]Mapping{
+				{Source: 0, Name: -1},
+			},
+			"AAAA",
+		},
+		{ // 3
+			[]Mapping{
+				{GeneratedColumn: 4, Source: 0, OriginalColumn: 4, Name: -1},
+			},
+			"IAAI",
+		},
+		{ // 4
+			[]Mapping{
+				{Source: 0, Name: -1},
+				{GeneratedColumn: 9, Source: 0, OriginalColumn: 9, Name: 0},
+				{GeneratedLine: 1, GeneratedColumn: 1, Source: 0, OriginalLine: 1, OriginalColumn: 1, Name: 1},
+			},
+			"AAAA,SAASA;CACRC",
+		},
+		{ // 5
+			[]Mapping{
+				{GeneratedLine: 2, GeneratedColumn: 3, Source: 0, OriginalLine: 4, OriginalColumn: 5, Name: -1},
+				{GeneratedLine: 2, GeneratedColumn: 1, Source: 1, OriginalLine: 2, OriginalColumn: 0, Name: 3},
+			},
+			";;CCEAG,EDEK",
+		},
+		{ // 6
+			[]Mapping{
+				{GeneratedColumn: 2, Source: -1, Name: -1},
+				{GeneratedColumn: 5, Source: 0, OriginalLine: 10, OriginalColumn: 3, Name: -1},
+			},
+			"E,GAUG",
+		},
+	} {
+		if out := Encode(test.Input); out != test.Output {
+			t.Errorf("test %d: expecting output %q, got %q", n+1, test.Output, out)
+		}
+	}
+}
+
+func TestGenerator(t *testing.T) {
+	g := NewGenerator("out.js")
+
+	a := g.AddSource("a.js", "")
+	b := g.AddSource("b.js", "b")
+
+	if c := g.AddSource("a.js", "a"); c != a {
+		t.Errorf("expecting duplicate source to return index %d, got %d", a, c)
+	}
+
+	x := g.AddName("x")
+
+	g.AddName("y")
+
+	if z := g.AddName("x"); z != x {
+		t.Errorf("expecting duplicate name to return index %d, got %d", x, z)
+	}
+
+	g.AddMapping(Mapping{Source: a, Name: x})
+	g.AddMapping(Mapping{GeneratedColumn: 2, Source: b, OriginalLine: 1, Name: -1})
+
+	expected := &SourceMap{
+		Version:        3,
+		File:           "out.js",
+		Sources:        []string{"a.js", "b.js"},
+		SourcesContent: []string{"a", "b"},
+		Names:          []string{"x", "y"},
+		Mappings:       "AAAAA,ECCA",
+	}
+
+	if sm := g.SourceMap(); !reflect.DeepEqual(sm, expected) {
+		t.Errorf("expecting source map %v, got %v", expected, sm)
+	}
+
+	var sb strings.Builder
+
+	if _, err := g.SourceMap().WriteTo(&sb); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	} else if str, expected := sb.String(), `{"version":3,"file":"out.js","sources":["a.js","b.js"],"sourcesContent":["a","b"],"names":["x","y"],"mappings":"AAAAA,ECCA"}`; str != expected {
+		t.Errorf("expecting JSON %s, got %s", expected, str)
+	}
+
+	if sm := NewGenerator("").SourceMap(); sm.Sources == nil || sm.Names == nil || sm.SourcesContent != nil {
+		t.Errorf("expecting empty, non-nil, sources and names, with no content, got %v", sm)
+	}
+}
@@ -0,0 +1,68 @@
+package sourcemap
+
+import "strings"
+
+const (
+	base64Chars = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+
+	vlqShift        = 5
+	vlqContinuation = 1 << vlqShift
+	vlqMask         = vlqContinuation - 1
+)
+
+func appendVLQ(buf []byte, v int64) []byte {
+	var u uint64
+
+	if v < 0 {
+		u = uint64(-v)<<1 | 1
+	} else {
+		u = uint64(v) << 1
+	}
+
+	for {
+		digit := u & vlqMask
+		u >>= vlqShift
+
+		if u > 0 {
+			digit |= vlqContinuation
+		}
+
+		buf = append(buf, base64Chars[digit])
+
+		if u == 0 {
+			return buf
+		}
+	}
+}
+
+func readVLQ(s string) (int64, string, error) {
+	var (
+		u     uint64
+		shift uint
+	)
+
+	for {
+		if len(s) == 0 {
+			return 0, s, ErrInvalidVLQ
+		}
+
+		digit := strings.IndexByte(base64Chars, s[0])
+		if digit < 0 || shift > 60 {
+			return 0, s, ErrInvalidVLQ
+		}
+
+		s = s[1:]
+		u |= uint64(digit&vlqMask) << shift
+		shift += vlqShift
+
+		if digit&vlqContinuation == 0 {
+			break
+		}
+	}
+
+	if u&1 == 1 {
+		return -int64(u >> 1), s, nil
+	}
+
+	return int64(u >> 1), s, nil
+}
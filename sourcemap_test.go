@@ -0,0 +1,243 @@
+package javascript
+
+import (
+	"encoding/base64"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"vimagination.zapto.org/parser"
+)
+
+func TestWriteWithSourceMap(t *testing.T) {
+	for n, test := range [...]struct {
+		Input, Output string
+		Original      bool
+		Mappings      string
+		Names         []string
+	}{
+		{ // 1
+			"a = b",
+			"a = b;",
+			false,
+			"AAAAA,IAAIC",
+			[]string{"a", "b"},
+		},
+		{ // 2
+			"a = b",
+			"a = b",
+			true,
+			"AAAAA,IAAIC",
+			[]string{"a", "b"},
+		},
+		{ // 3
+			"'😀'; a\nif (b) {\n\tc()\n}",
+			"'😀';\n\na;\n\nif (b) {\n\tc();\n}",
+			false,
+			"AAAA;;AAAMA;;AACN,IAAIC,GAAG;CACNC,CAAC",
+			[]string{"a", "b", "c"},
+		},
+		{ // 4
+			"'😀'; a\nif (b) {\n\tc()\n}",
+			"'😀'; a\nif (b) {\n\tc()\n}",
+			true,
+			"AAAA,MAAMA;AACN,IAAIC,GAAG;CACNC,CAAC",
+			[]string{"a", "b", "c"},
+		},
+		{ // 5
+			"class A {\n\t#b = 1;\n}",
+			"class A {\n\t#b = 1;\n}",
+			false,
+			"AAAA,MAAMA;CACLC,KAAK",
+			[]string{"A", "#b"},
+		},
+	} {
+		tk := parser.NewStringTokeniser(test.Input)
+
+		m, err := ParseModule(&tk)
+		if err != nil {
+			t.Errorf("test %d: unexpected error: %s", n+1, err)
+
+			continue
+		}
+
+		var sb strings.Builder
+
+		if l, sm, err := WriteWithSourceMap(&sb, m, SourceMapOptions{File: "out.js", Source: "in.js", Content: test.Input, Original: test.Original}); err != nil {
+			t.Errorf("test %d: unexpected error: %s", n+1, err)
+		} else if str := sb.String(); str != test.Output {
+			t.Errorf("test %d: expecting output %q, got %q", n+1, test.Output, str)
+		} else if l != int64(len(str)) {
+			t.Errorf("test %d: expecting length %d, got %d", n+1, len(str), l)
+		} else if sm.File != "out.js" || !reflect.DeepEqual(sm.Sources, []string{"in.js"}) || !reflect.DeepEqual(sm.SourcesContent, []string{test.Input}) {
+			t.Errorf("test %d: invalid file data: %v", n+1, sm)
+		} else if sm.Mappings != test.Mappings {
+			t.Errorf("test %d: expecting mappings %q, got %q", n+1, test.Mappings, sm.Mappings)
+		} else if !reflect.DeepEqual(sm.Names, test.Names) {
+			t.Errorf("test %d: expecting names %q, got %q", n+1, test.Names, sm.Names)
+		}
+	}
+}
+
+func TestWriteWithSourceMapRenamed(t *testing.T) {
+	const src = "const longName = 1;"
+
+	tk := parser.NewStringTokeniser(src)
+
+	m, err := ParseModule(&tk)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	m.ModuleListItems[0].StatementListItem.Declaration.LexicalDeclaration.BindingList[0].BindingIdentifier.Data = "a"
+
+	var sb strings.Builder
+
+	if _, sm, err := WriteWithSourceMap(&sb, m, SourceMapOptions{Content: src}); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	} else if str := sb.String(); str != "const a = 1;" {
+		t.Errorf("expecting output %q, got %q", "const a = 1;", str)
+	} else if !reflect.DeepEqual(sm.Names, []string{"longName"}) {
+		t.Errorf("expecting names %q, got %q", []string{"longName"}, sm.Names)
+	} else if sm.Mappings != "AAAA,MAAMA,IAAW" {
+		t.Errorf("expecting mappings %q, got %q", "AAAA,MAAMA,IAAW", sm.Mappings)
+	}
+
+	if _, sm, err := WriteWithSourceMap(&sb, m, SourceMapOptions{}); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	} else if !reflect.DeepEqual(sm.Names, []string{"a"}) {
+		t.Errorf("expecting names %q, got %q", []string{"a"}, sm.Names)
+	}
+
+	if _, _, err := WriteWithSourceMap(&sb, Token{}, SourceMapOptions{}); !errors.Is(err, ErrInvalidSourceMapType) {
+		t.Errorf("expecting error %v, got %v", ErrInvalidSourceMapType, err)
+	}
+}
+
+func TestWithSourceMap(t *testing.T) {
+	const (
+		original = "😀; a = (b);"
+		mapJSON  = `{"version":3,"file":"mid.js","sources":["orig.ts"],"sourcesContent":["😀; a = (b);"],"names":["a","b"],"mappings":"AAAIA,IAAMC"}`
+		noSource = `{"version":3,"file":"mid.js","sources":["orig.ts"],"names":["a","b"],"mappings":"AAAIA,IAAMC"}`
+	)
+
+	for n, test := range [...]struct {
+		Input    string
+		Load     func(string) ([]byte, error)
+		Loaded   bool
+		Content  []string
+		LinePos  [3]uint64
+		Mappings string
+		Err      error
+		ErrPos   string
+	}{
+		{ // 1
+			"a = b;\n//# sourceMappingURL=data:application/json;base64," + base64.StdEncoding.EncodeToString([]byte(mapJSON)),
+			nil,
+			true,
+			[]string{original},
+			[3]uint64{4, 6, 10},
+			"AAAIA,IAAMC",
+			nil,
+			"",
+		},
+		{ // 2
+			"a = b;\n//# sourceMappingURL=mid.js.map",
+			func(url string) ([]byte, error) {
+				if url != "mid.js.map" {
+					return nil, ErrInvalidSourceMapType
+				}
+
+				return []byte(mapJSON), nil
+			},
+			true,
+			[]string{original},
+			[3]uint64{4, 6, 10},
+			"AAAIA,IAAMC",
+			nil,
+			"",
+		},
+		{ // 3
+			"a = b;\n//# sourceMappingURL=mid.js.map",
+			nil,
+			false,
+			nil,
+			[3]uint64{0, 2, 4},
+			"AAAAA,IAAIC",
+			nil,
+			"",
+		},
+		{ // 4
+			"a = b;\n//# sourceMappingURL=mid.js.map",
+			func(string) ([]byte, error) {
+				return nil, io.ErrUnexpectedEOF
+			},
+			false,
+			nil,
+			[3]uint64{},
+			"",
+			io.ErrUnexpectedEOF,
+			"",
+		},
+		{ // 5
+			"a = b c;\n//# sourceMappingURL=mid.js.map",
+			func(string) ([]byte, error) {
+				return []byte(mapJSON), nil
+			},
+			false,
+			nil,
+			[3]uint64{},
+			"",
+			ErrMissingSemiColon,
+			"(1:12)",
+		},
+		{ // 6
+			"a = b;\n//# sourceMappingURL=data:application/json;base64," + base64.StdEncoding.EncodeToString([]byte(noSource)),
+			nil,
+			true,
+			nil,
+			[3]uint64{4, 6, 10},
+			"AAAIA,IAAMC",
+			nil,
+			"",
+		},
+	} {
+		tk := parser.NewStringTokeniser(test.Input)
+		smt := WithSourceMap(&tk, test.Load)
+
+		m, err := ParseModule(smt)
+		if !errors.Is(err, test.Err) {
+			t.Errorf("test %d: expecting error %v, got %v", n+1, test.Err, err)
+
+			continue
+		} else if err != nil {
+			if !strings.Contains(err.Error(), test.ErrPos) {
+				t.Errorf("test %d: expecting error at %s, got %v", n+1, test.ErrPos, err)
+			}
+
+			continue
+		} else if loaded := smt.SourceMap() != nil; loaded != test.Loaded {
+			t.Errorf("test %d: expecting loaded %v, got %v", n+1, test.Loaded, loaded)
+
+			continue
+		}
+
+		for i, p := range [...]uint64{0, 2, 4} {
+			if tk := m.Tokens[p]; tk.LinePos != test.LinePos[i] {
+				t.Errorf("test %d.%d: expecting token %q to have LinePos %d, got %d", n+1, i+1, tk.Data, test.LinePos[i], tk.LinePos)
+			}
+		}
+
+		var sb strings.Builder
+
+		if _, sm, err := WriteWithSourceMap(&sb, m, SourceMapOptions{File: "out.js", Source: "mid.js", Input: smt}); err != nil {
+			t.Errorf("test %d: unexpected error: %s", n+1, err)
+		} else if sm.Mappings != test.Mappings {
+			t.Errorf("test %d: expecting mappings %q, got %q", n+1, test.Mappings, sm.Mappings)
+		} else if test.Loaded && (!reflect.DeepEqual(sm.Sources, []string{"orig.ts"}) || !reflect.DeepEqual(sm.SourcesContent, test.Content)) {
+			t.Errorf("test %d: expecting original source, got %v", n+1, sm)
+		}
+	}
+}
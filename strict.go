@@ -0,0 +1,263 @@
+package javascript
+
+import (
+	"slices"
+	"strings"
+)
+
+// DirectivePrologue represents the Directive Prologue of a Script or function
+// body, as defined in ECMA-262.
+// https://262.ecma-international.org/11.0/#directive-prologue
+//
+// Directives contains the string literal of each directive, in order.
+//
+// Strict is set when the body is strict mode code, either because of a
+// 'use strict' directive or because it is contained within strict mode code.
+type DirectivePrologue struct {
+	Directives []*Token
+	Strict     bool
+}
+
+func parseDirectivePrologue(sl []StatementListItem) DirectivePrologue {
+	var dp DirectivePrologue
+
+	for n := range sl {
+		tk := directive(&sl[n])
+		if tk == nil {
+			break
+		}
+
+		dp.Directives = append(dp.Directives, tk)
+	}
+
+	dp.Strict = dp.UseStrict()
+
+	return dp
+}
+
+func directive(si *StatementListItem) *Token {
+	s := si.Statement
+	if s == nil || s.ExpressionStatement == nil || len(s.ExpressionStatement.Expressions) != 1 || s.ExpressionStatement.Expressions[0].ConditionalExpression == nil {
+		return nil
+	}
+
+	pe, ok := UnwrapConditional(s.ExpressionStatement.Expressions[0].ConditionalExpression).(*PrimaryExpression)
+	if !ok || pe.Literal == nil || pe.Literal.Type != TokenStringLiteral {
+		return nil
+	}
+
+	return pe.Literal
+}
+
+func isUseStrict(tk *Token) bool {
+	return len(tk.Data) > 1 && tk.Data[1:len(tk.Data)-1] == "use strict"
+}
+
+// DirectivePrologue returns the Directive Prologue of the Block, for when it
+// is used as the body of a function or method.
+func (b *Block) DirectivePrologue() DirectivePrologue {
+	return parseDirectivePrologue(b.StatementList)
+}
+
+// UseStrict returns true if the Directive Prologue contains a 'use strict'
+// directive.
+func (d *DirectivePrologue) UseStrict() bool {
+	return slices.ContainsFunc(d.Directives, isUseStrict)
+}
+
+// IsStrict returns true if the body is strict mode code.
+//
+// For a Script or function parsed as part of a Module or Script, this accounts
+// for strictness inherited from any enclosing code, such as the Module itself,
+// a class body, or a function containing a 'use strict' directive.
+func (d *DirectivePrologue) IsStrict() bool {
+	return d.Strict || d.UseStrict()
+}
+
+func (d *DirectivePrologue) printType(w writer, v bool) {
+	pp := w.Indent()
+
+	pp.WriteString("DirectivePrologue {")
+
+	if d.Directives == nil {
+		pp.WriteString("\nDirectives: nil")
+	} else if len(d.Directives) > 0 {
+		pp.WriteString("\nDirectives: [")
+
+		ipp := pp.Indent()
+
+		for n, e := range d.Directives {
+			ipp.Printf("\n%d: ", n)
+			e.printType(ipp, v)
+		}
+
+		pp.WriteString("\n]")
+	} else if v {
+		pp.WriteString("\nDirectives: []")
+	}
+
+	if d.Strict || v {
+		pp.Printf("\nStrict: %v", d.Strict)
+	}
+
+	w.WriteString("\n}")
+}
+
+var strictReserved = [...]string{"implements", "interface", "let", "package", "private", "protected", "public", "static", "yield"}
+
+// IsStrict returns true when the parser is currently parsing strict mode code.
+func (j *jsParser) IsStrict() bool {
+	return j.state != nil && j.state.strict
+}
+
+func (j *jsParser) setStrict(strict bool) {
+	if j.state == nil {
+		if !strict {
+			return
+		}
+
+		j.state = new(parseState)
+	}
+
+	j.state.strict = strict
+}
+
+// addDirective adds the parsed statement to the DirectivePrologue if it is a
+// directive.
+//
+// A 'use strict' directive switches the parser to strict mode, checking the
+// directives that preceded it for legacy octal escapes.
+func (j *jsParser) addDirective(si *StatementListItem, dp *DirectivePrologue) error {
+	tk := directive(si)
+	if tk == nil {
+		return nil
+	}
+
+	dp.Directives = append(dp.Directives, tk)
+
+	if !j.IsStrict() && isUseStrict(tk) {
+		j.setStrict(true)
+
+		for _, d := range dp.Directives {
+			if err := j.checkStrictLiteral("DirectivePrologue", d); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func isStrictReserved(tk *Token) bool {
+	return slices.Contains(strictReserved[:], tk.Data)
+}
+
+// checkStrictIdentifier returns an error when parsing strict mode code and the
+// given identifier is a reserved word.
+func (j *jsParser) checkStrictIdentifier(parsingFunc string, tk *Token) error {
+	if tk != nil && j.IsStrict() && isStrictReserved(tk) {
+		return Error{
+			Err:     ErrReservedIdentifier,
+			Parsing: parsingFunc,
+			Token:   *tk,
+		}
+	}
+
+	return nil
+}
+
+// checkStrictLiteral returns an error when parsing strict mode code and the
+// given token is a legacy octal numeric literal, or a string literal
+// containing a legacy octal escape sequence.
+func (j *jsParser) checkStrictLiteral(parsingFunc string, tk *Token) error {
+	if !j.IsStrict() {
+		return nil
+	}
+
+	switch tk.Type {
+	case TokenNumericLiteral:
+		if len(tk.Data) < 2 || tk.Data[0] != '0' || !strings.ContainsRune(decimalDigit, rune(tk.Data[1])) {
+			return nil
+		}
+	case TokenStringLiteral:
+		if !hasLegacyOctalEscape(tk.Data) {
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	return Error{
+		Err:     ErrStrictOctal,
+		Parsing: parsingFunc,
+		Token:   *tk,
+	}
+}
+
+// checkStrictParameters checks the name and parameters of a function whose
+// body contains a 'use strict' directive, as they are strict mode code despite
+// being parsed before the directive.
+//
+// A 'use strict' directive is not allowed in a function with non-simple
+// parameters.
+func checkStrictParameters(parsingFunc string, name *Token, fp *FormalParameters, dp *DirectivePrologue) error {
+	tks := []*Token{name}
+
+	if fp != nil {
+		if !fp.isSimple() {
+			return Error{
+				Err:     ErrStrictNonSimpleParameters,
+				Parsing: parsingFunc,
+				Token:   *dp.Directives[slices.IndexFunc(dp.Directives, isUseStrict)],
+			}
+		}
+
+		for n := range fp.FormalParameterList {
+			tks = append(tks, fp.FormalParameterList[n].SingleNameBinding)
+		}
+	}
+
+	for _, tk := range tks {
+		if tk != nil && isStrictReserved(tk) {
+			return Error{
+				Err:     ErrReservedIdentifier,
+				Parsing: parsingFunc,
+				Token:   *tk,
+			}
+		}
+	}
+
+	return nil
+}
+
+// isSimple returns true when the parameters are all plain identifiers, without
+// default values, destructuring, or a rest parameter.
+func (fp *FormalParameters) isSimple() bool {
+	if fp.BindingIdentifier != nil || fp.ArrayBindingPattern != nil || fp.ObjectBindingPattern != nil {
+		return false
+	}
+
+	for _, be := range fp.FormalParameterList {
+		if be.SingleNameBinding == nil || be.Initializer != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+func hasLegacyOctalEscape(str string) bool {
+	for n := 0; n < len(str)-1; n++ {
+		if str[n] != '\\' {
+			continue
+		}
+
+		n++
+
+		if c := str[n]; c >= '1' && c <= '9' || c == '0' && n+1 < len(str) && str[n+1] >= '0' && str[n+1] <= '9' {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,209 @@
+package javascript
+
+import (
+	"errors"
+	"testing"
+
+	"vimagination.zapto.org/parser"
+)
+
+func TestStrict(t *testing.T) {
+	for n, test := range [...]struct {
+		Input  string
+		Script bool
+		Err    error
+		Pos    uint64
+	}{
+		{ // 1
+			Input:  "with (a) b;\nvar c = 010 + 08, d = '\\01';\nvar implements, package, yield;\nstatic: for (;;) break static;",
+			Script: true,
+		},
+		{ // 2
+			Input: "with (a) b;",
+			Err:   ErrStrictWith,
+		},
+		{ // 3
+			Input:  "'use strict';\nwith (a) b;",
+			Script: true,
+			Err:    ErrStrictWith,
+			Pos:    14,
+		},
+		{ // 4
+			Input:  "'\\01';\n'use strict';",
+			Script: true,
+			Err:    ErrStrictOctal,
+		},
+		{ // 5
+			Input: "a = 010;",
+			Err:   ErrStrictOctal,
+			Pos:   4,
+		},
+		{ // 6
+			Input: "a = 08.5;",
+			Err:   ErrStrictOctal,
+			Pos:   4,
+		},
+		{ // 7
+			Input: "a = {'\\8': 1};",
+			Err:   ErrStrictOctal,
+			Pos:   5,
+		},
+		{ // 8
+			Input: "a = '\\0';",
+		},
+		{ // 9
+			Input: "var implements;",
+			Err:   ErrReservedIdentifier,
+			Pos:   4,
+		},
+		{ // 10
+			Input: "a.package = b.interface;",
+		},
+		{ // 11
+			Input:  "function a() {\n\t'use strict';\n\n\treturn package;\n}\nvar package;",
+			Script: true,
+			Err:    ErrReservedIdentifier,
+			Pos:    39,
+		},
+		{ // 12
+			Input:  "function a(static) {\n\t'use strict';\n}",
+			Script: true,
+			Err:    ErrReservedIdentifier,
+			Pos:    11,
+		},
+		{ // 13
+			Input:  "class a {\n\tb() {\n\t\treturn 010;\n\t}\n}",
+			Script: true,
+			Err:    ErrStrictOctal,
+			Pos:    26,
+		},
+		{ // 14
+			Input:  "a = () => {\n\t'use strict';\n\n\twith (b) c;\n};\nwith (d) e;",
+			Script: true,
+			Err:    ErrStrictWith,
+			Pos:    29,
+		},
+		{ // 15
+			Input:  "a = {\n\tb() {\n\t\t'use strict';\n\n\t\tstatic: c;\n\t}\n};",
+			Script: true,
+			Err:    ErrReservedIdentifier,
+			Pos:    32,
+		},
+		{ // 16
+			Input: "import {a as private} from './b';",
+			Err:   ErrReservedIdentifier,
+			Pos:   13,
+		},
+		{ // 17
+			Input:  "function a() {\n\t'use strict';\n}\nwith (b) c;\nd = () => {\n\t'use strict';\n};\nvar package = 010;",
+			Script: true,
+		},
+		{ // 18
+			Input:  "function a() {\n\t'\\01';\n\t'use strict';\n}",
+			Script: true,
+			Err:    ErrStrictOctal,
+			Pos:    16,
+		},
+		{ // 19
+			Input:  "package => {\n\t'use strict';\n};",
+			Script: true,
+			Err:    ErrReservedIdentifier,
+		},
+		{ // 20
+			Input:  "class package {}",
+			Script: true,
+			Err:    ErrReservedIdentifier,
+			Pos:    6,
+		},
+		{ // 21
+			Input: "import a from './\\01';",
+			Err:   ErrStrictOctal,
+			Pos:   14,
+		},
+		{ // 22
+			Input:  "function f(a = 1) { \"use strict\" }",
+			Script: true,
+			Err:    ErrStrictNonSimpleParameters,
+			Pos:    20,
+		},
+		{ // 23
+			Input:  "a = ({b}) => {\n\t'use strict';\n};",
+			Script: true,
+			Err:    ErrStrictNonSimpleParameters,
+			Pos:    16,
+		},
+		{ // 24
+			Input:  "class a {\n\tb(...c) {\n\t\t'use strict';\n\t}\n}",
+			Script: true,
+			Err:    ErrStrictNonSimpleParameters,
+			Pos:    23,
+		},
+		{ // 25
+			Input:  "function f(a, b) {\n\t'use strict';\n}",
+			Script: true,
+		},
+	} {
+		tk := makeTokeniser(parser.NewStringTokeniser(test.Input))
+
+		var err error
+
+		if test.Script {
+			_, err = ParseScript(tk)
+		} else {
+			_, err = ParseModule(tk)
+		}
+
+		var e Error
+
+		if test.Err == nil {
+			if err != nil {
+				t.Errorf("test %d: unexpected error: %s", n+1, err)
+			}
+		} else if !errors.Is(err, test.Err) {
+			t.Errorf("test %d: expecting error %v, got %v", n+1, test.Err, err)
+		} else if !errors.As(err, &e) {
+			t.Errorf("test %d: expecting Error type, got %T", n+1, err)
+		} else {
+			for errors.As(e.Err, &e) {
+			}
+
+			if e.Token.Pos != test.Pos {
+				t.Errorf("test %d: expecting error at position %d, got %d", n+1, test.Pos, e.Token.Pos)
+			}
+		}
+	}
+}
+
+func TestIsStrict(t *testing.T) {
+	s, err := ParseScript(makeTokeniser(parser.NewStringTokeniser("function a() {\n\t'use strict';\n\n\tfunction b() {}\n}\n\nfunction c() {\n\td = () => {};\n\tclass e {\n\t\tf() {\n\t\t\treturn function () {};\n\t\t}\n\t}\n}")))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	a := s.StatementList[0].Declaration.FunctionDeclaration
+	b := a.FunctionBody.StatementList[1].Declaration.FunctionDeclaration
+	c := s.StatementList[1].Declaration.FunctionDeclaration
+	d := c.FunctionBody.StatementList[0].Statement.ExpressionStatement.Expressions[0].AssignmentExpression.ArrowFunction
+	f := UnwrapConditional(c.FunctionBody.StatementList[1].Declaration.ClassDeclaration.ClassBody[0].MethodDefinition.FunctionBody.StatementList[0].Statement.ExpressionStatement.Expressions[0].ConditionalExpression).(*FunctionDeclaration)
+
+	for n, test := range [...]struct {
+		Name     string
+		Prologue *DirectivePrologue
+		Strict   bool
+	}{
+		{"script", &s.DirectivePrologue, false},
+		{"a", &a.DirectivePrologue, true},
+		{"b", &b.DirectivePrologue, true},
+		{"c", &c.DirectivePrologue, false},
+		{"d", &d.DirectivePrologue, false},
+		{"f", &f.DirectivePrologue, true},
+	} {
+		if strict := test.Prologue.IsStrict(); strict != test.Strict {
+			t.Errorf("test %d (%s): expecting strict %v, got %v", n+1, test.Name, test.Strict, strict)
+		}
+	}
+
+	if len(a.Directives) != 1 || a.Directives[0].Data != "'use strict'" {
+		t.Errorf("expecting a single 'use strict' directive, got %v", a.Directives)
+	}
+}
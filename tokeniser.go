@@ -51,6 +51,11 @@ const (
 	TokenJSXIdentifier
 	TokenJSXString
 	TokenJSXText
+	TokenHashbang
+
+	// tokenInvalid holds the input skipped after a tokenising error when
+	// parsing with recovery.
+	tokenInvalid
 
 	tokenTypescript = 0x20
 )
@@ -63,11 +68,161 @@ type jsTokeniser struct {
 
 // SetTokeniser provides JavaScript parsing functions to a Tokeniser
 func SetTokeniser(t *parser.Tokeniser) *parser.Tokeniser {
-	t.TokeniserState(new(jsTokeniser).inputElement)
+	t.TokeniserState(new(jsTokeniser).hashbang)
 
 	return t
 }
 
+// hashbang reads the optional Hashbang Comment at the start of the input,
+// defined in ECMA-262.
+// https://tc39.es/ecma262/#sec-hashbang
+func (j *jsTokeniser) hashbang(t *parser.Tokeniser) (parser.Token, parser.TokenFunc) {
+	if !t.Accept("#") {
+		return j.inputElement(t)
+	}
+
+	if t.Accept("!") {
+		t.ExceptRun(lineTerminators)
+
+		return t.Return(TokenHashbang, j.inputElement)
+	}
+
+	return j.privateIdentifier(t)
+}
+
+type tokenError struct {
+	pos uint64
+	err error
+}
+
+// recoveringTokeniser is a jsTokeniser that, instead of stopping at an error,
+// records it and resumes tokenising after skipping the invalid input.
+type recoveringTokeniser struct {
+	jsTokeniser
+	pos  uint64
+	errs []tokenError
+}
+
+func (r *recoveringTokeniser) wrap(fn parser.TokenFunc) parser.TokenFunc {
+	return func(t *parser.Tokeniser) (parser.Token, parser.TokenFunc) {
+		state := t.State()
+		tk, next := fn(t)
+
+		if tk.Type == parser.TokenError {
+			err := t.Err
+			t.Err = nil
+
+			state.Reset()
+
+			if r.closeBrackets(t.Peek()) {
+				state = t.State()
+				tk, next = fn(t)
+			}
+
+			if tk.Type == parser.TokenError {
+				r.errs = append(r.errs, tokenError{pos: r.pos, err: err})
+				t.Err = nil
+
+				state.Reset()
+
+				tk, next = r.resync(t)
+			}
+		}
+
+		r.pos += uint64(len(tk.Data))
+
+		return tk, r.wrap(next)
+	}
+}
+
+// closeBrackets drops any open parentheses and square brackets that would
+// prevent the given closing bracket from closing its matching opening
+// bracket, leaving any error for the parser to report.
+//
+// Returns true when any were dropped.
+func (r *recoveringTokeniser) closeBrackets(c rune) bool {
+	var opener byte
+
+	switch c {
+	case ')':
+		opener = '('
+	case ']':
+		opener = '['
+	case '}':
+		opener = '{'
+	default:
+		return false
+	}
+
+	for n := len(r.state) - 1; n >= 0; n-- {
+		switch s := r.state[n]; s {
+		case opener:
+		case '$':
+			if opener != '{' {
+				return false
+			}
+		case '(', '[':
+			continue
+		default:
+			return false
+		}
+
+		if n == len(r.state)-1 {
+			return false
+		}
+
+		r.state = r.state[:n+1]
+
+		return true
+	}
+
+	return false
+}
+
+// resync skips from the start of the token that caused an error to the next
+// line terminator, or to the next semi-colon or unopened closing bracket that
+// is not within a bracket opened by the skipped input, returning the skipped
+// input as a single token.
+func (r *recoveringTokeniser) resync(t *parser.Tokeniser) (parser.Token, parser.TokenFunc) {
+	if t.Next() == -1 {
+		r.state = r.state[:0]
+
+		return t.Done()
+	}
+
+	var depth int
+
+Loop:
+	for {
+		switch c := t.Peek(); c {
+		case -1:
+			break Loop
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			if depth == 0 {
+				break Loop
+			}
+
+			depth--
+		case ';':
+			if depth == 0 {
+				break Loop
+			}
+		default:
+			if strings.ContainsRune(lineTerminators, c) {
+				break Loop
+			}
+		}
+
+		t.Next()
+	}
+
+	r.divisionAllowed = false
+
+	return t.Return(tokenInvalid, r.inputElement)
+}
+
 func (j *jsTokeniser) inputElement(t *parser.Tokeniser) (parser.Token, parser.TokenFunc) {
 	if t.Accept(whitespace) {
 		t.AcceptRun(whitespace)
@@ -150,20 +305,7 @@ func (j *jsTokeniser) inputElement(t *parser.Tokeniser) (parser.Token, parser.To
 	case '#':
 		t.Next()
 
-		if !internal.IsIDStart(t.Peek()) {
-			t.Next()
-
-			return t.ReturnError(fmt.Errorf("%w: %s", ErrInvalidSequence, t.Get()))
-		}
-
-		tk, tf := j.identifier(t)
-
-		if tk.Type == TokenIdentifier {
-			tk.Type = TokenPrivateIdentifier
-			j.divisionAllowed = true
-		}
-
-		return tk, tf
+		return j.privateIdentifier(t)
 	default:
 		if strings.ContainsRune(decimalDigit, c) {
 			j.divisionAllowed = true
@@ -241,7 +383,7 @@ func (j *jsTokeniser) inputElement(t *parser.Tokeniser) (parser.Token, parser.To
 			} else {
 				t.Accept(".")
 			}
-		case ';', ',', ':', '~', '>':
+		case ';', ',', ':', '~', '>', '@':
 		case ')', ']':
 			if ld := j.lastState(); !(ld == '(' && c == ')') && !(ld == '[' && c == ']') {
 				return t.ReturnError(fmt.Errorf("%w: %s", ErrInvalidCharacter, t.Get()))
@@ -494,6 +636,14 @@ func (j *jsTokeniser) number(t *parser.Tokeniser) (parser.Token, parser.TokenFun
 					return t.ReturnError(fmt.Errorf("%w: %s", ErrInvalidNumber, t.Get()))
 				}
 			}
+		} else if t.Accept(octalDigit) {
+			t.AcceptRun(octalDigit)
+
+			if t.Accept("89") {
+				return j.nonOctalDecimal(t)
+			}
+		} else if t.Accept("89") {
+			return j.nonOctalDecimal(t)
 		} else {
 			t.Accept("n")
 		}
@@ -528,6 +678,43 @@ func (j *jsTokeniser) number(t *parser.Tokeniser) (parser.Token, parser.TokenFun
 	return t.Return(TokenNumericLiteral, j.inputElement)
 }
 
+func (j *jsTokeniser) nonOctalDecimal(t *parser.Tokeniser) (parser.Token, parser.TokenFunc) {
+	t.AcceptRun(decimalDigit)
+
+	if t.Accept(".") {
+		t.AcceptRun(decimalDigit)
+	}
+
+	if t.Accept("eE") {
+		t.Accept("+-")
+
+		if !numberRun(t, decimalDigit) {
+			t.Next()
+
+			return t.ReturnError(fmt.Errorf("%w: %s", ErrInvalidNumber, t.Get()))
+		}
+	}
+
+	return t.Return(TokenNumericLiteral, j.inputElement)
+}
+
+func (j *jsTokeniser) privateIdentifier(t *parser.Tokeniser) (parser.Token, parser.TokenFunc) {
+	if !internal.IsIDStart(t.Peek()) {
+		t.Next()
+
+		return t.ReturnError(fmt.Errorf("%w: %s", ErrInvalidSequence, t.Get()))
+	}
+
+	tk, tf := j.identifier(t)
+
+	if tk.Type == TokenIdentifier {
+		tk.Type = TokenPrivateIdentifier
+		j.divisionAllowed = true
+	}
+
+	return tk, tf
+}
+
 func (j *jsTokeniser) identifier(t *parser.Tokeniser) (parser.Token, parser.TokenFunc) {
 	c := t.Next()
 
@@ -587,7 +774,7 @@ func (j *jsTokeniser) lastState() rune {
 	return rune(j.state[len(j.state)-1])
 }
 
-func (j *jsTokeniser) escapeSequence(t *parser.Tokeniser) bool {
+func (j *jsTokeniser) escapeSequence(t *parser.Tokeniser, legacyOctal bool) bool {
 	t.Accept("\\")
 
 	if t.Accept("x") {
@@ -595,7 +782,7 @@ func (j *jsTokeniser) escapeSequence(t *parser.Tokeniser) bool {
 	} else if t.Accept("u") {
 		return j.unicodeEscapeSequence(t)
 	} else if t.Accept("0") {
-		return !t.Accept(decimalDigit)
+		return legacyOctal || !t.Accept(decimalDigit)
 	}
 
 	t.Except(lineTerminators)
@@ -626,7 +813,7 @@ Loop:
 
 			break Loop
 		case '\\':
-			if j.escapeSequence(t) {
+			if j.escapeSequence(t, true) {
 				continue
 			}
 
@@ -658,7 +845,7 @@ Loop:
 
 			break Loop
 		case '\\':
-			if j.escapeSequence(t) {
+			if j.escapeSequence(t, false) {
 				continue
 			}
 
@@ -2001,6 +2001,90 @@ func TestTokeniser(t *testing.T) {
 			TS:  true,
 			JSX: true,
 		},
+		{ // 184
+			Input: "010 07 08 09.5 08. 019e1 00",
+			Output: []parser.Token{
+				{Type: TokenNumericLiteral, Data: "010"},
+				{Type: TokenWhitespace, Data: " "},
+				{Type: TokenNumericLiteral, Data: "07"},
+				{Type: TokenWhitespace, Data: " "},
+				{Type: TokenNumericLiteral, Data: "08"},
+				{Type: TokenWhitespace, Data: " "},
+				{Type: TokenNumericLiteral, Data: "09.5"},
+				{Type: TokenWhitespace, Data: " "},
+				{Type: TokenNumericLiteral, Data: "08."},
+				{Type: TokenWhitespace, Data: " "},
+				{Type: TokenNumericLiteral, Data: "019e1"},
+				{Type: TokenWhitespace, Data: " "},
+				{Type: TokenNumericLiteral, Data: "00"},
+				{Type: parser.TokenDone, Data: ""},
+			},
+		},
+		{ // 185
+			Input: "08e",
+			Output: []parser.Token{
+				{Type: parser.TokenError, Data: "invalid number: 08e"},
+			},
+		},
+		{ // 186
+			Input: "\"\\01\" '\\08'",
+			Output: []parser.Token{
+				{Type: TokenStringLiteral, Data: "\"\\01\""},
+				{Type: TokenWhitespace, Data: " "},
+				{Type: TokenStringLiteral, Data: "'\\08'"},
+				{Type: parser.TokenDone, Data: ""},
+			},
+		},
+		{ // 187
+			Input: "`\\01`",
+			Output: []parser.Token{
+				{Type: parser.TokenError, Data: "invalid escape sequence: `\\01`"},
+			},
+		},
+		{ // 188
+			Input: "#!/usr/bin/env node\na",
+			Output: []parser.Token{
+				{Type: TokenHashbang, Data: "#!/usr/bin/env node"},
+				{Type: TokenLineTerminator, Data: "\n"},
+				{Type: TokenIdentifier, Data: "a"},
+				{Type: parser.TokenDone, Data: ""},
+			},
+		},
+		{ // 189
+			Input: "#!",
+			Output: []parser.Token{
+				{Type: TokenHashbang, Data: "#!"},
+				{Type: parser.TokenDone, Data: ""},
+			},
+		},
+		{ // 190
+			Input: "a\n#!b",
+			Output: []parser.Token{
+				{Type: TokenIdentifier, Data: "a"},
+				{Type: TokenLineTerminator, Data: "\n"},
+				{Type: parser.TokenError, Data: "invalid character sequence: #!"},
+			},
+		},
+		{ // 191
+			Input: "#a",
+			Output: []parser.Token{
+				{Type: TokenPrivateIdentifier, Data: "#a"},
+				{Type: parser.TokenDone, Data: ""},
+			},
+		},
+		{ // 192
+			Input: "#!/usr/bin/env node\n<a/>",
+			Output: []parser.Token{
+				{Type: TokenHashbang, Data: "#!/usr/bin/env node"},
+				{Type: TokenLineTerminator, Data: "\n"},
+				{Type: TokenJSXElementStart, Data: "<"},
+				{Type: TokenJSXIdentifier, Data: "a"},
+				{Type: TokenPunctuator, Data: "/"},
+				{Type: TokenJSXElementEnd, Data: ">"},
+				{Type: parser.TokenDone, Data: ""},
+			},
+			JSX: true,
+		},
 	} {
 		p := parser.NewStringTokeniser(test.Input)
 
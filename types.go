@@ -52,6 +52,8 @@ func (Block) javascriptType() {}
 
 func (CallExpression) javascriptType() {}
 
+func (CallSignature) javascriptType() {}
+
 func (CaseClause) javascriptType() {}
 
 func (ClassDeclaration) javascriptType() {}
@@ -68,8 +70,16 @@ func (ConditionalExpression) javascriptType() {}
 
 func (Declaration) javascriptType() {}
 
+func (Decorator) javascriptType() {}
+
+func (DecoratorMemberExpression) javascriptType() {}
+
 func (DestructuringAssignmentTarget) javascriptType() {}
 
+func (EnumDeclaration) javascriptType() {}
+
+func (EnumMember) javascriptType() {}
+
 func (EqualityExpression) javascriptType() {}
 
 func (ExponentiationExpression) javascriptType() {}
@@ -90,6 +100,8 @@ func (FromClause) javascriptType() {}
 
 func (FunctionDeclaration) javascriptType() {}
 
+func (FunctionTypeExpression) javascriptType() {}
+
 func (IfStatement) javascriptType() {}
 
 func (ImportClause) javascriptType() {}
@@ -98,6 +110,14 @@ func (ImportDeclaration) javascriptType() {}
 
 func (ImportSpecifier) javascriptType() {}
 
+func (ImportType) javascriptType() {}
+
+func (IndexSignature) javascriptType() {}
+
+func (InterfaceDeclaration) javascriptType() {}
+
+func (IntersectionType) javascriptType() {}
+
 func (IterationStatementDo) javascriptType() {}
 
 func (IterationStatementFor) javascriptType() {}
@@ -120,14 +140,20 @@ func (LexicalBinding) javascriptType() {}
 
 func (LexicalDeclaration) javascriptType() {}
 
+func (LiteralType) javascriptType() {}
+
 func (LogicalANDExpression) javascriptType() {}
 
 func (LogicalORExpression) javascriptType() {}
 
+func (MappedType) javascriptType() {}
+
 func (MemberExpression) javascriptType() {}
 
 func (MethodDefinition) javascriptType() {}
 
+func (MethodSignature) javascriptType() {}
+
 func (Module) javascriptType() {}
 
 func (ModuleItem) javascriptType() {}
@@ -136,6 +162,8 @@ func (MultiplicativeExpression) javascriptType() {}
 
 func (NamedImports) javascriptType() {}
 
+func (NamespaceDeclaration) javascriptType() {}
+
 func (NewExpression) javascriptType() {}
 
 func (ObjectAssignmentPattern) javascriptType() {}
@@ -144,18 +172,30 @@ func (ObjectBindingPattern) javascriptType() {}
 
 func (ObjectLiteral) javascriptType() {}
 
+func (ObjectType) javascriptType() {}
+
 func (OptionalChain) javascriptType() {}
 
 func (OptionalExpression) javascriptType() {}
 
+func (Parameter) javascriptType() {}
+
+func (ParameterList) javascriptType() {}
+
 func (ParenthesizedExpression) javascriptType() {}
 
+func (PostfixType) javascriptType() {}
+
 func (PrimaryExpression) javascriptType() {}
 
+func (PrimaryType) javascriptType() {}
+
 func (PropertyDefinition) javascriptType() {}
 
 func (PropertyName) javascriptType() {}
 
+func (PropertySignature) javascriptType() {}
+
 func (RelationalExpression) javascriptType() {}
 
 func (Script) javascriptType() {}
@@ -170,12 +210,40 @@ func (SwitchStatement) javascriptType() {}
 
 func (TemplateLiteral) javascriptType() {}
 
+func (TemplateLiteralType) javascriptType() {}
+
 func (TryStatement) javascriptType() {}
 
+func (TupleElement) javascriptType() {}
+
+func (TupleType) javascriptType() {}
+
+func (TypeAliasDeclaration) javascriptType() {}
+
+func (TypeAnnotation) javascriptType() {}
+
+func (TypeArguments) javascriptType() {}
+
+func (TypeExpression) javascriptType() {}
+
+func (TypeMember) javascriptType() {}
+
+func (TypeOperator) javascriptType() {}
+
+func (TypeParameter) javascriptType() {}
+
+func (TypeParameters) javascriptType() {}
+
+func (TypeQuery) javascriptType() {}
+
+func (TypeReference) javascriptType() {}
+
 func (UnaryExpression) javascriptType() {}
 
 func (UnaryOperatorComments) javascriptType() {}
 
+func (UnionType) javascriptType() {}
+
 func (UpdateExpression) javascriptType() {}
 
 func (VariableStatement) javascriptType() {}
@@ -6,16 +6,44 @@ import (
 	"vimagination.zapto.org/parser"
 )
 
-const tsMarker = "TS"
+const (
+	tsMarker       = "TS"
+	tsASTMarker    = tsMarker + "AST"
+	tsDefineMarker = "D"
+	tsAssignMarker = "S"
+)
+
+// TypescriptOption is a flag that changes how Typescript is converted to
+// JavaScript.
+type TypescriptOption uint8
+
+// Valid TypescriptOption's.
+const (
+	// UseDefineForClassFields follows the semantics of the Typescript
+	// 'useDefineForClassFields' compiler option, declaring constructor
+	// parameter properties as class fields in addition to assigning them in
+	// the constructor.
+	UseDefineForClassFields TypescriptOption = 1 << iota
+
+	// AssignClassFields gives instance fields [[Set]] semantics, as
+	// Typescript does when 'useDefineForClassFields' is false, by moving
+	// their initializers into the constructor. This option is ignored when
+	// UseDefineForClassFields is also set.
+	AssignClassFields
+)
 
 type typescript struct {
 	Tokeniser
+	ast     bool
+	options TypescriptOption
 }
 
 func (t *typescript) Iter(fn func(parser.Token) bool) {
+	marker := t.marker()
+
 	for tk := range t.Tokeniser.Iter {
 		if tk.Type == parser.TokenDone {
-			tk.Data = tsMarker + tk.Data
+			tk.Data = marker + tk.Data
 		}
 
 		if !fn(tk) {
@@ -30,263 +58,217 @@ func (t *typescript) hasFlags() (bool, bool) {
 	return true, j
 }
 
-// AsTypescript converts the tokeniser to one that reads Typescript.
-//
-// When used with ParseScript or ParseModule, will produce JavaScript AST from
-// most valid Typescript files, though it may also parse invalid Typescript.
-//
-// Currently does not support any Typescript feature that requires code-gen or
-// lookahead/lookback, such as the Typescript 'private' modifier, or the 'enum'
-// and 'namespace' declarations.
-func AsTypescript(t Tokeniser) Tokeniser {
-	_, jsx := tokeniserFlags(t)
-	ts := &typescript{Tokeniser: t}
-
-	ts.TokeniserState((&jsTokeniser{isTypescript: true, isJSX: jsx}).inputElement)
-
-	return ts
-}
-
-func (j *jsParser) IsTypescript() bool {
-	return strings.HasPrefix((*j)[:cap(*j)][cap(*j)-1].Data, tsMarker)
-}
-
-func (j *jsParser) ReadTypeParameters() bool {
-	g := j.NewGoal()
+func (t *typescript) marker() string {
+	marker := tsMarker
 
-	if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "<"}) {
-		return false
+	if t.ast {
+		marker = tsASTMarker
 	}
 
-	for {
-		g.AcceptRunWhitespace()
-
-		if g.AcceptToken(parser.Token{Type: TokenKeyword, Data: "const"}) {
-			g.AcceptRunWhitespace()
-		}
-
-		if !g.ReadTypeParameter() {
-			return false
-		}
-
-		g.AcceptRunWhitespace()
-
-		if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ">"}) {
-			break
-		} else if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ","}) {
-			return false
-		}
+	if t.options&UseDefineForClassFields != 0 {
+		marker += tsDefineMarker
+	} else if t.options&AssignClassFields != 0 {
+		marker += tsAssignMarker
 	}
 
-	j.Score(g)
-
-	return true
+	return marker
 }
 
-func (j *jsParser) ReadTypeParameter() bool {
-	g := j.NewGoal()
-
-	if g.parseIdentifier(false, false) == nil {
-		return false
-	}
-
-	h := g.NewGoal()
-
-	h.AcceptRunWhitespace()
-
-	if h.AcceptToken(parser.Token{Type: TokenKeyword, Data: "extends"}) {
-		h.AcceptRunWhitespace()
-
-		if !h.ReadType() {
-			return false
-		}
-
-		g.Score(h)
-
-		h = g.NewGoal()
-
-		h.AcceptRunWhitespace()
+func tokeniserTypescriptMarker(t Tokeniser) string {
+	switch t := t.(type) {
+	case *typescript:
+		return t.marker()
+	case *jsx:
+		return tokeniserTypescriptMarker(t.Tokeniser)
 	}
 
-	if h.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "="}) {
-		h.AcceptRunWhitespace()
+	return ""
+}
 
-		if !h.ReadType() {
-			return false
-		}
+// AsTypescript converts the tokeniser to one that reads Typescript.
+//
+// When used with ParseScript or ParseModule, will produce JavaScript AST from
+// most valid Typescript files, though it may also parse invalid Typescript.
+//
+// The 'enum' and 'namespace' declarations are lowered to the equivalent
+// JavaScript, with each becoming a 'var' whose value is initialised by an
+// IIFE. Exported namespace variables become properties of the namespace
+// object, with references to them rewritten to match, while other exported
+// members are copied to the namespace object when declared. References to the
+// constant members of a top-level 'const enum' are replaced with their values,
+// unless the name of the enum is bound elsewhere. Ambient ('declare') enums and
+// namespaces are converted to comments.
+//
+// The accessibility, 'readonly', and 'override' modifiers of constructor
+// parameters are converted to comments, with each such parameter property
+// being assigned to the instance at the start of the constructor body, after
+// the super() call in a derived class. When the super() call is not a
+// top-level statement of the constructor body, each super() call is instead
+// made through an arrow function that assigns the properties after calling
+// super(); a derived constructor without any super() call is left unchanged.
+// The UseDefineForClassFields option also declares the parameter properties as
+// fields at the start of the class body.
+//
+// Instance fields are kept as they were declared, unless the
+// AssignClassFields option is set, in which case their initializers are moved,
+// in order, into the constructor after the parameter assignments, adding a
+// constructor when the class has none. Classes with computed instance field
+// names keep their fields as declared, and an initializer that refers to a
+// name declared by the constructor results in an error.
+//
+// Currently does not support any other Typescript feature that requires
+// code-gen or lookahead/lookback.
+func AsTypescript(t Tokeniser, opts ...TypescriptOption) Tokeniser {
+	_, jsx := tokeniserFlags(t)
+	ts := &typescript{Tokeniser: t}
 
-		g.Score(h)
+	for _, opt := range opts {
+		ts.options |= opt
 	}
 
-	j.Score(g)
+	ts.TokeniserState((&jsTokeniser{isTypescript: true, isJSX: jsx}).hashbang)
 
-	return true
+	return ts
 }
 
-func (j *jsParser) ReadTypeArguments() bool {
-	g := j.NewGoal()
+// AsTypescriptAST converts the tokeniser to one that reads Typescript, as with
+// AsTypescript, but that also produces Typescript AST nodes instead of
+// converting all types to comments.
+//
+// The following will be parsed into typed nodes:
+//
+//	Type aliases and interfaces, as either a StatementListItem Declaration or
+//	the Declaration of an ExportDeclaration.
+//	Type annotations of a LexicalBinding, BindingElement, FieldDefinition, or
+//	of the rest element of FormalParameters.
+//	The optional markers of a BindingElement or FieldDefinition.
+//	Type parameters of a ClassDeclaration, FunctionDeclaration, ArrowFunction,
+//	or of a method ClassElementName.
+//	Return types of a FunctionDeclaration, ArrowFunction, or MethodDefinition.
+//	The 'abstract' modifier, heritage type arguments and 'implements' clause of
+//	a ClassDeclaration.
+//	Abstract members and index signatures, as the TypeMember of a
+//	ClassElement.
+//	Overloads of a FunctionDeclaration or method ClassElementName.
+//	Enums and namespaces, as the EnumDeclaration or NamespaceDeclaration of the
+//	VariableStatement they are lowered to, or, for a namespace that contains
+//	only types, as a Declaration.
+//
+// All other Typescript syntax, such as 'as' and 'satisfies' expressions,
+// non-null assertions, call type arguments, ambient declarations, member
+// modifiers, and definite assignment assertions on fields, will still be
+// converted to comments.
+//
+// Typescript nodes are only printed when printing in verbose mode.
+func AsTypescriptAST(t Tokeniser, opts ...TypescriptOption) Tokeniser {
+	ts := AsTypescript(t, opts...).(*typescript)
+	ts.ast = true
 
-	if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "<"}) {
-		return false
-	}
+	return ts
+}
 
-	for {
-		g.AcceptRunWhitespace()
+func (j *jsParser) IsTypescript() bool {
+	return strings.HasPrefix(j.marker().Data, tsMarker)
+}
 
-		if !g.ReadType() {
-			return false
-		}
+func (j *jsParser) IsTypescriptAST() bool {
+	return strings.HasPrefix(j.marker().Data, tsASTMarker)
+}
 
-		g.AcceptRunWhitespace()
+func (j *jsParser) typescriptOptions() string {
+	marker := j.marker().Data
 
-		if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ">"}) {
-			break
-		} else if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ","}) {
-			return false
-		}
+	if opts, ok := strings.CutPrefix(marker, tsASTMarker); ok {
+		return opts
+	} else if opts, ok := strings.CutPrefix(marker, tsMarker); ok {
+		return opts
 	}
 
-	j.Score(g)
-
-	return true
+	return ""
 }
 
-func (j *jsParser) ReadType() bool {
-	if g := j.NewGoal(); g.ReadFunctionType() {
-		j.Score(g)
+func (j *jsParser) UseDefineForClassFields() bool {
+	return strings.HasPrefix(j.typescriptOptions(), tsDefineMarker)
+}
 
-		return true
-	}
+func (j *jsParser) AssignClassFields() bool {
+	return strings.HasPrefix(j.typescriptOptions(), tsAssignMarker)
+}
 
+func readTypescript[T any, PT interface {
+	*T
+	parse(*jsParser) error
+}](j *jsParser) bool {
 	g := j.NewGoal()
 
-	if !g.ReadUnionOrIntersectionOrPrimaryType() {
+	if PT(new(T)).parse(&g) != nil {
 		return false
 	}
 
-	h := g.NewGoal()
-
-	h.AcceptRunWhitespaceNoNewLine()
-
-	if h.AcceptToken(parser.Token{Type: TokenKeyword, Data: "extends"}) {
-		h.AcceptRunWhitespace()
-
-		if !h.ReadType() {
-			return false
-		}
-
-		h.AcceptRunWhitespace()
-
-		if !h.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "?"}) {
-			return false
-		}
-
-		h.AcceptRunWhitespace()
-
-		if !h.ReadType() {
-			return false
-		}
-
-		h.AcceptRunWhitespace()
-
-		if !h.ReadTypeAnnotation() {
-			return false
-		}
-
-		g.Score(h)
-	}
-
 	j.Score(g)
 
 	return true
 }
 
-func (j *jsParser) ReadUnionOrIntersectionOrPrimaryType() bool {
-	g := j.NewGoal()
-
-	g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "|"})
-	g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "&"})
-	g.AcceptRunWhitespace()
-
-	for {
-		if !g.ReadTypeOperator() {
-			return false
-		}
-
-		h := g.NewGoal()
-
-		h.AcceptRunWhitespace()
-
-		if !h.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "|"}) && !h.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "&"}) {
-			break
-		}
+func (j *jsParser) ReadTypeParameters() bool {
+	return readTypescript[TypeParameters](j)
+}
 
-		g.Score(h)
-		g.AcceptRunWhitespace()
-	}
+func (j *jsParser) ReadTypeArguments() bool {
+	return readTypescript[TypeArguments](j)
+}
 
-	j.Score(g)
+func (j *jsParser) ReadType() bool {
+	return readTypescript[TypeExpression](j)
+}
 
-	return true
+func (j *jsParser) ReadUnionOrIntersectionOrPrimaryType() bool {
+	return readTypescript[UnionType](j)
 }
 
 func (j *jsParser) ReadTypeOperator() bool {
-	g := j.NewGoal()
-
-	if tk := g.Peek(); tk.Type == TokenIdentifier && (tk.Data == "keyof" || tk.Data == "unique" || tk.Data == "readonly") {
-		g.Skip()
-		g.AcceptRunWhitespace()
-
-		if !g.ReadTypeOperator() {
-			return false
-		}
-	} else if tk == (parser.Token{Type: TokenIdentifier, Data: "infer"}) {
-		g.Skip()
-		g.AcceptRunWhitespace()
-
-		if g.parseIdentifier(false, false) == nil {
-			return false
-		}
-	} else if !g.ReadPostfixType() {
-		return false
-	}
-
-	j.Score(g)
-
-	return true
+	return readTypescript[TypeOperator](j)
 }
 
 func (j *jsParser) ReadPostfixType() bool {
-	g := j.NewGoal()
+	return readTypescript[PostfixType](j)
+}
 
-	if !g.ReadPrimaryType() {
-		return false
-	}
+func (j *jsParser) ReadLiteralType() bool {
+	return readTypescript[LiteralType](j)
+}
 
-	for {
-		h := g.NewGoal()
+func (j *jsParser) ReadTemplateType() bool {
+	return readTypescript[TemplateLiteralType](j)
+}
 
-		h.AcceptRunWhitespaceNoNewLine()
+func (j *jsParser) ReadParenthesizedType() bool {
+	return j.Peek() == (parser.Token{Type: TokenPunctuator, Data: "("}) && readTypescript[PrimaryType](j)
+}
 
-		if h.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "["}) {
-			h.AcceptRunWhitespace()
+func (j *jsParser) ReadPredefinedType() bool {
+	return isPredefinedType(j.Peek()) && readTypescript[PrimaryType](j)
+}
 
-			i := h.NewGoal()
+func (j *jsParser) ReadMappedType() bool {
+	return readTypescript[MappedType](j)
+}
 
-			if i.ReadType() {
-				h.Score(i)
-				h.AcceptRunWhitespace()
-			}
+func (j *jsParser) ReadObjectType() bool {
+	return readTypescript[ObjectType](j)
+}
 
-			if !h.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "]"}) {
-				return false
-			}
+func (j *jsParser) ReadTypeMember() bool {
+	return readTypescript[TypeMember](j)
+}
 
-		} else if !h.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "!"}) {
-			break
-		}
+func (j *jsParser) ReadTypeAnnotation() bool {
+	g := j.NewGoal()
 
-		g.Score(h)
+	var ta TypeAnnotation
+
+	if ta.parse(&g, false) != nil {
+		return false
 	}
 
 	j.Score(g)
@@ -294,925 +276,97 @@ func (j *jsParser) ReadPostfixType() bool {
 	return true
 }
 
-func (j *jsParser) ReadPrimaryType() bool {
-	for _, fn := range [...]func(*jsParser) bool{
-		(*jsParser).ReadLiteralType,
-		(*jsParser).ReadTemplateType,
-		(*jsParser).ReadParenthesizedType,
-		(*jsParser).ReadPredefinedType,
-		(*jsParser).ReadObjectOrMappedType,
-		(*jsParser).ReadTupleType,
-		(*jsParser).ReadThisType,
-		(*jsParser).ReadImportType,
-		(*jsParser).ReadTypeQuery,
-		(*jsParser).ReadTypeReference,
-	} {
-		g := j.NewGoal()
-
-		if fn(&g) {
-			j.Score(g)
-
-			return true
-		}
-	}
-
-	return false
+func (j *jsParser) ReadCallSignature() bool {
+	return readTypescript[CallSignature](j)
 }
 
-func (j *jsParser) ReadLiteralType() bool {
-	g := j.NewGoal()
-
-	if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "-"}) {
-		g.AcceptRunWhitespace()
-
-		if g.Accept(TokenNumericLiteral) {
-			j.Score(g)
-
-			return true
-		}
-	}
-
-	return j.Accept(TokenNullLiteral, TokenBooleanLiteral, TokenNumericLiteral, TokenStringLiteral, TokenNoSubstitutionTemplate)
+func (j *jsParser) ReadTupleType() bool {
+	return readTypescript[TupleType](j)
 }
 
-func (j *jsParser) ReadTemplateType() bool {
-	g := j.NewGoal()
-
-	if !g.Accept(TokenTemplateHead) {
-		return false
-	}
+func (j *jsParser) ReadThisType() bool {
+	return j.AcceptToken(parser.Token{Type: TokenKeyword, Data: "this"})
+}
 
-	for {
-		g.AcceptRunWhitespace()
+func (j *jsParser) ReadTypeQuery() bool {
+	return readTypescript[TypeQuery](j)
+}
 
-		if !g.ReadType() {
-			return false
-		}
+func (j *jsParser) ReadTypeReference() bool {
+	return readTypescript[TypeReference](j)
+}
 
-		g.AcceptRunWhitespace()
+func (j *jsParser) ReadFunctionType() bool {
+	return readTypescript[FunctionTypeExpression](j)
+}
 
-		if g.Accept(TokenTemplateTail) {
-			break
-		}
+func (j *jsParser) SkipHeritage(impl *[]TypeReference) bool {
+	if j.IsTypescript() && j.Peek() == (parser.Token{Type: TokenIdentifier, Data: "implements"}) {
+		g := j.NewGoal()
 
-		if !g.Accept(TokenTemplateMiddle) {
-			return false
-		}
-	}
-
-	j.Score(g)
-
-	return true
-}
-
-func (j *jsParser) ReadParenthesizedType() bool {
-	g := j.NewGoal()
-
-	if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "("}) {
-		return false
-	}
-
-	g.AcceptRunWhitespace()
-
-	if !g.ReadType() {
-		return false
-	}
-
-	g.AcceptRunWhitespace()
-
-	if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ")"}) {
-		return false
-	}
-
-	j.Score(g)
-
-	return true
-}
-
-func (j *jsParser) ReadPredefinedType() bool {
-	if j.AcceptToken(parser.Token{Type: TokenKeyword, Data: "void"}) {
-		return true
-	}
-
-	if tk := j.Peek(); tk.Type == TokenIdentifier {
-		switch tk.Data {
-		case "any", "number", "boolean", "string", "symbol", "unknown", "bigint", "undefined", "never", "object":
-			j.Skip()
-
-			g := j.NewGoal()
-
-			g.AcceptRunWhitespace()
-
-			if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "."}) {
-				g.AcceptRunWhitespace()
-
-				if !g.ReadTypeReference() {
-					return false
-				}
+		if impl != nil && j.IsTypescriptAST() {
+			if refs, ok := g.parseImplements(); ok {
+				*impl = refs
 
 				j.Score(g)
-			}
-
-			return true
-		}
-	}
-
-	return false
-}
-
-func (j *jsParser) ReadObjectOrMappedType() bool {
-	g := j.NewGoal()
-
-	if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "{"}) {
-		return false
-	}
-
-	g.AcceptRunWhitespace()
-
-	h := g.NewGoal()
-
-	if h.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "+"}) || h.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "-"}) {
-		h.AcceptRunWhitespace()
-
-		if h.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "readonly"}) {
-			return j.ReadMappedType()
-		}
-	}
-
-	h = g.NewGoal()
-
-	if h.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "readonly"}) {
-		h.AcceptRunWhitespace()
-	}
-
-	if h.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "["}) {
-		h.AcceptRunWhitespace()
-
-		if h.parseIdentifier(false, false) != nil {
-			h.AcceptRunWhitespace()
-
-			if h.AcceptToken(parser.Token{Type: TokenKeyword, Data: "in"}) {
-				return j.ReadMappedType()
-			}
-		}
-	}
-
-	return j.ReadObjectType()
-}
-
-func (j *jsParser) ReadMappedType() bool {
-	g := j.NewGoal()
-
-	if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "{"}) {
-		return false
-	}
-
-	g.AcceptRunWhitespace()
-
-	if !g.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "readonly"}) && (g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "+"}) || g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "-"})) {
-		g.AcceptRunWhitespace()
-
-		if !g.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "readonly"}) {
-			return false
-		}
-	}
-
-	g.AcceptRunWhitespace()
-
-	if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "["}) {
-		return false
-	}
-
-	if g.parseIdentifier(false, false) == nil {
-		return false
-	}
-
-	g.AcceptRunWhitespace()
-
-	if !g.AcceptToken(parser.Token{Type: TokenKeyword, Data: "in"}) {
-		return false
-	}
-
-	g.AcceptRunWhitespace()
-
-	if !g.ReadType() {
-		return false
-	}
-
-	g.AcceptRunWhitespace()
-
-	if g.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "as"}) {
-		g.AcceptRunWhitespace()
-
-		if !g.ReadType() {
-			return false
-		}
-
-		g.AcceptRunWhitespace()
-	}
-
-	if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "]"}) {
-		return false
-	}
-
-	g.AcceptRunWhitespace()
-
-	if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "?"}) && g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "+"}) || g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "-"}) {
-		g.AcceptRunWhitespace()
-
-		if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "?"}) {
-			return false
-		}
-
-		g.AcceptRunWhitespace()
-	}
-
-	if g.ReadTypeAnnotation() {
-		g.AcceptRunWhitespace()
-	}
-
-	if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ";"}) || g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ","}) {
-		g.AcceptRunWhitespace()
-	}
-
-	if !g.Accept(TokenRightBracePunctuator) {
-		return false
-	}
-
-	j.Score(g)
-
-	return true
-}
-
-func (j *jsParser) ReadObjectType() bool {
-	g := j.NewGoal()
-
-	if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "{"}) {
-		return false
-	}
-
-	g.AcceptRunWhitespace()
-
-	if !g.Accept(TokenRightBracePunctuator) {
-		for {
-			if !g.ReadTypeMember() {
-				return false
-			}
-
-			g.AcceptRunWhitespaceNoNewLine()
-
-			tk := g.GetLastToken()
-
-			g.AcceptRunWhitespace()
-
-			sep := g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ";"}) || g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ","})
-			nl := g.GetLastToken() != tk
-
-			g.AcceptRunWhitespace()
-
-			if g.Accept(TokenRightBracePunctuator) {
-				break
-			}
-
-			if !sep && !nl {
-				return false
-			}
-		}
-	}
-
-	j.Score(g)
-
-	return true
-}
-
-func (j *jsParser) ReadTypeMember() bool {
-	for _, fn := range [...]func(*jsParser) bool{
-		(*jsParser).ReadCallSignature,
-		(*jsParser).ReadConstructSignature,
-		(*jsParser).ReadAccessorDeclaration,
-		(*jsParser).ReadIndexSignature,
-		(*jsParser).ReadMethodSignature,
-		(*jsParser).ReadPropertySignature,
-	} {
-		g := j.NewGoal()
-
-		if fn(&g) {
-			j.Score(g)
-
-			return true
-		}
-	}
-
-	return false
-}
-
-func (j *jsParser) ReadModifiers() {
-	var seenConst, seenStatic bool
-
-	g := j.NewGoal()
-
-	for {
-		g.AcceptRunWhitespace()
-		if !seenConst && g.AcceptToken(parser.Token{Type: TokenKeyword, Data: "const"}) {
-			seenConst = true
-		} else if !seenStatic && g.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "static"}) {
-			seenStatic = true
-		} else if !(g.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "abstract"}) || g.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "async"}) || g.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "const"}) || g.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "public"}) || g.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "private"}) || g.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "protected"}) || g.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "readonly"}) || g.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "static"})) {
-			break
-		}
-	}
-
-	j.Score(g)
-}
-
-func (j *jsParser) ReadAccessorDeclaration() bool {
-	g := j.NewGoal()
-
-	g.ReadModifiers()
-
-	if !g.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "get"}) && !g.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "set"}) {
-		return false
-	}
-
-	g.AcceptRunWhitespace()
-
-	if !g.ReadPropertyName() {
-		return false
-	}
-
-	g.AcceptRunWhitespace()
-
-	if !g.ReadCallSignature() {
-		return false
-	}
-
-	j.Score(g)
-
-	return true
-}
-
-func (j *jsParser) ReadPropertyName() bool {
-	if j.Accept(TokenStringLiteral, TokenNumericLiteral, TokenPrivateIdentifier) || j.parseIdentifier(false, false) != nil {
-		return true
-	}
-
-	g := j.NewGoal()
-
-	if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "["}) {
-		return false
-	}
-
-	g.AcceptRunWhitespace()
-
-	var e Expression
-
-	if e.parse(&g, false, false, false) != nil {
-		return false
-	}
-
-	g.AcceptRunWhitespace()
-
-	if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "]"}) {
-		return false
-	}
-
-	j.Score(g)
-
-	return true
-}
-
-func (j *jsParser) ReadPropertySignature() bool {
-	g := j.NewGoal()
-
-	g.ReadModifiers()
-
-	if !g.Accept(TokenIdentifier, TokenKeyword, TokenStringLiteral, TokenNumericLiteral) {
-		return false
-	}
-
-	g.AcceptRunWhitespace()
-
-	if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "?"}) {
-		g.AcceptRunWhitespace()
-	}
-
-	g.ReadTypeAnnotation()
-
-	j.Score(g)
-
-	return true
-}
-
-func (j *jsParser) ReadTypeAnnotation() bool {
-	g := j.NewGoal()
-
-	if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ":"}) {
-		return false
-	}
-
-	g.AcceptRunWhitespace()
-
-	if !g.ReadType() {
-		return false
-	}
-
-	j.Score(g)
-
-	return true
-}
-
-func (j *jsParser) ReadColonReturnType() bool {
-	g := j.NewGoal()
-
-	if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ":"}) {
-		return false
-	}
-
-	g.AcceptRunWhitespace()
-
-	if !g.ReadReturnType() {
-		return false
-	}
-
-	j.Score(g)
-
-	return true
-}
-
-func (j *jsParser) ReadReturnType() bool {
-	g := j.NewGoal()
-	h := g.NewGoal()
-
-	if h.parseIdentifier(false, false) != nil || h.AcceptToken(parser.Token{Type: TokenKeyword, Data: "this"}) {
-		h.AcceptRunWhitespaceNoNewLine()
-
-		if h.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "is"}) {
-			h.AcceptRunWhitespace()
-			g.Score(h)
-		}
-	}
-
-	if !g.ReadType() {
-		return false
-	}
-
-	j.Score(g)
-
-	return true
-}
-
-func (j *jsParser) ReadCallSignature() bool {
-	g := j.NewGoal()
-
-	if g.ReadTypeParameters() {
-		g.AcceptRunWhitespace()
-	}
-
-	if !g.ReadParameterList() {
-		return false
-	}
-
-	h := g.NewGoal()
-
-	h.AcceptRunWhitespace()
-
-	if h.ReadColonReturnType() {
-		g.Score(h)
-	}
-
-	j.Score(g)
-
-	return true
-}
-
-func (j *jsParser) ReadParameterList() bool {
-	g := j.NewGoal()
-
-	if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "("}) {
-		return false
-	}
-
-	g.AcceptRunWhitespace()
-
-	if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ")"}) {
-		optional := false
-		first := true
-
-		for {
-			g.AcceptRunWhitespace()
-
-			if g.Peek() == (parser.Token{Type: TokenPunctuator, Data: "..."}) {
-				g.Skip()
-				g.AcceptRunWhitespace()
-
-				if g.parseIdentifier(false, false) == nil {
-					return false
-				}
-
-				g.AcceptRunWhitespace()
-				g.ReadTypeAnnotation()
-				g.AcceptRunWhitespace()
-
-				if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ")"}) {
-					return false
-				}
-
-				break
-			}
-			if bi := g.parseIdentifier(false, false); bi == nil {
-				if !first || !g.AcceptToken(parser.Token{Type: TokenKeyword, Data: "this"}) {
-					return false
-				}
-			} else if bi.Data == "public" || bi.Data == "private" || bi.Data == "protected" {
-				g.AcceptRunWhitespace()
-				g.parseIdentifier(false, false)
-			}
-
-			g.AcceptRunWhitespace()
-
-			opt := g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "?"})
-
-			g.AcceptRunWhitespace()
-			g.ReadTypeAnnotation()
-			g.AcceptRunWhitespace()
-
-			init := g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "="})
-
-			if optional && !opt && !init {
-				return false
-			} else if init {
-				g.AcceptRunWhitespaceNoComment()
-
-				var ae AssignmentExpression
-
-				if err := ae.parse(&g, false, false, false); err != nil {
-					return false
-				}
-
-				g.AcceptRunWhitespace()
-			}
-
-			optional = optional || opt || init
-
-			if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ")"}) {
-				break
-			} else if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ","}) {
-				return false
-			}
-
-			first = false
-		}
-	}
-
-	j.Score(g)
-
-	return true
-}
-
-func (j *jsParser) ReadConstructSignature() bool {
-	g := j.NewGoal()
-
-	if !g.AcceptToken(parser.Token{Type: TokenKeyword, Data: "new"}) {
-		return false
-	}
-
-	g.AcceptRunWhitespace()
-
-	if !g.ReadCallSignature() {
-		return false
-	}
-
-	j.Score(g)
-
-	return true
-}
-
-func (j *jsParser) ReadIndexSignature() bool {
-	g := j.NewGoal()
-
-	g.ReadModifiers()
-
-	if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "["}) {
-		return false
-	}
-
-	for {
-		g.AcceptRunWhitespace()
-
-		if !g.ReadParameter() {
-			return false
-		}
-
-		g.AcceptRunWhitespace()
-
-		if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "."}) {
-			break
-		}
-	}
-
-	if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "]"}) {
-		return false
-	}
-
-	h := g.NewGoal()
-
-	h.AcceptRunWhitespace()
-
-	if h.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "?"}) {
-		g.Score(h)
-
-		h = g.NewGoal()
-
-		h.AcceptRunWhitespace()
-	}
-
-	if h.ReadTypeAnnotation() {
-		g.Score(h)
-	}
-
-	j.Score(g)
-
-	return true
-}
-
-func (j *jsParser) ReadParameter() bool {
-	g := j.NewGoal()
-
-	if g.parseIdentifier(false, false) == nil {
-		return false
-	}
-
-	h := g.NewGoal()
-
-	h.AcceptRunWhitespace()
-
-	if h.ReadTypeAnnotation() {
-		g.Score(h)
-	}
-
-	j.Score(g)
-
-	return true
-}
-
-func (j *jsParser) ReadMethodSignature() bool {
-	g := j.NewGoal()
-
-	g.ReadModifiers()
-
-	if !g.Accept(TokenIdentifier, TokenKeyword, TokenStringLiteral, TokenNumericLiteral) {
-		return false
-	}
-
-	g.AcceptRunWhitespace()
-
-	if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "?"}) {
-		g.AcceptRunWhitespace()
-	}
-
-	if !g.ReadCallSignature() {
-		return false
-	}
-
-	j.Score(g)
-
-	return true
-}
-
-func (j *jsParser) ReadTupleType() bool {
-	g := j.NewGoal()
-
-	if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "["}) {
-		return false
-	}
-
-	g.AcceptRunWhitespace()
-
-	if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "]"}) {
-		for {
-			h := g.NewGoal()
-
-			if h.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "..."}) {
-				h.AcceptRunWhitespace()
-			}
-
-			if h.Accept(TokenIdentifier, TokenKeyword) {
-				h.AcceptRunWhitespace()
-
-				if h.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "?"}) {
-					h.AcceptRunWhitespace()
-				}
-
-				if h.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ":"}) {
-					g.Score(h)
-					g.AcceptRunWhitespace()
-				}
-			}
-
-			if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "..."}) {
-				g.AcceptRunWhitespace()
-			}
-
-			if !g.ReadType() {
-				return false
-			}
-
-			g.AcceptRunWhitespace()
-
-			if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "?"}) {
-				g.AcceptRunWhitespace()
-			}
-
-			if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ","}) {
-				break
-			}
-
-			g.AcceptRunWhitespace()
-		}
-
-		if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "]"}) {
-			return false
-		}
-	}
-
-	j.Score(g)
-
-	return true
-}
-
-func (j *jsParser) ReadThisType() bool {
-	return j.AcceptToken(parser.Token{Type: TokenKeyword, Data: "this"})
-}
-
-func (j *jsParser) ReadImportType() bool {
-	g := j.NewGoal()
-
-	if !g.AcceptToken(parser.Token{Type: TokenKeyword, Data: "import"}) {
-		return false
-	}
-
-	g.AcceptRunWhitespace()
-
-	if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "("}) {
-		return false
-	}
-
-	g.AcceptRunWhitespace()
-
-	if !g.ReadType() {
-		return false
-	}
-
-	g.AcceptRunWhitespace()
-
-	if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ")"}) {
-		return false
-	}
-
-	h := g.NewGoal()
-
-	h.AcceptRunWhitespace()
-
-	if h.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "."}) {
-		if !h.ReadTypeReference() {
-			return false
-		}
-
-		g.Score(h)
-	}
-
-	h = g.NewGoal()
-
-	h.AcceptRunWhitespace()
-
-	if h.ReadTypeArguments() {
-		g.Score(h)
-	}
-
-	j.Score(g)
-
-	return true
-}
-
-func (j *jsParser) ReadTypeQuery() bool {
-	g := j.NewGoal()
-
-	if !g.AcceptToken(parser.Token{Type: TokenKeyword, Data: "typeof"}) {
-		return false
-	}
-
-	g.AcceptRunWhitespace()
-
-	if g.parseIdentifier(false, false) == nil {
-		return false
-	}
-
-	for {
-		h := g.NewGoal()
-
-		h.AcceptRunWhitespace()
-
-		if !h.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "."}) {
-			break
-		}
-
-		h.AcceptRunWhitespace()
-
-		if !h.Accept(TokenIdentifier, TokenKeyword, TokenPrivateIdentifier) {
-			return false
-		}
-
-		g.Score(h)
-	}
-
-	h := g.NewGoal()
-
-	h.AcceptRunWhitespaceNoNewLine()
-
-	if h.ReadTypeParameters() {
-		g.Score(h)
-	}
-
-	j.Score(g)
-
-	return true
-}
-
-func (j *jsParser) ReadTypeReference() bool {
-	g := j.NewGoal()
-
-	if g.parseIdentifier(false, false) == nil {
-		return false
-	}
-
-	for {
-		h := g.NewGoal()
-
-		h.AcceptRunWhitespace()
-
-		if !h.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "."}) {
-			break
-		}
-
-		h.AcceptRunWhitespace()
-
-		if h.parseIdentifier(false, false) == nil {
-			return false
-		}
 
-		g.Score(h)
-	}
+				return true
+			}
 
-	h := g.NewGoal()
+			g = j.NewGoal()
+		}
 
-	h.AcceptRunWhitespaceNoNewLine()
+		if g.ReadHeritage() {
+			j.Score(g)
 
-	if h.ReadTypeArguments() {
-		g.Score(h)
+			return true
+		}
 	}
 
-	j.Score(g)
-
-	return true
+	return false
 }
 
-func (j *jsParser) ReadFunctionType() bool {
-	g := j.NewGoal()
+func (j *jsParser) parseImplements() ([]TypeReference, bool) {
+	var refs []TypeReference
 
-	if g.AcceptToken(parser.Token{Type: TokenKeyword, Data: "new"}) {
-		g.AcceptRunWhitespace()
-	}
+	j.Skip()
 
-	if g.ReadTypeParameters() {
-		g.AcceptRunWhitespace()
-	}
+	for {
+		j.AcceptRunWhitespace()
 
-	if !g.ReadParameterList() {
-		return false
-	}
+		g := j.NewGoal()
+		r := len(refs)
 
-	g.AcceptRunWhitespace()
+		refs = append(refs, TypeReference{})
+		if refs[r].parse(&g) != nil {
+			return nil, false
+		}
 
-	if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "=>"}) {
-		return false
-	}
+		j.Score(g)
 
-	g.AcceptRunWhitespace()
+		g = j.NewGoal()
 
-	if !g.ReadReturnType() {
-		return false
-	}
+		g.AcceptRunWhitespace()
 
-	j.Score(g)
+		if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ","}) {
+			return refs, true
+		}
 
-	return true
+		j.Score(g)
+	}
 }
 
-func (j *jsParser) SkipHeritage() bool {
-	if j.IsTypescript() && j.Peek() == (parser.Token{Type: TokenIdentifier, Data: "implements"}) {
+func (j *jsParser) SkipGeneric(tp **TypeParameters) bool {
+	if j.IsTypescript() {
 		g := j.NewGoal()
 
-		if g.ReadHeritage() {
+		var t TypeParameters
+
+		if t.parse(&g) == nil {
+			if tp != nil && j.IsTypescriptAST() {
+				*tp = &t
+			}
+
 			j.Score(g)
 
 			return true
@@ -1222,10 +376,6 @@ func (j *jsParser) SkipHeritage() bool {
 	return false
 }
 
-func (j *jsParser) SkipGeneric() bool {
-	return j.IsTypescript() && j.ReadTypeParameters()
-}
-
 func (j *jsParser) SkipAsType() bool {
 	if j.IsTypescript() {
 		g := j.NewGoal()
@@ -1248,25 +398,25 @@ func (j *jsParser) SkipAsType() bool {
 	return false
 }
 
-func (j *jsParser) SkipColonType() bool {
-	if j.IsTypescript() {
-		g := j.NewGoal()
-
-		if g.ReadTypeAnnotation() {
-			j.Score(g)
-
-			return true
-		}
-	}
+func (j *jsParser) SkipColonType(ta **TypeAnnotation) bool {
+	return j.skipTypeAnnotation(ta, false)
+}
 
-	return false
+func (j *jsParser) SkipReturnType(ta **TypeAnnotation) bool {
+	return j.skipTypeAnnotation(ta, true)
 }
 
-func (j *jsParser) SkipReturnType() bool {
+func (j *jsParser) skipTypeAnnotation(ta **TypeAnnotation, returnType bool) bool {
 	if j.IsTypescript() {
 		g := j.NewGoal()
 
-		if g.ReadColonReturnType() {
+		var t TypeAnnotation
+
+		if t.parse(&g, returnType) == nil {
+			if ta != nil && j.IsTypescriptAST() {
+				*ta = &t
+			}
+
 			j.Score(g)
 
 			return true
@@ -1276,13 +426,17 @@ func (j *jsParser) SkipReturnType() bool {
 	return false
 }
 
-func (j *jsParser) SkipOptionalColonType() bool {
+func (j *jsParser) SkipOptionalColonType(optional *bool, ta **TypeAnnotation) bool {
 	ret := false
 
 	if j.IsTypescript() {
 		g := j.NewGoal()
 
 		if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "?"}) {
+			if optional != nil && j.IsTypescriptAST() {
+				*optional = true
+			}
+
 			j.Score(g)
 
 			g = j.NewGoal()
@@ -1292,7 +446,7 @@ func (j *jsParser) SkipOptionalColonType() bool {
 			ret = true
 		}
 
-		if g.ReadTypeAnnotation() {
+		if g.SkipColonType(ta) {
 			j.Score(g)
 
 			ret = true
@@ -1302,48 +456,21 @@ func (j *jsParser) SkipOptionalColonType() bool {
 	return ret
 }
 
-func (j *jsParser) SkipType() bool {
-	return j.IsTypescript() && j.ReadTypeDeclaration()
-}
-
-func (j *jsParser) ReadTypeDeclaration() bool {
-	g := j.NewGoal()
-
-	if g.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "type"}) {
-		g.AcceptRunWhitespace()
-
-		if g.parseIdentifier(false, false) == nil {
-			return false
-		}
-
-		g.AcceptRunWhitespace()
-
-		if g.ReadTypeParameters() {
-			g.AcceptRunWhitespace()
-		}
-
-		if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "="}) {
-			return false
-		}
-
-		g.AcceptRunWhitespace()
+func (j *jsParser) SkipType(ta **TypeAliasDeclaration) bool {
+	if j.IsTypescript() && j.Peek() == (parser.Token{Type: TokenIdentifier, Data: "type"}) {
+		g := j.NewGoal()
 
-		// TODO: instrinsic check?
-		if !g.ReadType() {
-			return false
-		}
+		var t TypeAliasDeclaration
 
-		h := g.NewGoal()
+		if t.parse(&g) == nil {
+			if ta != nil && j.IsTypescriptAST() {
+				*ta = &t
+			}
 
-		h.AcceptRunWhitespace()
+			j.Score(g)
 
-		if h.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ";"}) {
-			g.Score(h)
+			return true
 		}
-
-		j.Score(g)
-
-		return true
 	}
 
 	return false
@@ -1389,11 +516,17 @@ func (j *jsParser) ReadHeritage() bool {
 	return false
 }
 
-func (j *jsParser) SkipInterface() bool {
-	if j.IsTypescript() {
+func (j *jsParser) SkipInterface(id **InterfaceDeclaration) bool {
+	if j.IsTypescript() && j.Peek() == (parser.Token{Type: TokenIdentifier, Data: "interface"}) {
 		g := j.NewGoal()
 
-		if g.ReadInterface() {
+		var i InterfaceDeclaration
+
+		if i.parse(&g) == nil {
+			if id != nil && j.IsTypescriptAST() {
+				*id = &i
+			}
+
 			j.Score(g)
 
 			return true
@@ -1403,63 +536,97 @@ func (j *jsParser) SkipInterface() bool {
 	return false
 }
 
-func (j *jsParser) ReadInterface() bool {
-	g := j.NewGoal()
-	if g.AcceptToken(parser.Token{Type: TokenIdentifier, Data: "interface"}) {
-		g.AcceptRunWhitespace()
+func (j *jsParser) SkipParameterProperties() bool {
+	if j.IsTypescript() {
+		if tk := j.Peek(); tk == (parser.Token{Type: TokenIdentifier, Data: "private"}) || tk == (parser.Token{Type: TokenIdentifier, Data: "protected"}) || tk == (parser.Token{Type: TokenIdentifier, Data: "public"}) {
+			g := j.NewGoal()
 
-		if g.parseIdentifier(false, false) == nil {
-			return false
+			g.Skip()
+
+			h := g.NewGoal()
+
+			h.AcceptRunWhitespaceNoNewLine()
+
+			if tk := h.Peek(); tk.Type != TokenLineTerminator && tk != (parser.Token{Type: TokenPunctuator, Data: ";"}) {
+				j.Score(g)
+
+				return true
+			}
 		}
+	}
 
-		g.AcceptRunWhitespace()
+	return false
+}
 
-		if g.ReadTypeParameters() {
-			g.AcceptRunWhitespace()
+func (j *jsParser) SkipParameterModifiers() bool {
+	g := j.NewGoal()
+
+	if !g.IsTypescript() {
+		return false
+	}
+
+	for {
+		h := g.NewGoal()
+
+		if len(g.tokens) > 0 {
+			h.AcceptRunWhitespace()
 		}
 
-		if g.ReadHeritage() {
-			g.AcceptRunWhitespace()
+		if tk := h.Peek(); tk.Type != TokenIdentifier || !isParameterModifier(tk.Data) {
+			break
 		}
 
-		if !g.ReadObjectType() {
-			return false
+		h.Skip()
+
+		i := h.NewGoal()
+
+		i.AcceptRunWhitespace()
+
+		if tk := i.Peek(); tk.Type != TokenIdentifier && tk.Type != TokenKeyword && tk != (parser.Token{Type: TokenPunctuator, Data: "["}) && tk != (parser.Token{Type: TokenPunctuator, Data: "{"}) {
+			break
 		}
 
-		j.Score(g)
+		g.Score(h)
+	}
+
+	if len(g.tokens) == 0 {
+		return false
+	}
+
+	j.Score(g)
+
+	return true
+}
 
+func isParameterModifier(data string) bool {
+	switch data {
+	case "public", "private", "protected", "readonly", "override":
 		return true
 	}
 
 	return false
 }
 
-func (j *jsParser) SkipParameterProperties() bool {
+func (j *jsParser) SkipTypeArguments(ta **TypeArguments) bool {
 	if j.IsTypescript() {
-		if tk := j.Peek(); tk == (parser.Token{Type: TokenIdentifier, Data: "private"}) || tk == (parser.Token{Type: TokenIdentifier, Data: "protected"}) || tk == (parser.Token{Type: TokenIdentifier, Data: "public"}) {
-			g := j.NewGoal()
-
-			g.Skip()
+		g := j.NewGoal()
 
-			h := g.NewGoal()
+		var t TypeArguments
 
-			h.AcceptRunWhitespaceNoNewLine()
+		if t.parse(&g) == nil {
+			if ta != nil && j.IsTypescriptAST() {
+				*ta = &t
+			}
 
-			if tk := h.Peek(); tk.Type != TokenLineTerminator && tk != (parser.Token{Type: TokenPunctuator, Data: ";"}) {
-				j.Score(g)
+			j.Score(g)
 
-				return true
-			}
+			return true
 		}
 	}
 
 	return false
 }
 
-func (j *jsParser) SkipTypeArguments() bool {
-	return j.IsTypescript() && j.ReadTypeArguments()
-}
-
 func (j *jsParser) SkipReadOnly() bool {
 	if j.IsTypescript() {
 		if tk := j.Peek(); tk == (parser.Token{Type: TokenIdentifier, Data: "readonly"}) {
@@ -1518,18 +685,24 @@ func (j *jsParser) SkipImportType() bool {
 	return false
 }
 
-func (j *jsParser) SkipExportType() bool {
+func (j *jsParser) SkipExportType(d **Declaration) bool {
 	if j.IsTypescript() && j.Peek() == (parser.Token{Type: TokenKeyword, Data: "export"}) {
 		g := j.NewGoal()
 
 		g.Skip()
 		g.AcceptRunWhitespace()
 
-		if g.ReadTypeDeclaration() {
-			j.Score(g)
+		h := g.NewGoal()
 
-			return true
-		} else if g.ReadInterface() {
+		var decl Declaration
+
+		if h.SkipType(&decl.TypeAliasDeclaration) || h.SkipInterface(&decl.InterfaceDeclaration) {
+			if d != nil && (decl.TypeAliasDeclaration != nil || decl.InterfaceDeclaration != nil) {
+				decl.Tokens = h.ToTokens()
+				*d = &decl
+			}
+
+			g.Score(h)
 			j.Score(g)
 
 			return true
@@ -1633,7 +806,7 @@ func (j *jsParser) SkipThisParam() bool {
 
 		h.AcceptRunWhitespace()
 
-		if h.SkipColonType() {
+		if h.SkipColonType(nil) {
 			g.Score(h)
 		}
 
@@ -1663,7 +836,13 @@ func (j *jsParser) SkipDeclare() bool {
 		case parser.Token{Type: TokenKeyword, Data: "const"}, parser.Token{Type: TokenIdentifier, Data: "let"}:
 			var ld LexicalDeclaration
 
-			if ld.parse(&g, true, false, false) == nil {
+			if g.SkipEnum() || ld.parse(&g, true, false, false) == nil {
+				j.Score(g)
+
+				return true
+			}
+		case parser.Token{Type: TokenFutureReservedWord, Data: "enum"}:
+			if g.SkipEnum() {
 				j.Score(g)
 
 				return true
@@ -1698,7 +877,7 @@ func (j *jsParser) SkipDeclare() bool {
 			g.Skip()
 			g.AcceptRunWhitespace()
 
-			if g.Accept(TokenStringLiteral) {
+			if g.Accept(TokenStringLiteral) || g.skipNamespaceName() {
 				g.AcceptRunWhitespace()
 
 				if g.SkipDepth() {
@@ -1711,7 +890,7 @@ func (j *jsParser) SkipDeclare() bool {
 			g.Skip()
 			g.AcceptRunWhitespace()
 
-			if g.Accept(TokenIdentifier) {
+			if g.skipNamespaceName() {
 				g.AcceptRunWhitespace()
 
 				if g.SkipDepth() {
@@ -1726,6 +905,48 @@ func (j *jsParser) SkipDeclare() bool {
 	return false
 }
 
+func (j *jsParser) skipNamespaceName() bool {
+	g := j.NewGoal()
+
+	for g.Accept(TokenIdentifier) {
+		j.Score(g)
+
+		g.AcceptRunWhitespace()
+
+		if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "."}) {
+			return true
+		}
+
+		g.AcceptRunWhitespace()
+	}
+
+	return false
+}
+
+func (j *jsParser) SkipEnum() bool {
+	g := j.NewGoal()
+
+	if g.AcceptToken(parser.Token{Type: TokenKeyword, Data: "const"}) {
+		g.AcceptRunWhitespace()
+	}
+
+	if g.AcceptToken(parser.Token{Type: TokenFutureReservedWord, Data: "enum"}) {
+		g.AcceptRunWhitespace()
+
+		if g.parseIdentifier(false, false) != nil {
+			g.AcceptRunWhitespace()
+
+			if g.SkipDepth() {
+				j.Score(g)
+
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func (j *jsParser) SkipForce() bool {
 	return j.IsTypescript() && j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "!"})
 }
@@ -1734,7 +955,10 @@ func (j *jsParser) SkipMethodOverload(static bool, cen *ClassElementName, yield,
 	g := j.NewGoal()
 
 	if g.IsTypescript() {
-		if g.ReadCallSignature() {
+		var cs CallSignature
+
+		if h := g.NewGoal(); cs.parse(&h) == nil {
+			g.Score(h)
 			g.AcceptRunWhitespace()
 			g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ";"})
 			g.AcceptRunWhitespace()
@@ -1762,6 +986,12 @@ func (j *jsParser) SkipMethodOverload(static bool, cen *ClassElementName, yield,
 				return false
 			}
 
+			if g.IsTypescriptAST() {
+				cs.TypeParameters = cen.TypeParameters
+				cen.Overloads = append([]CallSignature{cs}, den.Overloads...)
+				cen.TypeParameters = den.TypeParameters
+			}
+
 			j.Score(g)
 
 			return true
@@ -1793,11 +1023,50 @@ func (j *jsParser) SkipAbstractField() bool {
 	return false
 }
 
+// SkipClassSignature skips an abstract member or index signature of a class
+// body, along with any preceding accessibility modifier.
+func (j *jsParser) SkipClassSignature(tm **TypeMember) bool {
+	if !j.IsTypescript() {
+		return false
+	}
+
+	g := j.NewGoal()
+
+	if g.SkipParameterProperties() {
+		g.AcceptRunWhitespace()
+	}
+
+	if !g.SkipAbstractField() && !g.SkipIndexSignature() {
+		return false
+	}
+
+	if tm != nil && j.IsTypescriptAST() {
+		h := j.NewGoal()
+
+		var t TypeMember
+
+		if t.parse(&h) == nil {
+			if len(h.tokens) < len(g.tokens) {
+				h.AcceptRunWhitespace()
+				h.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ";"})
+			}
+
+			if len(h.tokens) == len(g.tokens) {
+				*tm = &t
+			}
+		}
+	}
+
+	j.Score(g)
+
+	return true
+}
+
 func (j *jsParser) SkipIndexSignature() bool {
 	if j.IsTypescript() {
 		g := j.NewGoal()
 
-		g.ReadModifiers()
+		g.parseTypeModifiers()
 
 		if !g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "["}) {
 			return false
@@ -1876,28 +1145,16 @@ func (j *jsParser) ReadFunctionDeclaration() bool {
 
 	g.AcceptRunWhitespace()
 
-	if g.ReadTypeParameters() {
-		g.AcceptRunWhitespace()
-	}
-
-	if !g.ReadParameterList() {
+	if !g.ReadCallSignature() {
 		return false
 	}
 
-	h := g.NewGoal()
-
-	h.AcceptRunWhitespace()
-
-	if h.ReadColonReturnType() {
-		g.Score(h)
-	}
-
 	j.Score(g)
 
 	return true
 }
 
-func (j *jsParser) SkipFunctionOverload(bi *Token, yield, await, def, export, async bool) bool {
+func (j *jsParser) SkipFunctionOverload(cs **CallSignature, bi *Token, yield, await, def, export, async bool) bool {
 	g := j.NewGoal()
 
 	if g.IsTypescript() {
@@ -1907,7 +1164,10 @@ func (j *jsParser) SkipFunctionOverload(bi *Token, yield, await, def, export, as
 			g.AcceptRunWhitespace()
 		}
 
-		if g.ReadCallSignature() {
+		var t CallSignature
+
+		if h := g.NewGoal(); t.parse(&h) == nil {
+			g.Score(h)
 			g.AcceptRunWhitespace()
 
 			if g.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ";"}) {
@@ -1930,6 +1190,10 @@ func (j *jsParser) SkipFunctionOverload(bi *Token, yield, await, def, export, as
 				g.AcceptRunWhitespace()
 
 				if bi == nil || g.AcceptToken(bi.Token) {
+					if cs != nil && j.IsTypescriptAST() {
+						*cs = &t
+					}
+
 					j.Score(g)
 
 					return true
@@ -0,0 +1,1645 @@
+package javascript
+
+import (
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"vimagination.zapto.org/parser"
+)
+
+var (
+	tokenType             = reflect.TypeFor[*Token]()
+	tokensType            = reflect.TypeFor[Tokens]()
+	commentsType          = reflect.TypeFor[Comments]()
+	directivePrologueType = reflect.TypeFor[DirectivePrologue]()
+	memberExpressionType  = reflect.TypeFor[MemberExpression]()
+
+	enumDeclarationType      = reflect.TypeFor[*EnumDeclaration]()
+	namespaceDeclarationType = reflect.TypeFor[*NamespaceDeclaration]()
+
+	bindingFields = map[string]struct{}{
+		"BindingIdentifier":               {},
+		"BindingRestProperty":             {},
+		"CatchParameterBindingIdentifier": {},
+		"ForBindingIdentifier":            {},
+		"ImportedBinding":                 {},
+		"ImportedDefaultBinding":          {},
+		"NameSpaceImport":                 {},
+		"SingleNameBinding":               {},
+	}
+)
+
+func (j *jsParser) isEnumOrNamespace() bool {
+	g := j.NewGoal()
+
+	if !g.IsTypescript() {
+		return false
+	}
+
+	switch g.Peek() {
+	case parser.Token{Type: TokenKeyword, Data: "const"}:
+		g.Skip()
+		g.AcceptRunWhitespace()
+
+		return g.Peek() == parser.Token{Type: TokenFutureReservedWord, Data: "enum"}
+	case parser.Token{Type: TokenFutureReservedWord, Data: "enum"}:
+		return true
+	case parser.Token{Type: TokenIdentifier, Data: "namespace"}, parser.Token{Type: TokenIdentifier, Data: "module"}:
+		g.Skip()
+		g.AcceptRunWhitespaceNoNewLine()
+
+		return g.Peek().Type == TokenIdentifier
+	}
+
+	return false
+}
+
+// parseEnumOrNamespace parses a Typescript enum or namespace declaration,
+// lowering it to the equivalent JavaScript.
+//
+// When container is non-nil, the declaration is a member exported from the
+// namespace of that name, and will merge with any existing member of that
+// namespace.
+//
+// The declaration is lowered to a VariableStatement, unless it merges with an
+// earlier class, function, enum, or namespace of the same name in the same
+// statement list, in which case it is lowered to an ExpressionStatement that
+// extends that declaration.
+//
+// A namespace that contains no values is not lowered, and so produces a nil
+// Statement; when parsing with a tokeniser created by AsTypescriptAST, such a
+// namespace is instead returned as a Declaration.
+func (j *jsParser) parseEnumOrNamespace(container *Token) (*Statement, *Declaration, error) {
+	if tk := j.Peek(); tk.Data == "namespace" || tk.Data == "module" {
+		return j.parseNamespace(container)
+	}
+
+	var ed enumDeclaration
+
+	if err := ed.parse(j); err != nil {
+		return nil, nil, err
+	}
+
+	_, merged := j.declared(ed.name.Data)
+	s := ed.lower(container, merged)
+
+	j.declare(ed.name.Data)
+
+	if j.IsTypescriptAST() {
+		ed.typed.Tokens = j.ToTokens()
+
+		if s.VariableStatement != nil {
+			s.VariableStatement.EnumDeclaration = &ed.typed
+		} else {
+			s.EnumDeclaration = &ed.typed
+		}
+	}
+
+	return s, nil, nil
+}
+
+// enterScope starts the declarations of a new statement list, which the enums
+// and namespaces of that list can merge with; the returned func ends them.
+func (j *jsParser) enterScope() func() {
+	if j.state == nil || !j.IsTypescript() {
+		return func() {}
+	}
+
+	state := j.state
+	state.declarations = append(state.declarations, nil)
+
+	return func() {
+		state.declarations = state.declarations[:len(state.declarations)-1]
+	}
+}
+
+// declared returns whether the name has been declared in the current statement
+// list, along with the members exported by the namespaces of that name.
+func (j *jsParser) declared(name string) ([]*Token, bool) {
+	if j.state == nil || len(j.state.declarations) == 0 {
+		return nil, false
+	}
+
+	exports, ok := j.state.declarations[len(j.state.declarations)-1][name]
+
+	return exports, ok
+}
+
+// declare records the declaration of a name in the current statement list,
+// along with any members it exports.
+func (j *jsParser) declare(name string, exports ...*Token) {
+	if j.state == nil || len(j.state.declarations) == 0 {
+		return
+	}
+
+	scope := &j.state.declarations[len(j.state.declarations)-1]
+
+	if *scope == nil {
+		*scope = make(map[string][]*Token)
+	}
+
+	(*scope)[name] = append((*scope)[name], exports...)
+}
+
+type enumValue struct {
+	str      string
+	num      float64
+	isString bool
+}
+
+func (v enumValue) expression() ConditionalWrappable {
+	if v.isString {
+		return &PrimaryExpression{Literal: &Token{Token: parser.Token{Type: TokenStringLiteral, Data: Quote(v.str)}}}
+	} else if math.IsNaN(v.num) {
+		return &PrimaryExpression{IdentifierReference: &Token{Token: parser.Token{Type: TokenIdentifier, Data: "NaN"}}}
+	} else if math.Signbit(v.num) {
+		return &UnaryExpression{
+			UnaryOperators: []UnaryOperatorComments{{UnaryOperator: UnaryMinus}},
+			UpdateExpression: UpdateExpression{
+				LeftHandSideExpression: &LeftHandSideExpression{
+					NewExpression: &NewExpression{
+						MemberExpression: MemberExpression{
+							PrimaryExpression: enumValue{num: -v.num}.expression().(*PrimaryExpression),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	return &PrimaryExpression{Literal: &Token{Token: parser.Token{Type: TokenNumericLiteral, Data: formatNumber(v.num)}}}
+}
+
+func (v enumValue) primaryExpression() *PrimaryExpression {
+	if pe, ok := v.expression().(*PrimaryExpression); ok {
+		return pe
+	}
+
+	return &PrimaryExpression{
+		ParenthesizedExpression: &ParenthesizedExpression{
+			Expressions: []AssignmentExpression{{ConditionalExpression: WrapConditional(v.expression())}},
+		},
+	}
+}
+
+type enumMember struct {
+	name        string
+	value       *enumValue
+	initializer *AssignmentExpression
+}
+
+type enumDeclaration struct {
+	name    *Token
+	members []enumMember
+	values  map[string]*enumValue
+	typed   EnumDeclaration
+}
+
+func (ed *enumDeclaration) parse(j *jsParser) error {
+	if j.AcceptToken(parser.Token{Type: TokenKeyword, Data: "const"}) {
+		ed.typed.Const = true
+
+		j.AcceptRunWhitespace()
+	}
+
+	if !j.AcceptToken(parser.Token{Type: TokenFutureReservedWord, Data: "enum"}) {
+		return j.Error("EnumDeclaration", ErrInvalidDeclaration)
+	}
+
+	j.AcceptRunWhitespace()
+
+	if ed.name = j.parseIdentifier(false, false); ed.name == nil {
+		return j.Error("EnumDeclaration", ErrNoIdentifier)
+	}
+
+	ed.typed.BindingIdentifier = ed.name
+
+	j.AcceptRunWhitespace()
+
+	if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "{"}) {
+		return j.Error("EnumDeclaration", ErrMissingOpeningBrace)
+	}
+
+	ed.values = make(map[string]*enumValue)
+	next := &enumValue{}
+
+	for {
+		j.AcceptRunWhitespace()
+
+		if j.Accept(TokenRightBracePunctuator) {
+			break
+		}
+
+		var em enumMember
+
+		g := j.NewGoal()
+
+		if g.Accept(TokenStringLiteral) {
+			name, err := Unquote(g.GetLastToken().Data)
+			if err != nil {
+				return g.Error("EnumDeclaration", err)
+			}
+
+			em.name = name
+		} else if g.Accept(TokenIdentifier, TokenKeyword, TokenFutureReservedWord, TokenBooleanLiteral, TokenNullLiteral) {
+			em.name = g.GetLastToken().Data
+		} else {
+			return j.Error("EnumDeclaration", ErrInvalidEnumMember)
+		}
+
+		typed := EnumMember{Name: g.GetLastToken()}
+
+		h := g.NewGoal()
+
+		h.AcceptRunWhitespace()
+
+		if h.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "="}) {
+			h.AcceptRunWhitespace()
+
+			i := h.NewGoal()
+
+			em.initializer = new(AssignmentExpression)
+			if err := em.initializer.parse(&i, true, false, false); err != nil {
+				return h.Error("EnumDeclaration", err)
+			}
+
+			h.Score(i)
+			g.Score(h)
+
+			if j.IsTypescriptAST() {
+				typed.Initializer = em.initializer.Clone()
+			}
+
+			if v, ok := ed.evaluate(em.initializer); ok {
+				em.value = &v
+			} else {
+				ed.qualify(em.initializer)
+			}
+		} else if next == nil {
+			return h.Error("EnumDeclaration", ErrInvalidEnumMember)
+		} else {
+			em.value = next
+		}
+
+		if next = nil; em.value != nil && !em.value.isString {
+			next = &enumValue{num: em.value.num + 1}
+		}
+
+		ed.values[em.name] = em.value
+		ed.members = append(ed.members, em)
+
+		if j.IsTypescriptAST() {
+			if em.value != nil {
+				typed.Value = &AssignmentExpression{ConditionalExpression: WrapConditional(em.value.expression())}
+			}
+
+			typed.Tokens = g.ToTokens()
+			ed.typed.EnumMembers = append(ed.typed.EnumMembers, typed)
+		}
+
+		j.Score(g)
+
+		j.AcceptRunWhitespace()
+
+		if j.Accept(TokenRightBracePunctuator) {
+			break
+		} else if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: ","}) {
+			return j.Error("EnumDeclaration", ErrMissingComma)
+		}
+	}
+
+	return nil
+}
+
+// lower produces the following for each member, with string members not
+// receiving the reverse mapping:
+//
+//	E[E["A"] = 0] = "A";
+//
+// See lowerScope for the Statement containing those members.
+func (ed *enumDeclaration) lower(container *Token, merged bool) *Statement {
+	body := make([]StatementListItem, 0, len(ed.members))
+
+	for _, em := range ed.members {
+		var (
+			value   AssignmentExpression
+			reverse bool
+		)
+
+		if em.value != nil {
+			value.ConditionalExpression = WrapConditional(em.value.expression())
+			reverse = !em.value.isString
+		} else {
+			value = *em.initializer
+			reverse = true
+		}
+
+		name := enumValue{str: em.name, isString: true}
+		member := assignment(indexExpression(identifierExpression(ed.name), AssignmentExpression{ConditionalExpression: WrapConditional(name.expression())}), value)
+
+		if reverse {
+			member = assignment(indexExpression(identifierExpression(ed.name), member), AssignmentExpression{ConditionalExpression: WrapConditional(name.expression())})
+		}
+
+		body = append(body, expressionStatement(member))
+	}
+
+	return lowerScope(ed.name, container, body, merged)
+}
+
+func (ed *enumDeclaration) operand(c ConditionalWrappable) (enumValue, bool) {
+	return ed.evaluateWrappable(UnwrapConditional(WrapConditional(c)))
+}
+
+func (ed *enumDeclaration) evaluate(ae *AssignmentExpression) (enumValue, bool) {
+	if ae.ConditionalExpression == nil {
+		return enumValue{}, false
+	}
+
+	return ed.evaluateWrappable(UnwrapConditional(ae.ConditionalExpression))
+}
+
+func (ed *enumDeclaration) evaluateWrappable(c ConditionalWrappable) (enumValue, bool) {
+	switch c := c.(type) {
+	case *PrimaryExpression:
+		if c.Literal != nil {
+			return parseEnumLiteral(c.Literal)
+		} else if c.IdentifierReference != nil {
+			return ed.lookup(c.IdentifierReference.Data)
+		} else if c.TemplateLiteral != nil {
+			return ed.evaluateWrappable(c.TemplateLiteral)
+		} else if c.ParenthesizedExpression != nil && len(c.ParenthesizedExpression.Expressions) == 1 {
+			return ed.evaluate(&c.ParenthesizedExpression.Expressions[0])
+		}
+	case *TemplateLiteral:
+		if c.NoSubstitutionTemplate != nil {
+			str, err := UnquoteTemplate(c.NoSubstitutionTemplate.Data)
+
+			return enumValue{str: str, isString: true}, err == nil
+		}
+	case *MemberExpression:
+		if c.MemberExpression == nil || c.MemberExpression.PrimaryExpression == nil || c.MemberExpression.PrimaryExpression.IdentifierReference == nil || c.MemberExpression.PrimaryExpression.IdentifierReference.Data != ed.name.Data {
+			break
+		} else if c.IdentifierName != nil {
+			return ed.lookup(c.IdentifierName.Data)
+		} else if c.Expression != nil && len(c.Expression.Expressions) == 1 {
+			if key, ok := ed.evaluate(&c.Expression.Expressions[0]); ok && key.isString {
+				return ed.lookup(key.str)
+			}
+		}
+	case *UnaryExpression:
+		v, ok := ed.operand(&c.UpdateExpression)
+		if !ok || v.isString {
+			break
+		}
+
+		for n := len(c.UnaryOperators) - 1; n >= 0; n-- {
+			switch c.UnaryOperators[n].UnaryOperator {
+			case UnaryAdd:
+			case UnaryMinus:
+				v.num = -v.num
+			case UnaryBitwiseNot:
+				v.num = float64(^toInt32(v.num))
+			default:
+				return enumValue{}, false
+			}
+		}
+
+		return v, true
+	case *ExponentiationExpression:
+		return ed.binary(c.ExponentiationExpression, &c.UnaryExpression, math.Pow)
+	case *MultiplicativeExpression:
+		switch c.MultiplicativeOperator {
+		case MultiplicativeMultiply:
+			return ed.binary(c.MultiplicativeExpression, &c.ExponentiationExpression, func(a, b float64) float64 { return a * b })
+		case MultiplicativeDivide:
+			return ed.binary(c.MultiplicativeExpression, &c.ExponentiationExpression, func(a, b float64) float64 { return a / b })
+		case MultiplicativeRemainder:
+			return ed.binary(c.MultiplicativeExpression, &c.ExponentiationExpression, math.Mod)
+		}
+	case *AdditiveExpression:
+		switch c.AdditiveOperator {
+		case AdditiveAdd:
+			a, okA := ed.operand(c.AdditiveExpression)
+			b, okB := ed.operand(&c.MultiplicativeExpression)
+
+			if !okA || !okB {
+				break
+			} else if a.isString || b.isString {
+				return enumValue{str: a.String() + b.String(), isString: true}, true
+			}
+
+			return enumValue{num: a.num + b.num}, true
+		case AdditiveMinus:
+			return ed.binary(c.AdditiveExpression, &c.MultiplicativeExpression, func(a, b float64) float64 { return a - b })
+		}
+	case *ShiftExpression:
+		switch c.ShiftOperator {
+		case ShiftLeft:
+			return ed.binary(c.ShiftExpression, &c.AdditiveExpression, func(a, b float64) float64 { return float64(toInt32(a) << (toUint32(b) & 31)) })
+		case ShiftRight:
+			return ed.binary(c.ShiftExpression, &c.AdditiveExpression, func(a, b float64) float64 { return float64(toInt32(a) >> (toUint32(b) & 31)) })
+		case ShiftUnsignedRight:
+			return ed.binary(c.ShiftExpression, &c.AdditiveExpression, func(a, b float64) float64 { return float64(toUint32(a) >> (toUint32(b) & 31)) })
+		}
+	case *BitwiseANDExpression:
+		return ed.binary(c.BitwiseANDExpression, &c.EqualityExpression, func(a, b float64) float64 { return float64(toInt32(a) & toInt32(b)) })
+	case *BitwiseXORExpression:
+		return ed.binary(c.BitwiseXORExpression, &c.BitwiseANDExpression, func(a, b float64) float64 { return float64(toInt32(a) ^ toInt32(b)) })
+	case *BitwiseORExpression:
+		return ed.binary(c.BitwiseORExpression, &c.BitwiseXORExpression, func(a, b float64) float64 { return float64(toInt32(a) | toInt32(b)) })
+	}
+
+	return enumValue{}, false
+}
+
+func (ed *enumDeclaration) binary(a, b ConditionalWrappable, fn func(float64, float64) float64) (enumValue, bool) {
+	x, okA := ed.operand(a)
+	y, okB := ed.operand(b)
+
+	if !okA || !okB || x.isString || y.isString {
+		return enumValue{}, false
+	}
+
+	return enumValue{num: fn(x.num, y.num)}, true
+}
+
+func (ed *enumDeclaration) lookup(name string) (enumValue, bool) {
+	if v := ed.values[name]; v != nil {
+		return *v, true
+	} else if name == "NaN" {
+		return enumValue{num: math.NaN()}, true
+	}
+
+	return enumValue{}, false
+}
+
+// qualify replaces references to the members of the enum in an initializer
+// with property accesses on the enum.
+func (ed *enumDeclaration) qualify(ae *AssignmentExpression) {
+	rewriteMemberExpressions(reflect.ValueOf(ae), func(me *MemberExpression) bool {
+		if me.PrimaryExpression == nil || me.PrimaryExpression.IdentifierReference == nil {
+			return false
+		} else if _, ok := ed.values[me.PrimaryExpression.IdentifierReference.Data]; !ok {
+			return false
+		}
+
+		*me = *propertyExpression(identifierExpression(ed.name), me.PrimaryExpression.IdentifierReference)
+
+		return true
+	})
+}
+
+func (v enumValue) String() string {
+	if v.isString {
+		return v.str
+	}
+
+	return formatNumber(v.num)
+}
+
+func parseEnumLiteral(tk *Token) (enumValue, bool) {
+	switch tk.Type {
+	case TokenStringLiteral:
+		str, err := Unquote(tk.Data)
+
+		return enumValue{str: str, isString: true}, err == nil
+	case TokenNumericLiteral:
+		return parseNumber(tk.Data)
+	}
+
+	return enumValue{}, false
+}
+
+func parseNumber(num string) (enumValue, bool) {
+	num = strings.ReplaceAll(num, "_", "")
+
+	if num == "Infinity" {
+		return enumValue{num: math.Inf(1)}, true
+	} else if strings.HasSuffix(num, "n") {
+		return enumValue{}, false
+	} else if len(num) > 2 && num[0] == '0' {
+		base := 0
+
+		switch num[1] {
+		case 'b', 'B':
+			base = 2
+		case 'o', 'O':
+			base = 8
+		case 'x', 'X':
+			base = 16
+		}
+
+		if base != 0 {
+			n, err := strconv.ParseUint(num[2:], base, 64)
+
+			return enumValue{num: float64(n)}, err == nil
+		}
+	}
+
+	n, err := strconv.ParseFloat(num, 64)
+
+	return enumValue{num: n}, err == nil
+}
+
+func formatNumber(n float64) string {
+	switch {
+	case n == 0:
+		return "0"
+	case math.IsNaN(n):
+		return "NaN"
+	case math.IsInf(n, 1):
+		return "Infinity"
+	case math.IsInf(n, -1):
+		return "-Infinity"
+	case n == math.Trunc(n) && math.Abs(n) < 1e21:
+		return strconv.FormatFloat(n, 'f', -1, 64)
+	}
+
+	return strconv.FormatFloat(n, 'g', -1, 64)
+}
+
+func toInt32(n float64) int32 {
+	return int32(toUint32(n))
+}
+
+func toUint32(n float64) uint32 {
+	if math.IsNaN(n) || math.IsInf(n, 0) {
+		return 0
+	}
+
+	return uint32(int64(math.Mod(math.Trunc(n), 1<<32)))
+}
+
+func (j *jsParser) parseNamespace(container *Token) (*Statement, *Declaration, error) {
+	j.Skip()
+	j.AcceptRunWhitespaceNoNewLine()
+
+	var names []*Token
+
+	for {
+		name := j.parseIdentifier(false, false)
+		if name == nil {
+			return nil, nil, j.Error("NamespaceDeclaration", ErrNoIdentifier)
+		}
+
+		names = append(names, name)
+
+		j.AcceptRunWhitespace()
+
+		if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "."}) {
+			break
+		}
+
+		j.AcceptRunWhitespace()
+	}
+
+	path := make([]string, len(names))
+
+	for n, name := range names {
+		path[n] = name.Data
+	}
+
+	earlier, _ := j.declared(strings.Join(path, "."))
+	g := j.NewGoal()
+
+	body, items, exports, err := g.parseNamespaceBody(names[len(names)-1], earlier)
+	if err != nil {
+		return nil, nil, j.Error("NamespaceDeclaration", err)
+	}
+
+	j.Score(g)
+
+	var nd *NamespaceDeclaration
+
+	if j.IsTypescriptAST() {
+		nd = &NamespaceDeclaration{
+			NamespaceName:   names,
+			ModuleListItems: items,
+			Tokens:          j.ToTokens(),
+		}
+	}
+
+	if body == nil {
+		if nd == nil {
+			return nil, nil, nil
+		}
+
+		return nil, &Declaration{NamespaceDeclaration: nd, Tokens: nd.Tokens}, nil
+	}
+
+	for n := len(names) - 1; n > 0; n-- {
+		body = []StatementListItem{
+			{Statement: lowerScope(names[n], names[n-1], body, false)},
+			exportAssignment(names[n-1], names[n]),
+		}
+	}
+
+	_, merged := j.declared(names[0].Data)
+	s := lowerScope(names[0], container, body, merged)
+
+	for n := 1; n < len(names); n++ {
+		j.declare(strings.Join(path[:n], "."), names[n])
+	}
+
+	j.declare(strings.Join(path, "."), exports...)
+
+	if s.VariableStatement != nil {
+		s.VariableStatement.NamespaceDeclaration = nd
+	} else {
+		s.NamespaceDeclaration = nd
+	}
+
+	return s, nil, nil
+}
+
+// parseNamespaceBody parses the body of a namespace, adding an assignment to
+// the namespace object after each exported declaration.
+//
+// Exported variables are instead assigned directly to the namespace object,
+// with references to them replaced by property accesses on that object. Other
+// exported bindings, and variables declared with binding patterns, are copied to
+// the namespace object once, when declared, and so are not live bindings.
+//
+// References to the members exported by earlier declarations of the
+// namespace, which are given, are also replaced by property accesses on the
+// namespace object, unless declared again in the body.
+//
+// Returns a nil body when the namespace contains no values, and the names of
+// the members exported by the body.
+//
+// When parsing with a tokeniser created by AsTypescriptAST, the declarations of
+// the body, as written, are also returned as ModuleItems.
+func (j *jsParser) parseNamespaceBody(name *Token, earlier []*Token) ([]StatementListItem, []ModuleItem, []*Token, error) {
+	if !j.AcceptToken(parser.Token{Type: TokenPunctuator, Data: "{"}) {
+		return nil, nil, nil, j.Error("NamespaceBody", ErrMissingOpeningBrace)
+	}
+
+	defer j.enterScope()()
+
+	var (
+		body         []StatementListItem
+		items        []ModuleItem
+		exports      []*Token
+		qualified    []*Token
+		instantiated bool
+	)
+
+	for {
+		j.AcceptRunWhitespaceNoComment()
+
+		g := j.NewGoal()
+
+		g.AcceptRunWhitespace()
+
+		if g.Accept(TokenRightBracePunctuator) {
+			j.Score(g)
+
+			break
+		}
+
+		g = j.NewGoal()
+
+		var (
+			si       StatementListItem
+			d        *Declaration
+			item     ModuleItem
+			exported []*Token
+			assigned bool
+		)
+
+		if g.SkipExportType(&d) {
+			if d != nil {
+				si.Declaration = d
+				item.ExportDeclaration = &ExportDeclaration{Declaration: d, Tokens: g.ToTokens()}
+			} else {
+				si.Comments[0] = g.ToTypescriptComments()
+			}
+		} else if g.AcceptToken(parser.Token{Type: TokenKeyword, Data: "export"}) {
+			g.AcceptRunWhitespace()
+
+			h := g.NewGoal()
+
+			if h.isEnumOrNamespace() {
+				s, d, err := h.parseEnumOrNamespace(name)
+				if err != nil {
+					return nil, nil, nil, g.Error("NamespaceBody", err)
+				}
+
+				if s != nil {
+					s.Tokens = h.ToTokens()
+					si.Statement = s
+					exported = si.boundNames()
+
+					if s.VariableStatement != nil {
+						item.ExportDeclaration = &ExportDeclaration{VariableStatement: s.VariableStatement}
+					}
+				} else if d != nil {
+					si.Declaration = d
+					item.ExportDeclaration = &ExportDeclaration{Declaration: d}
+				} else {
+					si.Comments[0] = h.ToTypescriptComments()
+				}
+
+				g.Score(h)
+			} else {
+				if err := si.parse(&h, false, false, false); err != nil {
+					return nil, nil, nil, g.Error("NamespaceBody", err)
+				}
+
+				if exported = si.boundNames(); exported == nil && (si.Statement != nil || si.Declaration != nil && !si.Declaration.isTypescript()) {
+					return nil, nil, nil, h.Error("NamespaceBody", ErrInvalidExportDeclaration)
+				}
+
+				if g.IsTypescriptAST() {
+					if c := si.Clone(); c.Declaration != nil {
+						item.ExportDeclaration = &ExportDeclaration{Declaration: c.Declaration}
+					} else if c.Statement != nil && c.Statement.VariableStatement != nil {
+						item.ExportDeclaration = &ExportDeclaration{VariableStatement: c.Statement.VariableStatement}
+					} else {
+						item.StatementListItem = c
+					}
+				}
+
+				if assigned = si.exportVariables(name); assigned {
+					qualified = append(qualified, exported...)
+					exported = nil
+					instantiated = true
+				}
+
+				g.Score(h)
+			}
+		} else if err := si.parse(&g, false, false, false); err != nil {
+			return nil, nil, nil, j.Error("NamespaceBody", err)
+		}
+
+		if g.IsTypescriptAST() {
+			if item.ExportDeclaration != nil {
+				item.ExportDeclaration.Tokens = g.ToTokens()
+			} else if item.StatementListItem == nil {
+				item.StatementListItem = si.Clone()
+			}
+
+			item.Tokens = g.ToTokens()
+			items = append(items, item)
+		}
+
+		j.Score(g)
+
+		si.Tokens = j.ToTokens()
+		instantiated = instantiated || si.Statement != nil || si.Declaration != nil && !si.Declaration.isTypescript()
+
+		if !assigned || si.Statement != nil {
+			body = append(body, si)
+		}
+
+		for _, tk := range exported {
+			body = append(body, exportAssignment(name, tk))
+		}
+
+		exports = append(exports, exported...)
+	}
+
+	exports = append(exports, qualified...)
+
+	if !instantiated {
+		return nil, items, exports, nil
+	}
+
+	var local []*Token
+
+	for n := range body {
+		local = append(local, body[n].boundNames()...)
+	}
+
+	for _, tk := range earlier {
+		if !slices.ContainsFunc(local, func(l *Token) bool { return l.Data == tk.Data }) {
+			qualified = append(qualified, tk)
+		}
+	}
+
+	for _, tk := range qualified {
+		qualifyReferences(reflect.ValueOf(body), name, tk)
+	}
+
+	return body, items, exports, nil
+}
+
+// exportVariables replaces an exported variable declaration, in a namespace,
+// with the assignment of each initializer to the namespace object.
+//
+// Returns false, leaving the declaration unchanged, when it is not a variable
+// declaration or it declares a binding pattern.
+func (si *StatementListItem) exportVariables(container *Token) bool {
+	var bindings []LexicalBinding
+
+	if si.Statement != nil && si.Statement.VariableStatement != nil {
+		bindings = si.Statement.VariableStatement.VariableDeclarationList
+	} else if si.Declaration != nil && si.Declaration.LexicalDeclaration != nil {
+		bindings = si.Declaration.LexicalDeclaration.BindingList
+	} else {
+		return false
+	}
+
+	var assignments Expression
+
+	for _, lb := range bindings {
+		if lb.BindingIdentifier == nil {
+			return false
+		} else if lb.Initializer != nil {
+			assignments.Expressions = append(assignments.Expressions, assignment(propertyExpression(identifierExpression(container), lb.BindingIdentifier), *lb.Initializer))
+		}
+	}
+
+	si.Statement = nil
+	si.Declaration = nil
+
+	if len(assignments.Expressions) > 0 {
+		si.Statement = &Statement{ExpressionStatement: &assignments}
+	}
+
+	return true
+}
+
+// qualifyReferences replaces references to name in the given value with
+// property accesses on the container, not descending into functions or blocks
+// that bind the name themselves.
+func qualifyReferences(v reflect.Value, container, name *Token) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return
+		}
+
+		switch v.Interface().(type) {
+		case *FunctionDeclaration, *ArrowFunction, *MethodDefinition, *Block:
+			if bindsName(v, name.Data) {
+				return
+			}
+		}
+
+		qualifyReferences(v.Elem(), container, name)
+	case reflect.Struct:
+		typ := v.Type()
+
+		if typ == memberExpressionType && v.CanAddr() {
+			if me := v.Addr().Interface().(*MemberExpression); me.PrimaryExpression != nil && me.PrimaryExpression.IdentifierReference != nil && me.PrimaryExpression.IdentifierReference.Data == name.Data {
+				*me = *propertyExpression(identifierExpression(container), me.PrimaryExpression.IdentifierReference)
+
+				return
+			}
+		}
+
+		for n := range v.NumField() {
+			switch typ.Field(n).Type {
+			case tokenType, tokensType, commentsType, directivePrologueType, enumDeclarationType, namespaceDeclarationType:
+			default:
+				qualifyReferences(v.Field(n), container, name)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for n := range v.Len() {
+			qualifyReferences(v.Index(n), container, name)
+		}
+	}
+}
+
+func (si *StatementListItem) boundNames() []*Token {
+	var names []*Token
+
+	if si.Statement != nil && si.Statement.VariableStatement != nil {
+		for _, vd := range si.Statement.VariableStatement.VariableDeclarationList {
+			names = appendBoundNames(names, vd.BindingIdentifier, vd.ArrayBindingPattern, vd.ObjectBindingPattern)
+		}
+	} else if si.Declaration == nil {
+	} else if si.Declaration.FunctionDeclaration != nil {
+		names = appendBoundNames(names, si.Declaration.FunctionDeclaration.BindingIdentifier, nil, nil)
+	} else if si.Declaration.ClassDeclaration != nil {
+		names = appendBoundNames(names, si.Declaration.ClassDeclaration.BindingIdentifier, nil, nil)
+	} else if si.Declaration.LexicalDeclaration != nil {
+		for _, lb := range si.Declaration.LexicalDeclaration.BindingList {
+			names = appendBoundNames(names, lb.BindingIdentifier, lb.ArrayBindingPattern, lb.ObjectBindingPattern)
+		}
+	}
+
+	return names
+}
+
+func appendBoundNames(names []*Token, bi *Token, abp *ArrayBindingPattern, obp *ObjectBindingPattern) []*Token {
+	if bi != nil {
+		names = append(names, bi)
+	}
+
+	if abp != nil {
+		for _, be := range abp.BindingElementList {
+			names = appendBoundNames(names, be.SingleNameBinding, be.ArrayBindingPattern, be.ObjectBindingPattern)
+		}
+
+		if be := abp.BindingRestElement; be != nil {
+			names = appendBoundNames(names, be.SingleNameBinding, be.ArrayBindingPattern, be.ObjectBindingPattern)
+		}
+	}
+
+	if obp != nil {
+		for _, bp := range obp.BindingPropertyList {
+			names = appendBoundNames(names, bp.BindingElement.SingleNameBinding, bp.BindingElement.ArrayBindingPattern, bp.BindingElement.ObjectBindingPattern)
+		}
+
+		names = appendBoundNames(names, obp.BindingRestProperty, nil, nil)
+	}
+
+	return names
+}
+
+// lowerScope produces the following Statement, where the function body
+// consists of the given body followed by a return of the name:
+//
+//	var name = (function (name) { ... })(container.name || {});
+//
+// When merged is true, the name has already been declared, and is instead
+// extended by the following Statement:
+//
+//	(function (name) { ... })(name || (name = {}));
+func lowerScope(name, container *Token, body []StatementListItem, merged bool) *Statement {
+	existing := identifierExpression(name)
+	object := WrapConditional(&ObjectLiteral{})
+
+	if merged {
+		object = WrapConditional(&PrimaryExpression{
+			ParenthesizedExpression: &ParenthesizedExpression{
+				Expressions: []AssignmentExpression{assignment(identifierExpression(name), AssignmentExpression{ConditionalExpression: object})},
+			},
+		})
+	} else {
+		if container != nil {
+			existing = propertyExpression(identifierExpression(container), name)
+		}
+
+		body = append(body, StatementListItem{
+			Statement: &Statement{
+				Type: StatementReturn,
+				ExpressionStatement: &Expression{
+					Expressions: []AssignmentExpression{{ConditionalExpression: WrapConditional(identifierExpression(name))}},
+				},
+			},
+		})
+	}
+
+	fn := &FunctionDeclaration{
+		FormalParameters: FormalParameters{
+			FormalParameterList: []BindingElement{{SingleNameBinding: copyToken(name)}},
+		},
+		FunctionBody: Block{StatementList: body},
+	}
+	call := AssignmentExpression{
+		ConditionalExpression: WrapConditional(&CallExpression{
+			MemberExpression: &MemberExpression{
+				PrimaryExpression: &PrimaryExpression{
+					ParenthesizedExpression: &ParenthesizedExpression{
+						Expressions: []AssignmentExpression{{ConditionalExpression: WrapConditional(fn)}},
+					},
+				},
+			},
+			Arguments: &Arguments{
+				ArgumentList: []Argument{{
+					AssignmentExpression: AssignmentExpression{
+						ConditionalExpression: WrapConditional(&LogicalORExpression{
+							LogicalORExpression:  WrapConditional(existing).LogicalORExpression,
+							LogicalANDExpression: object.LogicalORExpression.LogicalANDExpression,
+						}),
+					},
+				}},
+			},
+		}),
+	}
+
+	if merged {
+		return &Statement{ExpressionStatement: &Expression{Expressions: []AssignmentExpression{call}}}
+	}
+
+	return &Statement{
+		VariableStatement: &VariableStatement{
+			VariableDeclarationList: []VariableDeclaration{{
+				BindingIdentifier: copyToken(name),
+				Initializer:       &call,
+			}},
+		},
+	}
+}
+
+// lowerParameterProperties assigns the parameter properties of the
+// constructor to the instance, after the super() call of a derived class, and,
+// when define is true, also declares them as fields at the start of the class
+// body.
+//
+// When define is false and assign is true, the instance fields are given
+// [[Set]] semantics: the initializers of the instance fields are moved, in
+// order, into the constructor after the parameter properties are assigned,
+// adding a constructor when the class has none. See lowerFieldInitializers.
+//
+// When the constructor of a derived class has no top-level super() call, the
+// assignments are made by an arrow function that wraps super(), and each
+// super() call is replaced by a call to that function. A derived constructor
+// without any super() call is left unchanged.
+func (cd *ClassDeclaration) lowerParameterProperties(define, assign bool) error {
+	var (
+		md          = cd.constructor()
+		assignments []StatementListItem
+		fields      []ClassElement
+		superCalls  []*CallExpression
+		pos         = -1
+	)
+
+	if md != nil {
+		for _, be := range md.Params.FormalParameterList {
+			if be.SingleNameBinding == nil || !isParameterProperty(be.Comments[0]) {
+				continue
+			}
+
+			assignments = append(assignments, expressionStatement(assignment(propertyExpression(thisExpression(), be.SingleNameBinding), AssignmentExpression{ConditionalExpression: WrapConditional(identifierExpression(be.SingleNameBinding))})))
+			fields = append(fields, ClassElement{FieldDefinition: &FieldDefinition{ClassElementName: ClassElementName{PropertyName: &PropertyName{LiteralPropertyName: copyToken(be.SingleNameBinding)}}}})
+		}
+
+		body := md.FunctionBody.StatementList
+		directives := len(parseDirectivePrologue(body).Directives)
+
+		if cd.ClassHeritage == nil {
+			pos = directives
+		} else {
+			for n := directives; n < len(body); n++ {
+				if isSuperCall(&body[n]) {
+					pos = n + 1
+
+					break
+				}
+			}
+
+			if pos == -1 {
+				if findSuperCalls(reflect.ValueOf(body), &superCalls); len(superCalls) == 0 {
+					return nil
+				}
+			}
+		}
+	}
+
+	if define {
+		cd.ClassBody = slices.Insert(cd.ClassBody, 0, fields...)
+	} else if assign {
+		initializers, err := cd.lowerFieldInitializers(md)
+		if err != nil {
+			return err
+		}
+
+		assignments = append(assignments, initializers...)
+	}
+
+	if len(assignments) == 0 {
+		return nil
+	}
+
+	if md == nil {
+		md = cd.addConstructor()
+
+		if cd.ClassHeritage != nil {
+			pos = 1
+		} else {
+			pos = 0
+		}
+	}
+
+	if pos == -1 {
+		wrapSuperCalls(md, superCalls, cd.Tokens, assignments)
+	} else {
+		md.FunctionBody.StatementList = slices.Insert(md.FunctionBody.StatementList, pos, assignments...)
+	}
+
+	return nil
+}
+
+func (cd *ClassDeclaration) constructor() *MethodDefinition {
+	for _, ce := range cd.ClassBody {
+		if md := ce.MethodDefinition; !ce.Static && md != nil && md.isConstructor() {
+			return md
+		}
+	}
+
+	return nil
+}
+
+// addConstructor adds an empty constructor to the start of the class body,
+// which, for a derived class, passes its arguments to super().
+func (cd *ClassDeclaration) addConstructor() *MethodDefinition {
+	md := &MethodDefinition{
+		ClassElementName: ClassElementName{
+			PropertyName: &PropertyName{
+				LiteralPropertyName: &Token{Token: parser.Token{Type: TokenIdentifier, Data: "constructor"}},
+			},
+		},
+	}
+
+	if cd.ClassHeritage != nil {
+		md.FunctionBody.StatementList = []StatementListItem{
+			expressionStatement(AssignmentExpression{
+				ConditionalExpression: WrapConditional(&CallExpression{
+					SuperCall: true,
+					Arguments: &Arguments{
+						ArgumentList: []Argument{{
+							Spread:               true,
+							AssignmentExpression: AssignmentExpression{ConditionalExpression: WrapConditional(identifierExpression(&Token{Token: parser.Token{Type: TokenIdentifier, Data: "arguments"}}))},
+						}},
+					},
+				}),
+			}),
+		}
+	}
+
+	cd.ClassBody = slices.Insert(cd.ClassBody, 0, ClassElement{MethodDefinition: md})
+
+	return cd.ClassBody[0].MethodDefinition
+}
+
+// lowerFieldInitializers returns the assignments to the instance that are
+// equivalent to the initializers of the instance fields, in order.
+//
+// Initialized instance fields with literal names are removed from the class
+// body, while the declarations of private fields are kept, without their
+// initializers. Fields without initializers are left in place.
+//
+// When any instance field has a computed name, which is evaluated along with
+// the class, no fields are changed.
+//
+// As the initializers are moved into the scope of the given constructor, an
+// initializer that refers to a name declared by the constructor results in an
+// error.
+func (cd *ClassDeclaration) lowerFieldInitializers(md *MethodDefinition) ([]StatementListItem, error) {
+	var (
+		assignments []StatementListItem
+		declared    []*Token
+	)
+
+	if md != nil {
+		declared = md.declaredNames()
+	}
+
+	for _, ce := range cd.ClassBody {
+		if fd := ce.FieldDefinition; !ce.Static && !ce.Accessor && fd != nil && fd.ClassElementName.PropertyName != nil && fd.ClassElementName.PropertyName.ComputedPropertyName != nil {
+			return nil, nil
+		}
+	}
+
+	for _, ce := range cd.ClassBody {
+		fd := ce.FieldDefinition
+		if ce.Static || ce.Accessor || fd == nil || fd.Initializer == nil {
+			continue
+		}
+
+		for _, name := range declared {
+			if tk := findReference(reflect.ValueOf(fd.Initializer), name.Data); tk != nil {
+				return nil, Error{
+					Err:     ErrShadowedInitializer,
+					Parsing: "FieldDefinition",
+					Token:   *tk,
+				}
+			}
+		}
+	}
+
+	cd.ClassBody = slices.DeleteFunc(cd.ClassBody, func(ce ClassElement) bool {
+		fd := ce.FieldDefinition
+		if ce.Static || ce.Accessor || fd == nil || fd.Initializer == nil {
+			return false
+		}
+
+		var target *MemberExpression
+
+		if name := fd.ClassElementName.PrivateIdentifier; name != nil {
+			target = &MemberExpression{MemberExpression: thisExpression(), PrivateIdentifier: copyToken(name)}
+		} else if name := fd.ClassElementName.PropertyName.LiteralPropertyName; name.Type == TokenStringLiteral || name.Type == TokenNumericLiteral {
+			target = indexExpression(thisExpression(), AssignmentExpression{ConditionalExpression: WrapConditional(&PrimaryExpression{Literal: copyToken(name)})})
+		} else {
+			target = propertyExpression(thisExpression(), name)
+		}
+
+		assignments = append(assignments, expressionStatement(assignment(target, *fd.Initializer)))
+		fd.Initializer = nil
+
+		return fd.ClassElementName.PrivateIdentifier == nil
+	})
+
+	return assignments, nil
+}
+
+// declaredNames returns the names declared by the parameters and the
+// top-level of the body of the method.
+func (md *MethodDefinition) declaredNames() []*Token {
+	var names []*Token
+
+	for _, be := range md.Params.FormalParameterList {
+		names = appendBoundNames(names, be.SingleNameBinding, be.ArrayBindingPattern, be.ObjectBindingPattern)
+	}
+
+	names = appendBoundNames(names, md.Params.BindingIdentifier, md.Params.ArrayBindingPattern, md.Params.ObjectBindingPattern)
+
+	for _, si := range md.FunctionBody.StatementList {
+		names = append(names, si.boundNames()...)
+	}
+
+	return names
+}
+
+// findReference returns the first reference to name in the given value, not
+// descending into functions or blocks that bind the name themselves.
+func findReference(v reflect.Value, name string) *Token {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return nil
+		}
+
+		switch v.Interface().(type) {
+		case *FunctionDeclaration, *ArrowFunction, *MethodDefinition, *Block:
+			if bindsName(v, name) {
+				return nil
+			}
+		}
+
+		return findReference(v.Elem(), name)
+	case reflect.Struct:
+		typ := v.Type()
+
+		for n := range v.NumField() {
+			switch f := typ.Field(n); f.Type {
+			case tokenType:
+				if tk := v.Field(n).Interface().(*Token); tk != nil && f.Name == "IdentifierReference" && tk.Data == name {
+					return tk
+				}
+			case tokensType, commentsType, directivePrologueType, enumDeclarationType, namespaceDeclarationType:
+			default:
+				if tk := findReference(v.Field(n), name); tk != nil {
+					return tk
+				}
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for n := range v.Len() {
+			if tk := findReference(v.Index(n), name); tk != nil {
+				return tk
+			}
+		}
+	}
+
+	return nil
+}
+
+// findSuperCalls appends to calls each super() call in the given value, not
+// descending into functions, other than arrow functions, or classes.
+func findSuperCalls(v reflect.Value, calls *[]*CallExpression) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return
+		}
+
+		switch ce := v.Interface().(type) {
+		case *FunctionDeclaration, *MethodDefinition, *ClassDeclaration:
+			return
+		case *CallExpression:
+			if ce.SuperCall {
+				*calls = append(*calls, ce)
+			}
+		}
+
+		findSuperCalls(v.Elem(), calls)
+	case reflect.Struct:
+		typ := v.Type()
+
+		for n := range v.NumField() {
+			switch typ.Field(n).Type {
+			case tokenType, tokensType, commentsType, directivePrologueType, enumDeclarationType, namespaceDeclarationType:
+			default:
+				findSuperCalls(v.Field(n), calls)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for n := range v.Len() {
+			findSuperCalls(v.Index(n), calls)
+		}
+	}
+}
+
+// wrapSuperCalls adds the following declaration to the start of the
+// constructor, after any directives, and replaces each of the given super()
+// calls with a call to it:
+//
+//	const _super = (...args) => {
+//		super(...args);
+//		assignments...
+//		return this;
+//	};
+//
+// The names are chosen so as not to match any identifier in the given tokens.
+func wrapSuperCalls(md *MethodDefinition, calls []*CallExpression, tks Tokens, assignments []StatementListItem) {
+	var (
+		name = uniqueIdentifier(tks, "_super")
+		args = uniqueIdentifier(tks, "args")
+		body = make([]StatementListItem, 0, len(assignments)+2)
+	)
+
+	body = append(body, expressionStatement(AssignmentExpression{
+		ConditionalExpression: WrapConditional(&CallExpression{
+			SuperCall: true,
+			Arguments: &Arguments{
+				ArgumentList: []Argument{{
+					Spread:               true,
+					AssignmentExpression: AssignmentExpression{ConditionalExpression: WrapConditional(identifierExpression(args))},
+				}},
+			},
+		}),
+	}))
+	body = append(body, assignments...)
+	body = append(body, StatementListItem{Statement: &Statement{Type: StatementReturn, ExpressionStatement: &Expression{Expressions: []AssignmentExpression{{ConditionalExpression: WrapConditional(thisExpression())}}}}})
+
+	for _, ce := range calls {
+		*ce = CallExpression{MemberExpression: identifierExpression(name), Arguments: ce.Arguments}
+	}
+
+	wrapper := StatementListItem{Declaration: &Declaration{LexicalDeclaration: &LexicalDeclaration{
+		LetOrConst: Const,
+		BindingList: []LexicalBinding{{
+			BindingIdentifier: name,
+			Initializer: &AssignmentExpression{ArrowFunction: &ArrowFunction{
+				FormalParameters: &FormalParameters{BindingIdentifier: args},
+				FunctionBody:     &Block{StatementList: body},
+			}},
+		}},
+	}}}
+
+	list := md.FunctionBody.StatementList
+	md.FunctionBody.StatementList = slices.Insert(list, len(parseDirectivePrologue(list).Directives), wrapper)
+}
+
+// uniqueIdentifier returns an identifier with the given name, suffixed with a
+// number if needed so that it does not match any of the given tokens.
+func uniqueIdentifier(tks Tokens, name string) *Token {
+	unique := name
+
+	for n := 1; slices.ContainsFunc(tks, func(tk Token) bool { return tk.Data == unique }); n++ {
+		unique = name + strconv.Itoa(n)
+	}
+
+	return &Token{Token: parser.Token{Type: TokenIdentifier, Data: unique}}
+}
+
+func (md *MethodDefinition) isConstructor() bool {
+	if md.Type != MethodNormal || md.ClassElementName.PropertyName == nil || md.ClassElementName.PropertyName.LiteralPropertyName == nil {
+		return false
+	}
+
+	switch md.ClassElementName.PropertyName.LiteralPropertyName.Data {
+	case "constructor", "\"constructor\"", "'constructor'":
+		return true
+	}
+
+	return false
+}
+
+func isParameterProperty(c Comments) bool {
+	for _, tk := range c {
+		if tk.IsTypescript() && tk.Type&^tokenTypescript == TokenIdentifier && isParameterModifier(tk.Data) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isSuperCall(si *StatementListItem) bool {
+	if si.Statement == nil || si.Statement.ExpressionStatement == nil || len(si.Statement.ExpressionStatement.Expressions) != 1 {
+		return false
+	}
+
+	ce, ok := UnwrapConditional(si.Statement.ExpressionStatement.Expressions[0].ConditionalExpression).(*CallExpression)
+
+	return ok && ce.SuperCall
+}
+
+func exportAssignment(container, name *Token) StatementListItem {
+	return expressionStatement(assignment(propertyExpression(identifierExpression(container), name), AssignmentExpression{ConditionalExpression: WrapConditional(identifierExpression(name))}))
+}
+
+func copyToken(tk *Token) *Token {
+	return &Token{
+		Token:   tk.Token,
+		Pos:     tk.Pos,
+		Line:    tk.Line,
+		LinePos: tk.LinePos,
+	}
+}
+
+func thisExpression() *MemberExpression {
+	return &MemberExpression{PrimaryExpression: &PrimaryExpression{This: &Token{Token: parser.Token{Type: TokenKeyword, Data: "this"}}}}
+}
+
+func identifierExpression(tk *Token) *MemberExpression {
+	return &MemberExpression{PrimaryExpression: &PrimaryExpression{IdentifierReference: copyToken(tk)}}
+}
+
+func propertyExpression(me *MemberExpression, tk *Token) *MemberExpression {
+	return &MemberExpression{MemberExpression: me, IdentifierName: copyToken(tk)}
+}
+
+func indexExpression(me *MemberExpression, ae AssignmentExpression) *MemberExpression {
+	return &MemberExpression{MemberExpression: me, Expression: &Expression{Expressions: []AssignmentExpression{ae}}}
+}
+
+func assignment(me *MemberExpression, ae AssignmentExpression) AssignmentExpression {
+	return AssignmentExpression{
+		LeftHandSideExpression: &LeftHandSideExpression{NewExpression: &NewExpression{MemberExpression: *me}},
+		AssignmentOperator:     AssignmentAssign,
+		AssignmentExpression:   &ae,
+	}
+}
+
+func expressionStatement(ae AssignmentExpression) StatementListItem {
+	return StatementListItem{Statement: &Statement{ExpressionStatement: &Expression{Expressions: []AssignmentExpression{ae}}}}
+}
+
+// rewriteMemberExpressions calls fn for each MemberExpression in the given
+// value, not descending into those that fn reports as having been replaced.
+func rewriteMemberExpressions(v reflect.Value, fn func(*MemberExpression) bool) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if !v.IsNil() {
+			rewriteMemberExpressions(v.Elem(), fn)
+		}
+	case reflect.Struct:
+		typ := v.Type()
+
+		if typ == memberExpressionType && v.CanAddr() && fn(v.Addr().Interface().(*MemberExpression)) {
+			return
+		}
+
+		for n := range v.NumField() {
+			switch typ.Field(n).Type {
+			case tokenType, tokensType, commentsType, directivePrologueType, enumDeclarationType, namespaceDeclarationType:
+			default:
+				rewriteMemberExpressions(v.Field(n), fn)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for n := range v.Len() {
+			rewriteMemberExpressions(v.Index(n), fn)
+		}
+	}
+}
+
+func bindsName(v reflect.Value, name string) bool {
+	switch v.Kind() {
+	case reflect.Pointer:
+		return !v.IsNil() && bindsName(v.Elem(), name)
+	case reflect.Struct:
+		typ := v.Type()
+
+		for n := range v.NumField() {
+			switch f := typ.Field(n); f.Type {
+			case tokenType:
+				if _, ok := bindingFields[f.Name]; ok {
+					if tk := v.Field(n).Interface().(*Token); tk != nil && tk.Data == name {
+						return true
+					}
+				}
+			case tokensType, commentsType, directivePrologueType, enumDeclarationType, namespaceDeclarationType:
+			default:
+				if bindsName(v.Field(n), name) {
+					return true
+				}
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for n := range v.Len() {
+			if bindsName(v.Index(n), name) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (si *StatementListItem) constEnum() Tokens {
+	if si.Statement != nil && si.Statement.VariableStatement != nil && isConstEnum(si.Tokens) {
+		return si.Tokens
+	}
+
+	return nil
+}
+
+func (ml *ModuleItem) constEnum() Tokens {
+	if ml.StatementListItem != nil {
+		return ml.StatementListItem.constEnum()
+	} else if ml.ExportDeclaration != nil && ml.ExportDeclaration.VariableStatement != nil && isConstEnum(ml.Tokens) {
+		return ml.Tokens
+	}
+
+	return nil
+}
+
+func isConstEnum(tks Tokens) bool {
+	var words []string
+
+	for _, tk := range tks {
+		switch tk.Type {
+		case TokenWhitespace, TokenLineTerminator, TokenSingleLineComment, TokenMultiLineComment:
+			continue
+		}
+
+		if words = append(words, tk.Data); len(words) == 3 {
+			break
+		}
+	}
+
+	if len(words) > 0 && words[0] == "export" {
+		words = words[1:]
+	}
+
+	return len(words) > 1 && words[0] == "const" && words[1] == "enum"
+}
+
+// inlineConstEnums replaces references to the constant members of the const
+// enums declared in the given list with the values of those members.
+//
+// An enum is not inlined if its name is bound anywhere else in the list.
+func inlineConstEnums[T any, PT interface {
+	*T
+	constEnum() Tokens
+}](items []T, marker Token) {
+	for n := range items {
+		tks := PT(&items[n]).constEnum()
+		if tks == nil {
+			continue
+		}
+
+		j := jsParser{tokens: append(append(make(Tokens, 0, len(tks)+1), tks...), marker)[:0]}
+
+		j.AcceptRunWhitespace()
+
+		if j.AcceptToken(parser.Token{Type: TokenKeyword, Data: "export"}) {
+			j.AcceptRunWhitespace()
+		}
+
+		var ed enumDeclaration
+
+		if ed.parse(&j) != nil {
+			continue
+		}
+
+		bound := false
+
+		for m := range items {
+			if m != n && bindsName(reflect.ValueOf(&items[m]), ed.name.Data) {
+				bound = true
+
+				break
+			}
+		}
+
+		if bound {
+			continue
+		}
+
+		for m := range items {
+			if m == n {
+				continue
+			}
+
+			rewriteMemberExpressions(reflect.ValueOf(&items[m]), func(me *MemberExpression) bool {
+				if me.MemberExpression == nil || me.MemberExpression.PrimaryExpression == nil || me.MemberExpression.PrimaryExpression.IdentifierReference == nil || me.MemberExpression.PrimaryExpression.IdentifierReference.Data != ed.name.Data {
+					return false
+				}
+
+				var key string
+
+				if me.IdentifierName != nil {
+					key = me.IdentifierName.Data
+				} else if me.Expression != nil && len(me.Expression.Expressions) == 1 {
+					if pe, ok := UnwrapConditional(me.Expression.Expressions[0].ConditionalExpression).(*PrimaryExpression); ok && pe.Literal != nil {
+						if v, ok := parseEnumLiteral(pe.Literal); ok && v.isString {
+							key = v.str
+						}
+					}
+				}
+
+				if v := ed.values[key]; v != nil {
+					*me = MemberExpression{PrimaryExpression: v.primaryExpression()}
+
+					return true
+				}
+
+				return false
+			})
+		}
+	}
+}
@@ -1,17 +1,19 @@
 package javascript
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"vimagination.zapto.org/parser"
 )
 
-func (j jsParser) toTypescript() Comments {
-	c := make(Comments, len(j))
+func (t Tokens) toTypescript() Comments {
+	c := make(Comments, len(t))
 
-	for n := range j {
-		c[n] = &(j)[n]
+	for n := range t {
+		c[n] = &t[n]
 	}
 
 	return c
@@ -39,14 +41,14 @@ func TestTypescriptModule(t *testing.T) {
 					},
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{jsParser(tk[8:18]).toTypescript()},
+							Comments: [2]Comments{tk[8:18].toTypescript()},
 							Tokens:   tk[8:18],
 						},
 						Tokens: tk[8:18],
 					},
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{jsParser(tk[18:33]).toTypescript()},
+							Comments: [2]Comments{tk[18:33].toTypescript()},
 							Tokens:   tk[18:33],
 						},
 						Tokens: tk[18:33],
@@ -518,7 +520,7 @@ static readonly E;
 						ImportDeclaration: &ImportDeclaration{
 							ImportClause: &ImportClause{
 								NamedImports: &NamedImports{
-									Comments: [2]Comments{nil, jsParser(tk[3:6]).toTypescript()},
+									Comments: [2]Comments{nil, tk[3:6].toTypescript()},
 									Tokens:   tk[2:7],
 								},
 								Tokens: tk[2:7],
@@ -562,7 +564,7 @@ static readonly E;
 						ImportDeclaration: &ImportDeclaration{
 							ImportClause: &ImportClause{
 								NamedImports: &NamedImports{
-									Comments: [2]Comments{nil, jsParser(tk[3:6]).toTypescript()},
+									Comments: [2]Comments{nil, tk[3:6].toTypescript()},
 									Tokens:   tk[2:7],
 								},
 								Tokens: tk[2:7],
@@ -618,7 +620,7 @@ static readonly E;
 						ImportDeclaration: &ImportDeclaration{
 							ImportClause: &ImportClause{
 								NamedImports: &NamedImports{
-									Comments: [2]Comments{nil, jsParser(tk[3:10]).toTypescript()},
+									Comments: [2]Comments{nil, tk[3:10].toTypescript()},
 									Tokens:   tk[2:11],
 								},
 								Tokens: tk[2:11],
@@ -655,7 +657,7 @@ type B = number;
 					},
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[3:11]).toTypescript()},
+							Comments: [2]Comments{nil, tk[3:11].toTypescript()},
 							Tokens:   tk[3:11],
 						},
 						Tokens: tk[3:11],
@@ -715,7 +717,7 @@ type B = number;
 										},
 										Tokens: tk[12:13],
 									},
-									Comments: [5]Comments{nil, jsParser(tk[3:9]).toTypescript(), append(jsParser(tk[13:16:16]).toTypescript(), jsParser(tk[17:26]).toTypescript()...)},
+									Comments: [5]Comments{nil, tk[3:9].toTypescript(), append(tk[13:16:16].toTypescript(), tk[17:26].toTypescript()...)},
 									Tokens:   tk[:29],
 								},
 								Tokens: tk[:29],
@@ -807,7 +809,7 @@ type B = number;
 										},
 										Tokens: tk[12:13],
 									},
-									Comments: [5]Comments{nil, jsParser(tk[3:9]).toTypescript(), append(jsParser(tk[13:16:16]).toTypescript(), jsParser(tk[17:26]).toTypescript()...)},
+									Comments: [5]Comments{nil, tk[3:9].toTypescript(), append(tk[13:16:16].toTypescript(), tk[17:26].toTypescript()...)},
 									Tokens:   tk[:29],
 								},
 								Tokens: tk[:29],
@@ -869,7 +871,7 @@ i <J> () {}
 												FunctionBody: Block{
 													Tokens: tk[13:15],
 												},
-												Comments: [4]Comments{nil, nil, jsParser(tk[9:12]).toTypescript()},
+												Comments: [4]Comments{nil, nil, tk[9:12].toTypescript()},
 												Tokens:   tk[6:15],
 											},
 											Tokens: tk[6:15],
@@ -890,7 +892,7 @@ i <J> () {}
 												FunctionBody: Block{
 													Tokens: tk[25:27],
 												},
-												Comments: [4]Comments{nil, nil, jsParser(tk[21:24]).toTypescript()},
+												Comments: [4]Comments{nil, nil, tk[21:24].toTypescript()},
 												Tokens:   tk[16:27],
 											},
 											Tokens: tk[16:27],
@@ -917,7 +919,7 @@ i <J> () {}
 												FunctionBody: Block{
 													Tokens: tk[38:40],
 												},
-												Comments: [4]Comments{nil, nil, jsParser(tk[34:37]).toTypescript()},
+												Comments: [4]Comments{nil, nil, tk[34:37].toTypescript()},
 												Tokens:   tk[28:40],
 											},
 											Tokens: tk[28:40],
@@ -929,7 +931,7 @@ i <J> () {}
 														LiteralPropertyName: &tk[41],
 														Tokens:              tk[41:42],
 													},
-													Comments: [2]Comments{nil, jsParser(tk[43:46]).toTypescript()},
+													Comments: [2]Comments{nil, tk[43:46].toTypescript()},
 													Tokens:   tk[41:46],
 												},
 												Params: FormalParameters{
@@ -1004,7 +1006,7 @@ i <J> () {}
 										FormalParameterList: []BindingElement{
 											{
 												SingleNameBinding: &tk[7],
-												Comments:          [2]Comments{nil, jsParser(tk[8:11]).toTypescript()},
+												Comments:          [2]Comments{nil, tk[8:11].toTypescript()},
 												Tokens:            tk[7:11],
 											},
 											{
@@ -1021,7 +1023,7 @@ i <J> () {}
 													},
 													Tokens: tk[13:19],
 												},
-												Comments: [2]Comments{nil, jsParser(tk[19:28]).toTypescript()},
+												Comments: [2]Comments{nil, tk[19:28].toTypescript()},
 												Tokens:   tk[13:28],
 											},
 											{
@@ -1041,19 +1043,20 @@ i <J> () {}
 													},
 													Tokens: tk[30:33],
 												},
-												Comments: [2]Comments{nil, jsParser(tk[33:41]).toTypescript()},
+												Comments: [2]Comments{nil, tk[33:41].toTypescript()},
 												Tokens:   tk[30:41],
 											},
 										},
 										BindingIdentifier: &tk[44],
-										Comments:          [5]Comments{nil, nil, nil, jsParser(tk[45:48]).toTypescript()},
+										Comments:          [5]Comments{nil, nil, nil, tk[45:48].toTypescript()},
 										Tokens:            tk[6:49],
 									},
 									FunctionBody: Block{
 										Tokens: tk[53:55],
 									},
-									Comments: [5]Comments{nil, nil, nil, jsParser(tk[3:6]).toTypescript(), jsParser(tk[49:52]).toTypescript()},
-									Tokens:   tk[:55],
+									Comments:          [5]Comments{nil, nil, nil, tk[3:6].toTypescript(), tk[49:52].toTypescript()},
+									DirectivePrologue: DirectivePrologue{Strict: true},
+									Tokens:            tk[:55],
 								},
 								Tokens: tk[:55],
 							},
@@ -1109,7 +1112,7 @@ i <J> () {}
 													},
 													Tokens: tk[0:1],
 												},
-												Comments: jsParser(tk[2:5]).toTypescript(),
+												Comments: tk[2:5].toTypescript(),
 												Tokens:   tk[:5],
 											}),
 											Tokens: tk[:5],
@@ -1158,7 +1161,8 @@ i <J> () {}
 									FunctionBody: Block{
 										Tokens: tk[6:8],
 									},
-									Tokens: tk[:8],
+									DirectivePrologue: DirectivePrologue{Strict: true},
+									Tokens:            tk[:8],
 								},
 								Tokens: tk[:8],
 							},
@@ -1207,13 +1211,14 @@ i <J> () {}
 								FunctionDeclaration: &FunctionDeclaration{
 									BindingIdentifier: &tk[2],
 									FormalParameters: FormalParameters{
-										Comments: [5]Comments{jsParser(tk[4:8]).toTypescript()},
+										Comments: [5]Comments{tk[4:8].toTypescript()},
 										Tokens:   tk[3:9],
 									},
 									FunctionBody: Block{
 										Tokens: tk[9:11],
 									},
-									Tokens: tk[:11],
+									DirectivePrologue: DirectivePrologue{Strict: true},
+									Tokens:            tk[:11],
 								},
 								Tokens: tk[:11],
 							},
@@ -1268,13 +1273,14 @@ i <J> () {}
 												Tokens:            tk[7:8],
 											},
 										},
-										Comments: [5]Comments{jsParser(tk[4:6]).toTypescript()},
+										Comments: [5]Comments{tk[4:6].toTypescript()},
 										Tokens:   tk[3:9],
 									},
 									FunctionBody: Block{
 										Tokens: tk[9:11],
 									},
-									Tokens: tk[:11],
+									DirectivePrologue: DirectivePrologue{Strict: true},
+									Tokens:            tk[:11],
 								},
 								Tokens: tk[:11],
 							},
@@ -1330,8 +1336,9 @@ i <J> () {}
 												FunctionBody: &Block{
 													Tokens: tk[8:10],
 												},
-												Tokens:   tk[:10],
-												Comments: [5]Comments{nil, jsParser(tk[:3]).toTypescript()},
+												DirectivePrologue: DirectivePrologue{Strict: true},
+												Tokens:            tk[:10],
+												Comments:          [5]Comments{nil, tk[:3].toTypescript()},
 											},
 											Tokens: tk[:10],
 										},
@@ -1365,8 +1372,9 @@ i <J> () {}
 												FunctionBody: &Block{
 													Tokens: tk[8:10],
 												},
-												Tokens:   tk[:10],
-												Comments: [5]Comments{nil, nil, jsParser(tk[2:5]).toTypescript()},
+												DirectivePrologue: DirectivePrologue{Strict: true},
+												Tokens:            tk[:10],
+												Comments:          [5]Comments{nil, nil, tk[2:5].toTypescript()},
 											},
 											Tokens: tk[:10],
 										},
@@ -1398,7 +1406,7 @@ i <J> () {}
 													FormalParameterList: []BindingElement{
 														{
 															SingleNameBinding: &tk[1],
-															Comments:          [2]Comments{nil, jsParser(tk[2:5]).toTypescript()},
+															Comments:          [2]Comments{nil, tk[2:5].toTypescript()},
 															Tokens:            tk[1:5],
 														},
 													},
@@ -1407,7 +1415,8 @@ i <J> () {}
 												FunctionBody: &Block{
 													Tokens: tk[9:11],
 												},
-												Tokens: tk[:11],
+												DirectivePrologue: DirectivePrologue{Strict: true},
+												Tokens:            tk[:11],
 											},
 											Tokens: tk[:11],
 										},
@@ -1439,12 +1448,12 @@ i <J> () {}
 													FormalParameterList: []BindingElement{
 														{
 															SingleNameBinding: &tk[4],
-															Comments:          [2]Comments{nil, jsParser(tk[5:8]).toTypescript()},
+															Comments:          [2]Comments{nil, tk[5:8].toTypescript()},
 															Tokens:            tk[4:8],
 														},
 														{
 															SingleNameBinding: &tk[10],
-															Comments:          [2]Comments{nil, jsParser(tk[11:14]).toTypescript()},
+															Comments:          [2]Comments{nil, tk[11:14].toTypescript()},
 															Tokens:            tk[10:14],
 														},
 													},
@@ -1453,8 +1462,9 @@ i <J> () {}
 												FunctionBody: &Block{
 													Tokens: tk[21:23],
 												},
-												Comments: [5]Comments{nil, jsParser(tk[:3]).toTypescript(), jsParser(tk[15:18]).toTypescript()},
-												Tokens:   tk[:23],
+												Comments:          [5]Comments{nil, tk[:3].toTypescript(), tk[15:18].toTypescript()},
+												DirectivePrologue: DirectivePrologue{Strict: true},
+												Tokens:            tk[:23],
 											},
 											Tokens: tk[:23],
 										},
@@ -1489,8 +1499,9 @@ i <J> () {}
 												FunctionBody: &Block{
 													Tokens: tk[10:12],
 												},
-												Comments: [5]Comments{nil, jsParser(tk[2:5]).toTypescript()},
-												Tokens:   tk[:12],
+												Comments:          [5]Comments{nil, tk[2:5].toTypescript()},
+												DirectivePrologue: DirectivePrologue{Strict: true},
+												Tokens:            tk[:12],
 											},
 											Tokens: tk[:12],
 										},
@@ -1525,8 +1536,9 @@ i <J> () {}
 												FunctionBody: &Block{
 													Tokens: tk[10:12],
 												},
-												Tokens:   tk[:12],
-												Comments: [5]Comments{nil, nil, jsParser(tk[4:7]).toTypescript()},
+												DirectivePrologue: DirectivePrologue{Strict: true},
+												Tokens:            tk[:12],
+												Comments:          [5]Comments{nil, nil, tk[4:7].toTypescript()},
 											},
 											Tokens: tk[:12],
 										},
@@ -1559,7 +1571,7 @@ i <J> () {}
 													FormalParameterList: []BindingElement{
 														{
 															SingleNameBinding: &tk[3],
-															Comments:          [2]Comments{nil, jsParser(tk[4:7]).toTypescript()},
+															Comments:          [2]Comments{nil, tk[4:7].toTypescript()},
 															Tokens:            tk[3:7],
 														},
 													},
@@ -1568,7 +1580,8 @@ i <J> () {}
 												FunctionBody: &Block{
 													Tokens: tk[11:13],
 												},
-												Tokens: tk[:13],
+												DirectivePrologue: DirectivePrologue{Strict: true},
+												Tokens:            tk[:13],
 											},
 											Tokens: tk[:13],
 										},
@@ -1601,12 +1614,12 @@ i <J> () {}
 													FormalParameterList: []BindingElement{
 														{
 															SingleNameBinding: &tk[6],
-															Comments:          [2]Comments{nil, jsParser(tk[7:10]).toTypescript()},
+															Comments:          [2]Comments{nil, tk[7:10].toTypescript()},
 															Tokens:            tk[6:10],
 														},
 														{
 															SingleNameBinding: &tk[12],
-															Comments:          [2]Comments{nil, jsParser(tk[13:16]).toTypescript()},
+															Comments:          [2]Comments{nil, tk[13:16].toTypescript()},
 															Tokens:            tk[12:16],
 														},
 													},
@@ -1615,8 +1628,9 @@ i <J> () {}
 												FunctionBody: &Block{
 													Tokens: tk[23:25],
 												},
-												Comments: [5]Comments{nil, jsParser(tk[2:5]).toTypescript(), jsParser(tk[17:20]).toTypescript()},
-												Tokens:   tk[:25],
+												Comments:          [5]Comments{nil, tk[2:5].toTypescript(), tk[17:20].toTypescript()},
+												DirectivePrologue: DirectivePrologue{Strict: true},
+												Tokens:            tk[:25],
 											},
 											Tokens: tk[:25],
 										},
@@ -1656,12 +1670,12 @@ i <J> () {}
 														},
 														Tokens: tk[9:10],
 													},
-													Comments: jsParser(tk[11:14]).toTypescript(),
+													Comments: tk[11:14].toTypescript(),
 													Tokens:   tk[9:14],
 												}),
 												Tokens: tk[9:14],
 											},
-											Comments: [2]Comments{nil, jsParser(tk[3:6]).toTypescript()},
+											Comments: [2]Comments{nil, tk[3:6].toTypescript()},
 											Tokens:   tk[2:14],
 										},
 										{
@@ -1686,7 +1700,7 @@ i <J> () {}
 														},
 														Tokens: tk[22:23],
 													},
-													Comments: jsParser(tk[24:27]).toTypescript(),
+													Comments: tk[24:27].toTypescript(),
 													Tokens:   tk[22:27],
 												}),
 												Tokens: tk[22:27],
@@ -1734,7 +1748,7 @@ i <J> () {}
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:23]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:23].toTypescript()},
 							Tokens:   tk[:23],
 						},
 						Tokens: tk[:23],
@@ -1767,7 +1781,7 @@ i <J> () {}
 													Arguments: &Arguments{
 														Tokens: tk[13:15],
 													},
-													Comments: [5]Comments{nil, jsParser(tk[7:13]).toTypescript()},
+													Comments: [5]Comments{nil, tk[7:13].toTypescript()},
 													Tokens:   tk[6:15],
 												}),
 												Tokens: tk[6:15],
@@ -1807,7 +1821,7 @@ i <J> () {}
 																IdentifierReference: &tk[8],
 																Tokens:              tk[8:9],
 															},
-															Comments: [5]Comments{nil, nil, nil, nil, jsParser(tk[9:15]).toTypescript()},
+															Comments: [5]Comments{nil, nil, nil, nil, tk[9:15].toTypescript()},
 															Tokens:   tk[8:15],
 														},
 														Arguments: &Arguments{
@@ -1854,7 +1868,7 @@ i <J> () {}
 																IdentifierReference: &tk[8],
 																Tokens:              tk[8:9],
 															},
-															Comments: [5]Comments{nil, nil, nil, nil, jsParser(tk[9:18]).toTypescript()},
+															Comments: [5]Comments{nil, nil, nil, nil, tk[9:18].toTypescript()},
 															Tokens:   tk[8:18],
 														},
 														Arguments: &Arguments{
@@ -1907,8 +1921,9 @@ i <J> () {}
 													FunctionBody: &Block{
 														Tokens: tk[19:21],
 													},
-													Comments: [5]Comments{nil, nil, jsParser(tk[9:16]).toTypescript()},
-													Tokens:   tk[6:21],
+													Comments:          [5]Comments{nil, nil, tk[9:16].toTypescript()},
+													DirectivePrologue: DirectivePrologue{Strict: true},
+													Tokens:            tk[6:21],
 												},
 												Tokens: tk[6:21],
 											},
@@ -2378,7 +2393,7 @@ i <J> () {}
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:14]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:14].toTypescript()},
 							Tokens:   tk[:14],
 						},
 						Tokens: tk[:14],
@@ -2403,7 +2418,7 @@ i <J> () {}
 													PrivateIdentifier: &tk[5],
 													Tokens:            tk[5:6],
 												},
-												Comments: jsParser(tk[6:9]).toTypescript(),
+												Comments: tk[6:9].toTypescript(),
 												Tokens:   tk[5:9],
 											},
 											Tokens: tk[5:9],
@@ -2432,7 +2447,7 @@ i <J> () {}
 									BindingIdentifier: &tk[4],
 									Tokens:            tk[:8],
 								},
-								Comments: jsParser(tk[:1]).toTypescript(),
+								Comments: tk[:1].toTypescript(),
 								Tokens:   tk[:8],
 							},
 							Tokens: tk[:8],
@@ -2476,10 +2491,10 @@ public abstract d;
 							Declaration: &Declaration{
 								ClassDeclaration: &ClassDeclaration{
 									BindingIdentifier: &tk[4],
-									Comments:          [5]Comments{nil, nil, nil, nil, append(append(append(jsParser(tk[8:17]).toTypescript(), jsParser(tk[18:22]).toTypescript()...), jsParser(tk[23:30]).toTypescript()...), jsParser(tk[31:37]).toTypescript()...)},
+									Comments:          [5]Comments{nil, nil, nil, nil, append(append(append(tk[8:17].toTypescript(), tk[18:22].toTypescript()...), tk[23:30].toTypescript()...), tk[31:37].toTypescript()...)},
 									Tokens:            tk[:39],
 								},
-								Comments: jsParser(tk[:1]).toTypescript(),
+								Comments: tk[:1].toTypescript(),
 								Tokens:   tk[:39],
 							},
 							Tokens: tk[:39],
@@ -2496,7 +2511,7 @@ public abstract d;
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{jsParser(tk[:9]).toTypescript()},
+							Comments: [2]Comments{tk[:9].toTypescript()},
 							Tokens:   tk[:9],
 						},
 						Tokens: tk[:9],
@@ -2524,7 +2539,7 @@ public abstract d;
 													},
 													Tokens: tk[5:6],
 												},
-												Comments: jsParser(tk[6:10]).toTypescript(),
+												Comments: tk[6:10].toTypescript(),
 												Tokens:   tk[5:10],
 											},
 											Tokens: tk[5:10],
@@ -2553,7 +2568,7 @@ public abstract d;
 									BindingList: []LexicalBinding{
 										{
 											BindingIdentifier: &tk[4],
-											Comments:          [2]Comments{nil, jsParser(tk[5:8]).toTypescript()},
+											Comments:          [2]Comments{nil, tk[5:8].toTypescript()},
 											Tokens:            tk[4:8],
 										},
 									},
@@ -2581,7 +2596,7 @@ public abstract d;
 									BindingList: []LexicalBinding{
 										{
 											BindingIdentifier: &tk[2],
-											Comments:          [2]Comments{nil, jsParser(tk[3:19]).toTypescript()},
+											Comments:          [2]Comments{nil, tk[3:19].toTypescript()},
 											Tokens:            tk[2:19],
 										},
 									},
@@ -2628,7 +2643,8 @@ public abstract d;
 														}),
 														Tokens: tk[13:14],
 													},
-													Tokens: tk[6:14],
+													DirectivePrologue: DirectivePrologue{Strict: true},
+													Tokens:            tk[6:14],
 												},
 												Tokens: tk[6:14],
 											},
@@ -2665,7 +2681,7 @@ public abstract d;
 														FormalParameterList: []BindingElement{
 															{
 																SingleNameBinding: &tk[7],
-																Comments:          [2]Comments{nil, jsParser(tk[8:11]).toTypescript()},
+																Comments:          [2]Comments{nil, tk[8:11].toTypescript()},
 																Tokens:            tk[7:11],
 															},
 														},
@@ -2678,8 +2694,9 @@ public abstract d;
 														}),
 														Tokens: tk[30:31],
 													},
-													Comments: [5]Comments{nil, nil, jsParser(tk[12:27]).toTypescript()},
-													Tokens:   tk[6:31],
+													Comments:          [5]Comments{nil, nil, tk[12:27].toTypescript()},
+													DirectivePrologue: DirectivePrologue{Strict: true},
+													Tokens:            tk[6:31],
 												},
 												Tokens: tk[6:31],
 											},
@@ -2723,7 +2740,7 @@ public abstract d;
 																	}),
 																	Tokens: tk[14:15],
 																},
-																Comments: [2]Comments{nil, jsParser(tk[8:11]).toTypescript()},
+																Comments: [2]Comments{nil, tk[8:11].toTypescript()},
 																Tokens:   tk[7:15],
 															},
 														},
@@ -2736,7 +2753,8 @@ public abstract d;
 														}),
 														Tokens: tk[19:20],
 													},
-													Tokens: tk[6:20],
+													DirectivePrologue: DirectivePrologue{Strict: true},
+													Tokens:            tk[6:20],
 												},
 												Tokens: tk[6:20],
 											},
@@ -2850,7 +2868,7 @@ case "b":
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:18]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:18].toTypescript()},
 							Tokens:   tk[:18],
 						},
 						Tokens: tk[:18],
@@ -2865,7 +2883,7 @@ case "b":
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{jsParser(tk[:10]).toTypescript()},
+							Comments: [2]Comments{tk[:10].toTypescript()},
 							Tokens:   tk[:10],
 						},
 						Tokens: tk[:10],
@@ -2880,7 +2898,7 @@ case "b":
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{jsParser(tk[:8]).toTypescript()},
+							Comments: [2]Comments{tk[:8].toTypescript()},
 							Tokens:   tk[:8],
 						},
 						Tokens: tk[:8],
@@ -2895,7 +2913,7 @@ case "b":
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:23]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:23].toTypescript()},
 							Tokens:   tk[:23],
 						},
 						Tokens: tk[:23],
@@ -2922,7 +2940,7 @@ case "b":
 														LiteralPropertyName: &tk[7],
 														Tokens:              tk[7:8],
 													},
-													Comments: [2]Comments{nil, jsParser(tk[9:12]).toTypescript()},
+													Comments: [2]Comments{nil, tk[9:12].toTypescript()},
 													Tokens:   tk[7:12],
 												},
 												Params: FormalParameters{
@@ -2942,7 +2960,7 @@ case "b":
 														LiteralPropertyName: &tk[17],
 														Tokens:              tk[17:18],
 													},
-													Comments: [2]Comments{nil, jsParser(tk[19:22]).toTypescript()},
+													Comments: [2]Comments{nil, tk[19:22].toTypescript()},
 													Tokens:   tk[17:22],
 												},
 												Params: FormalParameters{
@@ -3021,13 +3039,14 @@ case "b":
 											ArrowFunction: &ArrowFunction{
 												FormalParameters: &FormalParameters{
 													BindingIdentifier: &tk[2],
-													Comments:          [5]Comments{nil, nil, nil, jsParser(tk[3:8]).toTypescript()},
+													Comments:          [5]Comments{nil, nil, nil, tk[3:8].toTypescript()},
 													Tokens:            tk[:9],
 												},
 												FunctionBody: &Block{
 													Tokens: tk[12:14],
 												},
-												Tokens: tk[:14],
+												DirectivePrologue: DirectivePrologue{Strict: true},
+												Tokens:            tk[:14],
 											},
 											Tokens: tk[:14],
 										},
@@ -3210,7 +3229,7 @@ case "b":
 																}),
 																Tokens: tk[12:15],
 															},
-															Comments: [2]Comments{nil, jsParser(tk[4:9]).toTypescript()},
+															Comments: [2]Comments{nil, tk[4:9].toTypescript()},
 															Tokens:   tk[1:15],
 														},
 													},
@@ -3223,7 +3242,8 @@ case "b":
 													}),
 													Tokens: tk[19:20],
 												},
-												Tokens: tk[:20],
+												DirectivePrologue: DirectivePrologue{Strict: true},
+												Tokens:            tk[:20],
 											},
 											Tokens: tk[:20],
 										},
@@ -3428,7 +3448,7 @@ case "b":
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:11]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:11].toTypescript()},
 							Tokens:   tk[:11],
 						},
 						Tokens: tk[:11],
@@ -3443,7 +3463,7 @@ case "b":
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:6]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:6].toTypescript()},
 							Tokens:   tk[:6],
 						},
 						Tokens: tk[:6],
@@ -3458,7 +3478,7 @@ case "b":
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:13]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:13].toTypescript()},
 							Tokens:   tk[:13],
 						},
 						Tokens: tk[:13],
@@ -3473,7 +3493,7 @@ case "b":
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{jsParser(tk[:12]).toTypescript()},
+							Comments: [2]Comments{tk[:12].toTypescript()},
 							Tokens:   tk[:12],
 						},
 						Tokens: tk[:12],
@@ -3490,7 +3510,7 @@ case "b":
 						ImportDeclaration: &ImportDeclaration{
 							ImportClause: &ImportClause{
 								NamedImports: &NamedImports{
-									Comments: [2]Comments{nil, jsParser(tk[3:10]).toTypescript()},
+									Comments: [2]Comments{nil, tk[3:10].toTypescript()},
 									Tokens:   tk[2:11],
 								},
 								Tokens: tk[2:11],
@@ -3513,7 +3533,7 @@ case "b":
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:9]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:9].toTypescript()},
 							Tokens:   tk[:9],
 						},
 						Tokens: tk[:9],
@@ -3528,7 +3548,7 @@ case "b":
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:9]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:9].toTypescript()},
 							Tokens:   tk[:9],
 						},
 						Tokens: tk[:9],
@@ -3543,7 +3563,7 @@ case "b":
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:9]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:9].toTypescript()},
 							Tokens:   tk[:9],
 						},
 						Tokens: tk[:9],
@@ -3558,7 +3578,7 @@ case "b":
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:15]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:15].toTypescript()},
 							Tokens:   tk[:15],
 						},
 						Tokens: tk[:15],
@@ -3573,7 +3593,7 @@ case "b":
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:11]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:11].toTypescript()},
 							Tokens:   tk[:11],
 						},
 						Tokens: tk[:11],
@@ -3588,7 +3608,7 @@ case "b":
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:8]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:8].toTypescript()},
 							Tokens:   tk[:8],
 						},
 						Tokens: tk[:8],
@@ -3603,7 +3623,7 @@ case "b":
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:18]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:18].toTypescript()},
 							Tokens:   tk[:18],
 						},
 						Tokens: tk[:18],
@@ -3618,7 +3638,7 @@ case "b":
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:14]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:14].toTypescript()},
 							Tokens:   tk[:14],
 						},
 						Tokens: tk[:14],
@@ -3633,7 +3653,7 @@ case "b":
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:16]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:16].toTypescript()},
 							Tokens:   tk[:16],
 						},
 						Tokens: tk[:16],
@@ -3648,7 +3668,7 @@ case "b":
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:16]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:16].toTypescript()},
 							Tokens:   tk[:16],
 						},
 						Tokens: tk[:16],
@@ -3663,7 +3683,7 @@ case "b":
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:13]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:13].toTypescript()},
 							Tokens:   tk[:13],
 						},
 						Tokens: tk[:13],
@@ -3678,7 +3698,7 @@ case "b":
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:23]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:23].toTypescript()},
 							Tokens:   tk[:23],
 						},
 						Tokens: tk[:23],
@@ -3693,7 +3713,7 @@ case "b":
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:12]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:12].toTypescript()},
 							Tokens:   tk[:12],
 						},
 						Tokens: tk[:12],
@@ -3723,7 +3743,7 @@ case "b":
 														LiteralPropertyName: &tk[7],
 														Tokens:              tk[7:8],
 													},
-													Comments: [2]Comments{nil, jsParser(tk[8:33]).toTypescript()},
+													Comments: [2]Comments{nil, tk[8:33].toTypescript()},
 													Tokens:   tk[7:33],
 												},
 												Params: FormalParameters{
@@ -3732,7 +3752,7 @@ case "b":
 												FunctionBody: Block{
 													Tokens: tk[39:41],
 												},
-												Comments: [4]Comments{nil, nil, jsParser(tk[35:38]).toTypescript()},
+												Comments: [4]Comments{nil, nil, tk[35:38].toTypescript()},
 												Tokens:   tk[7:41],
 											},
 											Tokens: tk[7:41],
@@ -3767,8 +3787,9 @@ function a() {}`, func(t *test, tk Tokens) { // 106
 									FunctionBody: Block{
 										Tokens: tk[26:28],
 									},
-									Comments: [5]Comments{nil, nil, nil, jsParser(tk[3:23]).toTypescript()},
-									Tokens:   tk[:28],
+									Comments:          [5]Comments{nil, nil, nil, tk[3:23].toTypescript()},
+									DirectivePrologue: DirectivePrologue{Strict: true},
+									Tokens:            tk[:28],
 								},
 								Tokens: tk[:28],
 							},
@@ -3797,8 +3818,9 @@ export function a() {}`, func(t *test, tk Tokens) { // 107
 									FunctionBody: Block{
 										Tokens: tk[32:34],
 									},
-									Comments: [5]Comments{nil, nil, nil, jsParser(tk[5:29]).toTypescript()},
-									Tokens:   tk[2:34],
+									Comments:          [5]Comments{nil, nil, nil, tk[5:29].toTypescript()},
+									DirectivePrologue: DirectivePrologue{Strict: true},
+									Tokens:            tk[2:34],
 								},
 								Tokens: tk[2:34],
 							},
@@ -3825,8 +3847,9 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 								FunctionBody: Block{
 									Tokens: tk[32:34],
 								},
-								Comments: [5]Comments{nil, nil, nil, jsParser(tk[5:29]).toTypescript()},
-								Tokens:   tk[4:34],
+								Comments:          [5]Comments{nil, nil, nil, tk[5:29].toTypescript()},
+								DirectivePrologue: DirectivePrologue{Strict: true},
+								Tokens:            tk[4:34],
 							},
 							Tokens: tk[:34],
 						},
@@ -3858,7 +3881,7 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 														LiteralPropertyName: &tk[9],
 														Tokens:              tk[9:10],
 													},
-													Comments: [2]Comments{nil, jsParser(tk[10:39]).toTypescript()},
+													Comments: [2]Comments{nil, tk[10:39].toTypescript()},
 													Tokens:   tk[9:39],
 												},
 												Params: FormalParameters{
@@ -3867,7 +3890,7 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 												FunctionBody: Block{
 													Tokens: tk[45:47],
 												},
-												Comments: [4]Comments{nil, nil, jsParser(tk[41:44]).toTypescript()},
+												Comments: [4]Comments{nil, nil, tk[41:44].toTypescript()},
 												Tokens:   tk[9:47],
 											},
 											Tokens: tk[7:47],
@@ -3923,7 +3946,7 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 												},
 												Tokens: tk[19:26],
 											},
-											Comments: [3]Comments{jsParser(tk[7:17]).toTypescript()},
+											Comments: [3]Comments{tk[7:17].toTypescript()},
 											Tokens:   tk[7:27],
 										},
 										{
@@ -3981,7 +4004,7 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 														},
 														Tokens: tk[:1],
 													},
-													Comments: jsParser(tk[2:13]).toTypescript(),
+													Comments: tk[2:13].toTypescript(),
 													Tokens:   tk[:13],
 												}).LogicalORExpression,
 
@@ -4020,7 +4043,7 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 														FormalParameterList: []BindingElement{
 															{
 																SingleNameBinding: &tk[7],
-																Comments:          [2]Comments{nil, jsParser(tk[9:11]).toTypescript()},
+																Comments:          [2]Comments{nil, tk[9:11].toTypescript()},
 																Tokens:            tk[7:11],
 															},
 														},
@@ -4029,7 +4052,8 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 													FunctionBody: &Block{
 														Tokens: tk[15:17],
 													},
-													Tokens: tk[6:17],
+													DirectivePrologue: DirectivePrologue{Strict: true},
+													Tokens:            tk[6:17],
 												},
 												Tokens: tk[6:17],
 											},
@@ -4105,7 +4129,7 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:19]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:19].toTypescript()},
 							Tokens:   tk[:19],
 						},
 						Tokens: tk[:19],
@@ -4127,7 +4151,7 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 										FormalParameterList: []BindingElement{
 											{
 												SingleNameBinding: &tk[15],
-												Comments:          [2]Comments{nil, jsParser(tk[16:19]).toTypescript()},
+												Comments:          [2]Comments{nil, tk[16:19].toTypescript()},
 												Tokens:            tk[15:19],
 											},
 										},
@@ -4136,8 +4160,9 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 									FunctionBody: Block{
 										Tokens: tk[21:23],
 									},
-									Comments: [5]Comments{nil, nil, nil, jsParser(tk[3:14]).toTypescript()},
-									Tokens:   tk[:23],
+									Comments:          [5]Comments{nil, nil, nil, tk[3:14].toTypescript()},
+									DirectivePrologue: DirectivePrologue{Strict: true},
+									Tokens:            tk[:23],
 								},
 								Tokens: tk[:23],
 							},
@@ -4155,7 +4180,7 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:28]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:28].toTypescript()},
 							Tokens:   tk[:28],
 						},
 						Tokens: tk[:28],
@@ -4188,8 +4213,9 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 																}),
 																Tokens: tk[13:14],
 															},
-															Comments: [5]Comments{nil, nil, jsParser(tk[3:10]).toTypescript()},
-															Tokens:   tk[1:14],
+															Comments:          [5]Comments{nil, nil, tk[3:10].toTypescript()},
+															DirectivePrologue: DirectivePrologue{Strict: true},
+															Tokens:            tk[1:14],
 														},
 														Tokens: tk[1:14],
 													},
@@ -4236,7 +4262,7 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 												FunctionBody: Block{
 													Tokens: tk[16:18],
 												},
-												Comments: [4]Comments{nil, nil, jsParser(tk[8:15]).toTypescript()},
+												Comments: [4]Comments{nil, nil, tk[8:15].toTypescript()},
 												Tokens:   tk[5:18],
 											},
 											Tokens: tk[5:18],
@@ -4260,7 +4286,7 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:36]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:36].toTypescript()},
 							Tokens:   tk[:36],
 						},
 						Tokens: tk[:36],
@@ -4291,7 +4317,7 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 										},
 										Tokens: tk[6:7],
 									},
-									Comments: [5]Comments{nil, nil, jsParser(tk[7:13]).toTypescript()},
+									Comments: [5]Comments{nil, nil, tk[7:13].toTypescript()},
 									Tokens:   tk[:16],
 								},
 								Tokens: tk[:16],
@@ -4323,7 +4349,7 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 												}),
 												Tokens: tk[14:15],
 											},
-											Comments: [2]Comments{nil, jsParser(tk[3:11]).toTypescript()},
+											Comments: [2]Comments{nil, tk[3:11].toTypescript()},
 											Tokens:   tk[2:15],
 										},
 									},
@@ -4345,7 +4371,7 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:11]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:11].toTypescript()},
 							Tokens:   tk[:11],
 						},
 						Tokens: tk[:11],
@@ -4389,7 +4415,7 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 												Arguments: &Arguments{
 													Tokens: tk[10:12],
 												},
-												Comments: [5]Comments{nil, jsParser(tk[7:10]).toTypescript()},
+												Comments: [5]Comments{nil, tk[7:10].toTypescript()},
 												Tokens:   tk[:12],
 											}),
 											Tokens: tk[:12],
@@ -4429,7 +4455,7 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 													Arguments: &Arguments{
 														Tokens: tk[5:7],
 													},
-													Comments: [4]Comments{jsParser(tk[2:5]).toTypescript()},
+													Comments: [4]Comments{tk[2:5].toTypescript()},
 													Tokens:   tk[1:7],
 												},
 												Tokens: tk[:7],
@@ -4475,7 +4501,7 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 													Arguments: &Arguments{
 														Tokens: tk[6:8],
 													},
-													Comments: [4]Comments{jsParser(tk[3:6]).toTypescript()},
+													Comments: [4]Comments{tk[3:6].toTypescript()},
 													Tokens:   tk[1:8],
 												},
 												Tokens: tk[:8],
@@ -4522,7 +4548,7 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 																	},
 																	Tokens: tk[6:7],
 																},
-																Comments: jsParser(tk[8:11]).toTypescript(),
+																Comments: tk[8:11].toTypescript(),
 																Tokens:   tk[6:11],
 															}).LogicalORExpression.LogicalANDExpression.BitwiseORExpression,
 															Tokens: tk[6:11],
@@ -4648,7 +4674,7 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:10]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:10].toTypescript()},
 							Tokens:   tk[:10],
 						},
 						Tokens: tk[:10],
@@ -4663,7 +4689,7 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:14]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:14].toTypescript()},
 							Tokens:   tk[:14],
 						},
 						Tokens: tk[:14],
@@ -4678,7 +4704,7 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{nil, jsParser(tk[:15]).toTypescript()},
+							Comments: [2]Comments{nil, tk[:15].toTypescript()},
 							Tokens:   tk[:15],
 						},
 						Tokens: tk[:15],
@@ -4693,7 +4719,7 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 				ModuleListItems: []ModuleItem{
 					{
 						StatementListItem: &StatementListItem{
-							Comments: [2]Comments{jsParser(tk[:18]).toTypescript()},
+							Comments: [2]Comments{tk[:18].toTypescript()},
 							Tokens:   tk[:18],
 						},
 						Tokens: tk[:18],
@@ -4704,7 +4730,7 @@ export default function() {}`, func(t *test, tk Tokens) { // 108
 		}},
 	}, func(t *test) (Type, error) {
 		if t.Typescript {
-			t.Tokens[:cap(t.Tokens)][cap(t.Tokens)-1].Data = tsMarker
+			t.Tokens.marker().Data = tsMarker
 		}
 
 		var m Module
@@ -5341,15 +5367,15 @@ func TestTypescriptTypes(t *testing.T) {
 		if j, err := newJSParser(&tk); err != nil {
 			t.Errorf("test %d: unexpected error: %s", n+1, err)
 		} else {
-			j[:cap(j)][cap(j)-1].Data = tsMarker
+			j.marker().Data = tsMarker
 			g := j
 
 			if !test.Fn(&j) {
 				t.Errorf("test %d: failed on specific type fn ", n+1)
 			} else if !g.ReadType() {
 				t.Errorf("test %d: failed on generic type fn", n+1)
-			} else if len(j) != len(g) {
-				t.Errorf("test %d: inconsistent number of tokens read. %d != %d", n+1, len(j), len(g))
+			} else if len(j.tokens) != len(g.tokens) {
+				t.Errorf("test %d: inconsistent number of tokens read. %d != %d", n+1, len(j.tokens), len(g.tokens))
 			}
 		}
 	}
@@ -5378,6 +5404,298 @@ func TestAsTypescript(t *testing.T) {
 	}
 }
 
+func TestTypescriptEnumNamespace(t *testing.T) {
+	for n, test := range [...]struct {
+		Input, Output string
+		Err           error
+	}{
+		{ // 1
+			"enum A {B, C}",
+			"var A = (function (A) {\n\tA[A[\"B\"] = 0] = \"B\";\n\tA[A[\"C\"] = 1] = \"C\";\n\treturn A;\n})(A || {});",
+			nil,
+		},
+		{ // 2
+			"enum A {B = 1 << 2, C = B | 1, D = ~C, E = \"e\", F = 2 ** 3 % 5, G = `g` + A.F}",
+			"var A = (function (A) {\n\tA[A[\"B\"] = 4] = \"B\";\n\tA[A[\"C\"] = 5] = \"C\";\n\tA[A[\"D\"] = -6] = \"D\";\n\tA[\"E\"] = \"e\";\n\tA[A[\"F\"] = 3] = \"F\";\n\tA[\"G\"] = \"g3\";\n\treturn A;\n})(A || {});",
+			nil,
+		},
+		{ // 3
+			"enum A {B = f(), C = B + 1}",
+			"var A = (function (A) {\n\tA[A[\"B\"] = f()] = \"B\";\n\tA[A[\"C\"] = A.B + 1] = \"C\";\n\treturn A;\n})(A || {});",
+			nil,
+		},
+		{ // 4
+			"enum A {B = f(), C}",
+			"",
+			ErrInvalidEnumMember,
+		},
+		{ // 5
+			"enum A {B = \"b\", C}",
+			"",
+			ErrInvalidEnumMember,
+		},
+		{ // 6
+			"export enum A {B}",
+			"export var A = (function (A) {\n\tA[A[\"B\"] = 0] = \"B\";\n\treturn A;\n})(A || {});",
+			nil,
+		},
+		{ // 7
+			"const enum A {B = -1, C = \"c\"}\nf(A.B, A[\"C\"], A[x]);",
+			"var A = (function (A) {\n\tA[A[\"B\"] = -1] = \"B\";\n\tA[\"C\"] = \"c\";\n\treturn A;\n})(A || {});\n\nf((-1), \"c\", A[x]);",
+			nil,
+		},
+		{ // 8
+			"export const enum A {B = 2}\nA.B;",
+			"export var A = (function (A) {\n\tA[A[\"B\"] = 2] = \"B\";\n\treturn A;\n})(A || {});\n\n2;",
+			nil,
+		},
+		{ // 9
+			"const enum A {B}\nfunction f(A) {\n\treturn A.B;\n}",
+			"var A = (function (A) {\n\tA[A[\"B\"] = 0] = \"B\";\n\treturn A;\n})(A || {});\n\nfunction f(A) {\n\treturn A.B;\n}",
+			nil,
+		},
+		{ // 10
+			"declare enum A {B}\ndeclare const enum C {D}\nlet e = 1;",
+			"let e = 1;",
+			nil,
+		},
+		{ // 11
+			"namespace A {\n\texport const b = 1, {c} = d;\n\texport function e() {}\n\tlet f = 2;\n\texport type G = H;\n}",
+			"var A = (function (A) {\n\tconst b = 1, {c} = d;\n\tA.b = b;\n\tA.c = c;\n\tfunction e() {}\n\tA.e = e;\n\tlet f = 2;\n\n\treturn A;\n})(A || {});",
+			nil,
+		},
+		{ // 12
+			"namespace A.B {\n\texport enum C {D}\n}",
+			"var A = (function (A) {\n\tvar B = (function (B) {\n\t\tvar C = (function (C) {\n\t\t\tC[C[\"D\"] = 0] = \"D\";\n\t\t\treturn C;\n\t\t})(B.C || {});\n\t\tB.C = C;\n\t\treturn B;\n\t})(A.B || {});\n\tA.B = B;\n\treturn A;\n})(A || {});",
+			nil,
+		},
+		{ // 13
+			"module A {\n\texport class B {}\n}",
+			"var A = (function (A) {\n\tclass B {}\n\tA.B = B;\n\treturn A;\n})(A || {});",
+			nil,
+		},
+		{ // 14
+			"export namespace A {\n\texport interface B {}\n}\nlet c = 1;",
+			"let c = 1;",
+			nil,
+		},
+		{ // 15
+			"declare namespace A {\n\tconst b: number;\n}\ndeclare module C.D {}\nlet e = 1;",
+			"let e = 1;",
+			nil,
+		},
+		{ // 16
+			"namespace A {\n\texport {b};\n}",
+			"",
+			ErrInvalidExportDeclaration,
+		},
+		{ // 17
+			"namespace A {\n\texport var b = 1;\n\tb = 2;\n\texport let c = b, d;\n\tfunction e(b) {\n\t\treturn b;\n\t}\n\tfunction f() {\n\t\treturn {b, g: b.h};\n\t}\n}",
+			"var A = (function (A) {\n\tA.b = 1;\n\tA.b = 2;\n\tA.c = A.b;\n\tfunction e(b) {\n\t\treturn b;\n\t}\n\tfunction f() {\n\t\treturn {b: A.b, g: A.b.h};\n\t}\n\treturn A;\n})(A || {});",
+			nil,
+		},
+		{ // 18
+			"export enum A {B}\nexport enum A {C = 2}",
+			"export var A = (function (A) {\n\tA[A[\"B\"] = 0] = \"B\";\n\treturn A;\n})(A || {});\n\n(function (A) {\n\tA[A[\"C\"] = 2] = \"C\";\n})(A || (A = {}));",
+			nil,
+		},
+		{ // 19
+			"class A {}\nnamespace A {\n\texport const b = 1;\n}",
+			"class A {}\n\n(function (A) {\n\tA.b = 1;\n})(A || (A = {}));",
+			nil,
+		},
+		{ // 20
+			"namespace A {\n\texport const b = 1;\n\texport function c() {}\n}\nnamespace A {\n\texport const d = b + c();\n\tfunction e(b) {\n\t\treturn b;\n\t}\n}",
+			"var A = (function (A) {\n\tA.b = 1;\n\tfunction c() {}\n\tA.c = c;\n\treturn A;\n})(A || {});\n\n(function (A) {\n\tA.d = A.b + A.c();\n\tfunction e(b) {\n\t\treturn b;\n\t}\n})(A || (A = {}));",
+			nil,
+		},
+		{ // 21
+			"namespace A.B {\n\texport const c = 1;\n}\nnamespace A.B {\n\texport const d = c;\n}",
+			"var A = (function (A) {\n\tvar B = (function (B) {\n\t\tB.c = 1;\n\t\treturn B;\n\t})(A.B || {});\n\tA.B = B;\n\treturn A;\n})(A || {});\n\n(function (A) {\n\tvar B = (function (B) {\n\t\tB.d = B.c;\n\t\treturn B;\n\t})(A.B || {});\n\tA.B = B;\n})(A || (A = {}));",
+			nil,
+		},
+		{ // 22
+			"enum A {B}\nfunction f() {\n\tenum A {C}\n}",
+			"var A = (function (A) {\n\tA[A[\"B\"] = 0] = \"B\";\n\treturn A;\n})(A || {});\n\nfunction f() {\n\tvar A = (function (A) {\n\t\tA[A[\"C\"] = 0] = \"C\";\n\t\treturn A;\n\t})(A || {});\n}",
+			nil,
+		},
+	} {
+		tk := parser.NewStringTokeniser(test.Input)
+
+		m, err := ParseModule(AsTypescript(&tk))
+		if test.Err != nil {
+			if !errors.Is(err, test.Err) {
+				t.Errorf("test %d: expecting error %v, got %v", n+1, test.Err, err)
+			}
+		} else if err != nil {
+			t.Errorf("test %d: unexpected error: %s", n+1, err)
+		} else if str := strings.TrimSpace(fmt.Sprintf("%s", m)); str != test.Output {
+			t.Errorf("test %d: expecting output %q, got %q", n+1, test.Output, str)
+		}
+	}
+}
+
+func TestTypescriptParameterProperties(t *testing.T) {
+	for n, test := range [...]struct {
+		Input, Output string
+		Options       []TypescriptOption
+		Err           error
+	}{
+		{ // 1
+			"class A {\n\tconstructor(private a, public b: number, protected c = 1, readonly d, public override readonly e, f) {}\n}",
+			"class A {\n\tconstructor(a, b, c = 1, d, e, f) {\n\t\tthis.a = a;\n\t\tthis.b = b;\n\t\tthis.c = c;\n\t\tthis.d = d;\n\t\tthis.e = e;\n\t}\n}",
+			nil,
+			nil,
+		},
+		{ // 2
+			"class A extends B {\n\tconstructor(private a) {\n\t\t\"use strict\";\n\t\tf();\n\t\tsuper(a);\n\t\tg();\n\t}\n}",
+			"class A extends B {\n\tconstructor(a) {\n\t\t\"use strict\";\n\t\tf();\n\t\tsuper(a);\n\t\tthis.a = a;\n\t\tg();\n\t}\n}",
+			nil,
+			nil,
+		},
+		{ // 3
+			"class A extends B {\n\tconstructor(private a) {\n\t\t\"use strict\";\n\t\tif (a) super();\n\t}\n}",
+			"class A extends B {\n\tconstructor(a) {\n\t\t\"use strict\";\n\t\tconst _super = (...args) => {\n\t\t\tsuper(...args);\n\t\t\tthis.a = a;\n\t\t\treturn this;\n\t\t};\n\t\tif (a) _super();\n\t}\n}",
+			nil,
+			nil,
+		},
+		{ // 4
+			"class A {\n\tb = 1;\n\tconstructor(private a) {}\n}",
+			"class A {\n\ta;\n\tb = 1;\n\tconstructor(a) {\n\t\tthis.a = a;\n\t}\n}",
+			[]TypescriptOption{UseDefineForClassFields},
+			nil,
+		},
+		{ // 5
+			"class A {\n\tb = 1;\n\tconstructor(private a) {}\n}",
+			"class A {\n\tconstructor(a) {\n\t\tthis.a = a;\n\t\tthis.b = 1;\n\t}\n}",
+			[]TypescriptOption{AssignClassFields},
+			nil,
+		},
+		{ // 6
+			"const a = class {\n\tconstructor(readonly b) {}\n\tm(c) {}\n};",
+			"const a = class {\n\tb;\n\tconstructor(b) {\n\t\tthis.b = b;\n\t}\n\tm(c) {}\n};",
+			[]TypescriptOption{UseDefineForClassFields},
+			nil,
+		},
+		{ // 7
+			"class A {\n\tconstructor(readonly) {}\n\tstatic constructor(private a) {}\n}",
+			"class A {\n\tconstructor(readonly) {}\n\tstatic constructor(a) {}\n}",
+			nil,
+			nil,
+		},
+		{ // 8
+			"class A extends B {\n\tx = this.a;\n\t'y-z' = 1;\n\t#p = 2;\n\tstatic s = 3;\n\tq;\n\tconstructor(private a) {\n\t\tsuper();\n\t}\n}",
+			"class A extends B {\n\t#p;\n\tstatic s = 3;\n\tq;\n\tconstructor(a) {\n\t\tsuper();\n\t\tthis.a = a;\n\t\tthis.x = this.a;\n\t\tthis['y-z'] = 1;\n\t\tthis.#p = 2;\n\t}\n}",
+			[]TypescriptOption{AssignClassFields},
+			nil,
+		},
+		{ // 9
+			"class A extends B {\n\tconstructor(public a) {\n\t\tconst f = () => super();\n\t\tf();\n\t}\n}",
+			"class A extends B {\n\tconstructor(a) {\n\t\tconst _super = (...args) => {\n\t\t\tsuper(...args);\n\t\t\tthis.a = a;\n\t\t\treturn this;\n\t\t};\n\t\tconst f = () => _super();\n\t\tf();\n\t}\n}",
+			nil,
+			nil,
+		},
+		{ // 10
+			"class C {\n\ta = 1;\n\t#p = this.a + 1;\n\tconstructor(public x) {}\n}",
+			"class C {\n\t#p;\n\tconstructor(x) {\n\t\tthis.x = x;\n\t\tthis.a = 1;\n\t\tthis.#p = this.a + 1;\n\t}\n}",
+			[]TypescriptOption{AssignClassFields},
+			nil,
+		},
+		{ // 11
+			"class A {\n\ta = 1;\n\tb;\n}",
+			"class A {\n\tconstructor() {\n\t\tthis.a = 1;\n\t}\n\tb;\n}",
+			[]TypescriptOption{AssignClassFields},
+			nil,
+		},
+		{ // 12
+			"class A extends B {\n\ta = 1;\n}",
+			"class A extends B {\n\tconstructor() {\n\t\tsuper(...arguments);\n\t\tthis.a = 1;\n\t}\n}",
+			[]TypescriptOption{AssignClassFields},
+			nil,
+		},
+		{ // 13
+			"class A {\n\ta = 1;\n}",
+			"class A {\n\ta = 1;\n}",
+			[]TypescriptOption{UseDefineForClassFields},
+			nil,
+		},
+		{ // 14
+			"class A {\n\t[b] = 1;\n\tc = 2;\n\tconstructor(public d) {}\n}",
+			"class A {\n\t[b] = 1;\n\tc = 2;\n\tconstructor(d) {\n\t\tthis.d = d;\n\t}\n}",
+			nil,
+			nil,
+		},
+		{ // 15
+			"class A {\n\tconstructor(private {a}) {}\n}",
+			"",
+			nil,
+			ErrInvalidParameter,
+		},
+		{ // 16
+			"class A {\n\tconstructor(public readonly [a]) {}\n}",
+			"",
+			nil,
+			ErrInvalidParameter,
+		},
+		{ // 17
+			"class A {\n\ta = 1;\n\tconstructor(public b) {}\n}",
+			"class A {\n\ta = 1;\n\tconstructor(b) {\n\t\tthis.b = b;\n\t}\n}",
+			nil,
+			nil,
+		},
+		{ // 18
+			"const a = 1;\n\nclass A {\n\tx = a;\n\tconstructor(a) {}\n}",
+			"",
+			[]TypescriptOption{AssignClassFields},
+			ErrShadowedInitializer,
+		},
+		{ // 19
+			"class A {\n\tx = a;\n\tconstructor() {\n\t\tvar a;\n\t}\n}",
+			"",
+			[]TypescriptOption{AssignClassFields},
+			ErrShadowedInitializer,
+		},
+		{ // 20
+			"class A {\n\tx = (a) => a;\n\ty = b;\n\tconstructor(a) {}\n}",
+			"class A {\n\tconstructor(a) {\n\t\tthis.x = (a) => a;\n\t\tthis.y = b;\n\t}\n}",
+			[]TypescriptOption{AssignClassFields},
+			nil,
+		},
+		{ // 21
+			"class A extends B {\n\tx = 1;\n\tconstructor(public a, _super) {\n\t\treturn super(), f();\n\t}\n}",
+			"class A extends B {\n\tconstructor(a, _super) {\n\t\tconst _super1 = (...args) => {\n\t\t\tsuper(...args);\n\t\t\tthis.a = a;\n\t\t\tthis.x = 1;\n\t\t\treturn this;\n\t\t};\n\t\treturn _super1(), f();\n\t}\n}",
+			[]TypescriptOption{AssignClassFields},
+			nil,
+		},
+		{ // 22
+			"class A extends B {\n\tconstructor(public a) {\n\t\treturn {};\n\t}\n}",
+			"class A extends B {\n\tconstructor(a) {\n\t\treturn {};\n\t}\n}",
+			nil,
+			nil,
+		},
+		{ // 23
+			"class A {\n\ta = 1;\n}",
+			"class A {\n\ta = 1;\n}",
+			[]TypescriptOption{UseDefineForClassFields, AssignClassFields},
+			nil,
+		},
+	} {
+		for o, fn := range [...]func(Tokeniser, ...TypescriptOption) Tokeniser{AsTypescript, AsTypescriptAST} {
+			tk := parser.NewStringTokeniser(test.Input)
+
+			if m, err := ParseModule(fn(&tk, test.Options...)); test.Err != nil {
+				if !errors.Is(err, test.Err) {
+					t.Errorf("test %d.%d: expecting error %v, got %v", n+1, o+1, test.Err, err)
+				}
+			} else if err != nil {
+				t.Errorf("test %d.%d: unexpected error: %s", n+1, o+1, err)
+			} else if str := fmt.Sprintf("%s", m); str != test.Output {
+				t.Errorf("test %d.%d: expecting output %q, got %q", n+1, o+1, test.Output, str)
+			}
+		}
+	}
+}
+
 func TestPrintingTypescript(t *testing.T) {
 	var st state
 
@@ -5679,6 +5997,11 @@ func TestPrintingTypescript(t *testing.T) {
 			"function a(b /* A */ /*: c*/ /* D */, e) {}",
 			"function a(b /* A *//*: c*/ /* D */, e) {}",
 		},
+		{ // 60
+			"class A {constructor(/* A */ private /* B */ readonly b /* C */) {}}",
+			"class A {\n\tconstructor( /* A */ /*private /* B * / readonly*/ b /* C */) {\n\t\tthis.b = b;\n\t}\n}",
+			"class A {constructor(/* A */ /*private /* B * / readonly*/ b /* C */) {this.b=b}}",
+		},
 	} {
 		s, err := ParseModule(AsTypescript(makeTokeniser(parser.NewStringTokeniser(test.Input))))
 		if err != nil {
@@ -5706,3 +6029,102 @@ func TestPrintingTypescript(t *testing.T) {
 		}
 	}
 }
+
+func TestPrintingTypescriptAST(t *testing.T) {
+	for n, test := range [...]struct {
+		Input, Output, Verbose string
+	}{
+		{ // 1
+			"let a: number = 1",
+			"let a = 1;",
+			"let a: number = 1;",
+		},
+		{ // 2
+			"type A<B extends string = \"c\"> = B[] | keyof D & {readonly [E in B]-?: D[E]}",
+			"",
+			"type A<B extends string = \"c\"> = B[] | keyof D & { readonly [E in B]-?: D[E] };",
+		},
+		{ // 3
+			"interface A<B> extends C, D.E<B> { f?: number; g(h: string, ...i: number[]): void; readonly [j: string]: any; new (k: B): A<B>; get l(): number }",
+			"",
+			"interface A<B> extends C, D.E<B> {\n\tf?: number;\n\tg(h: string, ...i: number[]): void;\n\treadonly [j: string]: any;\n\tnew (k: B): A<B>;\n\tget l(): number;\n}",
+		},
+		{ // 4
+			"export type A = `b${C}d${E}f` | [g: string, h?: number, ...I[]] | -1 | (J extends infer K ? K : never)",
+			"",
+			"export type A = `b${C}d${E}f` | [g: string, h?: number, ...I[]] | -1 | (J extends infer K ? K : never);",
+		},
+		{ // 5
+			"export interface A {}",
+			"",
+			"export interface A {}",
+		},
+		{ // 6
+			"function a<B>(c?: B, {d}: E = {}, ...f: B[]): B { return c }",
+			"function a(c, {d} = {}, ...f) {\n\treturn c;\n}",
+			"function a<B>(c?: B, {d: d}: E = {}, ...f: B[]): B {\n\treturn c;\n}",
+		},
+		{ // 7
+			"class A<B> extends C { d?: B; e<F>(g: F): B { return g } }",
+			"class A extends C {\n\td;\n\te(g) {\n\t\treturn g;\n\t}\n}",
+			"class A<B> extends C {\n\td?: B;\n\te<F>(g: F): B {\n\t\treturn g;\n\t}\n}",
+		},
+		{ // 8
+			"const a = async <B,>(c: B): Promise<B> => c;",
+			"const a = async (c) => c;",
+			"const a = async <B>(c: B): Promise<B> => c;",
+		},
+		{ // 9
+			"let a: (b: string) => b is string",
+			"let a;",
+			"let a: (b: string) => b is string;",
+		},
+		{ // 10
+			"interface A { b: string, c(): void\n d: number }",
+			"",
+			"interface A {\n\tb: string;\n\tc(): void;\n\td: number;\n}",
+		},
+		{ // 11
+			"abstract class A<B> extends C<B> implements D, E<B> { abstract f: B; [g: string]: any; h(i: string): void; h(i) {} }",
+			"class A extends C {\n\th(i) {}\n}",
+			"abstract class A<B> extends C<B> implements D, E<B> {\n\tabstract f: B;\n\t[g: string]: any;\n\th(i: string): void;\n\th(i) {}\n}",
+		},
+		{ // 12
+			"function a(b: string): string;\nfunction a(b) { return b }",
+			"function a(b) {\n\treturn b;\n}",
+			"function a(b: string): string;\nfunction a(b) {\n\treturn b;\n}",
+		},
+		{ // 13
+			"export default function a(b: string): void;\nexport default function a(b) {}",
+			"export default function a(b) {}",
+			"export default function a(b: string): void;\nexport default function a(b) {}",
+		},
+		{ // 14
+			"const enum A { B, C = \"c\" }",
+			"var A = (function (A) {\n\tA[A[\"B\"] = 0] = \"B\";\n\tA[\"C\"] = \"c\";\n\treturn A;\n})(A || {});",
+			"const enum A {\n\tB,\n\tC = \"c\"\n}",
+		},
+		{ // 15
+			"namespace A.B { export const c = 1 }",
+			"var A = (function (A) {\n\tvar B = (function (B) {\n\t\tB.c = 1;\n\t\treturn B;\n\t})(A.B || {});\n\tA.B = B;\n\treturn A;\n})(A || {});",
+			"namespace A.B {\n\texport const c = 1;\n}",
+		},
+		{ // 16
+			"namespace A { export type B = string }",
+			"",
+			"namespace A {\n\texport type B = string;\n}",
+		},
+	} {
+		tk := parser.NewStringTokeniser(test.Input)
+
+		if m, err := ParseModule(AsTypescriptAST(&tk)); err != nil {
+			t.Errorf("test %d: unexpected error: %s", n+1, err)
+		} else if str := fmt.Sprintf("%s", m); str != test.Output {
+			t.Errorf("test %d.1: expecting output %q, got %q", n+1, test.Output, str)
+		} else if str := fmt.Sprintf("%+s", m); str != test.Verbose {
+			t.Errorf("test %d.2: expecting output %q, got %q", n+1, test.Verbose, str)
+		} else if str := fmt.Sprintf("%#s", m); str != test.Input {
+			t.Errorf("test %d.3: expecting output %q, got %q", n+1, test.Input, str)
+		}
+	}
+}
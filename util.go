@@ -1,6 +1,7 @@
 package javascript
 
 import (
+	"fmt"
 	"html"
 	"strconv"
 	"strings"
@@ -88,6 +89,40 @@ func unquoteEscape(ret *strings.Builder, s *parser.Tokeniser) bool {
 	return true
 }
 
+// Quote produces a double quoted JavaScript string, escaping characters as
+// necessary.
+func Quote(str string) string {
+	var sb strings.Builder
+
+	sb.WriteByte('"')
+
+	for _, c := range str {
+		switch c {
+		case '"', '\\':
+			sb.WriteByte('\\')
+			sb.WriteRune(c)
+		case '\n':
+			sb.WriteString("\\n")
+		case '\r':
+			sb.WriteString("\\r")
+		case '\t':
+			sb.WriteString("\\t")
+		case '\u2028', '\u2029':
+			fmt.Fprintf(&sb, "\\u%04x", c)
+		default:
+			if c < ' ' || c == 0x7f {
+				fmt.Fprintf(&sb, "\\x%02x", c)
+			} else {
+				sb.WriteRune(c)
+			}
+		}
+	}
+
+	sb.WriteByte('"')
+
+	return sb.String()
+}
+
 // Unquote parses a JavaScript quoted string and produces the unquoted version
 func Unquote(str string) (string, error) {
 	s := parser.NewStringTokeniser(str)
@@ -232,6 +232,23 @@ func TestUnquote(t *testing.T) {
 	}
 }
 
+func TestQuote(t *testing.T) {
+	for n, test := range [...]struct {
+		Input, Output string
+	}{
+		{``, `""`},                         // 1
+		{`abc`, `"abc"`},                   // 2
+		{`a"b'c`, `"a\"b'c"`},              // 3
+		{"a\\b\n\r\t", `"a\\b\n\r\t"`},     // 4
+		{"\x00\x1f\x7f", `"\x00\x1f\x7f"`}, // 5
+		{"  \U0001F600", "\"\\u2028\\u2029\U0001F600\""}, // 6
+	} {
+		if out := Quote(test.Input); out != test.Output {
+			t.Errorf("test %d: expecting output %q, got %q", n+1, test.Output, out)
+		}
+	}
+}
+
 func TestUnquoteTemplate(t *testing.T) {
 	for n, test := range [...]struct {
 		Input, Output string
@@ -0,0 +1,417 @@
+package validate
+
+import (
+	"slices"
+
+	"vimagination.zapto.org/javascript"
+	"vimagination.zapto.org/javascript/internal/tokens"
+	"vimagination.zapto.org/javascript/scope"
+	"vimagination.zapto.org/javascript/walk"
+)
+
+func (v *validator) validateFunction(params *javascript.FormalParameters, dp *javascript.DirectivePrologue, allowDuplicates bool) *validator {
+	strict := dp.IsStrict()
+	simple := params == nil || isSimpleParameterList(params)
+
+	if allowDuplicates && simple && !v.strict && !strict && params != nil {
+		for _, be := range params.FormalParameterList {
+			v.sloppyParams[be.SingleNameBinding] = struct{}{}
+		}
+	}
+
+	return v.function(strict)
+}
+
+func (v *validator) validateStatement(s *javascript.Statement) error {
+	w := *v
+
+	switch {
+	case s.LabelIdentifier != nil && s.Type == javascript.StatementNormal:
+		if v.findLabel(s.LabelIdentifier.Data) != nil {
+			v.error(ErrDuplicateLabel, "Statement", s.LabelIdentifier)
+		}
+
+		if v.strict && s.LabelledItemFunction != nil {
+			v.error(ErrLabelledFunction, "Statement", tokens.First(s.LabelledItemFunction.Tokens))
+		}
+
+		w.labels = append(slices.Clip(v.labels), label{name: s.LabelIdentifier.Data, iteration: isIteration(s)})
+	case s.Type == javascript.StatementBreak:
+		if s.LabelIdentifier != nil {
+			if v.findLabel(s.LabelIdentifier.Data) == nil {
+				v.error(ErrUndefinedLabel, "Statement", s.LabelIdentifier)
+			}
+		} else if !v.inBreak {
+			v.error(ErrInvalidBreak, "Statement", tokens.First(s.Tokens))
+		}
+	case s.Type == javascript.StatementContinue:
+		if s.LabelIdentifier != nil {
+			if l := v.findLabel(s.LabelIdentifier.Data); l == nil {
+				v.error(ErrUndefinedLabel, "Statement", s.LabelIdentifier)
+			} else if !l.iteration {
+				v.error(ErrInvalidContinueLabel, "Statement", s.LabelIdentifier)
+			}
+		} else if !v.inIteration {
+			v.error(ErrInvalidContinue, "Statement", tokens.First(s.Tokens))
+		}
+	case s.IterationStatementDo != nil, s.IterationStatementWhile != nil, s.IterationStatementFor != nil:
+		w.inIteration = true
+		w.inBreak = true
+	case s.SwitchStatement != nil:
+		w.inBreak = true
+
+		v.validateUsingPlacement(s.SwitchStatement.DefaultClause)
+	}
+
+	return walk.Walk(s, &w)
+}
+
+func (v *validator) validateUsingPlacement(sl []javascript.StatementListItem) {
+	for _, sli := range sl {
+		if sli.Declaration != nil && sli.Declaration.LexicalDeclaration != nil && sli.Declaration.LexicalDeclaration.LetOrConst >= javascript.Using {
+			v.error(ErrInvalidUsingDeclaration, "LexicalDeclaration", tokens.First(sli.Declaration.LexicalDeclaration.Tokens))
+		}
+	}
+}
+
+func (v *validator) validateUsingDeclaration(ld *javascript.LexicalDeclaration) {
+	if ld.LetOrConst < javascript.Using {
+		return
+	}
+
+	for _, lb := range ld.BindingList {
+		if lb.Initializer == nil {
+			v.error(ErrMissingUsingInitializer, "LexicalBinding", lb.BindingIdentifier)
+		}
+	}
+}
+
+func (v *validator) findLabel(name string) *label {
+	for n := range v.labels {
+		if v.labels[n].name == name {
+			return &v.labels[n]
+		}
+	}
+
+	return nil
+}
+
+func isIteration(s *javascript.Statement) bool {
+	for s.LabelIdentifier != nil && s.LabelledItemStatement != nil {
+		s = s.LabelledItemStatement
+	}
+
+	return s.IterationStatementDo != nil || s.IterationStatementWhile != nil || s.IterationStatementFor != nil
+}
+
+func (v *validator) validateObjectLiteral(ol *javascript.ObjectLiteral) {
+	var proto bool
+
+	for n := range ol.PropertyDefinitionList {
+		pd := &ol.PropertyDefinitionList[n]
+
+		if pd.IsCoverInitializedName || pd.PropertyName == nil || pd.PropertyName.LiteralPropertyName == nil || pd.AssignmentExpression == nil {
+			continue
+		}
+
+		tk := pd.PropertyName.LiteralPropertyName
+
+		if propertyName(tk) != "__proto__" || isShorthand(tk, pd.AssignmentExpression) {
+			continue
+		}
+
+		if proto {
+			v.error(ErrDuplicateProto, "PropertyDefinition", tk)
+		}
+
+		proto = true
+	}
+}
+
+func isShorthand(name *javascript.Token, ae *javascript.AssignmentExpression) bool {
+	pe, ok := javascript.UnwrapConditional(ae.ConditionalExpression).(*javascript.PrimaryExpression)
+
+	return ok && pe.IdentifierReference != nil && pe.IdentifierReference.Pos == name.Pos
+}
+
+func (v *validator) validateUnaryExpression(ue *javascript.UnaryExpression) {
+	if !v.strict || len(ue.UnaryOperators) == 0 || ue.UnaryOperators[len(ue.UnaryOperators)-1].UnaryOperator != javascript.UnaryDelete {
+		return
+	}
+
+	if tk := identifierReference(javascript.WrapConditional(&ue.UpdateExpression)); tk != nil {
+		v.error(ErrStrictDelete, "UnaryExpression", tk)
+	}
+}
+
+func (v *validator) validateBindingIdentifiers(parsing string, tks ...*javascript.Token) {
+	if !v.strict {
+		return
+	}
+
+	for _, tk := range tks {
+		if tk != nil && (tk.Data == "eval" || tk.Data == "arguments") {
+			v.error(ErrStrictBinding, parsing, tk)
+		}
+	}
+}
+
+func identifierReference(c *javascript.ConditionalExpression) *javascript.Token {
+	switch e := javascript.UnwrapConditional(c).(type) {
+	case *javascript.PrimaryExpression:
+		return e.IdentifierReference
+	case *javascript.ParenthesizedExpression:
+		if len(e.Expressions) == 1 && e.Expressions[0].ConditionalExpression != nil {
+			return identifierReference(e.Expressions[0].ConditionalExpression)
+		}
+	}
+
+	return nil
+}
+
+type privateName struct {
+	static, getter, setter bool
+}
+
+func (v *validator) validateClass(cd *javascript.ClassDeclaration) map[string]privateName {
+	var (
+		constructor bool
+		privates    = make(map[string]privateName)
+	)
+
+	for n := range cd.ClassBody {
+		ce := &cd.ClassBody[n]
+
+		var (
+			name *javascript.ClassElementName
+			pn   = privateName{static: ce.Static}
+		)
+
+		if md := ce.MethodDefinition; md != nil {
+			name = &md.ClassElementName
+			pn.getter = md.Type == javascript.MethodGetter
+			pn.setter = md.Type == javascript.MethodSetter
+
+			if !ce.Static && isConstructor(name) {
+				if constructor {
+					v.error(ErrDuplicateConstructor, "ClassElement", name.PropertyName.LiteralPropertyName)
+				}
+
+				constructor = true
+			}
+		} else if ce.FieldDefinition != nil {
+			name = &ce.FieldDefinition.ClassElementName
+		}
+
+		if name == nil || name.PrivateIdentifier == nil {
+			continue
+		}
+
+		if prev, ok := privates[name.PrivateIdentifier.Data]; !ok {
+			privates[name.PrivateIdentifier.Data] = pn
+		} else if prev.static == pn.static && (prev.getter && !prev.setter && pn.setter || prev.setter && !prev.getter && pn.getter) {
+			privates[name.PrivateIdentifier.Data] = privateName{static: pn.static, getter: true, setter: true}
+		} else {
+			v.error(ErrDuplicatePrivateName, "ClassElement", name.PrivateIdentifier)
+		}
+	}
+
+	return privates
+}
+
+func isConstructor(name *javascript.ClassElementName) bool {
+	return name.PropertyName != nil && name.PropertyName.LiteralPropertyName != nil && propertyName(name.PropertyName.LiteralPropertyName) == "constructor"
+}
+
+func (v *validator) validateMemberExpression(me *javascript.MemberExpression) {
+	switch {
+	case !v.checkContext:
+	case me.NewTarget && !v.newTarget:
+		v.error(ErrInvalidNewTarget, "MemberExpression", tokens.First(me.Tokens))
+	case me.SuperProperty && !v.superProperty:
+		v.error(ErrInvalidSuperProperty, "MemberExpression", tokens.First(me.Tokens))
+	}
+
+	v.validatePrivateName(me.PrivateIdentifier)
+}
+
+func (v *validator) validateCallExpression(ce *javascript.CallExpression) {
+	if v.checkContext && ce.SuperCall && !v.superCall {
+		v.error(ErrInvalidSuperCall, "CallExpression", tokens.First(ce.Tokens))
+	}
+
+	v.validatePrivateName(ce.PrivateIdentifier)
+}
+
+func (v *validator) validatePrivateName(tk *javascript.Token) {
+	if !v.checkContext || tk == nil {
+		return
+	}
+
+	for _, names := range v.privateNames {
+		if _, ok := names[tk.Data]; ok {
+			return
+		}
+	}
+
+	v.error(ErrUndeclaredPrivateName, "PrivateIdentifier", tk)
+}
+
+func (v *validator) validateExportDeclaration(ed *javascript.ExportDeclaration) {
+	if ed.Declaration != nil && ed.Declaration.LexicalDeclaration != nil && ed.Declaration.LexicalDeclaration.LetOrConst >= javascript.Using {
+		v.error(ErrInvalidExportUsing, "ExportDeclaration", tokens.First(ed.Declaration.LexicalDeclaration.Tokens))
+	}
+}
+
+func (v *validator) validateExportNames(m *javascript.Module) {
+	names := make(map[string]struct{})
+	add := func(name string, tk *javascript.Token) {
+		if _, ok := names[name]; ok {
+			v.error(ErrDuplicateExport, "ExportDeclaration", tk)
+		}
+
+		names[name] = struct{}{}
+	}
+
+	for n := range m.ModuleListItems {
+		ed := m.ModuleListItems[n].ExportDeclaration
+
+		switch {
+		case ed == nil:
+		case ed.ExportClause != nil:
+			for _, es := range ed.ExportClause.ExportList {
+				tk := es.EIdentifierName
+				if tk == nil {
+					tk = es.IdentifierName
+				}
+
+				if tk != nil {
+					add(propertyName(tk), tk)
+				}
+			}
+		case ed.ExportFromClause != nil:
+			add(propertyName(ed.ExportFromClause), ed.ExportFromClause)
+		case ed.VariableStatement != nil:
+			for _, tk := range boundNames(ed.VariableStatement.VariableDeclarationList) {
+				add(tk.Data, tk)
+			}
+		case ed.Declaration != nil:
+			if ed.Declaration.FunctionDeclaration != nil && ed.Declaration.FunctionDeclaration.BindingIdentifier != nil {
+				add(ed.Declaration.FunctionDeclaration.BindingIdentifier.Data, ed.Declaration.FunctionDeclaration.BindingIdentifier)
+			} else if ed.Declaration.ClassDeclaration != nil && ed.Declaration.ClassDeclaration.BindingIdentifier != nil {
+				add(ed.Declaration.ClassDeclaration.BindingIdentifier.Data, ed.Declaration.ClassDeclaration.BindingIdentifier)
+			} else if ed.Declaration.LexicalDeclaration != nil {
+				for _, tk := range boundNames(ed.Declaration.LexicalDeclaration.BindingList) {
+					add(tk.Data, tk)
+				}
+			}
+		case ed.DefaultFunction != nil, ed.DefaultClass != nil, ed.DefaultAssignmentExpression != nil:
+			add("default", defaultToken(ed.Tokens))
+		}
+	}
+}
+
+func (v *validator) validateExportBindings(m *javascript.Module, s *scope.Scope) {
+	for n := range m.ModuleListItems {
+		ed := m.ModuleListItems[n].ExportDeclaration
+		if ed == nil || ed.ExportClause == nil || ed.FromClause != nil {
+			continue
+		}
+
+		for _, es := range ed.ExportClause.ExportList {
+			if es.IdentifierName != nil && !isDeclared(s.Bindings[es.IdentifierName.Data]) {
+				v.error(ErrUndeclaredExport, "ExportSpecifier", es.IdentifierName)
+			}
+		}
+	}
+}
+
+func isDeclared(bs []scope.Binding) bool {
+	for _, b := range bs {
+		if b.BindingType != scope.BindingRef && b.BindingType != scope.BindingBare {
+			return true
+		}
+	}
+
+	return false
+}
+
+func boundNames(lbs []javascript.LexicalBinding) []*javascript.Token {
+	var tks []*javascript.Token
+
+	for n := range lbs {
+		if lbs[n].BindingIdentifier != nil {
+			tks = append(tks, lbs[n].BindingIdentifier)
+		} else {
+			tks = appendPatternNames(tks, lbs[n].ArrayBindingPattern, lbs[n].ObjectBindingPattern)
+		}
+	}
+
+	return tks
+}
+
+func appendPatternNames(tks []*javascript.Token, ab *javascript.ArrayBindingPattern, ob *javascript.ObjectBindingPattern) []*javascript.Token {
+	if ab != nil {
+		for n := range ab.BindingElementList {
+			tks = appendElementNames(tks, &ab.BindingElementList[n])
+		}
+
+		tks = appendElementNames(tks, ab.BindingRestElement)
+	}
+
+	if ob != nil {
+		for n := range ob.BindingPropertyList {
+			tks = appendElementNames(tks, &ob.BindingPropertyList[n].BindingElement)
+		}
+
+		if ob.BindingRestProperty != nil {
+			tks = append(tks, ob.BindingRestProperty)
+		}
+	}
+
+	return tks
+}
+
+func appendElementNames(tks []*javascript.Token, be *javascript.BindingElement) []*javascript.Token {
+	if be == nil {
+		return tks
+	} else if be.SingleNameBinding != nil {
+		return append(tks, be.SingleNameBinding)
+	}
+
+	return appendPatternNames(tks, be.ArrayBindingPattern, be.ObjectBindingPattern)
+}
+
+func defaultToken(tks javascript.Tokens) *javascript.Token {
+	for n := range tks {
+		if tks[n].Type == javascript.TokenKeyword && tks[n].Data == "default" {
+			return &tks[n]
+		}
+	}
+
+	return tokens.First(tks)
+}
+
+func isSimpleParameterList(fp *javascript.FormalParameters) bool {
+	if fp.BindingIdentifier != nil || fp.ArrayBindingPattern != nil || fp.ObjectBindingPattern != nil {
+		return false
+	}
+
+	for _, be := range fp.FormalParameterList {
+		if be.SingleNameBinding == nil || be.Initializer != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+func propertyName(tk *javascript.Token) string {
+	if tk.Type == javascript.TokenStringLiteral {
+		if str, err := javascript.Unquote(tk.Data); err == nil {
+			return str
+		}
+	}
+
+	return tk.Data
+}
@@ -0,0 +1,27 @@
+package validate
+
+import "errors"
+
+// Errors.
+var (
+	ErrDuplicateConstructor    = errors.New("duplicate constructor")
+	ErrDuplicateExport         = errors.New("duplicate export name")
+	ErrDuplicateLabel          = errors.New("duplicate label")
+	ErrDuplicatePrivateName    = errors.New("duplicate private name")
+	ErrDuplicateProto          = errors.New("duplicate __proto__ property")
+	ErrInvalidBreak            = errors.New("break statement not within a loop or switch")
+	ErrInvalidContinue         = errors.New("continue statement not within a loop")
+	ErrInvalidContinueLabel    = errors.New("continue label does not denote a loop")
+	ErrInvalidExportUsing      = errors.New("using declaration cannot be exported")
+	ErrInvalidNewTarget        = errors.New("new.target outside of a function")
+	ErrInvalidSuperCall        = errors.New("super call outside of a derived class constructor")
+	ErrInvalidSuperProperty    = errors.New("super property outside of a method")
+	ErrInvalidUsingDeclaration = errors.New("using declaration at the top level of a script or in a switch case")
+	ErrLabelledFunction        = errors.New("labelled function declaration in strict mode")
+	ErrMissingUsingInitializer = errors.New("missing initializer in using declaration")
+	ErrStrictBinding           = errors.New("eval or arguments used as a binding name in strict mode")
+	ErrStrictDelete            = errors.New("delete of an unqualified identifier in strict mode")
+	ErrUndeclaredExport        = errors.New("exported binding is not declared")
+	ErrUndeclaredPrivateName   = errors.New("reference to an undeclared private name")
+	ErrUndefinedLabel          = errors.New("undefined label")
+)
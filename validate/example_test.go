@@ -0,0 +1,32 @@
+package validate_test
+
+import (
+	"fmt"
+
+	"vimagination.zapto.org/javascript"
+	"vimagination.zapto.org/javascript/validate"
+	"vimagination.zapto.org/parser"
+)
+
+func Example() {
+	src := "a: while (true) {\n\tbreak b;\n}\n\ndelete c;"
+
+	tk := parser.NewStringTokeniser(src)
+
+	ast, err := javascript.ParseModule(&tk)
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	for _, err := range validate.Validate(ast) {
+		fmt.Println(err)
+	}
+
+	// Output:
+	// Statement: error at position 26 (2:8):
+	// undefined label
+	// UnaryExpression: error at position 39 (5:8):
+	// delete of an unqualified identifier in strict mode
+}
@@ -0,0 +1,223 @@
+// Package validate checks a JavaScript AST for the early errors defined in ECMA-262.
+package validate // import "vimagination.zapto.org/javascript/validate"
+
+import (
+	"errors"
+	"slices"
+
+	"vimagination.zapto.org/javascript"
+	"vimagination.zapto.org/javascript/scope"
+	"vimagination.zapto.org/javascript/walk"
+)
+
+// Validate checks the given JavaScript type for early errors, as defined in
+// ECMA-262, returning all that are found.
+//
+// Each error is returned as a javascript.Error, with the Token set to the
+// position of the error.
+//
+// A Module is always validated as strict code, as is any class body, and a
+// Script or function is validated as strict code when it contains, or is
+// contained within code with, a 'use strict' directive.
+//
+// Duplicate parameter names are only reported for functions that are strict,
+// or that have a parameter list that isn't simple.
+//
+// The uses of new.target, super, and private names are only checked when
+// validating a Module or Script, as the context of any other type is unknown.
+func Validate(t javascript.Type) []error {
+	switch m := t.(type) {
+	case javascript.Module:
+		t = &m
+	case javascript.Script:
+		t = &m
+	}
+
+	var (
+		v         = &validator{errs: new([]error), sloppyParams: make(map[*javascript.Token]struct{})}
+		scopeErrs []error
+	)
+
+	switch t := t.(type) {
+	case *javascript.Module:
+		var s *scope.Scope
+
+		v.checkContext = true
+
+		s, scopeErrs = scope.BuildWithErrors(t, nil)
+
+		v.validateExportBindings(t, s)
+	case *javascript.Script:
+		_, scopeErrs = scope.BuildWithErrors(t, nil)
+		v.checkContext = true
+	}
+
+	v.Handle(t)
+
+	errs := *v.errs
+	*v.errs = nil
+
+	for _, err := range scopeErrs {
+		v.scopeError(err)
+	}
+
+	return append(*v.errs, errs...)
+}
+
+type label struct {
+	name      string
+	iteration bool
+}
+
+type validator struct {
+	errs                 *[]error
+	sloppyParams         map[*javascript.Token]struct{}
+	strict               bool
+	labels               []label
+	inIteration, inBreak bool
+
+	checkContext                        bool
+	newTarget, superProperty, superCall bool
+	derived, constructor                bool
+	privateNames                        []map[string]privateName
+}
+
+func (v *validator) function(strict bool) *validator {
+	return &validator{
+		errs:          v.errs,
+		sloppyParams:  v.sloppyParams,
+		strict:        v.strict || strict,
+		checkContext:  v.checkContext,
+		newTarget:     v.newTarget,
+		superProperty: v.superProperty,
+		superCall:     v.superCall,
+		privateNames:  v.privateNames,
+	}
+}
+
+func (v *validator) error(err error, parsing string, tk *javascript.Token) {
+	e := javascript.Error{
+		Err:     err,
+		Parsing: parsing,
+	}
+
+	if tk != nil {
+		e.Token = *tk
+	}
+
+	*v.errs = append(*v.errs, e)
+}
+
+func (v *validator) scopeError(err error) {
+	var dd scope.ErrDuplicateDeclaration
+
+	if errors.As(err, &dd) {
+		if _, ok := v.sloppyParams[dd.Duplicate]; ok {
+			return
+		}
+
+		v.error(err, "Scope", dd.Duplicate)
+	} else {
+		v.error(err, "Scope", nil)
+	}
+}
+
+func (v *validator) Handle(t javascript.Type) error {
+	switch t := t.(type) {
+	case *javascript.Module:
+		v.strict = true
+
+		v.validateExportNames(t)
+	case *javascript.Script:
+		v.strict = t.IsStrict()
+
+		v.validateUsingPlacement(t.StatementList)
+	case *javascript.FunctionDeclaration:
+		w := v.validateFunction(&t.FormalParameters, &t.DirectivePrologue, true)
+		w.newTarget = true
+		w.superProperty = false
+		w.superCall = false
+
+		w.validateBindingIdentifiers("FunctionDeclaration", t.BindingIdentifier)
+
+		return walk.Walk(t, w)
+	case *javascript.MethodDefinition:
+		dp := t.FunctionBody.DirectivePrologue()
+		w := v.validateFunction(&t.Params, &dp, false)
+		w.newTarget = true
+		w.superProperty = true
+		w.superCall = v.constructor && v.derived
+
+		return walk.Walk(t, w)
+	case *javascript.ArrowFunction:
+		w := v.function(false)
+
+		if t.FunctionBody != nil {
+			w = v.validateFunction(t.FormalParameters, &t.DirectivePrologue, false)
+		}
+
+		w.validateBindingIdentifiers("ArrowFunction", t.BindingIdentifier)
+
+		return walk.Walk(t, w)
+	case *javascript.ClassDeclaration:
+		w := v.function(true)
+		w.derived = t.ClassHeritage != nil
+		w.privateNames = append(slices.Clip(v.privateNames), v.validateClass(t))
+
+		w.validateBindingIdentifiers("ClassDeclaration", t.BindingIdentifier)
+
+		return walk.Walk(t, w)
+	case *javascript.ClassElement:
+		if t.ClassStaticBlock != nil || t.FieldDefinition != nil {
+			w := v.function(false)
+			w.newTarget = true
+			w.superProperty = true
+			w.superCall = false
+
+			return walk.Walk(t, w)
+		} else if t.MethodDefinition != nil && !t.Static && isConstructor(&t.MethodDefinition.ClassElementName) {
+			w := *v
+			w.constructor = true
+
+			return walk.Walk(t, &w)
+		}
+	case *javascript.ExportDeclaration:
+		v.validateExportDeclaration(t)
+	case *javascript.MemberExpression:
+		v.validateMemberExpression(t)
+	case *javascript.CallExpression:
+		v.validateCallExpression(t)
+	case *javascript.OptionalChain:
+		v.validatePrivateName(t.PrivateIdentifier)
+	case *javascript.RelationalExpression:
+		v.validatePrivateName(t.PrivateIdentifier)
+	case *javascript.Statement:
+		return v.validateStatement(t)
+	case *javascript.CaseClause:
+		v.validateUsingPlacement(t.StatementList)
+	case *javascript.LexicalDeclaration:
+		v.validateUsingDeclaration(t)
+	case *javascript.ObjectLiteral:
+		v.validateObjectLiteral(t)
+	case *javascript.UnaryExpression:
+		v.validateUnaryExpression(t)
+	case *javascript.LexicalBinding:
+		v.validateBindingIdentifiers("LexicalBinding", t.BindingIdentifier)
+	case *javascript.BindingElement:
+		v.validateBindingIdentifiers("BindingElement", t.SingleNameBinding)
+	case *javascript.ObjectBindingPattern:
+		v.validateBindingIdentifiers("ObjectBindingPattern", t.BindingRestProperty)
+	case *javascript.FormalParameters:
+		v.validateBindingIdentifiers("FormalParameters", t.BindingIdentifier)
+	case *javascript.TryStatement:
+		v.validateBindingIdentifiers("TryStatement", t.CatchParameterBindingIdentifier)
+	case *javascript.IterationStatementFor:
+		v.validateBindingIdentifiers("IterationStatementFor", t.ForBindingIdentifier)
+	case *javascript.ImportClause:
+		v.validateBindingIdentifiers("ImportClause", t.ImportedDefaultBinding, t.NameSpaceImport)
+	case *javascript.ImportSpecifier:
+		v.validateBindingIdentifiers("ImportSpecifier", t.ImportedBinding)
+	}
+
+	return walk.Walk(t, v)
+}
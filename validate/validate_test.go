@@ -0,0 +1,293 @@
+package validate
+
+import (
+	"errors"
+	"testing"
+
+	"vimagination.zapto.org/javascript"
+	"vimagination.zapto.org/javascript/scope"
+	"vimagination.zapto.org/parser"
+)
+
+func TestValidate(t *testing.T) {
+	for n, test := range [...]struct {
+		Input  string
+		Script bool
+		Errors []error
+		Lines  []uint64
+	}{
+		{ // 1
+			"let a = 1;\nexport {a};",
+			false,
+			nil,
+			nil,
+		},
+		{ // 2
+			"a: while (1) {\n\tbreak b;\n}",
+			false,
+			[]error{ErrUndefinedLabel},
+			[]uint64{1},
+		},
+		{ // 3
+			"a: while (1) {\n\tb: {\n\t\tbreak a;\n\t\tcontinue a;\n\t\tbreak b;\n\t}\n}",
+			false,
+			nil,
+			nil,
+		},
+		{ // 4
+			"a: {\n\twhile (1) continue a;\n}",
+			false,
+			[]error{ErrInvalidContinueLabel},
+			[]uint64{1},
+		},
+		{ // 5
+			"break;\ncontinue;\nswitch (a) {\ncase 1:\n\tbreak;\n\tcontinue;\n}",
+			false,
+			[]error{ErrInvalidBreak, ErrInvalidContinue, ErrInvalidContinue},
+			[]uint64{0, 1, 5},
+		},
+		{ // 6
+			"a: a: ;",
+			false,
+			[]error{ErrDuplicateLabel},
+			[]uint64{0},
+		},
+		{ // 7
+			"a: while (1) {\n\tfunction f() {\n\t\tbreak a;\n\t}\n}",
+			false,
+			[]error{ErrUndefinedLabel},
+			[]uint64{2},
+		},
+		{ // 8
+			"a = {__proto__: 1, \"__proto__\": 2};\nb = {__proto__: 1, __proto__};\nc = {__proto__: 1, ['__proto__']: 2, __proto__() {}};",
+			false,
+			[]error{ErrDuplicateProto},
+			[]uint64{0},
+		},
+		{ // 9
+			"let a, b;\nexport {a, b as a};\nexport default 1;\nexport default 2;",
+			false,
+			[]error{ErrDuplicateExport, ErrDuplicateExport},
+			[]uint64{1, 3},
+		},
+		{ // 10
+			"export const {a, b: [c]} = d;\nexport function e() {}\nexport {e as c};\nexport * as a from 'f';",
+			false,
+			[]error{ErrDuplicateExport, ErrDuplicateExport},
+			[]uint64{2, 3},
+		},
+		{ // 11
+			"export {a};\nexport {b} from 'c';",
+			false,
+			[]error{ErrUndeclaredExport},
+			[]uint64{0},
+		},
+		{ // 12
+			"delete a;\ndelete (b);\ndelete a.b;\ntypeof delete c;",
+			false,
+			[]error{ErrStrictDelete, ErrStrictDelete, ErrStrictDelete},
+			[]uint64{0, 1, 3},
+		},
+		{ // 13
+			"delete a;\nfunction f() {\n\t'use strict';\n\n\tdelete b;\n}\nclass C {\n\tm() {\n\t\tdelete c;\n\t}\n}",
+			true,
+			[]error{ErrStrictDelete, ErrStrictDelete},
+			[]uint64{4, 8},
+		},
+		{ // 14
+			"(() => {\n\t'use strict';\n\n\tdelete a;\n})();\ndelete b;",
+			true,
+			[]error{ErrStrictDelete},
+			[]uint64{3},
+		},
+		{ // 15
+			"with (a) {}",
+			true,
+			nil,
+			nil,
+		},
+		{ // 16
+			"function f(a = 1) {\n\tdelete a;\n}\nfunction g(a, b) {\n\t'use strict';\n\n\tdelete a;\n}\nconst h = (...c) => {\n\tdelete c;\n};",
+			true,
+			[]error{ErrStrictDelete},
+			[]uint64{6},
+		},
+		{ // 17
+			"class A {\n\tconstructor() {}\n\tstatic constructor() {}\n\t'constructor'() {}\n}",
+			false,
+			[]error{ErrDuplicateConstructor},
+			[]uint64{3},
+		},
+		{ // 18
+			"class A {\n\t#a;\n\tget #b() {}\n\tset #b(v) {}\n\t#a() {}\n\tstatic get #c() {}\n\tset #c(v) {}\n}",
+			false,
+			[]error{ErrDuplicatePrivateName, ErrDuplicatePrivateName},
+			[]uint64{4, 6},
+		},
+		{ // 19
+			"let a;\nlet a;",
+			false,
+			[]error{scope.ErrDuplicateDeclaration{}},
+			[]uint64{1},
+		},
+		{ // 20
+			"using a = b();\n{\n\tusing c = d(), e;\n}\nfor (using f of g) {}",
+			true,
+			[]error{ErrInvalidUsingDeclaration, ErrMissingUsingInitializer},
+			[]uint64{0, 2},
+		},
+		{ // 21
+			"switch (a) {\ncase 1:\n\tusing b = c;\ndefault:\n\tawait using d = e;\n}\nawait using f = g;",
+			false,
+			[]error{ErrInvalidUsingDeclaration, ErrInvalidUsingDeclaration},
+			[]uint64{4, 2},
+		},
+		{ // 22
+			"function f(a, a) {}\nvar g = function (b, b) {};",
+			true,
+			nil,
+			nil,
+		},
+		{ // 23
+			"'use strict';\nfunction f(a, a) {}",
+			true,
+			[]error{scope.ErrDuplicateDeclaration{}},
+			[]uint64{1},
+		},
+		{ // 24
+			"function f(a, a) {\n\t'use strict';\n}\nfunction g(b,\nb = 1) {}\nvar h = (c,\nc) => 1;",
+			true,
+			[]error{scope.ErrDuplicateDeclaration{}, scope.ErrDuplicateDeclaration{}, scope.ErrDuplicateDeclaration{}},
+			[]uint64{0, 4, 6},
+		},
+		{ // 25
+			"let a;\nlet a;\nlet b;\nlet b;\nbreak;",
+			false,
+			[]error{scope.ErrDuplicateDeclaration{}, scope.ErrDuplicateDeclaration{}, ErrInvalidBreak},
+			[]uint64{1, 3, 4},
+		},
+		{ // 26
+			"new.target;\nfunction f() {\n\tnew.target;\n\t() => new.target;\n}\n() => new.target;\nclass A {\n\tb = new.target;\n\tstatic {\n\t\tnew.target;\n\t}\n\tc() {\n\t\tnew.target;\n\t}\n}",
+			true,
+			[]error{ErrInvalidNewTarget, ErrInvalidNewTarget},
+			[]uint64{0, 5},
+		},
+		{ // 27
+			"class A extends B {\n\tconstructor() {\n\t\tsuper();\n\t\t() => super();\n\t\tfunction f() {\n\t\t\tsuper();\n\t\t}\n\t}\n\tm() {\n\t\tsuper();\n\t}\n\tc = super();\n}\nclass C {\n\tconstructor() {\n\t\tsuper();\n\t}\n}\nsuper();",
+			false,
+			[]error{ErrInvalidSuperCall, ErrInvalidSuperCall, ErrInvalidSuperCall, ErrInvalidSuperCall, ErrInvalidSuperCall},
+			[]uint64{5, 9, 11, 15, 18},
+		},
+		{ // 28
+			"class A extends B {\n\tm() {\n\t\tsuper.m();\n\t\t() => super.n;\n\t\tfunction f() {\n\t\t\tsuper.o;\n\t\t}\n\t}\n\tp = super.p;\n\tstatic {\n\t\tsuper[q];\n\t}\n}\na = {\n\tb() {\n\t\treturn super.c;\n\t},\n\td: function () {\n\t\treturn super.e;\n\t}\n};\nsuper.f;",
+			false,
+			[]error{ErrInvalidSuperProperty, ErrInvalidSuperProperty, ErrInvalidSuperProperty},
+			[]uint64{5, 18, 21},
+		},
+		{ // 29
+			"a: function f() {}\nfunction g() {\n\t'use strict';\n\tb: c: function h() {}\n}",
+			true,
+			[]error{ErrLabelledFunction},
+			[]uint64{3},
+		},
+		{ // 30
+			"class A {\n\t#a;\n\tb() {\n\t\tthis.#a;\n\t\tthis.#b;\n\t\tthis?.#c;\n\t\t#d in this;\n\t\tclass C {\n\t\t\tm(o) {\n\t\t\t\to.#a;\n\t\t\t\tthis.#e();\n\t\t\t}\n\t\t}\n\t}\n}",
+			false,
+			[]error{ErrUndeclaredPrivateName, ErrUndeclaredPrivateName, ErrUndeclaredPrivateName, ErrUndeclaredPrivateName},
+			[]uint64{4, 5, 6, 10},
+		},
+		{ // 31
+			"'use strict';\nvar eval;\nfunction f(arguments) {}\ntry {} catch (eval) {}",
+			true,
+			[]error{ErrStrictBinding, ErrStrictBinding, ErrStrictBinding},
+			[]uint64{1, 2, 3},
+		},
+		{ // 32
+			"var eval;\nfunction arguments(eval) {}\ntry {} catch (arguments) {}\nfunction g(a) {\n\t'use strict';\n\tlet [arguments] = a;\n}\nfunction eval() {\n\t'use strict';\n}",
+			true,
+			[]error{ErrStrictBinding, ErrStrictBinding},
+			[]uint64{5, 7},
+		},
+		{ // 33
+			"import eval from 'a';\nimport * as arguments from 'b';\n{\n\tlet {...eval} = c;\n}\nconst f = (...arguments) => {}, g = eval => {};\nfor (const arguments of d) {}\n(class eval {});",
+			false,
+			[]error{ErrStrictBinding, ErrStrictBinding, ErrStrictBinding, ErrStrictBinding, ErrStrictBinding, ErrStrictBinding, ErrStrictBinding},
+			[]uint64{0, 1, 3, 5, 5, 6, 7},
+		},
+	} {
+		tk := parser.NewStringTokeniser(test.Input)
+
+		var (
+			ast javascript.Type
+			err error
+		)
+
+		if test.Script {
+			ast, err = javascript.ParseScript(&tk)
+		} else {
+			ast, err = javascript.ParseModule(&tk)
+		}
+
+		if err != nil {
+			t.Errorf("test %d: unexpected error: %s", n+1, err)
+
+			continue
+		}
+
+		errs := Validate(ast)
+
+		if len(errs) != len(test.Errors) {
+			t.Errorf("test %d: expecting %d errors, got %d: %v", n+1, len(test.Errors), len(errs), errs)
+
+			continue
+		}
+
+		for m, err := range errs {
+			var e javascript.Error
+
+			if !errors.As(err, &e) {
+				t.Errorf("test %d.%d: expecting javascript.Error, got %T", n+1, m+1, err)
+			} else if _, ok := test.Errors[m].(scope.ErrDuplicateDeclaration); ok {
+				if !errors.As(err, new(scope.ErrDuplicateDeclaration)) {
+					t.Errorf("test %d.%d: expecting duplicate declaration error, got %s", n+1, m+1, err)
+				}
+			} else if !errors.Is(err, test.Errors[m]) {
+				t.Errorf("test %d.%d: expecting error %v, got %s", n+1, m+1, test.Errors[m], err)
+			}
+
+			if e.Token.Line != test.Lines[m] {
+				t.Errorf("test %d.%d: expecting error on line %d, got %d", n+1, m+1, test.Lines[m], e.Token.Line)
+			}
+		}
+	}
+}
+
+func TestValidateExportUsing(t *testing.T) {
+	tk := parser.NewStringTokeniser("export const a = b;\nexport let c = d;\nexport const e = f;")
+
+	m, err := javascript.ParseModule(&tk)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	m.ModuleListItems[0].ExportDeclaration.Declaration.LexicalDeclaration.LetOrConst = javascript.Using
+	m.ModuleListItems[2].ExportDeclaration.Declaration.LexicalDeclaration.LetOrConst = javascript.AwaitUsing
+
+	errs := Validate(m)
+
+	if len(errs) != 2 {
+		t.Fatalf("expecting 2 errors, got %d: %v", len(errs), errs)
+	}
+
+	for n, line := range [...]uint64{0, 2} {
+		var e javascript.Error
+
+		if !errors.As(errs[n], &e) {
+			t.Errorf("test %d: expecting javascript.Error, got %T", n+1, errs[n])
+		} else if !errors.Is(e, ErrInvalidExportUsing) {
+			t.Errorf("test %d: expecting error %v, got %s", n+1, ErrInvalidExportUsing, e)
+		} else if e.Token.Line != line {
+			t.Errorf("test %d: expecting error on line %d, got %d", n+1, line, e.Token.Line)
+		}
+	}
+}
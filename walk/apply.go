@@ -0,0 +1,344 @@
+package walk
+
+import (
+	"fmt"
+	"reflect"
+
+	"vimagination.zapto.org/javascript"
+)
+
+var (
+	typeType = reflect.TypeFor[javascript.Type]()
+
+	// types that are not walked by Walk.
+	tokenTypes = map[reflect.Type]struct{}{
+		reflect.TypeFor[javascript.Token]():                 {},
+		reflect.TypeFor[javascript.CommentsToken]():         {},
+		reflect.TypeFor[javascript.UnaryOperatorComments](): {},
+		reflect.TypeFor[javascript.WithEntry]():             {},
+	}
+)
+
+// ApplyFunc is called by Apply for each node, with the Cursor positioned at
+// that node.
+//
+// The return value of a pre function determines whether the children of the
+// node are traversed, and the return value of a post function determines
+// whether the traversal continues.
+type ApplyFunc func(*Cursor) bool
+
+// Apply traverses the given JavaScript type, calling pre before, and post
+// after, the children of each node are traversed; either may be nil.
+//
+// If pre returns false, the children of the node are skipped, as is the call
+// to post for that node. If post returns false, the traversal is stopped.
+//
+// The children of a node are the same non-nil, non-Token fields that are
+// walked by Walk, with each node being a pointer to the AST type.
+//
+// The Cursor can be used to replace the current node, or to delete it or
+// insert nodes around it when it is an element of a slice. Nodes inserted
+// before the current node are not traversed; nodes inserted after it are.
+// When the current node is replaced in a pre function, the children of the
+// new node are traversed.
+//
+// Apply returns the root node, which may have been replaced.
+func Apply(t javascript.Type, pre, post ApplyFunc) (result javascript.Type) {
+	if t == nil {
+		return nil
+	}
+
+	root := reflect.New(reflect.TypeFor[javascript.Type]()).Elem()
+
+	if v := reflect.ValueOf(t); v.Kind() == reflect.Struct {
+		p := reflect.New(v.Type())
+
+		p.Elem().Set(v)
+		root.Set(p)
+	} else {
+		root.Set(v)
+	}
+
+	defer func() {
+		if r := recover(); r != nil && r != abort {
+			panic(r)
+		}
+
+		result, _ = root.Interface().(javascript.Type)
+	}()
+
+	a := applier{pre: pre, post: post}
+
+	a.apply(nil, "", nil, -1, root)
+
+	return result
+}
+
+type abortType struct{}
+
+var abort abortType
+
+type iterator struct {
+	index, step int
+}
+
+// Cursor describes a node encountered during Apply.
+type Cursor struct {
+	parent  javascript.Type
+	name    string
+	iter    *iterator
+	index   int
+	field   reflect.Value
+	node    javascript.Type
+	deleted bool
+}
+
+// Node returns the current node, which will be a pointer to an AST type.
+func (c *Cursor) Node() javascript.Type {
+	return c.node
+}
+
+// Parent returns the node that contains the current node; for the root node
+// this is nil.
+func (c *Cursor) Parent() javascript.Type {
+	return c.parent
+}
+
+// Name returns the name of the field of the parent that contains the current
+// node; for the root node this is the empty string.
+func (c *Cursor) Name() string {
+	return c.name
+}
+
+// Index returns the index of the current node in the slice or array field of
+// the parent, or -1 if the current node is not in a slice or array.
+func (c *Cursor) Index() int {
+	if c.iter != nil {
+		return c.iter.index
+	}
+
+	return c.index
+}
+
+// Replace replaces the current node with the given node, which must either
+// be of the same type as the current node, or be the type pointed to by the
+// current node.
+//
+// A nil node can only replace a node that is held in a pointer field.
+//
+// Panics if the current node has been deleted.
+func (c *Cursor) Replace(t javascript.Type) {
+	if c.deleted {
+		panic("walk: Replace called on a deleted node")
+	}
+
+	slot := c.slot()
+
+	slot.Set(c.value(slot.Type(), t))
+
+	c.node = nodeOf(slot)
+}
+
+// Delete removes the current node from the slice that contains it. The
+// children of a node deleted by a pre function are not traversed.
+//
+// Panics if the current node is not an element of a slice.
+func (c *Cursor) Delete() {
+	c.checkList("Delete")
+
+	i := c.iter.index
+
+	c.field.Set(reflect.AppendSlice(c.field.Slice(0, i), c.field.Slice(i+1, c.field.Len())))
+
+	c.iter.step--
+	c.deleted = true
+}
+
+// InsertBefore inserts the given node into the slice that contains the
+// current node, immediately before the current node. The inserted node will
+// not be traversed.
+//
+// Panics if the current node is not an element of a slice.
+func (c *Cursor) InsertBefore(t javascript.Type) {
+	c.checkList("InsertBefore")
+	c.insert(c.iter.index, t)
+
+	c.iter.index++
+
+	c.resync()
+}
+
+// InsertAfter inserts the given node into the slice that contains the
+// current node, immediately after the current node. The inserted node will be
+// traversed.
+//
+// Panics if the current node is not an element of a slice.
+func (c *Cursor) InsertAfter(t javascript.Type) {
+	c.checkList("InsertAfter")
+
+	if c.deleted {
+		c.insert(c.iter.index+c.iter.step, t)
+	} else {
+		c.insert(c.iter.index+1, t)
+	}
+
+	c.resync()
+}
+
+func (c *Cursor) checkList(method string) {
+	if c.iter == nil {
+		panic("walk: " + method + " called on a node that is not in a slice")
+	}
+}
+
+func (c *Cursor) insert(i int, t javascript.Type) {
+	l := c.field.Len()
+
+	c.field.Set(reflect.Append(c.field, reflect.Zero(c.field.Type().Elem())))
+	reflect.Copy(c.field.Slice(i+1, l+1), c.field.Slice(i, l))
+	c.field.Index(i).Set(c.value(c.field.Type().Elem(), t))
+}
+
+func (c *Cursor) resync() {
+	if !c.deleted {
+		c.node = nodeOf(c.slot())
+	}
+}
+
+func (c *Cursor) slot() reflect.Value {
+	if c.iter != nil {
+		return c.field.Index(c.iter.index)
+	} else if c.index >= 0 {
+		return c.field.Index(c.index)
+	}
+
+	return c.field
+}
+
+func (c *Cursor) value(typ reflect.Type, t javascript.Type) reflect.Value {
+	v := reflect.ValueOf(t)
+
+	if !v.IsValid() {
+		if typ.Kind() == reflect.Pointer {
+			return reflect.Zero(typ)
+		}
+	} else if typ.Kind() == reflect.Interface && v.Kind() == reflect.Struct || typ.Kind() == reflect.Pointer && v.Type() == typ.Elem() {
+		p := reflect.New(v.Type())
+
+		p.Elem().Set(v)
+
+		return p
+	} else if v.Type() == typ || typ.Kind() == reflect.Interface {
+		return v
+	} else if v.Kind() == reflect.Pointer && v.Type().Elem() == typ && !v.IsNil() {
+		return v.Elem()
+	}
+
+	panic(fmt.Sprintf("walk: cannot use %T as %s", t, typ))
+}
+
+func nodeOf(v reflect.Value) javascript.Type {
+	switch v.Kind() {
+	case reflect.Interface:
+		return nodeOf(v.Elem())
+	case reflect.Pointer:
+		if v.IsNil() {
+			return nil
+		}
+	case reflect.Struct:
+		v = v.Addr()
+	default:
+		return nil
+	}
+
+	t, _ := v.Interface().(javascript.Type)
+
+	return t
+}
+
+type applier struct {
+	pre, post ApplyFunc
+	cursor    Cursor
+}
+
+func (a *applier) apply(parent javascript.Type, name string, iter *iterator, index int, field reflect.Value) {
+	saved := a.cursor
+	a.cursor = Cursor{
+		parent: parent,
+		name:   name,
+		iter:   iter,
+		index:  index,
+		field:  field,
+	}
+
+	defer func() { a.cursor = saved }()
+
+	if a.cursor.node = nodeOf(a.cursor.slot()); a.cursor.node == nil {
+		return
+	}
+
+	if a.pre != nil && !a.pre(&a.cursor) {
+		return
+	}
+
+	if !a.cursor.deleted && a.cursor.node != nil {
+		a.applyChildren(a.cursor.node)
+	}
+
+	if a.post != nil && !a.post(&a.cursor) {
+		panic(abort)
+	}
+}
+
+func (a *applier) applyChildren(node javascript.Type) {
+	v := reflect.ValueOf(node).Elem()
+	typ := v.Type()
+
+	if typ.Kind() != reflect.Struct {
+		return
+	}
+
+	for n := range typ.NumField() {
+		f := typ.Field(n)
+
+		if !isNodeType(f.Type) {
+			continue
+		}
+
+		field := v.Field(n)
+
+		switch f.Type.Kind() {
+		case reflect.Slice:
+			var iter iterator
+
+			for iter.index < field.Len() {
+				iter.step = 1
+
+				a.apply(node, f.Name, &iter, -1, field)
+
+				iter.index += iter.step
+			}
+		case reflect.Array:
+			for i := range field.Len() {
+				a.apply(node, f.Name, nil, i, field)
+			}
+		default:
+			a.apply(node, f.Name, nil, -1, field)
+		}
+	}
+}
+
+func isNodeType(typ reflect.Type) bool {
+	switch typ.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Pointer:
+		typ = typ.Elem()
+	}
+
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	_, isToken := tokenTypes[typ]
+
+	return typ.Kind() == reflect.Struct && !isToken && reflect.PointerTo(typ).Implements(typeType)
+}
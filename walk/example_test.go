@@ -2,6 +2,7 @@ package walk_test
 
 import (
 	"fmt"
+	"strings"
 
 	"vimagination.zapto.org/javascript"
 	"vimagination.zapto.org/javascript/walk"
@@ -40,3 +41,62 @@ func Example() {
 	// Output:
 	// const a = 'Hello' + `, world`;
 }
+
+func ExampleApply() {
+	src := "console.log(1);\nconst a = 2;\nconsole.log(a);"
+	tk := parser.NewStringTokeniser(src)
+
+	m, _ := javascript.ParseModule(&tk)
+
+	walk.Apply(m, func(c *walk.Cursor) bool {
+		switch t := c.Node().(type) {
+		case *javascript.ModuleItem:
+			if strings.HasPrefix(fmt.Sprintf("%s", t), "console.") {
+				c.Delete()
+			}
+		case *javascript.LexicalBinding:
+			if c.Index() == 0 {
+				t.BindingIdentifier.Data = "b"
+			}
+		}
+
+		return true
+	}, nil)
+
+	fmt.Printf("%s", m)
+
+	// Output:
+	// const b = 2;
+}
+
+type functionCounter struct {
+	walk.BaseVisitor
+	depth, max int
+}
+
+func (f *functionCounter) VisitFunctionDeclaration(*javascript.FunctionDeclaration) bool {
+	f.depth++
+	f.max = max(f.max, f.depth)
+
+	return true
+}
+
+func (f *functionCounter) LeaveFunctionDeclaration(*javascript.FunctionDeclaration) {
+	f.depth--
+}
+
+func ExampleVisit() {
+	src := "function a() {\n\tfunction b() {\n\t\tfunction c() {}\n\t}\n}\nfunction d() {}"
+	tk := parser.NewStringTokeniser(src)
+
+	m, _ := javascript.ParseModule(&tk)
+
+	var f functionCounter
+
+	walk.Visit(m, &f)
+
+	fmt.Println(f.max)
+
+	// Output:
+	// 3
+}
@@ -0,0 +1,2125 @@
+package walk
+
+// File automatically generated with visitor.sh.
+
+import "vimagination.zapto.org/javascript"
+
+// Visitor is used to process JavaScript types by their concrete type.
+//
+// For each type, the Visit method is called before the children of that type
+// are visited, and returns whether those children should be visited. The Leave
+// method is called once the children have been visited, or skipped.
+type Visitor interface {
+	VisitAdditiveExpression(*javascript.AdditiveExpression) bool
+	LeaveAdditiveExpression(*javascript.AdditiveExpression)
+	VisitArgument(*javascript.Argument) bool
+	LeaveArgument(*javascript.Argument)
+	VisitArguments(*javascript.Arguments) bool
+	LeaveArguments(*javascript.Arguments)
+	VisitArrayAssignmentPattern(*javascript.ArrayAssignmentPattern) bool
+	LeaveArrayAssignmentPattern(*javascript.ArrayAssignmentPattern)
+	VisitArrayBindingPattern(*javascript.ArrayBindingPattern) bool
+	LeaveArrayBindingPattern(*javascript.ArrayBindingPattern)
+	VisitArrayElement(*javascript.ArrayElement) bool
+	LeaveArrayElement(*javascript.ArrayElement)
+	VisitArrayLiteral(*javascript.ArrayLiteral) bool
+	LeaveArrayLiteral(*javascript.ArrayLiteral)
+	VisitArrowFunction(*javascript.ArrowFunction) bool
+	LeaveArrowFunction(*javascript.ArrowFunction)
+	VisitAssignmentElement(*javascript.AssignmentElement) bool
+	LeaveAssignmentElement(*javascript.AssignmentElement)
+	VisitAssignmentExpression(*javascript.AssignmentExpression) bool
+	LeaveAssignmentExpression(*javascript.AssignmentExpression)
+	VisitAssignmentPattern(*javascript.AssignmentPattern) bool
+	LeaveAssignmentPattern(*javascript.AssignmentPattern)
+	VisitAssignmentProperty(*javascript.AssignmentProperty) bool
+	LeaveAssignmentProperty(*javascript.AssignmentProperty)
+	VisitBindingElement(*javascript.BindingElement) bool
+	LeaveBindingElement(*javascript.BindingElement)
+	VisitBindingProperty(*javascript.BindingProperty) bool
+	LeaveBindingProperty(*javascript.BindingProperty)
+	VisitBitwiseANDExpression(*javascript.BitwiseANDExpression) bool
+	LeaveBitwiseANDExpression(*javascript.BitwiseANDExpression)
+	VisitBitwiseORExpression(*javascript.BitwiseORExpression) bool
+	LeaveBitwiseORExpression(*javascript.BitwiseORExpression)
+	VisitBitwiseXORExpression(*javascript.BitwiseXORExpression) bool
+	LeaveBitwiseXORExpression(*javascript.BitwiseXORExpression)
+	VisitBlock(*javascript.Block) bool
+	LeaveBlock(*javascript.Block)
+	VisitCallExpression(*javascript.CallExpression) bool
+	LeaveCallExpression(*javascript.CallExpression)
+	VisitCallSignature(*javascript.CallSignature) bool
+	LeaveCallSignature(*javascript.CallSignature)
+	VisitCaseClause(*javascript.CaseClause) bool
+	LeaveCaseClause(*javascript.CaseClause)
+	VisitClassDeclaration(*javascript.ClassDeclaration) bool
+	LeaveClassDeclaration(*javascript.ClassDeclaration)
+	VisitClassElement(*javascript.ClassElement) bool
+	LeaveClassElement(*javascript.ClassElement)
+	VisitClassElementName(*javascript.ClassElementName) bool
+	LeaveClassElementName(*javascript.ClassElementName)
+	VisitCoalesceExpression(*javascript.CoalesceExpression) bool
+	LeaveCoalesceExpression(*javascript.CoalesceExpression)
+	VisitConditionalExpression(*javascript.ConditionalExpression) bool
+	LeaveConditionalExpression(*javascript.ConditionalExpression)
+	VisitDeclaration(*javascript.Declaration) bool
+	LeaveDeclaration(*javascript.Declaration)
+	VisitDecorator(*javascript.Decorator) bool
+	LeaveDecorator(*javascript.Decorator)
+	VisitDecoratorMemberExpression(*javascript.DecoratorMemberExpression) bool
+	LeaveDecoratorMemberExpression(*javascript.DecoratorMemberExpression)
+	VisitDestructuringAssignmentTarget(*javascript.DestructuringAssignmentTarget) bool
+	LeaveDestructuringAssignmentTarget(*javascript.DestructuringAssignmentTarget)
+	VisitEnumDeclaration(*javascript.EnumDeclaration) bool
+	LeaveEnumDeclaration(*javascript.EnumDeclaration)
+	VisitEnumMember(*javascript.EnumMember) bool
+	LeaveEnumMember(*javascript.EnumMember)
+	VisitEqualityExpression(*javascript.EqualityExpression) bool
+	LeaveEqualityExpression(*javascript.EqualityExpression)
+	VisitExponentiationExpression(*javascript.ExponentiationExpression) bool
+	LeaveExponentiationExpression(*javascript.ExponentiationExpression)
+	VisitExportClause(*javascript.ExportClause) bool
+	LeaveExportClause(*javascript.ExportClause)
+	VisitExportDeclaration(*javascript.ExportDeclaration) bool
+	LeaveExportDeclaration(*javascript.ExportDeclaration)
+	VisitExportSpecifier(*javascript.ExportSpecifier) bool
+	LeaveExportSpecifier(*javascript.ExportSpecifier)
+	VisitExpression(*javascript.Expression) bool
+	LeaveExpression(*javascript.Expression)
+	VisitFieldDefinition(*javascript.FieldDefinition) bool
+	LeaveFieldDefinition(*javascript.FieldDefinition)
+	VisitFormalParameters(*javascript.FormalParameters) bool
+	LeaveFormalParameters(*javascript.FormalParameters)
+	VisitFromClause(*javascript.FromClause) bool
+	LeaveFromClause(*javascript.FromClause)
+	VisitFunctionDeclaration(*javascript.FunctionDeclaration) bool
+	LeaveFunctionDeclaration(*javascript.FunctionDeclaration)
+	VisitFunctionTypeExpression(*javascript.FunctionTypeExpression) bool
+	LeaveFunctionTypeExpression(*javascript.FunctionTypeExpression)
+	VisitIfStatement(*javascript.IfStatement) bool
+	LeaveIfStatement(*javascript.IfStatement)
+	VisitImportClause(*javascript.ImportClause) bool
+	LeaveImportClause(*javascript.ImportClause)
+	VisitImportDeclaration(*javascript.ImportDeclaration) bool
+	LeaveImportDeclaration(*javascript.ImportDeclaration)
+	VisitImportSpecifier(*javascript.ImportSpecifier) bool
+	LeaveImportSpecifier(*javascript.ImportSpecifier)
+	VisitImportType(*javascript.ImportType) bool
+	LeaveImportType(*javascript.ImportType)
+	VisitIndexSignature(*javascript.IndexSignature) bool
+	LeaveIndexSignature(*javascript.IndexSignature)
+	VisitInterfaceDeclaration(*javascript.InterfaceDeclaration) bool
+	LeaveInterfaceDeclaration(*javascript.InterfaceDeclaration)
+	VisitIntersectionType(*javascript.IntersectionType) bool
+	LeaveIntersectionType(*javascript.IntersectionType)
+	VisitIterationStatementDo(*javascript.IterationStatementDo) bool
+	LeaveIterationStatementDo(*javascript.IterationStatementDo)
+	VisitIterationStatementFor(*javascript.IterationStatementFor) bool
+	LeaveIterationStatementFor(*javascript.IterationStatementFor)
+	VisitIterationStatementWhile(*javascript.IterationStatementWhile) bool
+	LeaveIterationStatementWhile(*javascript.IterationStatementWhile)
+	VisitJSXAttribute(*javascript.JSXAttribute) bool
+	LeaveJSXAttribute(*javascript.JSXAttribute)
+	VisitJSXChild(*javascript.JSXChild) bool
+	LeaveJSXChild(*javascript.JSXChild)
+	VisitJSXElement(*javascript.JSXElement) bool
+	LeaveJSXElement(*javascript.JSXElement)
+	VisitJSXElementName(*javascript.JSXElementName) bool
+	LeaveJSXElementName(*javascript.JSXElementName)
+	VisitJSXFragment(*javascript.JSXFragment) bool
+	LeaveJSXFragment(*javascript.JSXFragment)
+	VisitLeftHandSideExpression(*javascript.LeftHandSideExpression) bool
+	LeaveLeftHandSideExpression(*javascript.LeftHandSideExpression)
+	VisitLexicalBinding(*javascript.LexicalBinding) bool
+	LeaveLexicalBinding(*javascript.LexicalBinding)
+	VisitLexicalDeclaration(*javascript.LexicalDeclaration) bool
+	LeaveLexicalDeclaration(*javascript.LexicalDeclaration)
+	VisitLiteralType(*javascript.LiteralType) bool
+	LeaveLiteralType(*javascript.LiteralType)
+	VisitLogicalANDExpression(*javascript.LogicalANDExpression) bool
+	LeaveLogicalANDExpression(*javascript.LogicalANDExpression)
+	VisitLogicalORExpression(*javascript.LogicalORExpression) bool
+	LeaveLogicalORExpression(*javascript.LogicalORExpression)
+	VisitMappedType(*javascript.MappedType) bool
+	LeaveMappedType(*javascript.MappedType)
+	VisitMemberExpression(*javascript.MemberExpression) bool
+	LeaveMemberExpression(*javascript.MemberExpression)
+	VisitMethodDefinition(*javascript.MethodDefinition) bool
+	LeaveMethodDefinition(*javascript.MethodDefinition)
+	VisitMethodSignature(*javascript.MethodSignature) bool
+	LeaveMethodSignature(*javascript.MethodSignature)
+	VisitModule(*javascript.Module) bool
+	LeaveModule(*javascript.Module)
+	VisitModuleItem(*javascript.ModuleItem) bool
+	LeaveModuleItem(*javascript.ModuleItem)
+	VisitMultiplicativeExpression(*javascript.MultiplicativeExpression) bool
+	LeaveMultiplicativeExpression(*javascript.MultiplicativeExpression)
+	VisitNamedImports(*javascript.NamedImports) bool
+	LeaveNamedImports(*javascript.NamedImports)
+	VisitNamespaceDeclaration(*javascript.NamespaceDeclaration) bool
+	LeaveNamespaceDeclaration(*javascript.NamespaceDeclaration)
+	VisitNewExpression(*javascript.NewExpression) bool
+	LeaveNewExpression(*javascript.NewExpression)
+	VisitObjectAssignmentPattern(*javascript.ObjectAssignmentPattern) bool
+	LeaveObjectAssignmentPattern(*javascript.ObjectAssignmentPattern)
+	VisitObjectBindingPattern(*javascript.ObjectBindingPattern) bool
+	LeaveObjectBindingPattern(*javascript.ObjectBindingPattern)
+	VisitObjectLiteral(*javascript.ObjectLiteral) bool
+	LeaveObjectLiteral(*javascript.ObjectLiteral)
+	VisitObjectType(*javascript.ObjectType) bool
+	LeaveObjectType(*javascript.ObjectType)
+	VisitOptionalChain(*javascript.OptionalChain) bool
+	LeaveOptionalChain(*javascript.OptionalChain)
+	VisitOptionalExpression(*javascript.OptionalExpression) bool
+	LeaveOptionalExpression(*javascript.OptionalExpression)
+	VisitParameter(*javascript.Parameter) bool
+	LeaveParameter(*javascript.Parameter)
+	VisitParameterList(*javascript.ParameterList) bool
+	LeaveParameterList(*javascript.ParameterList)
+	VisitParenthesizedExpression(*javascript.ParenthesizedExpression) bool
+	LeaveParenthesizedExpression(*javascript.ParenthesizedExpression)
+	VisitPostfixType(*javascript.PostfixType) bool
+	LeavePostfixType(*javascript.PostfixType)
+	VisitPrimaryExpression(*javascript.PrimaryExpression) bool
+	LeavePrimaryExpression(*javascript.PrimaryExpression)
+	VisitPrimaryType(*javascript.PrimaryType) bool
+	LeavePrimaryType(*javascript.PrimaryType)
+	VisitPropertyDefinition(*javascript.PropertyDefinition) bool
+	LeavePropertyDefinition(*javascript.PropertyDefinition)
+	VisitPropertyName(*javascript.PropertyName) bool
+	LeavePropertyName(*javascript.PropertyName)
+	VisitPropertySignature(*javascript.PropertySignature) bool
+	LeavePropertySignature(*javascript.PropertySignature)
+	VisitRelationalExpression(*javascript.RelationalExpression) bool
+	LeaveRelationalExpression(*javascript.RelationalExpression)
+	VisitScript(*javascript.Script) bool
+	LeaveScript(*javascript.Script)
+	VisitShiftExpression(*javascript.ShiftExpression) bool
+	LeaveShiftExpression(*javascript.ShiftExpression)
+	VisitStatement(*javascript.Statement) bool
+	LeaveStatement(*javascript.Statement)
+	VisitStatementListItem(*javascript.StatementListItem) bool
+	LeaveStatementListItem(*javascript.StatementListItem)
+	VisitSwitchStatement(*javascript.SwitchStatement) bool
+	LeaveSwitchStatement(*javascript.SwitchStatement)
+	VisitTemplateLiteral(*javascript.TemplateLiteral) bool
+	LeaveTemplateLiteral(*javascript.TemplateLiteral)
+	VisitTemplateLiteralType(*javascript.TemplateLiteralType) bool
+	LeaveTemplateLiteralType(*javascript.TemplateLiteralType)
+	VisitTryStatement(*javascript.TryStatement) bool
+	LeaveTryStatement(*javascript.TryStatement)
+	VisitTupleElement(*javascript.TupleElement) bool
+	LeaveTupleElement(*javascript.TupleElement)
+	VisitTupleType(*javascript.TupleType) bool
+	LeaveTupleType(*javascript.TupleType)
+	VisitTypeAliasDeclaration(*javascript.TypeAliasDeclaration) bool
+	LeaveTypeAliasDeclaration(*javascript.TypeAliasDeclaration)
+	VisitTypeAnnotation(*javascript.TypeAnnotation) bool
+	LeaveTypeAnnotation(*javascript.TypeAnnotation)
+	VisitTypeArguments(*javascript.TypeArguments) bool
+	LeaveTypeArguments(*javascript.TypeArguments)
+	VisitTypeExpression(*javascript.TypeExpression) bool
+	LeaveTypeExpression(*javascript.TypeExpression)
+	VisitTypeMember(*javascript.TypeMember) bool
+	LeaveTypeMember(*javascript.TypeMember)
+	VisitTypeOperator(*javascript.TypeOperator) bool
+	LeaveTypeOperator(*javascript.TypeOperator)
+	VisitTypeParameter(*javascript.TypeParameter) bool
+	LeaveTypeParameter(*javascript.TypeParameter)
+	VisitTypeParameters(*javascript.TypeParameters) bool
+	LeaveTypeParameters(*javascript.TypeParameters)
+	VisitTypeQuery(*javascript.TypeQuery) bool
+	LeaveTypeQuery(*javascript.TypeQuery)
+	VisitTypeReference(*javascript.TypeReference) bool
+	LeaveTypeReference(*javascript.TypeReference)
+	VisitUnaryExpression(*javascript.UnaryExpression) bool
+	LeaveUnaryExpression(*javascript.UnaryExpression)
+	VisitUnionType(*javascript.UnionType) bool
+	LeaveUnionType(*javascript.UnionType)
+	VisitUpdateExpression(*javascript.UpdateExpression) bool
+	LeaveUpdateExpression(*javascript.UpdateExpression)
+	VisitVariableStatement(*javascript.VariableStatement) bool
+	LeaveVariableStatement(*javascript.VariableStatement)
+	VisitWithStatement(*javascript.WithStatement) bool
+	LeaveWithStatement(*javascript.WithStatement)
+}
+
+// BaseVisitor implements the Visitor interface with methods that do nothing,
+// allowing all children to be visited.
+//
+// It can be embedded in a type that only needs to implement some of the
+// Visitor methods.
+type BaseVisitor struct{}
+
+// VisitAdditiveExpression implements the Visitor interface.
+func (BaseVisitor) VisitAdditiveExpression(*javascript.AdditiveExpression) bool {
+	return true
+}
+
+// LeaveAdditiveExpression implements the Visitor interface.
+func (BaseVisitor) LeaveAdditiveExpression(*javascript.AdditiveExpression) {}
+
+// VisitArgument implements the Visitor interface.
+func (BaseVisitor) VisitArgument(*javascript.Argument) bool {
+	return true
+}
+
+// LeaveArgument implements the Visitor interface.
+func (BaseVisitor) LeaveArgument(*javascript.Argument) {}
+
+// VisitArguments implements the Visitor interface.
+func (BaseVisitor) VisitArguments(*javascript.Arguments) bool {
+	return true
+}
+
+// LeaveArguments implements the Visitor interface.
+func (BaseVisitor) LeaveArguments(*javascript.Arguments) {}
+
+// VisitArrayAssignmentPattern implements the Visitor interface.
+func (BaseVisitor) VisitArrayAssignmentPattern(*javascript.ArrayAssignmentPattern) bool {
+	return true
+}
+
+// LeaveArrayAssignmentPattern implements the Visitor interface.
+func (BaseVisitor) LeaveArrayAssignmentPattern(*javascript.ArrayAssignmentPattern) {}
+
+// VisitArrayBindingPattern implements the Visitor interface.
+func (BaseVisitor) VisitArrayBindingPattern(*javascript.ArrayBindingPattern) bool {
+	return true
+}
+
+// LeaveArrayBindingPattern implements the Visitor interface.
+func (BaseVisitor) LeaveArrayBindingPattern(*javascript.ArrayBindingPattern) {}
+
+// VisitArrayElement implements the Visitor interface.
+func (BaseVisitor) VisitArrayElement(*javascript.ArrayElement) bool {
+	return true
+}
+
+// LeaveArrayElement implements the Visitor interface.
+func (BaseVisitor) LeaveArrayElement(*javascript.ArrayElement) {}
+
+// VisitArrayLiteral implements the Visitor interface.
+func (BaseVisitor) VisitArrayLiteral(*javascript.ArrayLiteral) bool {
+	return true
+}
+
+// LeaveArrayLiteral implements the Visitor interface.
+func (BaseVisitor) LeaveArrayLiteral(*javascript.ArrayLiteral) {}
+
+// VisitArrowFunction implements the Visitor interface.
+func (BaseVisitor) VisitArrowFunction(*javascript.ArrowFunction) bool {
+	return true
+}
+
+// LeaveArrowFunction implements the Visitor interface.
+func (BaseVisitor) LeaveArrowFunction(*javascript.ArrowFunction) {}
+
+// VisitAssignmentElement implements the Visitor interface.
+func (BaseVisitor) VisitAssignmentElement(*javascript.AssignmentElement) bool {
+	return true
+}
+
+// LeaveAssignmentElement implements the Visitor interface.
+func (BaseVisitor) LeaveAssignmentElement(*javascript.AssignmentElement) {}
+
+// VisitAssignmentExpression implements the Visitor interface.
+func (BaseVisitor) VisitAssignmentExpression(*javascript.AssignmentExpression) bool {
+	return true
+}
+
+// LeaveAssignmentExpression implements the Visitor interface.
+func (BaseVisitor) LeaveAssignmentExpression(*javascript.AssignmentExpression) {}
+
+// VisitAssignmentPattern implements the Visitor interface.
+func (BaseVisitor) VisitAssignmentPattern(*javascript.AssignmentPattern) bool {
+	return true
+}
+
+// LeaveAssignmentPattern implements the Visitor interface.
+func (BaseVisitor) LeaveAssignmentPattern(*javascript.AssignmentPattern) {}
+
+// VisitAssignmentProperty implements the Visitor interface.
+func (BaseVisitor) VisitAssignmentProperty(*javascript.AssignmentProperty) bool {
+	return true
+}
+
+// LeaveAssignmentProperty implements the Visitor interface.
+func (BaseVisitor) LeaveAssignmentProperty(*javascript.AssignmentProperty) {}
+
+// VisitBindingElement implements the Visitor interface.
+func (BaseVisitor) VisitBindingElement(*javascript.BindingElement) bool {
+	return true
+}
+
+// LeaveBindingElement implements the Visitor interface.
+func (BaseVisitor) LeaveBindingElement(*javascript.BindingElement) {}
+
+// VisitBindingProperty implements the Visitor interface.
+func (BaseVisitor) VisitBindingProperty(*javascript.BindingProperty) bool {
+	return true
+}
+
+// LeaveBindingProperty implements the Visitor interface.
+func (BaseVisitor) LeaveBindingProperty(*javascript.BindingProperty) {}
+
+// VisitBitwiseANDExpression implements the Visitor interface.
+func (BaseVisitor) VisitBitwiseANDExpression(*javascript.BitwiseANDExpression) bool {
+	return true
+}
+
+// LeaveBitwiseANDExpression implements the Visitor interface.
+func (BaseVisitor) LeaveBitwiseANDExpression(*javascript.BitwiseANDExpression) {}
+
+// VisitBitwiseORExpression implements the Visitor interface.
+func (BaseVisitor) VisitBitwiseORExpression(*javascript.BitwiseORExpression) bool {
+	return true
+}
+
+// LeaveBitwiseORExpression implements the Visitor interface.
+func (BaseVisitor) LeaveBitwiseORExpression(*javascript.BitwiseORExpression) {}
+
+// VisitBitwiseXORExpression implements the Visitor interface.
+func (BaseVisitor) VisitBitwiseXORExpression(*javascript.BitwiseXORExpression) bool {
+	return true
+}
+
+// LeaveBitwiseXORExpression implements the Visitor interface.
+func (BaseVisitor) LeaveBitwiseXORExpression(*javascript.BitwiseXORExpression) {}
+
+// VisitBlock implements the Visitor interface.
+func (BaseVisitor) VisitBlock(*javascript.Block) bool {
+	return true
+}
+
+// LeaveBlock implements the Visitor interface.
+func (BaseVisitor) LeaveBlock(*javascript.Block) {}
+
+// VisitCallExpression implements the Visitor interface.
+func (BaseVisitor) VisitCallExpression(*javascript.CallExpression) bool {
+	return true
+}
+
+// LeaveCallExpression implements the Visitor interface.
+func (BaseVisitor) LeaveCallExpression(*javascript.CallExpression) {}
+
+// VisitCallSignature implements the Visitor interface.
+func (BaseVisitor) VisitCallSignature(*javascript.CallSignature) bool {
+	return true
+}
+
+// LeaveCallSignature implements the Visitor interface.
+func (BaseVisitor) LeaveCallSignature(*javascript.CallSignature) {}
+
+// VisitCaseClause implements the Visitor interface.
+func (BaseVisitor) VisitCaseClause(*javascript.CaseClause) bool {
+	return true
+}
+
+// LeaveCaseClause implements the Visitor interface.
+func (BaseVisitor) LeaveCaseClause(*javascript.CaseClause) {}
+
+// VisitClassDeclaration implements the Visitor interface.
+func (BaseVisitor) VisitClassDeclaration(*javascript.ClassDeclaration) bool {
+	return true
+}
+
+// LeaveClassDeclaration implements the Visitor interface.
+func (BaseVisitor) LeaveClassDeclaration(*javascript.ClassDeclaration) {}
+
+// VisitClassElement implements the Visitor interface.
+func (BaseVisitor) VisitClassElement(*javascript.ClassElement) bool {
+	return true
+}
+
+// LeaveClassElement implements the Visitor interface.
+func (BaseVisitor) LeaveClassElement(*javascript.ClassElement) {}
+
+// VisitClassElementName implements the Visitor interface.
+func (BaseVisitor) VisitClassElementName(*javascript.ClassElementName) bool {
+	return true
+}
+
+// LeaveClassElementName implements the Visitor interface.
+func (BaseVisitor) LeaveClassElementName(*javascript.ClassElementName) {}
+
+// VisitCoalesceExpression implements the Visitor interface.
+func (BaseVisitor) VisitCoalesceExpression(*javascript.CoalesceExpression) bool {
+	return true
+}
+
+// LeaveCoalesceExpression implements the Visitor interface.
+func (BaseVisitor) LeaveCoalesceExpression(*javascript.CoalesceExpression) {}
+
+// VisitConditionalExpression implements the Visitor interface.
+func (BaseVisitor) VisitConditionalExpression(*javascript.ConditionalExpression) bool {
+	return true
+}
+
+// LeaveConditionalExpression implements the Visitor interface.
+func (BaseVisitor) LeaveConditionalExpression(*javascript.ConditionalExpression) {}
+
+// VisitDeclaration implements the Visitor interface.
+func (BaseVisitor) VisitDeclaration(*javascript.Declaration) bool {
+	return true
+}
+
+// LeaveDeclaration implements the Visitor interface.
+func (BaseVisitor) LeaveDeclaration(*javascript.Declaration) {}
+
+// VisitDecorator implements the Visitor interface.
+func (BaseVisitor) VisitDecorator(*javascript.Decorator) bool {
+	return true
+}
+
+// LeaveDecorator implements the Visitor interface.
+func (BaseVisitor) LeaveDecorator(*javascript.Decorator) {}
+
+// VisitDecoratorMemberExpression implements the Visitor interface.
+func (BaseVisitor) VisitDecoratorMemberExpression(*javascript.DecoratorMemberExpression) bool {
+	return true
+}
+
+// LeaveDecoratorMemberExpression implements the Visitor interface.
+func (BaseVisitor) LeaveDecoratorMemberExpression(*javascript.DecoratorMemberExpression) {}
+
+// VisitDestructuringAssignmentTarget implements the Visitor interface.
+func (BaseVisitor) VisitDestructuringAssignmentTarget(*javascript.DestructuringAssignmentTarget) bool {
+	return true
+}
+
+// LeaveDestructuringAssignmentTarget implements the Visitor interface.
+func (BaseVisitor) LeaveDestructuringAssignmentTarget(*javascript.DestructuringAssignmentTarget) {}
+
+// VisitEnumDeclaration implements the Visitor interface.
+func (BaseVisitor) VisitEnumDeclaration(*javascript.EnumDeclaration) bool {
+	return true
+}
+
+// LeaveEnumDeclaration implements the Visitor interface.
+func (BaseVisitor) LeaveEnumDeclaration(*javascript.EnumDeclaration) {}
+
+// VisitEnumMember implements the Visitor interface.
+func (BaseVisitor) VisitEnumMember(*javascript.EnumMember) bool {
+	return true
+}
+
+// LeaveEnumMember implements the Visitor interface.
+func (BaseVisitor) LeaveEnumMember(*javascript.EnumMember) {}
+
+// VisitEqualityExpression implements the Visitor interface.
+func (BaseVisitor) VisitEqualityExpression(*javascript.EqualityExpression) bool {
+	return true
+}
+
+// LeaveEqualityExpression implements the Visitor interface.
+func (BaseVisitor) LeaveEqualityExpression(*javascript.EqualityExpression) {}
+
+// VisitExponentiationExpression implements the Visitor interface.
+func (BaseVisitor) VisitExponentiationExpression(*javascript.ExponentiationExpression) bool {
+	return true
+}
+
+// LeaveExponentiationExpression implements the Visitor interface.
+func (BaseVisitor) LeaveExponentiationExpression(*javascript.ExponentiationExpression) {}
+
+// VisitExportClause implements the Visitor interface.
+func (BaseVisitor) VisitExportClause(*javascript.ExportClause) bool {
+	return true
+}
+
+// LeaveExportClause implements the Visitor interface.
+func (BaseVisitor) LeaveExportClause(*javascript.ExportClause) {}
+
+// VisitExportDeclaration implements the Visitor interface.
+func (BaseVisitor) VisitExportDeclaration(*javascript.ExportDeclaration) bool {
+	return true
+}
+
+// LeaveExportDeclaration implements the Visitor interface.
+func (BaseVisitor) LeaveExportDeclaration(*javascript.ExportDeclaration) {}
+
+// VisitExportSpecifier implements the Visitor interface.
+func (BaseVisitor) VisitExportSpecifier(*javascript.ExportSpecifier) bool {
+	return true
+}
+
+// LeaveExportSpecifier implements the Visitor interface.
+func (BaseVisitor) LeaveExportSpecifier(*javascript.ExportSpecifier) {}
+
+// VisitExpression implements the Visitor interface.
+func (BaseVisitor) VisitExpression(*javascript.Expression) bool {
+	return true
+}
+
+// LeaveExpression implements the Visitor interface.
+func (BaseVisitor) LeaveExpression(*javascript.Expression) {}
+
+// VisitFieldDefinition implements the Visitor interface.
+func (BaseVisitor) VisitFieldDefinition(*javascript.FieldDefinition) bool {
+	return true
+}
+
+// LeaveFieldDefinition implements the Visitor interface.
+func (BaseVisitor) LeaveFieldDefinition(*javascript.FieldDefinition) {}
+
+// VisitFormalParameters implements the Visitor interface.
+func (BaseVisitor) VisitFormalParameters(*javascript.FormalParameters) bool {
+	return true
+}
+
+// LeaveFormalParameters implements the Visitor interface.
+func (BaseVisitor) LeaveFormalParameters(*javascript.FormalParameters) {}
+
+// VisitFromClause implements the Visitor interface.
+func (BaseVisitor) VisitFromClause(*javascript.FromClause) bool {
+	return true
+}
+
+// LeaveFromClause implements the Visitor interface.
+func (BaseVisitor) LeaveFromClause(*javascript.FromClause) {}
+
+// VisitFunctionDeclaration implements the Visitor interface.
+func (BaseVisitor) VisitFunctionDeclaration(*javascript.FunctionDeclaration) bool {
+	return true
+}
+
+// LeaveFunctionDeclaration implements the Visitor interface.
+func (BaseVisitor) LeaveFunctionDeclaration(*javascript.FunctionDeclaration) {}
+
+// VisitFunctionTypeExpression implements the Visitor interface.
+func (BaseVisitor) VisitFunctionTypeExpression(*javascript.FunctionTypeExpression) bool {
+	return true
+}
+
+// LeaveFunctionTypeExpression implements the Visitor interface.
+func (BaseVisitor) LeaveFunctionTypeExpression(*javascript.FunctionTypeExpression) {}
+
+// VisitIfStatement implements the Visitor interface.
+func (BaseVisitor) VisitIfStatement(*javascript.IfStatement) bool {
+	return true
+}
+
+// LeaveIfStatement implements the Visitor interface.
+func (BaseVisitor) LeaveIfStatement(*javascript.IfStatement) {}
+
+// VisitImportClause implements the Visitor interface.
+func (BaseVisitor) VisitImportClause(*javascript.ImportClause) bool {
+	return true
+}
+
+// LeaveImportClause implements the Visitor interface.
+func (BaseVisitor) LeaveImportClause(*javascript.ImportClause) {}
+
+// VisitImportDeclaration implements the Visitor interface.
+func (BaseVisitor) VisitImportDeclaration(*javascript.ImportDeclaration) bool {
+	return true
+}
+
+// LeaveImportDeclaration implements the Visitor interface.
+func (BaseVisitor) LeaveImportDeclaration(*javascript.ImportDeclaration) {}
+
+// VisitImportSpecifier implements the Visitor interface.
+func (BaseVisitor) VisitImportSpecifier(*javascript.ImportSpecifier) bool {
+	return true
+}
+
+// LeaveImportSpecifier implements the Visitor interface.
+func (BaseVisitor) LeaveImportSpecifier(*javascript.ImportSpecifier) {}
+
+// VisitImportType implements the Visitor interface.
+func (BaseVisitor) VisitImportType(*javascript.ImportType) bool {
+	return true
+}
+
+// LeaveImportType implements the Visitor interface.
+func (BaseVisitor) LeaveImportType(*javascript.ImportType) {}
+
+// VisitIndexSignature implements the Visitor interface.
+func (BaseVisitor) VisitIndexSignature(*javascript.IndexSignature) bool {
+	return true
+}
+
+// LeaveIndexSignature implements the Visitor interface.
+func (BaseVisitor) LeaveIndexSignature(*javascript.IndexSignature) {}
+
+// VisitInterfaceDeclaration implements the Visitor interface.
+func (BaseVisitor) VisitInterfaceDeclaration(*javascript.InterfaceDeclaration) bool {
+	return true
+}
+
+// LeaveInterfaceDeclaration implements the Visitor interface.
+func (BaseVisitor) LeaveInterfaceDeclaration(*javascript.InterfaceDeclaration) {}
+
+// VisitIntersectionType implements the Visitor interface.
+func (BaseVisitor) VisitIntersectionType(*javascript.IntersectionType) bool {
+	return true
+}
+
+// LeaveIntersectionType implements the Visitor interface.
+func (BaseVisitor) LeaveIntersectionType(*javascript.IntersectionType) {}
+
+// VisitIterationStatementDo implements the Visitor interface.
+func (BaseVisitor) VisitIterationStatementDo(*javascript.IterationStatementDo) bool {
+	return true
+}
+
+// LeaveIterationStatementDo implements the Visitor interface.
+func (BaseVisitor) LeaveIterationStatementDo(*javascript.IterationStatementDo) {}
+
+// VisitIterationStatementFor implements the Visitor interface.
+func (BaseVisitor) VisitIterationStatementFor(*javascript.IterationStatementFor) bool {
+	return true
+}
+
+// LeaveIterationStatementFor implements the Visitor interface.
+func (BaseVisitor) LeaveIterationStatementFor(*javascript.IterationStatementFor) {}
+
+// VisitIterationStatementWhile implements the Visitor interface.
+func (BaseVisitor) VisitIterationStatementWhile(*javascript.IterationStatementWhile) bool {
+	return true
+}
+
+// LeaveIterationStatementWhile implements the Visitor interface.
+func (BaseVisitor) LeaveIterationStatementWhile(*javascript.IterationStatementWhile) {}
+
+// VisitJSXAttribute implements the Visitor interface.
+func (BaseVisitor) VisitJSXAttribute(*javascript.JSXAttribute) bool {
+	return true
+}
+
+// LeaveJSXAttribute implements the Visitor interface.
+func (BaseVisitor) LeaveJSXAttribute(*javascript.JSXAttribute) {}
+
+// VisitJSXChild implements the Visitor interface.
+func (BaseVisitor) VisitJSXChild(*javascript.JSXChild) bool {
+	return true
+}
+
+// LeaveJSXChild implements the Visitor interface.
+func (BaseVisitor) LeaveJSXChild(*javascript.JSXChild) {}
+
+// VisitJSXElement implements the Visitor interface.
+func (BaseVisitor) VisitJSXElement(*javascript.JSXElement) bool {
+	return true
+}
+
+// LeaveJSXElement implements the Visitor interface.
+func (BaseVisitor) LeaveJSXElement(*javascript.JSXElement) {}
+
+// VisitJSXElementName implements the Visitor interface.
+func (BaseVisitor) VisitJSXElementName(*javascript.JSXElementName) bool {
+	return true
+}
+
+// LeaveJSXElementName implements the Visitor interface.
+func (BaseVisitor) LeaveJSXElementName(*javascript.JSXElementName) {}
+
+// VisitJSXFragment implements the Visitor interface.
+func (BaseVisitor) VisitJSXFragment(*javascript.JSXFragment) bool {
+	return true
+}
+
+// LeaveJSXFragment implements the Visitor interface.
+func (BaseVisitor) LeaveJSXFragment(*javascript.JSXFragment) {}
+
+// VisitLeftHandSideExpression implements the Visitor interface.
+func (BaseVisitor) VisitLeftHandSideExpression(*javascript.LeftHandSideExpression) bool {
+	return true
+}
+
+// LeaveLeftHandSideExpression implements the Visitor interface.
+func (BaseVisitor) LeaveLeftHandSideExpression(*javascript.LeftHandSideExpression) {}
+
+// VisitLexicalBinding implements the Visitor interface.
+func (BaseVisitor) VisitLexicalBinding(*javascript.LexicalBinding) bool {
+	return true
+}
+
+// LeaveLexicalBinding implements the Visitor interface.
+func (BaseVisitor) LeaveLexicalBinding(*javascript.LexicalBinding) {}
+
+// VisitLexicalDeclaration implements the Visitor interface.
+func (BaseVisitor) VisitLexicalDeclaration(*javascript.LexicalDeclaration) bool {
+	return true
+}
+
+// LeaveLexicalDeclaration implements the Visitor interface.
+func (BaseVisitor) LeaveLexicalDeclaration(*javascript.LexicalDeclaration) {}
+
+// VisitLiteralType implements the Visitor interface.
+func (BaseVisitor) VisitLiteralType(*javascript.LiteralType) bool {
+	return true
+}
+
+// LeaveLiteralType implements the Visitor interface.
+func (BaseVisitor) LeaveLiteralType(*javascript.LiteralType) {}
+
+// VisitLogicalANDExpression implements the Visitor interface.
+func (BaseVisitor) VisitLogicalANDExpression(*javascript.LogicalANDExpression) bool {
+	return true
+}
+
+// LeaveLogicalANDExpression implements the Visitor interface.
+func (BaseVisitor) LeaveLogicalANDExpression(*javascript.LogicalANDExpression) {}
+
+// VisitLogicalORExpression implements the Visitor interface.
+func (BaseVisitor) VisitLogicalORExpression(*javascript.LogicalORExpression) bool {
+	return true
+}
+
+// LeaveLogicalORExpression implements the Visitor interface.
+func (BaseVisitor) LeaveLogicalORExpression(*javascript.LogicalORExpression) {}
+
+// VisitMappedType implements the Visitor interface.
+func (BaseVisitor) VisitMappedType(*javascript.MappedType) bool {
+	return true
+}
+
+// LeaveMappedType implements the Visitor interface.
+func (BaseVisitor) LeaveMappedType(*javascript.MappedType) {}
+
+// VisitMemberExpression implements the Visitor interface.
+func (BaseVisitor) VisitMemberExpression(*javascript.MemberExpression) bool {
+	return true
+}
+
+// LeaveMemberExpression implements the Visitor interface.
+func (BaseVisitor) LeaveMemberExpression(*javascript.MemberExpression) {}
+
+// VisitMethodDefinition implements the Visitor interface.
+func (BaseVisitor) VisitMethodDefinition(*javascript.MethodDefinition) bool {
+	return true
+}
+
+// LeaveMethodDefinition implements the Visitor interface.
+func (BaseVisitor) LeaveMethodDefinition(*javascript.MethodDefinition) {}
+
+// VisitMethodSignature implements the Visitor interface.
+func (BaseVisitor) VisitMethodSignature(*javascript.MethodSignature) bool {
+	return true
+}
+
+// LeaveMethodSignature implements the Visitor interface.
+func (BaseVisitor) LeaveMethodSignature(*javascript.MethodSignature) {}
+
+// VisitModule implements the Visitor interface.
+func (BaseVisitor) VisitModule(*javascript.Module) bool {
+	return true
+}
+
+// LeaveModule implements the Visitor interface.
+func (BaseVisitor) LeaveModule(*javascript.Module) {}
+
+// VisitModuleItem implements the Visitor interface.
+func (BaseVisitor) VisitModuleItem(*javascript.ModuleItem) bool {
+	return true
+}
+
+// LeaveModuleItem implements the Visitor interface.
+func (BaseVisitor) LeaveModuleItem(*javascript.ModuleItem) {}
+
+// VisitMultiplicativeExpression implements the Visitor interface.
+func (BaseVisitor) VisitMultiplicativeExpression(*javascript.MultiplicativeExpression) bool {
+	return true
+}
+
+// LeaveMultiplicativeExpression implements the Visitor interface.
+func (BaseVisitor) LeaveMultiplicativeExpression(*javascript.MultiplicativeExpression) {}
+
+// VisitNamedImports implements the Visitor interface.
+func (BaseVisitor) VisitNamedImports(*javascript.NamedImports) bool {
+	return true
+}
+
+// LeaveNamedImports implements the Visitor interface.
+func (BaseVisitor) LeaveNamedImports(*javascript.NamedImports) {}
+
+// VisitNamespaceDeclaration implements the Visitor interface.
+func (BaseVisitor) VisitNamespaceDeclaration(*javascript.NamespaceDeclaration) bool {
+	return true
+}
+
+// LeaveNamespaceDeclaration implements the Visitor interface.
+func (BaseVisitor) LeaveNamespaceDeclaration(*javascript.NamespaceDeclaration) {}
+
+// VisitNewExpression implements the Visitor interface.
+func (BaseVisitor) VisitNewExpression(*javascript.NewExpression) bool {
+	return true
+}
+
+// LeaveNewExpression implements the Visitor interface.
+func (BaseVisitor) LeaveNewExpression(*javascript.NewExpression) {}
+
+// VisitObjectAssignmentPattern implements the Visitor interface.
+func (BaseVisitor) VisitObjectAssignmentPattern(*javascript.ObjectAssignmentPattern) bool {
+	return true
+}
+
+// LeaveObjectAssignmentPattern implements the Visitor interface.
+func (BaseVisitor) LeaveObjectAssignmentPattern(*javascript.ObjectAssignmentPattern) {}
+
+// VisitObjectBindingPattern implements the Visitor interface.
+func (BaseVisitor) VisitObjectBindingPattern(*javascript.ObjectBindingPattern) bool {
+	return true
+}
+
+// LeaveObjectBindingPattern implements the Visitor interface.
+func (BaseVisitor) LeaveObjectBindingPattern(*javascript.ObjectBindingPattern) {}
+
+// VisitObjectLiteral implements the Visitor interface.
+func (BaseVisitor) VisitObjectLiteral(*javascript.ObjectLiteral) bool {
+	return true
+}
+
+// LeaveObjectLiteral implements the Visitor interface.
+func (BaseVisitor) LeaveObjectLiteral(*javascript.ObjectLiteral) {}
+
+// VisitObjectType implements the Visitor interface.
+func (BaseVisitor) VisitObjectType(*javascript.ObjectType) bool {
+	return true
+}
+
+// LeaveObjectType implements the Visitor interface.
+func (BaseVisitor) LeaveObjectType(*javascript.ObjectType) {}
+
+// VisitOptionalChain implements the Visitor interface.
+func (BaseVisitor) VisitOptionalChain(*javascript.OptionalChain) bool {
+	return true
+}
+
+// LeaveOptionalChain implements the Visitor interface.
+func (BaseVisitor) LeaveOptionalChain(*javascript.OptionalChain) {}
+
+// VisitOptionalExpression implements the Visitor interface.
+func (BaseVisitor) VisitOptionalExpression(*javascript.OptionalExpression) bool {
+	return true
+}
+
+// LeaveOptionalExpression implements the Visitor interface.
+func (BaseVisitor) LeaveOptionalExpression(*javascript.OptionalExpression) {}
+
+// VisitParameter implements the Visitor interface.
+func (BaseVisitor) VisitParameter(*javascript.Parameter) bool {
+	return true
+}
+
+// LeaveParameter implements the Visitor interface.
+func (BaseVisitor) LeaveParameter(*javascript.Parameter) {}
+
+// VisitParameterList implements the Visitor interface.
+func (BaseVisitor) VisitParameterList(*javascript.ParameterList) bool {
+	return true
+}
+
+// LeaveParameterList implements the Visitor interface.
+func (BaseVisitor) LeaveParameterList(*javascript.ParameterList) {}
+
+// VisitParenthesizedExpression implements the Visitor interface.
+func (BaseVisitor) VisitParenthesizedExpression(*javascript.ParenthesizedExpression) bool {
+	return true
+}
+
+// LeaveParenthesizedExpression implements the Visitor interface.
+func (BaseVisitor) LeaveParenthesizedExpression(*javascript.ParenthesizedExpression) {}
+
+// VisitPostfixType implements the Visitor interface.
+func (BaseVisitor) VisitPostfixType(*javascript.PostfixType) bool {
+	return true
+}
+
+// LeavePostfixType implements the Visitor interface.
+func (BaseVisitor) LeavePostfixType(*javascript.PostfixType) {}
+
+// VisitPrimaryExpression implements the Visitor interface.
+func (BaseVisitor) VisitPrimaryExpression(*javascript.PrimaryExpression) bool {
+	return true
+}
+
+// LeavePrimaryExpression implements the Visitor interface.
+func (BaseVisitor) LeavePrimaryExpression(*javascript.PrimaryExpression) {}
+
+// VisitPrimaryType implements the Visitor interface.
+func (BaseVisitor) VisitPrimaryType(*javascript.PrimaryType) bool {
+	return true
+}
+
+// LeavePrimaryType implements the Visitor interface.
+func (BaseVisitor) LeavePrimaryType(*javascript.PrimaryType) {}
+
+// VisitPropertyDefinition implements the Visitor interface.
+func (BaseVisitor) VisitPropertyDefinition(*javascript.PropertyDefinition) bool {
+	return true
+}
+
+// LeavePropertyDefinition implements the Visitor interface.
+func (BaseVisitor) LeavePropertyDefinition(*javascript.PropertyDefinition) {}
+
+// VisitPropertyName implements the Visitor interface.
+func (BaseVisitor) VisitPropertyName(*javascript.PropertyName) bool {
+	return true
+}
+
+// LeavePropertyName implements the Visitor interface.
+func (BaseVisitor) LeavePropertyName(*javascript.PropertyName) {}
+
+// VisitPropertySignature implements the Visitor interface.
+func (BaseVisitor) VisitPropertySignature(*javascript.PropertySignature) bool {
+	return true
+}
+
+// LeavePropertySignature implements the Visitor interface.
+func (BaseVisitor) LeavePropertySignature(*javascript.PropertySignature) {}
+
+// VisitRelationalExpression implements the Visitor interface.
+func (BaseVisitor) VisitRelationalExpression(*javascript.RelationalExpression) bool {
+	return true
+}
+
+// LeaveRelationalExpression implements the Visitor interface.
+func (BaseVisitor) LeaveRelationalExpression(*javascript.RelationalExpression) {}
+
+// VisitScript implements the Visitor interface.
+func (BaseVisitor) VisitScript(*javascript.Script) bool {
+	return true
+}
+
+// LeaveScript implements the Visitor interface.
+func (BaseVisitor) LeaveScript(*javascript.Script) {}
+
+// VisitShiftExpression implements the Visitor interface.
+func (BaseVisitor) VisitShiftExpression(*javascript.ShiftExpression) bool {
+	return true
+}
+
+// LeaveShiftExpression implements the Visitor interface.
+func (BaseVisitor) LeaveShiftExpression(*javascript.ShiftExpression) {}
+
+// VisitStatement implements the Visitor interface.
+func (BaseVisitor) VisitStatement(*javascript.Statement) bool {
+	return true
+}
+
+// LeaveStatement implements the Visitor interface.
+func (BaseVisitor) LeaveStatement(*javascript.Statement) {}
+
+// VisitStatementListItem implements the Visitor interface.
+func (BaseVisitor) VisitStatementListItem(*javascript.StatementListItem) bool {
+	return true
+}
+
+// LeaveStatementListItem implements the Visitor interface.
+func (BaseVisitor) LeaveStatementListItem(*javascript.StatementListItem) {}
+
+// VisitSwitchStatement implements the Visitor interface.
+func (BaseVisitor) VisitSwitchStatement(*javascript.SwitchStatement) bool {
+	return true
+}
+
+// LeaveSwitchStatement implements the Visitor interface.
+func (BaseVisitor) LeaveSwitchStatement(*javascript.SwitchStatement) {}
+
+// VisitTemplateLiteral implements the Visitor interface.
+func (BaseVisitor) VisitTemplateLiteral(*javascript.TemplateLiteral) bool {
+	return true
+}
+
+// LeaveTemplateLiteral implements the Visitor interface.
+func (BaseVisitor) LeaveTemplateLiteral(*javascript.TemplateLiteral) {}
+
+// VisitTemplateLiteralType implements the Visitor interface.
+func (BaseVisitor) VisitTemplateLiteralType(*javascript.TemplateLiteralType) bool {
+	return true
+}
+
+// LeaveTemplateLiteralType implements the Visitor interface.
+func (BaseVisitor) LeaveTemplateLiteralType(*javascript.TemplateLiteralType) {}
+
+// VisitTryStatement implements the Visitor interface.
+func (BaseVisitor) VisitTryStatement(*javascript.TryStatement) bool {
+	return true
+}
+
+// LeaveTryStatement implements the Visitor interface.
+func (BaseVisitor) LeaveTryStatement(*javascript.TryStatement) {}
+
+// VisitTupleElement implements the Visitor interface.
+func (BaseVisitor) VisitTupleElement(*javascript.TupleElement) bool {
+	return true
+}
+
+// LeaveTupleElement implements the Visitor interface.
+func (BaseVisitor) LeaveTupleElement(*javascript.TupleElement) {}
+
+// VisitTupleType implements the Visitor interface.
+func (BaseVisitor) VisitTupleType(*javascript.TupleType) bool {
+	return true
+}
+
+// LeaveTupleType implements the Visitor interface.
+func (BaseVisitor) LeaveTupleType(*javascript.TupleType) {}
+
+// VisitTypeAliasDeclaration implements the Visitor interface.
+func (BaseVisitor) VisitTypeAliasDeclaration(*javascript.TypeAliasDeclaration) bool {
+	return true
+}
+
+// LeaveTypeAliasDeclaration implements the Visitor interface.
+func (BaseVisitor) LeaveTypeAliasDeclaration(*javascript.TypeAliasDeclaration) {}
+
+// VisitTypeAnnotation implements the Visitor interface.
+func (BaseVisitor) VisitTypeAnnotation(*javascript.TypeAnnotation) bool {
+	return true
+}
+
+// LeaveTypeAnnotation implements the Visitor interface.
+func (BaseVisitor) LeaveTypeAnnotation(*javascript.TypeAnnotation) {}
+
+// VisitTypeArguments implements the Visitor interface.
+func (BaseVisitor) VisitTypeArguments(*javascript.TypeArguments) bool {
+	return true
+}
+
+// LeaveTypeArguments implements the Visitor interface.
+func (BaseVisitor) LeaveTypeArguments(*javascript.TypeArguments) {}
+
+// VisitTypeExpression implements the Visitor interface.
+func (BaseVisitor) VisitTypeExpression(*javascript.TypeExpression) bool {
+	return true
+}
+
+// LeaveTypeExpression implements the Visitor interface.
+func (BaseVisitor) LeaveTypeExpression(*javascript.TypeExpression) {}
+
+// VisitTypeMember implements the Visitor interface.
+func (BaseVisitor) VisitTypeMember(*javascript.TypeMember) bool {
+	return true
+}
+
+// LeaveTypeMember implements the Visitor interface.
+func (BaseVisitor) LeaveTypeMember(*javascript.TypeMember) {}
+
+// VisitTypeOperator implements the Visitor interface.
+func (BaseVisitor) VisitTypeOperator(*javascript.TypeOperator) bool {
+	return true
+}
+
+// LeaveTypeOperator implements the Visitor interface.
+func (BaseVisitor) LeaveTypeOperator(*javascript.TypeOperator) {}
+
+// VisitTypeParameter implements the Visitor interface.
+func (BaseVisitor) VisitTypeParameter(*javascript.TypeParameter) bool {
+	return true
+}
+
+// LeaveTypeParameter implements the Visitor interface.
+func (BaseVisitor) LeaveTypeParameter(*javascript.TypeParameter) {}
+
+// VisitTypeParameters implements the Visitor interface.
+func (BaseVisitor) VisitTypeParameters(*javascript.TypeParameters) bool {
+	return true
+}
+
+// LeaveTypeParameters implements the Visitor interface.
+func (BaseVisitor) LeaveTypeParameters(*javascript.TypeParameters) {}
+
+// VisitTypeQuery implements the Visitor interface.
+func (BaseVisitor) VisitTypeQuery(*javascript.TypeQuery) bool {
+	return true
+}
+
+// LeaveTypeQuery implements the Visitor interface.
+func (BaseVisitor) LeaveTypeQuery(*javascript.TypeQuery) {}
+
+// VisitTypeReference implements the Visitor interface.
+func (BaseVisitor) VisitTypeReference(*javascript.TypeReference) bool {
+	return true
+}
+
+// LeaveTypeReference implements the Visitor interface.
+func (BaseVisitor) LeaveTypeReference(*javascript.TypeReference) {}
+
+// VisitUnaryExpression implements the Visitor interface.
+func (BaseVisitor) VisitUnaryExpression(*javascript.UnaryExpression) bool {
+	return true
+}
+
+// LeaveUnaryExpression implements the Visitor interface.
+func (BaseVisitor) LeaveUnaryExpression(*javascript.UnaryExpression) {}
+
+// VisitUnionType implements the Visitor interface.
+func (BaseVisitor) VisitUnionType(*javascript.UnionType) bool {
+	return true
+}
+
+// LeaveUnionType implements the Visitor interface.
+func (BaseVisitor) LeaveUnionType(*javascript.UnionType) {}
+
+// VisitUpdateExpression implements the Visitor interface.
+func (BaseVisitor) VisitUpdateExpression(*javascript.UpdateExpression) bool {
+	return true
+}
+
+// LeaveUpdateExpression implements the Visitor interface.
+func (BaseVisitor) LeaveUpdateExpression(*javascript.UpdateExpression) {}
+
+// VisitVariableStatement implements the Visitor interface.
+func (BaseVisitor) VisitVariableStatement(*javascript.VariableStatement) bool {
+	return true
+}
+
+// LeaveVariableStatement implements the Visitor interface.
+func (BaseVisitor) LeaveVariableStatement(*javascript.VariableStatement) {}
+
+// VisitWithStatement implements the Visitor interface.
+func (BaseVisitor) VisitWithStatement(*javascript.WithStatement) bool {
+	return true
+}
+
+// LeaveWithStatement implements the Visitor interface.
+func (BaseVisitor) LeaveWithStatement(*javascript.WithStatement) {}
+
+// Visit calls the Visit and Leave methods of the Visitor for the given
+// JavaScript type, and for each non-nil, non-Token type beneath it.
+func Visit(t javascript.Type, v Visitor) {
+	visitor{v}.Handle(t)
+}
+
+type visitor struct {
+	Visitor
+}
+
+func (v visitor) Handle(t javascript.Type) error {
+	switch t := t.(type) {
+	case javascript.AdditiveExpression:
+		return v.Handle(&t)
+	case *javascript.AdditiveExpression:
+		if v.VisitAdditiveExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveAdditiveExpression(t)
+	case javascript.Argument:
+		return v.Handle(&t)
+	case *javascript.Argument:
+		if v.VisitArgument(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveArgument(t)
+	case javascript.Arguments:
+		return v.Handle(&t)
+	case *javascript.Arguments:
+		if v.VisitArguments(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveArguments(t)
+	case javascript.ArrayAssignmentPattern:
+		return v.Handle(&t)
+	case *javascript.ArrayAssignmentPattern:
+		if v.VisitArrayAssignmentPattern(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveArrayAssignmentPattern(t)
+	case javascript.ArrayBindingPattern:
+		return v.Handle(&t)
+	case *javascript.ArrayBindingPattern:
+		if v.VisitArrayBindingPattern(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveArrayBindingPattern(t)
+	case javascript.ArrayElement:
+		return v.Handle(&t)
+	case *javascript.ArrayElement:
+		if v.VisitArrayElement(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveArrayElement(t)
+	case javascript.ArrayLiteral:
+		return v.Handle(&t)
+	case *javascript.ArrayLiteral:
+		if v.VisitArrayLiteral(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveArrayLiteral(t)
+	case javascript.ArrowFunction:
+		return v.Handle(&t)
+	case *javascript.ArrowFunction:
+		if v.VisitArrowFunction(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveArrowFunction(t)
+	case javascript.AssignmentElement:
+		return v.Handle(&t)
+	case *javascript.AssignmentElement:
+		if v.VisitAssignmentElement(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveAssignmentElement(t)
+	case javascript.AssignmentExpression:
+		return v.Handle(&t)
+	case *javascript.AssignmentExpression:
+		if v.VisitAssignmentExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveAssignmentExpression(t)
+	case javascript.AssignmentPattern:
+		return v.Handle(&t)
+	case *javascript.AssignmentPattern:
+		if v.VisitAssignmentPattern(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveAssignmentPattern(t)
+	case javascript.AssignmentProperty:
+		return v.Handle(&t)
+	case *javascript.AssignmentProperty:
+		if v.VisitAssignmentProperty(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveAssignmentProperty(t)
+	case javascript.BindingElement:
+		return v.Handle(&t)
+	case *javascript.BindingElement:
+		if v.VisitBindingElement(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveBindingElement(t)
+	case javascript.BindingProperty:
+		return v.Handle(&t)
+	case *javascript.BindingProperty:
+		if v.VisitBindingProperty(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveBindingProperty(t)
+	case javascript.BitwiseANDExpression:
+		return v.Handle(&t)
+	case *javascript.BitwiseANDExpression:
+		if v.VisitBitwiseANDExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveBitwiseANDExpression(t)
+	case javascript.BitwiseORExpression:
+		return v.Handle(&t)
+	case *javascript.BitwiseORExpression:
+		if v.VisitBitwiseORExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveBitwiseORExpression(t)
+	case javascript.BitwiseXORExpression:
+		return v.Handle(&t)
+	case *javascript.BitwiseXORExpression:
+		if v.VisitBitwiseXORExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveBitwiseXORExpression(t)
+	case javascript.Block:
+		return v.Handle(&t)
+	case *javascript.Block:
+		if v.VisitBlock(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveBlock(t)
+	case javascript.CallExpression:
+		return v.Handle(&t)
+	case *javascript.CallExpression:
+		if v.VisitCallExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveCallExpression(t)
+	case javascript.CallSignature:
+		return v.Handle(&t)
+	case *javascript.CallSignature:
+		if v.VisitCallSignature(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveCallSignature(t)
+	case javascript.CaseClause:
+		return v.Handle(&t)
+	case *javascript.CaseClause:
+		if v.VisitCaseClause(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveCaseClause(t)
+	case javascript.ClassDeclaration:
+		return v.Handle(&t)
+	case *javascript.ClassDeclaration:
+		if v.VisitClassDeclaration(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveClassDeclaration(t)
+	case javascript.ClassElement:
+		return v.Handle(&t)
+	case *javascript.ClassElement:
+		if v.VisitClassElement(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveClassElement(t)
+	case javascript.ClassElementName:
+		return v.Handle(&t)
+	case *javascript.ClassElementName:
+		if v.VisitClassElementName(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveClassElementName(t)
+	case javascript.CoalesceExpression:
+		return v.Handle(&t)
+	case *javascript.CoalesceExpression:
+		if v.VisitCoalesceExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveCoalesceExpression(t)
+	case javascript.ConditionalExpression:
+		return v.Handle(&t)
+	case *javascript.ConditionalExpression:
+		if v.VisitConditionalExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveConditionalExpression(t)
+	case javascript.Declaration:
+		return v.Handle(&t)
+	case *javascript.Declaration:
+		if v.VisitDeclaration(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveDeclaration(t)
+	case javascript.Decorator:
+		return v.Handle(&t)
+	case *javascript.Decorator:
+		if v.VisitDecorator(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveDecorator(t)
+	case javascript.DecoratorMemberExpression:
+		return v.Handle(&t)
+	case *javascript.DecoratorMemberExpression:
+		if v.VisitDecoratorMemberExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveDecoratorMemberExpression(t)
+	case javascript.DestructuringAssignmentTarget:
+		return v.Handle(&t)
+	case *javascript.DestructuringAssignmentTarget:
+		if v.VisitDestructuringAssignmentTarget(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveDestructuringAssignmentTarget(t)
+	case javascript.EnumDeclaration:
+		return v.Handle(&t)
+	case *javascript.EnumDeclaration:
+		if v.VisitEnumDeclaration(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveEnumDeclaration(t)
+	case javascript.EnumMember:
+		return v.Handle(&t)
+	case *javascript.EnumMember:
+		if v.VisitEnumMember(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveEnumMember(t)
+	case javascript.EqualityExpression:
+		return v.Handle(&t)
+	case *javascript.EqualityExpression:
+		if v.VisitEqualityExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveEqualityExpression(t)
+	case javascript.ExponentiationExpression:
+		return v.Handle(&t)
+	case *javascript.ExponentiationExpression:
+		if v.VisitExponentiationExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveExponentiationExpression(t)
+	case javascript.ExportClause:
+		return v.Handle(&t)
+	case *javascript.ExportClause:
+		if v.VisitExportClause(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveExportClause(t)
+	case javascript.ExportDeclaration:
+		return v.Handle(&t)
+	case *javascript.ExportDeclaration:
+		if v.VisitExportDeclaration(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveExportDeclaration(t)
+	case javascript.ExportSpecifier:
+		return v.Handle(&t)
+	case *javascript.ExportSpecifier:
+		if v.VisitExportSpecifier(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveExportSpecifier(t)
+	case javascript.Expression:
+		return v.Handle(&t)
+	case *javascript.Expression:
+		if v.VisitExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveExpression(t)
+	case javascript.FieldDefinition:
+		return v.Handle(&t)
+	case *javascript.FieldDefinition:
+		if v.VisitFieldDefinition(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveFieldDefinition(t)
+	case javascript.FormalParameters:
+		return v.Handle(&t)
+	case *javascript.FormalParameters:
+		if v.VisitFormalParameters(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveFormalParameters(t)
+	case javascript.FromClause:
+		return v.Handle(&t)
+	case *javascript.FromClause:
+		if v.VisitFromClause(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveFromClause(t)
+	case javascript.FunctionDeclaration:
+		return v.Handle(&t)
+	case *javascript.FunctionDeclaration:
+		if v.VisitFunctionDeclaration(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveFunctionDeclaration(t)
+	case javascript.FunctionTypeExpression:
+		return v.Handle(&t)
+	case *javascript.FunctionTypeExpression:
+		if v.VisitFunctionTypeExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveFunctionTypeExpression(t)
+	case javascript.IfStatement:
+		return v.Handle(&t)
+	case *javascript.IfStatement:
+		if v.VisitIfStatement(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveIfStatement(t)
+	case javascript.ImportClause:
+		return v.Handle(&t)
+	case *javascript.ImportClause:
+		if v.VisitImportClause(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveImportClause(t)
+	case javascript.ImportDeclaration:
+		return v.Handle(&t)
+	case *javascript.ImportDeclaration:
+		if v.VisitImportDeclaration(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveImportDeclaration(t)
+	case javascript.ImportSpecifier:
+		return v.Handle(&t)
+	case *javascript.ImportSpecifier:
+		if v.VisitImportSpecifier(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveImportSpecifier(t)
+	case javascript.ImportType:
+		return v.Handle(&t)
+	case *javascript.ImportType:
+		if v.VisitImportType(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveImportType(t)
+	case javascript.IndexSignature:
+		return v.Handle(&t)
+	case *javascript.IndexSignature:
+		if v.VisitIndexSignature(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveIndexSignature(t)
+	case javascript.InterfaceDeclaration:
+		return v.Handle(&t)
+	case *javascript.InterfaceDeclaration:
+		if v.VisitInterfaceDeclaration(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveInterfaceDeclaration(t)
+	case javascript.IntersectionType:
+		return v.Handle(&t)
+	case *javascript.IntersectionType:
+		if v.VisitIntersectionType(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveIntersectionType(t)
+	case javascript.IterationStatementDo:
+		return v.Handle(&t)
+	case *javascript.IterationStatementDo:
+		if v.VisitIterationStatementDo(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveIterationStatementDo(t)
+	case javascript.IterationStatementFor:
+		return v.Handle(&t)
+	case *javascript.IterationStatementFor:
+		if v.VisitIterationStatementFor(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveIterationStatementFor(t)
+	case javascript.IterationStatementWhile:
+		return v.Handle(&t)
+	case *javascript.IterationStatementWhile:
+		if v.VisitIterationStatementWhile(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveIterationStatementWhile(t)
+	case javascript.JSXAttribute:
+		return v.Handle(&t)
+	case *javascript.JSXAttribute:
+		if v.VisitJSXAttribute(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveJSXAttribute(t)
+	case javascript.JSXChild:
+		return v.Handle(&t)
+	case *javascript.JSXChild:
+		if v.VisitJSXChild(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveJSXChild(t)
+	case javascript.JSXElement:
+		return v.Handle(&t)
+	case *javascript.JSXElement:
+		if v.VisitJSXElement(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveJSXElement(t)
+	case javascript.JSXElementName:
+		return v.Handle(&t)
+	case *javascript.JSXElementName:
+		if v.VisitJSXElementName(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveJSXElementName(t)
+	case javascript.JSXFragment:
+		return v.Handle(&t)
+	case *javascript.JSXFragment:
+		if v.VisitJSXFragment(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveJSXFragment(t)
+	case javascript.LeftHandSideExpression:
+		return v.Handle(&t)
+	case *javascript.LeftHandSideExpression:
+		if v.VisitLeftHandSideExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveLeftHandSideExpression(t)
+	case javascript.LexicalBinding:
+		return v.Handle(&t)
+	case *javascript.LexicalBinding:
+		if v.VisitLexicalBinding(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveLexicalBinding(t)
+	case javascript.LexicalDeclaration:
+		return v.Handle(&t)
+	case *javascript.LexicalDeclaration:
+		if v.VisitLexicalDeclaration(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveLexicalDeclaration(t)
+	case javascript.LiteralType:
+		return v.Handle(&t)
+	case *javascript.LiteralType:
+		if v.VisitLiteralType(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveLiteralType(t)
+	case javascript.LogicalANDExpression:
+		return v.Handle(&t)
+	case *javascript.LogicalANDExpression:
+		if v.VisitLogicalANDExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveLogicalANDExpression(t)
+	case javascript.LogicalORExpression:
+		return v.Handle(&t)
+	case *javascript.LogicalORExpression:
+		if v.VisitLogicalORExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveLogicalORExpression(t)
+	case javascript.MappedType:
+		return v.Handle(&t)
+	case *javascript.MappedType:
+		if v.VisitMappedType(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveMappedType(t)
+	case javascript.MemberExpression:
+		return v.Handle(&t)
+	case *javascript.MemberExpression:
+		if v.VisitMemberExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveMemberExpression(t)
+	case javascript.MethodDefinition:
+		return v.Handle(&t)
+	case *javascript.MethodDefinition:
+		if v.VisitMethodDefinition(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveMethodDefinition(t)
+	case javascript.MethodSignature:
+		return v.Handle(&t)
+	case *javascript.MethodSignature:
+		if v.VisitMethodSignature(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveMethodSignature(t)
+	case javascript.Module:
+		return v.Handle(&t)
+	case *javascript.Module:
+		if v.VisitModule(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveModule(t)
+	case javascript.ModuleItem:
+		return v.Handle(&t)
+	case *javascript.ModuleItem:
+		if v.VisitModuleItem(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveModuleItem(t)
+	case javascript.MultiplicativeExpression:
+		return v.Handle(&t)
+	case *javascript.MultiplicativeExpression:
+		if v.VisitMultiplicativeExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveMultiplicativeExpression(t)
+	case javascript.NamedImports:
+		return v.Handle(&t)
+	case *javascript.NamedImports:
+		if v.VisitNamedImports(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveNamedImports(t)
+	case javascript.NamespaceDeclaration:
+		return v.Handle(&t)
+	case *javascript.NamespaceDeclaration:
+		if v.VisitNamespaceDeclaration(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveNamespaceDeclaration(t)
+	case javascript.NewExpression:
+		return v.Handle(&t)
+	case *javascript.NewExpression:
+		if v.VisitNewExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveNewExpression(t)
+	case javascript.ObjectAssignmentPattern:
+		return v.Handle(&t)
+	case *javascript.ObjectAssignmentPattern:
+		if v.VisitObjectAssignmentPattern(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveObjectAssignmentPattern(t)
+	case javascript.ObjectBindingPattern:
+		return v.Handle(&t)
+	case *javascript.ObjectBindingPattern:
+		if v.VisitObjectBindingPattern(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveObjectBindingPattern(t)
+	case javascript.ObjectLiteral:
+		return v.Handle(&t)
+	case *javascript.ObjectLiteral:
+		if v.VisitObjectLiteral(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveObjectLiteral(t)
+	case javascript.ObjectType:
+		return v.Handle(&t)
+	case *javascript.ObjectType:
+		if v.VisitObjectType(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveObjectType(t)
+	case javascript.OptionalChain:
+		return v.Handle(&t)
+	case *javascript.OptionalChain:
+		if v.VisitOptionalChain(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveOptionalChain(t)
+	case javascript.OptionalExpression:
+		return v.Handle(&t)
+	case *javascript.OptionalExpression:
+		if v.VisitOptionalExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveOptionalExpression(t)
+	case javascript.Parameter:
+		return v.Handle(&t)
+	case *javascript.Parameter:
+		if v.VisitParameter(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveParameter(t)
+	case javascript.ParameterList:
+		return v.Handle(&t)
+	case *javascript.ParameterList:
+		if v.VisitParameterList(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveParameterList(t)
+	case javascript.ParenthesizedExpression:
+		return v.Handle(&t)
+	case *javascript.ParenthesizedExpression:
+		if v.VisitParenthesizedExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveParenthesizedExpression(t)
+	case javascript.PostfixType:
+		return v.Handle(&t)
+	case *javascript.PostfixType:
+		if v.VisitPostfixType(t) {
+			Walk(t, v)
+		}
+
+		v.LeavePostfixType(t)
+	case javascript.PrimaryExpression:
+		return v.Handle(&t)
+	case *javascript.PrimaryExpression:
+		if v.VisitPrimaryExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeavePrimaryExpression(t)
+	case javascript.PrimaryType:
+		return v.Handle(&t)
+	case *javascript.PrimaryType:
+		if v.VisitPrimaryType(t) {
+			Walk(t, v)
+		}
+
+		v.LeavePrimaryType(t)
+	case javascript.PropertyDefinition:
+		return v.Handle(&t)
+	case *javascript.PropertyDefinition:
+		if v.VisitPropertyDefinition(t) {
+			Walk(t, v)
+		}
+
+		v.LeavePropertyDefinition(t)
+	case javascript.PropertyName:
+		return v.Handle(&t)
+	case *javascript.PropertyName:
+		if v.VisitPropertyName(t) {
+			Walk(t, v)
+		}
+
+		v.LeavePropertyName(t)
+	case javascript.PropertySignature:
+		return v.Handle(&t)
+	case *javascript.PropertySignature:
+		if v.VisitPropertySignature(t) {
+			Walk(t, v)
+		}
+
+		v.LeavePropertySignature(t)
+	case javascript.RelationalExpression:
+		return v.Handle(&t)
+	case *javascript.RelationalExpression:
+		if v.VisitRelationalExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveRelationalExpression(t)
+	case javascript.Script:
+		return v.Handle(&t)
+	case *javascript.Script:
+		if v.VisitScript(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveScript(t)
+	case javascript.ShiftExpression:
+		return v.Handle(&t)
+	case *javascript.ShiftExpression:
+		if v.VisitShiftExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveShiftExpression(t)
+	case javascript.Statement:
+		return v.Handle(&t)
+	case *javascript.Statement:
+		if v.VisitStatement(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveStatement(t)
+	case javascript.StatementListItem:
+		return v.Handle(&t)
+	case *javascript.StatementListItem:
+		if v.VisitStatementListItem(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveStatementListItem(t)
+	case javascript.SwitchStatement:
+		return v.Handle(&t)
+	case *javascript.SwitchStatement:
+		if v.VisitSwitchStatement(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveSwitchStatement(t)
+	case javascript.TemplateLiteral:
+		return v.Handle(&t)
+	case *javascript.TemplateLiteral:
+		if v.VisitTemplateLiteral(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveTemplateLiteral(t)
+	case javascript.TemplateLiteralType:
+		return v.Handle(&t)
+	case *javascript.TemplateLiteralType:
+		if v.VisitTemplateLiteralType(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveTemplateLiteralType(t)
+	case javascript.TryStatement:
+		return v.Handle(&t)
+	case *javascript.TryStatement:
+		if v.VisitTryStatement(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveTryStatement(t)
+	case javascript.TupleElement:
+		return v.Handle(&t)
+	case *javascript.TupleElement:
+		if v.VisitTupleElement(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveTupleElement(t)
+	case javascript.TupleType:
+		return v.Handle(&t)
+	case *javascript.TupleType:
+		if v.VisitTupleType(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveTupleType(t)
+	case javascript.TypeAliasDeclaration:
+		return v.Handle(&t)
+	case *javascript.TypeAliasDeclaration:
+		if v.VisitTypeAliasDeclaration(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveTypeAliasDeclaration(t)
+	case javascript.TypeAnnotation:
+		return v.Handle(&t)
+	case *javascript.TypeAnnotation:
+		if v.VisitTypeAnnotation(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveTypeAnnotation(t)
+	case javascript.TypeArguments:
+		return v.Handle(&t)
+	case *javascript.TypeArguments:
+		if v.VisitTypeArguments(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveTypeArguments(t)
+	case javascript.TypeExpression:
+		return v.Handle(&t)
+	case *javascript.TypeExpression:
+		if v.VisitTypeExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveTypeExpression(t)
+	case javascript.TypeMember:
+		return v.Handle(&t)
+	case *javascript.TypeMember:
+		if v.VisitTypeMember(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveTypeMember(t)
+	case javascript.TypeOperator:
+		return v.Handle(&t)
+	case *javascript.TypeOperator:
+		if v.VisitTypeOperator(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveTypeOperator(t)
+	case javascript.TypeParameter:
+		return v.Handle(&t)
+	case *javascript.TypeParameter:
+		if v.VisitTypeParameter(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveTypeParameter(t)
+	case javascript.TypeParameters:
+		return v.Handle(&t)
+	case *javascript.TypeParameters:
+		if v.VisitTypeParameters(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveTypeParameters(t)
+	case javascript.TypeQuery:
+		return v.Handle(&t)
+	case *javascript.TypeQuery:
+		if v.VisitTypeQuery(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveTypeQuery(t)
+	case javascript.TypeReference:
+		return v.Handle(&t)
+	case *javascript.TypeReference:
+		if v.VisitTypeReference(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveTypeReference(t)
+	case javascript.UnaryExpression:
+		return v.Handle(&t)
+	case *javascript.UnaryExpression:
+		if v.VisitUnaryExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveUnaryExpression(t)
+	case javascript.UnionType:
+		return v.Handle(&t)
+	case *javascript.UnionType:
+		if v.VisitUnionType(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveUnionType(t)
+	case javascript.UpdateExpression:
+		return v.Handle(&t)
+	case *javascript.UpdateExpression:
+		if v.VisitUpdateExpression(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveUpdateExpression(t)
+	case javascript.VariableStatement:
+		return v.Handle(&t)
+	case *javascript.VariableStatement:
+		if v.VisitVariableStatement(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveVariableStatement(t)
+	case javascript.WithStatement:
+		return v.Handle(&t)
+	case *javascript.WithStatement:
+		if v.VisitWithStatement(t) {
+			Walk(t, v)
+		}
+
+		v.LeaveWithStatement(t)
+	}
+
+	return nil
+}
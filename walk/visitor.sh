@@ -0,0 +1,89 @@
+#!/bin/bash
+
+cd "$(dirname "$0")";
+
+types() {
+	for file in ast_class.go ast_conditional.go ast_expression.go ast_function.go ast.go ast_module.go ast_statement.go ast_typescript.go jsx.go; do
+		grep "type [A-Z].* struct {" "../$file" | cut -d' ' -f2;
+	done | sort | while read type; do
+		if grep -q "case \*javascript\.$type:" walk.go; then
+			echo "$type";
+		fi;
+	done;
+}
+
+(
+	cat <<HEREDOC
+package walk
+
+// File automatically generated with visitor.sh.
+
+import "vimagination.zapto.org/javascript"
+
+// Visitor is used to process JavaScript types by their concrete type.
+//
+// For each type, the Visit method is called before the children of that type
+// are visited, and returns whether those children should be visited. The Leave
+// method is called once the children have been visited, or skipped.
+type Visitor interface {
+HEREDOC
+
+	while read type; do
+		echo "	Visit$type(*javascript.$type) bool";
+		echo "	Leave$type(*javascript.$type)";
+	done < <(types);
+
+	cat <<HEREDOC
+}
+
+// BaseVisitor implements the Visitor interface with methods that do nothing,
+// allowing all children to be visited.
+//
+// It can be embedded in a type that only needs to implement some of the
+// Visitor methods.
+type BaseVisitor struct{}
+HEREDOC
+
+	while read type; do
+		echo -e "\n// Visit$type implements the Visitor interface.";
+		echo "func (BaseVisitor) Visit$type(*javascript.$type) bool {";
+		echo "	return true";
+		echo "}";
+		echo -e "\n// Leave$type implements the Visitor interface.";
+		echo "func (BaseVisitor) Leave$type(*javascript.$type) {}";
+	done < <(types);
+
+	cat <<HEREDOC
+
+// Visit calls the Visit and Leave methods of the Visitor for the given
+// JavaScript type, and for each non-nil, non-Token type beneath it.
+func Visit(t javascript.Type, v Visitor) {
+	visitor{v}.Handle(t)
+}
+
+type visitor struct {
+	Visitor
+}
+
+func (v visitor) Handle(t javascript.Type) error {
+	switch t := t.(type) {
+HEREDOC
+
+	while read type; do
+		echo "	case javascript.$type:";
+		echo "		return v.Handle(&t)";
+		echo "	case *javascript.$type:";
+		echo "		if v.Visit$type(t) {";
+		echo "			Walk(t, v)";
+		echo "		}";
+		echo;
+		echo "		v.Leave$type(t)";
+	done < <(types);
+
+	cat <<HEREDOC
+	}
+
+	return nil
+}
+HEREDOC
+) > "visitor.go";
@@ -31,6 +31,14 @@ func Walk(t javascript.Type, h Handler) error {
 		return walkFieldDefinition(&t, h)
 	case *javascript.FieldDefinition:
 		return walkFieldDefinition(t, h)
+	case javascript.Decorator:
+		return walkDecorator(&t, h)
+	case *javascript.Decorator:
+		return walkDecorator(t, h)
+	case javascript.DecoratorMemberExpression:
+		return walkDecoratorMemberExpression(&t, h)
+	case *javascript.DecoratorMemberExpression:
+		return walkDecoratorMemberExpression(t, h)
 	case javascript.ClassElementName:
 		return walkClassElementName(&t, h)
 	case *javascript.ClassElementName:
@@ -347,18 +355,168 @@ func Walk(t javascript.Type, h Handler) error {
 		return walkJSXChild(&t, h)
 	case *javascript.JSXChild:
 		return walkJSXChild(t, h)
+	case javascript.TypeAliasDeclaration:
+		return walkTypeAliasDeclaration(&t, h)
+	case *javascript.TypeAliasDeclaration:
+		return walkTypeAliasDeclaration(t, h)
+	case javascript.InterfaceDeclaration:
+		return walkInterfaceDeclaration(&t, h)
+	case *javascript.InterfaceDeclaration:
+		return walkInterfaceDeclaration(t, h)
+	case javascript.EnumDeclaration:
+		return walkEnumDeclaration(&t, h)
+	case *javascript.EnumDeclaration:
+		return walkEnumDeclaration(t, h)
+	case javascript.EnumMember:
+		return walkEnumMember(&t, h)
+	case *javascript.EnumMember:
+		return walkEnumMember(t, h)
+	case javascript.NamespaceDeclaration:
+		return walkNamespaceDeclaration(&t, h)
+	case *javascript.NamespaceDeclaration:
+		return walkNamespaceDeclaration(t, h)
+	case javascript.TypeParameters:
+		return walkTypeParameters(&t, h)
+	case *javascript.TypeParameters:
+		return walkTypeParameters(t, h)
+	case javascript.TypeParameter:
+		return walkTypeParameter(&t, h)
+	case *javascript.TypeParameter:
+		return walkTypeParameter(t, h)
+	case javascript.TypeArguments:
+		return walkTypeArguments(&t, h)
+	case *javascript.TypeArguments:
+		return walkTypeArguments(t, h)
+	case javascript.TypeAnnotation:
+		return walkTypeAnnotation(&t, h)
+	case *javascript.TypeAnnotation:
+		return walkTypeAnnotation(t, h)
+	case javascript.TypeExpression:
+		return walkTypeExpression(&t, h)
+	case *javascript.TypeExpression:
+		return walkTypeExpression(t, h)
+	case javascript.FunctionTypeExpression:
+		return walkFunctionTypeExpression(&t, h)
+	case *javascript.FunctionTypeExpression:
+		return walkFunctionTypeExpression(t, h)
+	case javascript.UnionType:
+		return walkUnionType(&t, h)
+	case *javascript.UnionType:
+		return walkUnionType(t, h)
+	case javascript.IntersectionType:
+		return walkIntersectionType(&t, h)
+	case *javascript.IntersectionType:
+		return walkIntersectionType(t, h)
+	case javascript.TypeOperator:
+		return walkTypeOperator(&t, h)
+	case *javascript.TypeOperator:
+		return walkTypeOperator(t, h)
+	case javascript.PostfixType:
+		return walkPostfixType(&t, h)
+	case *javascript.PostfixType:
+		return walkPostfixType(t, h)
+	case javascript.PrimaryType:
+		return walkPrimaryType(&t, h)
+	case *javascript.PrimaryType:
+		return walkPrimaryType(t, h)
+	case javascript.LiteralType:
+		return walkLiteralType(&t, h)
+	case *javascript.LiteralType:
+		return walkLiteralType(t, h)
+	case javascript.TemplateLiteralType:
+		return walkTemplateLiteralType(&t, h)
+	case *javascript.TemplateLiteralType:
+		return walkTemplateLiteralType(t, h)
+	case javascript.ObjectType:
+		return walkObjectType(&t, h)
+	case *javascript.ObjectType:
+		return walkObjectType(t, h)
+	case javascript.TypeMember:
+		return walkTypeMember(&t, h)
+	case *javascript.TypeMember:
+		return walkTypeMember(t, h)
+	case javascript.PropertySignature:
+		return walkPropertySignature(&t, h)
+	case *javascript.PropertySignature:
+		return walkPropertySignature(t, h)
+	case javascript.MethodSignature:
+		return walkMethodSignature(&t, h)
+	case *javascript.MethodSignature:
+		return walkMethodSignature(t, h)
+	case javascript.CallSignature:
+		return walkCallSignature(&t, h)
+	case *javascript.CallSignature:
+		return walkCallSignature(t, h)
+	case javascript.ParameterList:
+		return walkParameterList(&t, h)
+	case *javascript.ParameterList:
+		return walkParameterList(t, h)
+	case javascript.Parameter:
+		return walkParameter(&t, h)
+	case *javascript.Parameter:
+		return walkParameter(t, h)
+	case javascript.IndexSignature:
+		return walkIndexSignature(&t, h)
+	case *javascript.IndexSignature:
+		return walkIndexSignature(t, h)
+	case javascript.MappedType:
+		return walkMappedType(&t, h)
+	case *javascript.MappedType:
+		return walkMappedType(t, h)
+	case javascript.TupleType:
+		return walkTupleType(&t, h)
+	case *javascript.TupleType:
+		return walkTupleType(t, h)
+	case javascript.TupleElement:
+		return walkTupleElement(&t, h)
+	case *javascript.TupleElement:
+		return walkTupleElement(t, h)
+	case javascript.ImportType:
+		return walkImportType(&t, h)
+	case *javascript.ImportType:
+		return walkImportType(t, h)
+	case javascript.TypeQuery:
+		return walkTypeQuery(&t, h)
+	case *javascript.TypeQuery:
+		return walkTypeQuery(t, h)
+	case javascript.TypeReference:
+		return walkTypeReference(&t, h)
+	case *javascript.TypeReference:
+		return walkTypeReference(t, h)
 	}
 
 	return nil
 }
 
 func walkClassDeclaration(t *javascript.ClassDeclaration, h Handler) error {
+	if err := walkDecorators(t.Decorators, h); err != nil {
+		return err
+	}
+
+	if t.TypeParameters != nil {
+		if err := h.Handle(t.TypeParameters); err != nil {
+			return err
+		}
+	}
+
 	if t.ClassHeritage != nil {
 		if err := h.Handle(t.ClassHeritage); err != nil {
 			return err
 		}
 	}
 
+	if t.TypeArguments != nil {
+		if err := h.Handle(t.TypeArguments); err != nil {
+			return err
+		}
+	}
+
+	for n := range t.Implements {
+		if err := h.Handle(&t.Implements[n]); err != nil {
+			return err
+		}
+	}
+
 	for n := range t.ClassBody {
 		if err := h.Handle(&t.ClassBody[n]); err != nil {
 			return err
@@ -369,12 +527,52 @@ func walkClassDeclaration(t *javascript.ClassDeclaration, h Handler) error {
 }
 
 func walkClassElement(t *javascript.ClassElement, h Handler) error {
+	if err := walkDecorators(t.Decorators, h); err != nil {
+		return err
+	}
+
 	if t.FieldDefinition != nil {
 		return h.Handle(t.FieldDefinition)
 	} else if t.MethodDefinition != nil {
 		return h.Handle(t.MethodDefinition)
 	} else if t.ClassStaticBlock != nil {
 		return h.Handle(t.ClassStaticBlock)
+	} else if t.TypeMember != nil {
+		return h.Handle(t.TypeMember)
+	}
+
+	return nil
+}
+
+func walkDecorators(ds []javascript.Decorator, h Handler) error {
+	for n := range ds {
+		if err := h.Handle(&ds[n]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func walkDecorator(t *javascript.Decorator, h Handler) error {
+	if t.DecoratorMemberExpression != nil {
+		if err := h.Handle(t.DecoratorMemberExpression); err != nil {
+			return err
+		}
+
+		if t.Arguments != nil {
+			return h.Handle(t.Arguments)
+		}
+	} else if t.DecoratorParenthesizedExpression != nil {
+		return h.Handle(t.DecoratorParenthesizedExpression)
+	}
+
+	return nil
+}
+
+func walkDecoratorMemberExpression(t *javascript.DecoratorMemberExpression, h Handler) error {
+	if t.DecoratorMemberExpression != nil {
+		return h.Handle(t.DecoratorMemberExpression)
 	}
 
 	return nil
@@ -383,7 +581,15 @@ func walkClassElement(t *javascript.ClassElement, h Handler) error {
 func walkFieldDefinition(t *javascript.FieldDefinition, h Handler) error {
 	if err := h.Handle(&t.ClassElementName); err != nil {
 		return err
-	} else if t.Initializer != nil {
+	}
+
+	if t.TypeAnnotation != nil {
+		if err := h.Handle(t.TypeAnnotation); err != nil {
+			return err
+		}
+	}
+
+	if t.Initializer != nil {
 		return h.Handle(t.Initializer)
 	}
 
@@ -392,7 +598,19 @@ func walkFieldDefinition(t *javascript.FieldDefinition, h Handler) error {
 
 func walkClassElementName(t *javascript.ClassElementName, h Handler) error {
 	if t.PropertyName != nil {
-		return h.Handle(t.PropertyName)
+		if err := h.Handle(t.PropertyName); err != nil {
+			return err
+		}
+	}
+
+	for n := range t.Overloads {
+		if err := h.Handle(&t.Overloads[n]); err != nil {
+			return err
+		}
+	}
+
+	if t.TypeParameters != nil {
+		return h.Handle(t.TypeParameters)
 	}
 
 	return nil
@@ -405,6 +623,12 @@ func walkMethodDefinition(t *javascript.MethodDefinition, h Handler) error {
 		return err
 	}
 
+	if t.ReturnType != nil {
+		if err := h.Handle(t.ReturnType); err != nil {
+			return err
+		}
+	}
+
 	return h.Handle(&t.FunctionBody)
 }
 
@@ -843,10 +1067,28 @@ func walkCallExpression(t *javascript.CallExpression, h Handler) error {
 }
 
 func walkFunctionDeclaration(t *javascript.FunctionDeclaration, h Handler) error {
+	for n := range t.Overloads {
+		if err := h.Handle(&t.Overloads[n]); err != nil {
+			return err
+		}
+	}
+
+	if t.TypeParameters != nil {
+		if err := h.Handle(t.TypeParameters); err != nil {
+			return err
+		}
+	}
+
 	if err := h.Handle(&t.FormalParameters); err != nil {
 		return err
 	}
 
+	if t.ReturnType != nil {
+		if err := h.Handle(t.ReturnType); err != nil {
+			return err
+		}
+	}
+
 	return h.Handle(&t.FunctionBody)
 }
 
@@ -858,15 +1100,27 @@ func walkFormalParameters(t *javascript.FormalParameters, h Handler) error {
 	}
 
 	if t.ArrayBindingPattern != nil {
-		return h.Handle(t.ArrayBindingPattern)
+		if err := h.Handle(t.ArrayBindingPattern); err != nil {
+			return err
+		}
 	} else if t.ObjectBindingPattern != nil {
-		return h.Handle(t.ObjectBindingPattern)
+		if err := h.Handle(t.ObjectBindingPattern); err != nil {
+			return err
+		}
+	}
+
+	if t.TypeAnnotation != nil {
+		return h.Handle(t.TypeAnnotation)
 	}
 
 	return nil
 }
 
 func walkBindingElement(t *javascript.BindingElement, h Handler) error {
+	if err := walkDecorators(t.Decorators, h); err != nil {
+		return err
+	}
+
 	if t.ArrayBindingPattern != nil {
 		if err := h.Handle(t.ArrayBindingPattern); err != nil {
 			return err
@@ -877,6 +1131,12 @@ func walkBindingElement(t *javascript.BindingElement, h Handler) error {
 		}
 	}
 
+	if t.TypeAnnotation != nil {
+		if err := h.Handle(t.TypeAnnotation); err != nil {
+			return err
+		}
+	}
+
 	if t.Initializer != nil {
 		return h.Handle(t.Initializer)
 	}
@@ -901,6 +1161,12 @@ func walkDeclaration(t *javascript.Declaration, h Handler) error {
 		return h.Handle(t.FunctionDeclaration)
 	} else if t.LexicalDeclaration != nil {
 		return h.Handle(t.LexicalDeclaration)
+	} else if t.TypeAliasDeclaration != nil {
+		return h.Handle(t.TypeAliasDeclaration)
+	} else if t.InterfaceDeclaration != nil {
+		return h.Handle(t.InterfaceDeclaration)
+	} else if t.NamespaceDeclaration != nil {
+		return h.Handle(t.NamespaceDeclaration)
 	}
 
 	return nil
@@ -927,6 +1193,12 @@ func walkLexicalBinding(t *javascript.LexicalBinding, h Handler) error {
 		}
 	}
 
+	if t.TypeAnnotation != nil {
+		if err := h.Handle(t.TypeAnnotation); err != nil {
+			return err
+		}
+	}
+
 	if t.Initializer != nil {
 		return h.Handle(t.Initializer)
 	}
@@ -1019,12 +1291,24 @@ func walkTemplateLiteral(t *javascript.TemplateLiteral, h Handler) error {
 }
 
 func walkArrowFunction(t *javascript.ArrowFunction, h Handler) error {
+	if t.TypeParameters != nil {
+		if err := h.Handle(t.TypeParameters); err != nil {
+			return err
+		}
+	}
+
 	if t.FormalParameters != nil {
 		if err := h.Handle(t.FormalParameters); err != nil {
 			return err
 		}
 	}
 
+	if t.ReturnType != nil {
+		if err := h.Handle(t.ReturnType); err != nil {
+			return err
+		}
+	}
+
 	if t.AssignmentExpression != nil {
 		return h.Handle(t.AssignmentExpression)
 	} else if t.FunctionBody != nil {
@@ -1101,6 +1385,10 @@ func walkImportSpecifier(_ *javascript.ImportSpecifier, _ Handler) error {
 }
 
 func walkExportDeclaration(t *javascript.ExportDeclaration, h Handler) error {
+	if err := walkDecorators(t.Decorators, h); err != nil {
+		return err
+	}
+
 	if t.ExportClause != nil {
 		if err := h.Handle(t.ExportClause); err != nil {
 			return err
@@ -1184,7 +1472,17 @@ func walkStatement(t *javascript.Statement, h Handler) error {
 	} else if t.VariableStatement != nil {
 		return h.Handle(t.VariableStatement)
 	} else if t.ExpressionStatement != nil {
-		return h.Handle(t.ExpressionStatement)
+		if err := h.Handle(t.ExpressionStatement); err != nil {
+			return err
+		}
+
+		if t.EnumDeclaration != nil {
+			return h.Handle(t.EnumDeclaration)
+		} else if t.NamespaceDeclaration != nil {
+			return h.Handle(t.NamespaceDeclaration)
+		}
+
+		return nil
 	} else if t.IfStatement != nil {
 		return h.Handle(t.IfStatement)
 	} else if t.IterationStatementDo != nil {
@@ -1387,6 +1685,12 @@ func walkVariableStatement(t *javascript.VariableStatement, h Handler) error {
 		}
 	}
 
+	if t.EnumDeclaration != nil {
+		return h.Handle(t.EnumDeclaration)
+	} else if t.NamespaceDeclaration != nil {
+		return h.Handle(t.NamespaceDeclaration)
+	}
+
 	return nil
 }
 
@@ -1447,3 +1751,393 @@ func walkJSXChild(t *javascript.JSXChild, h Handler) error {
 
 	return nil
 }
+
+func walkTypeAliasDeclaration(t *javascript.TypeAliasDeclaration, h Handler) error {
+	if t.TypeParameters != nil {
+		if err := h.Handle(t.TypeParameters); err != nil {
+			return err
+		}
+	}
+
+	return h.Handle(&t.Type)
+}
+
+func walkInterfaceDeclaration(t *javascript.InterfaceDeclaration, h Handler) error {
+	if t.TypeParameters != nil {
+		if err := h.Handle(t.TypeParameters); err != nil {
+			return err
+		}
+	}
+
+	for n := range t.Extends {
+		if err := h.Handle(&t.Extends[n]); err != nil {
+			return err
+		}
+	}
+
+	return h.Handle(&t.ObjectType)
+}
+
+func walkEnumDeclaration(t *javascript.EnumDeclaration, h Handler) error {
+	for n := range t.EnumMembers {
+		if err := h.Handle(&t.EnumMembers[n]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func walkEnumMember(t *javascript.EnumMember, h Handler) error {
+	if t.Initializer != nil {
+		if err := h.Handle(t.Initializer); err != nil {
+			return err
+		}
+	}
+
+	if t.Value != nil {
+		return h.Handle(t.Value)
+	}
+
+	return nil
+}
+
+func walkNamespaceDeclaration(t *javascript.NamespaceDeclaration, h Handler) error {
+	for n := range t.ModuleListItems {
+		if err := h.Handle(&t.ModuleListItems[n]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func walkTypeParameters(t *javascript.TypeParameters, h Handler) error {
+	for n := range t.TypeParameters {
+		if err := h.Handle(&t.TypeParameters[n]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func walkTypeParameter(t *javascript.TypeParameter, h Handler) error {
+	if t.Constraint != nil {
+		if err := h.Handle(t.Constraint); err != nil {
+			return err
+		}
+	}
+
+	if t.Default != nil {
+		return h.Handle(t.Default)
+	}
+
+	return nil
+}
+
+func walkTypeArguments(t *javascript.TypeArguments, h Handler) error {
+	for n := range t.TypeArguments {
+		if err := h.Handle(&t.TypeArguments[n]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func walkTypeAnnotation(t *javascript.TypeAnnotation, h Handler) error {
+	return h.Handle(&t.Type)
+}
+
+func walkTypeExpression(t *javascript.TypeExpression, h Handler) error {
+	if t.FunctionTypeExpression != nil {
+		return h.Handle(t.FunctionTypeExpression)
+	} else if t.UnionType != nil {
+		if err := h.Handle(t.UnionType); err != nil {
+			return err
+		}
+	}
+
+	if t.ExtendsType != nil {
+		if err := h.Handle(t.ExtendsType); err != nil {
+			return err
+		}
+	}
+
+	if t.TrueType != nil {
+		if err := h.Handle(t.TrueType); err != nil {
+			return err
+		}
+	}
+
+	if t.FalseType != nil {
+		return h.Handle(t.FalseType)
+	}
+
+	return nil
+}
+
+func walkFunctionTypeExpression(t *javascript.FunctionTypeExpression, h Handler) error {
+	if t.TypeParameters != nil {
+		if err := h.Handle(t.TypeParameters); err != nil {
+			return err
+		}
+	}
+
+	if err := h.Handle(&t.ParameterList); err != nil {
+		return err
+	}
+
+	return h.Handle(&t.ReturnType)
+}
+
+func walkUnionType(t *javascript.UnionType, h Handler) error {
+	for n := range t.IntersectionTypes {
+		if err := h.Handle(&t.IntersectionTypes[n]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func walkIntersectionType(t *javascript.IntersectionType, h Handler) error {
+	for n := range t.TypeOperators {
+		if err := h.Handle(&t.TypeOperators[n]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func walkTypeOperator(t *javascript.TypeOperator, h Handler) error {
+	if t.TypeOperator != nil {
+		return h.Handle(t.TypeOperator)
+	} else if t.PostfixType != nil {
+		return h.Handle(t.PostfixType)
+	}
+
+	return nil
+}
+
+func walkPostfixType(t *javascript.PostfixType, h Handler) error {
+	if t.PrimaryType != nil {
+		return h.Handle(t.PrimaryType)
+	} else if t.PostfixType != nil {
+		if err := h.Handle(t.PostfixType); err != nil {
+			return err
+		}
+	}
+
+	if t.IndexType != nil {
+		return h.Handle(t.IndexType)
+	}
+
+	return nil
+}
+
+func walkPrimaryType(t *javascript.PrimaryType, h Handler) error {
+	if t.LiteralType != nil {
+		return h.Handle(t.LiteralType)
+	} else if t.TemplateLiteralType != nil {
+		return h.Handle(t.TemplateLiteralType)
+	} else if t.ParenthesizedType != nil {
+		return h.Handle(t.ParenthesizedType)
+	} else if t.ObjectType != nil {
+		return h.Handle(t.ObjectType)
+	} else if t.MappedType != nil {
+		return h.Handle(t.MappedType)
+	} else if t.TupleType != nil {
+		return h.Handle(t.TupleType)
+	} else if t.ImportType != nil {
+		return h.Handle(t.ImportType)
+	} else if t.TypeQuery != nil {
+		return h.Handle(t.TypeQuery)
+	} else if t.TypeReference != nil {
+		return h.Handle(t.TypeReference)
+	}
+
+	return nil
+}
+
+func walkLiteralType(_ *javascript.LiteralType, _ Handler) error {
+	return nil
+}
+
+func walkTemplateLiteralType(t *javascript.TemplateLiteralType, h Handler) error {
+	for n := range t.Types {
+		if err := h.Handle(&t.Types[n]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func walkObjectType(t *javascript.ObjectType, h Handler) error {
+	for n := range t.TypeMembers {
+		if err := h.Handle(&t.TypeMembers[n]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func walkTypeMember(t *javascript.TypeMember, h Handler) error {
+	if t.CallSignature != nil {
+		return h.Handle(t.CallSignature)
+	} else if t.ConstructSignature != nil {
+		return h.Handle(t.ConstructSignature)
+	} else if t.PropertySignature != nil {
+		return h.Handle(t.PropertySignature)
+	} else if t.MethodSignature != nil {
+		return h.Handle(t.MethodSignature)
+	} else if t.IndexSignature != nil {
+		return h.Handle(t.IndexSignature)
+	}
+
+	return nil
+}
+
+func walkPropertySignature(t *javascript.PropertySignature, h Handler) error {
+	if err := h.Handle(&t.PropertyName); err != nil {
+		return err
+	}
+
+	if t.TypeAnnotation != nil {
+		return h.Handle(t.TypeAnnotation)
+	}
+
+	return nil
+}
+
+func walkMethodSignature(t *javascript.MethodSignature, h Handler) error {
+	if err := h.Handle(&t.PropertyName); err != nil {
+		return err
+	}
+
+	return h.Handle(&t.CallSignature)
+}
+
+func walkCallSignature(t *javascript.CallSignature, h Handler) error {
+	if t.TypeParameters != nil {
+		if err := h.Handle(t.TypeParameters); err != nil {
+			return err
+		}
+	}
+
+	if err := h.Handle(&t.ParameterList); err != nil {
+		return err
+	}
+
+	if t.ReturnType != nil {
+		return h.Handle(t.ReturnType)
+	}
+
+	return nil
+}
+
+func walkParameterList(t *javascript.ParameterList, h Handler) error {
+	for n := range t.Parameters {
+		if err := h.Handle(&t.Parameters[n]); err != nil {
+			return err
+		}
+	}
+
+	if t.RestParameter != nil {
+		return h.Handle(t.RestParameter)
+	}
+
+	return nil
+}
+
+func walkParameter(t *javascript.Parameter, h Handler) error {
+	if t.TypeAnnotation != nil {
+		if err := h.Handle(t.TypeAnnotation); err != nil {
+			return err
+		}
+	}
+
+	if t.Initializer != nil {
+		return h.Handle(t.Initializer)
+	}
+
+	return nil
+}
+
+func walkIndexSignature(t *javascript.IndexSignature, h Handler) error {
+	if err := h.Handle(&t.IndexType); err != nil {
+		return err
+	}
+
+	if t.TypeAnnotation != nil {
+		return h.Handle(t.TypeAnnotation)
+	}
+
+	return nil
+}
+
+func walkMappedType(t *javascript.MappedType, h Handler) error {
+	if err := h.Handle(&t.Constraint); err != nil {
+		return err
+	}
+
+	if t.NameType != nil {
+		if err := h.Handle(t.NameType); err != nil {
+			return err
+		}
+	}
+
+	if t.TypeAnnotation != nil {
+		return h.Handle(t.TypeAnnotation)
+	}
+
+	return nil
+}
+
+func walkTupleType(t *javascript.TupleType, h Handler) error {
+	for n := range t.TupleElements {
+		if err := h.Handle(&t.TupleElements[n]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func walkTupleElement(t *javascript.TupleElement, h Handler) error {
+	return h.Handle(&t.Type)
+}
+
+func walkImportType(t *javascript.ImportType, h Handler) error {
+	if err := h.Handle(&t.Type); err != nil {
+		return err
+	}
+
+	if t.TypeReference != nil {
+		return h.Handle(t.TypeReference)
+	} else if t.TypeArguments != nil {
+		return h.Handle(t.TypeArguments)
+	}
+
+	return nil
+}
+
+func walkTypeQuery(t *javascript.TypeQuery, h Handler) error {
+	if t.TypeArguments != nil {
+		return h.Handle(t.TypeArguments)
+	}
+
+	return nil
+}
+
+func walkTypeReference(t *javascript.TypeReference, h Handler) error {
+	if t.TypeArguments != nil {
+		return h.Handle(t.TypeArguments)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package walk
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -40,6 +41,10 @@ func (w *walker) Handle(t javascript.Type) error {
 }
 
 func TestWalk(t *testing.T) {
+	// these tests use syntax that is not valid in strict mode code, so are
+	// parsed as scripts
+	sloppy := map[int]bool{34: true, 219: true, 220: true, 221: true}
+
 	for n, test := range [...]struct {
 		Input string
 		End   func(m *javascript.Module) javascript.Type
@@ -1748,10 +1753,59 @@ func TestWalk(t *testing.T) {
 			},
 			[]string{"Module", "ModuleItem", "StatementListItem", "Declaration", "FunctionDeclaration", "Block", "StatementListItem", "Statement", "Expression", "AssignmentExpression", "AssignmentExpression"},
 		},
+		{ // 254
+			"@a.b(c) class d {}",
+			func(m *javascript.Module) javascript.Type {
+				return m.ModuleListItems[0].StatementListItem.Declaration.ClassDeclaration.Decorators[0].DecoratorMemberExpression.DecoratorMemberExpression
+			},
+			[]string{"Module", "ModuleItem", "StatementListItem", "Declaration", "ClassDeclaration", "Decorator", "DecoratorMemberExpression", "DecoratorMemberExpression"},
+		},
+		{ // 255
+			"@a.b(c) class d {}",
+			func(m *javascript.Module) javascript.Type {
+				return m.ModuleListItems[0].StatementListItem.Declaration.ClassDeclaration.Decorators[0].Arguments
+			},
+			[]string{"Module", "ModuleItem", "StatementListItem", "Declaration", "ClassDeclaration", "Decorator", "Arguments"},
+		},
+		{ // 256
+			"class a { @(b) c() {} }",
+			func(m *javascript.Module) javascript.Type {
+				return m.ModuleListItems[0].StatementListItem.Declaration.ClassDeclaration.ClassBody[0].Decorators[0].DecoratorParenthesizedExpression
+			},
+			[]string{"Module", "ModuleItem", "StatementListItem", "Declaration", "ClassDeclaration", "ClassElement", "Decorator", "ParenthesizedExpression"},
+		},
+		{ // 257
+			"function a(@b c) {}",
+			func(m *javascript.Module) javascript.Type {
+				return &m.ModuleListItems[0].StatementListItem.Declaration.FunctionDeclaration.FormalParameters.FormalParameterList[0].Decorators[0]
+			},
+			[]string{"Module", "ModuleItem", "StatementListItem", "Declaration", "FunctionDeclaration", "FormalParameters", "BindingElement", "Decorator"},
+		},
+		{ // 258
+			"@a export class b {}",
+			func(m *javascript.Module) javascript.Type {
+				return &m.ModuleListItems[0].ExportDeclaration.Decorators[0]
+			},
+			[]string{"Module", "ModuleItem", "ExportDeclaration", "Decorator"},
+		},
 	} {
 		tk := parser.NewStringTokeniser(test.Input)
 
-		m, err := javascript.ParseModule(javascript.AsJSX(&tk))
+		var (
+			m   *javascript.Module
+			err error
+		)
+
+		if sloppy[n+1] {
+			var s *javascript.Script
+
+			if s, err = javascript.ParseScript(javascript.AsJSX(&tk)); err == nil {
+				m = javascript.ScriptToModule(s)
+			}
+		} else {
+			m, err = javascript.ParseModule(javascript.AsJSX(&tk))
+		}
+
 		if err != nil {
 			t.Errorf("test %d: unexpected error parsing script: %s", n+1, err)
 		} else {
@@ -1774,6 +1828,148 @@ func TestWalk(t *testing.T) {
 	}
 }
 
+func TestWalkTypescript(t *testing.T) {
+	for n, test := range [...]struct {
+		Input string
+		End   func(m *javascript.Module) javascript.Type
+		Level []string
+	}{
+		{ // 1
+			"type A<B> = B[];",
+			func(m *javascript.Module) javascript.Type {
+				return &m.ModuleListItems[0].StatementListItem.Declaration.TypeAliasDeclaration.TypeParameters.TypeParameters[0]
+			},
+			[]string{"Module", "ModuleItem", "StatementListItem", "Declaration", "TypeAliasDeclaration", "TypeParameters", "TypeParameter"},
+		},
+		{ // 2
+			"type A<B> = B[];",
+			func(m *javascript.Module) javascript.Type {
+				return m.ModuleListItems[0].StatementListItem.Declaration.TypeAliasDeclaration.Type.UnionType.IntersectionTypes[0].TypeOperators[0].PostfixType.PostfixType.PrimaryType.TypeReference
+			},
+			[]string{"Module", "ModuleItem", "StatementListItem", "Declaration", "TypeAliasDeclaration", "TypeExpression", "UnionType", "IntersectionType", "TypeOperator", "PostfixType", "PostfixType", "PrimaryType", "TypeReference"},
+		},
+		{ // 3
+			"export interface A extends B { c(d: E): void }",
+			func(m *javascript.Module) javascript.Type {
+				return &m.ModuleListItems[0].ExportDeclaration.Declaration.InterfaceDeclaration.Extends[0]
+			},
+			[]string{"Module", "ModuleItem", "ExportDeclaration", "Declaration", "InterfaceDeclaration", "TypeReference"},
+		},
+		{ // 4
+			"export interface A extends B { c(d: E): void }",
+			func(m *javascript.Module) javascript.Type {
+				return m.ModuleListItems[0].ExportDeclaration.Declaration.InterfaceDeclaration.ObjectType.TypeMembers[0].MethodSignature.CallSignature.ParameterList.Parameters[0].TypeAnnotation
+			},
+			[]string{"Module", "ModuleItem", "ExportDeclaration", "Declaration", "InterfaceDeclaration", "ObjectType", "TypeMember", "MethodSignature", "CallSignature", "ParameterList", "Parameter", "TypeAnnotation"},
+		},
+		{ // 5
+			"let a: string = b;",
+			func(m *javascript.Module) javascript.Type {
+				return m.ModuleListItems[0].StatementListItem.Declaration.LexicalDeclaration.BindingList[0].TypeAnnotation
+			},
+			[]string{"Module", "ModuleItem", "StatementListItem", "Declaration", "LexicalDeclaration", "LexicalBinding", "TypeAnnotation"},
+		},
+		{ // 6
+			"function a<B>(c?: B): B {}",
+			func(m *javascript.Module) javascript.Type {
+				return m.ModuleListItems[0].StatementListItem.Declaration.FunctionDeclaration.TypeParameters
+			},
+			[]string{"Module", "ModuleItem", "StatementListItem", "Declaration", "FunctionDeclaration", "TypeParameters"},
+		},
+		{ // 7
+			"function a<B>(c?: B): B {}",
+			func(m *javascript.Module) javascript.Type {
+				return m.ModuleListItems[0].StatementListItem.Declaration.FunctionDeclaration.FormalParameters.FormalParameterList[0].TypeAnnotation
+			},
+			[]string{"Module", "ModuleItem", "StatementListItem", "Declaration", "FunctionDeclaration", "FormalParameters", "BindingElement", "TypeAnnotation"},
+		},
+		{ // 8
+			"function a<B>(c?: B): B {}",
+			func(m *javascript.Module) javascript.Type {
+				return m.ModuleListItems[0].StatementListItem.Declaration.FunctionDeclaration.ReturnType
+			},
+			[]string{"Module", "ModuleItem", "StatementListItem", "Declaration", "FunctionDeclaration", "TypeAnnotation"},
+		},
+		{ // 9
+			"class A<B> { c?: B; d<E>(): E {} }",
+			func(m *javascript.Module) javascript.Type {
+				return m.ModuleListItems[0].StatementListItem.Declaration.ClassDeclaration.ClassBody[0].FieldDefinition.TypeAnnotation
+			},
+			[]string{"Module", "ModuleItem", "StatementListItem", "Declaration", "ClassDeclaration", "ClassElement", "FieldDefinition", "TypeAnnotation"},
+		},
+		{ // 10
+			"class A<B> { c?: B; d<E>(): E {} }",
+			func(m *javascript.Module) javascript.Type {
+				return m.ModuleListItems[0].StatementListItem.Declaration.ClassDeclaration.ClassBody[1].MethodDefinition.ClassElementName.TypeParameters
+			},
+			[]string{"Module", "ModuleItem", "StatementListItem", "Declaration", "ClassDeclaration", "ClassElement", "MethodDefinition", "ClassElementName", "TypeParameters"},
+		},
+		{ // 11
+			"a = <B,>(c: B): B => c;",
+			func(m *javascript.Module) javascript.Type {
+				return m.ModuleListItems[0].StatementListItem.Statement.ExpressionStatement.Expressions[0].AssignmentExpression.ArrowFunction.ReturnType
+			},
+			[]string{"Module", "ModuleItem", "StatementListItem", "Statement", "Expression", "AssignmentExpression", "AssignmentExpression", "ArrowFunction", "TypeAnnotation"},
+		},
+		{ // 12
+			"abstract class A extends B<C> implements D { abstract e: F }",
+			func(m *javascript.Module) javascript.Type {
+				return &m.ModuleListItems[0].StatementListItem.Declaration.ClassDeclaration.Implements[0]
+			},
+			[]string{"Module", "ModuleItem", "StatementListItem", "Declaration", "ClassDeclaration", "TypeReference"},
+		},
+		{ // 13
+			"abstract class A extends B<C> implements D { abstract e: F }",
+			func(m *javascript.Module) javascript.Type {
+				return m.ModuleListItems[0].StatementListItem.Declaration.ClassDeclaration.ClassBody[0].TypeMember.PropertySignature
+			},
+			[]string{"Module", "ModuleItem", "StatementListItem", "Declaration", "ClassDeclaration", "ClassElement", "TypeMember", "PropertySignature"},
+		},
+		{ // 14
+			"function a(b: string): void;\nfunction a(b) {}",
+			func(m *javascript.Module) javascript.Type {
+				return &m.ModuleListItems[0].StatementListItem.Declaration.FunctionDeclaration.Overloads[0].ParameterList
+			},
+			[]string{"Module", "ModuleItem", "StatementListItem", "Declaration", "FunctionDeclaration", "CallSignature", "ParameterList"},
+		},
+		{ // 15
+			"enum A { B = 1 }",
+			func(m *javascript.Module) javascript.Type {
+				return &m.ModuleListItems[0].StatementListItem.Statement.VariableStatement.EnumDeclaration.EnumMembers[0]
+			},
+			[]string{"Module", "ModuleItem", "StatementListItem", "Statement", "VariableStatement", "EnumDeclaration", "EnumMember"},
+		},
+		{ // 16
+			"namespace A { export type B = string }",
+			func(m *javascript.Module) javascript.Type {
+				return &m.ModuleListItems[0].StatementListItem.Declaration.NamespaceDeclaration.ModuleListItems[0]
+			},
+			[]string{"Module", "ModuleItem", "StatementListItem", "Declaration", "NamespaceDeclaration", "ModuleItem"},
+		},
+	} {
+		tk := parser.NewStringTokeniser(test.Input)
+
+		m, err := javascript.ParseModule(javascript.AsTypescriptAST(&tk))
+		if err != nil {
+			t.Errorf("test %d: unexpected error parsing script: %s", n+1, err)
+		} else {
+			w := walker{end: test.End(m)}
+
+			if err := w.Handle(m); err == nil {
+				t.Errorf("test %d: expected to receive sentinel error, but didn't", n+1)
+			} else if len(w.level) != len(test.Level) {
+				t.Errorf("test %d: expected to have %d levels, got %d: %v", n+1, len(test.Level), len(w.level), w.level)
+			} else {
+				for m, l := range w.level {
+					if e := test.Level[len(test.Level)-m-1]; e != l {
+						t.Errorf("test %d.%d: expected to read level %s, got %s", n+1, m+1, e, l)
+					}
+				}
+			}
+		}
+	}
+}
+
 func TestWalkScript(t *testing.T) {
 	for n, test := range [...]struct {
 		Input string
@@ -1824,3 +2020,322 @@ func TestWalkScript(t *testing.T) {
 		}
 	}
 }
+
+type order []javascript.Type
+
+func (o *order) Handle(t javascript.Type) error {
+	*o = append(*o, t)
+
+	return Walk(t, o)
+}
+
+func TestApplyOrder(t *testing.T) {
+	for n, test := range [...]struct {
+		Input      string
+		Typescript bool
+	}{
+		{ // 1
+			"import a, {b as c} from './d';\nexport default class e extends f {\n\t#g = 1;\n\tstatic {\n\t\th();\n\t}\n\tget [i]() {\n\t\treturn `j${k}l`;\n\t}\n}\nlabel: for (const [m, {n = o, ...p}] of q) {\n\tif (r?.s ?? t) {\n\t\tcontinue label;\n\t} else {\n\t\tswitch (u) {\n\t\tcase 1:\n\t\t\tv = w => x + y;\n\t\t\tfunction* z() {\n\t\t\t\tyield* aa;\n\t\t\t}\n\t\t\tasync function ab() {\n\t\t\t\tawait ac;\n\t\t\t}\n\t\tdefault:\n\t\t\ttry {\n\t\t\t\tthrow new bb[cc](...dd);\n\t\t\t} catch ({ee}) {\n\t\t\t} finally {\n\t\t\t}\n\t\t}\n\t}\n}",
+			false,
+		},
+		{ // 2
+			"type A<B extends C = D> = {[E in keyof F]?: G[E]};\ninterface H extends I<J> {\n\tk(l: string): void;\n}\nfunction m<N>(o: N, ...p: Q[]): o is R {}\nenum S {\n\tT = 1\n}\nnamespace U {\n\texport const v: W = x as Y;\n}",
+			true,
+		},
+	} {
+		tk := parser.NewStringTokeniser(test.Input)
+
+		var (
+			m   *javascript.Module
+			err error
+		)
+
+		if test.Typescript {
+			m, err = javascript.ParseModule(javascript.AsTypescript(&tk))
+		} else {
+			m, err = javascript.ParseModule(&tk)
+		}
+
+		if err != nil {
+			t.Errorf("test %d: unexpected error: %s", n+1, err)
+
+			continue
+		}
+
+		walked := order{m}
+
+		Walk(m, &walked)
+
+		var applied order
+
+		Apply(m, func(c *Cursor) bool {
+			applied = append(applied, c.Node())
+
+			return true
+		}, nil)
+
+		if len(walked) != len(applied) {
+			t.Errorf("test %d: expecting %d nodes, got %d", n+1, len(walked), len(applied))
+		}
+
+		for i := range min(len(walked), len(applied)) {
+			if walked[i] != applied[i] {
+				t.Errorf("test %d.%d: expecting node %T, got %T", n+1, i+1, walked[i], applied[i])
+
+				break
+			}
+		}
+	}
+}
+
+func identifierStatement(name string) *javascript.ModuleItem {
+	tk := parser.NewStringTokeniser(name + ";")
+	m, _ := javascript.ParseModule(&tk)
+
+	return &m.ModuleListItems[0]
+}
+
+func isIdentifier(c *Cursor, name string) bool {
+	pe, ok := c.Node().(*javascript.PrimaryExpression)
+
+	return ok && pe.IdentifierReference != nil && pe.IdentifierReference.Data == name
+}
+
+func TestApply(t *testing.T) {
+	var (
+		path  []string
+		count int
+	)
+
+	for n, test := range [...]struct {
+		Input     string
+		Pre, Post ApplyFunc
+		Output    string
+	}{
+		{ // 1
+			"a;\nb;\nc;",
+			func(c *Cursor) bool {
+				if _, ok := c.Node().(*javascript.ModuleItem); ok && c.Index() == 1 && c.Name() == "ModuleListItems" && fmt.Sprintf("%s", c.Node()) == "b;" {
+					if _, ok := c.Parent().(*javascript.Module); ok {
+						c.Delete()
+					}
+				}
+
+				return true
+			},
+			nil,
+			"a;\n\nc;",
+		},
+		{ // 2
+			"a;\nb;\nc;",
+			func(c *Cursor) bool {
+				if _, ok := c.Node().(*javascript.ModuleItem); ok && c.Index() == 1 {
+					c.InsertBefore(identifierStatement("d"))
+					c.InsertAfter(identifierStatement("e"))
+				} else if isIdentifier(c, "d") {
+					c.Node().(*javascript.PrimaryExpression).IdentifierReference.Data = "f"
+				} else if isIdentifier(c, "e") {
+					c.Node().(*javascript.PrimaryExpression).IdentifierReference.Data = "g"
+				}
+
+				return true
+			},
+			nil,
+			"a;\n\nd;\n\nb;\n\ng;\n\nc;",
+		},
+		{ // 3
+			"a(b, c);",
+			func(c *Cursor) bool {
+				if isIdentifier(c, "b") {
+					c.Replace(javascript.PrimaryExpression{IdentifierReference: &javascript.Token{Token: parser.Token{Type: javascript.TokenIdentifier, Data: "d"}}})
+				} else if isIdentifier(c, "c") {
+					c.Replace(&javascript.PrimaryExpression{IdentifierReference: &javascript.Token{Token: parser.Token{Type: javascript.TokenIdentifier, Data: "e"}}})
+				}
+
+				return true
+			},
+			nil,
+			"a(d, e);",
+		},
+		{ // 4
+			"a(b, c);",
+			func(c *Cursor) bool {
+				if _, ok := c.Node().(*javascript.Argument); ok && c.Index() == 0 && fmt.Sprintf("%s", c.Node()) == "b" {
+					c.Delete()
+				}
+
+				return true
+			},
+			nil,
+			"a(c);",
+		},
+		{ // 5
+			"a(b, c);",
+			func(c *Cursor) bool {
+				if isIdentifier(c, "b") {
+					return false
+				}
+
+				path = append(path, c.Name())
+
+				return true
+			},
+			func(c *Cursor) bool {
+				if _, ok := c.Node().(*javascript.Argument); ok {
+					path = append(path, "Argument")
+				}
+
+				return true
+			},
+			"a(b, c);",
+		},
+		{ // 6
+			"a;\nb;\nc;",
+			func(c *Cursor) bool {
+				count++
+
+				return true
+			},
+			func(c *Cursor) bool {
+				return !isIdentifier(c, "b")
+			},
+			"a;\n\nb;\n\nc;",
+		},
+	} {
+		tk := parser.NewStringTokeniser(test.Input)
+
+		m, err := javascript.ParseModule(&tk)
+		if err != nil {
+			t.Errorf("test %d: unexpected error: %s", n+1, err)
+
+			continue
+		}
+
+		if res := Apply(m, test.Pre, test.Post); res != m {
+			t.Errorf("test %d: expecting root to be returned", n+1)
+		} else if output := fmt.Sprintf("%s", m); output != test.Output {
+			t.Errorf("test %d: expecting output %q, got %q", n+1, test.Output, output)
+		}
+	}
+
+	if expected := []string{"", "ModuleListItems", "StatementListItem", "Statement", "ExpressionStatement", "Expressions", "ConditionalExpression", "LogicalORExpression", "LogicalANDExpression", "BitwiseORExpression", "BitwiseXORExpression", "BitwiseANDExpression", "EqualityExpression", "RelationalExpression", "ShiftExpression", "AdditiveExpression", "MultiplicativeExpression", "ExponentiationExpression", "UnaryExpression", "UpdateExpression", "LeftHandSideExpression", "CallExpression", "MemberExpression", "PrimaryExpression", "Arguments", "ArgumentList", "AssignmentExpression", "ConditionalExpression", "LogicalORExpression", "LogicalANDExpression", "BitwiseORExpression", "BitwiseXORExpression", "BitwiseANDExpression", "EqualityExpression", "RelationalExpression", "ShiftExpression", "AdditiveExpression", "MultiplicativeExpression", "ExponentiationExpression", "UnaryExpression", "UpdateExpression", "LeftHandSideExpression", "NewExpression", "MemberExpression", "Argument", "ArgumentList", "AssignmentExpression", "ConditionalExpression", "LogicalORExpression", "LogicalANDExpression", "BitwiseORExpression", "BitwiseXORExpression", "BitwiseANDExpression", "EqualityExpression", "RelationalExpression", "ShiftExpression", "AdditiveExpression", "MultiplicativeExpression", "ExponentiationExpression", "UnaryExpression", "UpdateExpression", "LeftHandSideExpression", "NewExpression", "MemberExpression", "PrimaryExpression", "Argument"}; !reflect.DeepEqual(path, expected) {
+		t.Errorf("expecting path %q, got %q", expected, path)
+	}
+
+	if count != 47 {
+		t.Errorf("expecting 47 nodes to be visited before stopping, got %d", count)
+	}
+
+	root := &javascript.Module{}
+
+	if res := Apply(root, func(c *Cursor) bool {
+		if c.Parent() == nil {
+			c.Replace(&javascript.Script{})
+		}
+
+		return true
+	}, nil); reflect.TypeOf(res) != reflect.TypeFor[*javascript.Script]() {
+		t.Errorf("expecting root to be replaced with *javascript.Script, got %T", res)
+	}
+}
+
+type recorder struct {
+	BaseVisitor
+	calls []string
+}
+
+func (r *recorder) VisitFunctionDeclaration(f *javascript.FunctionDeclaration) bool {
+	r.calls = append(r.calls, "VisitFunctionDeclaration "+f.BindingIdentifier.Data)
+
+	return true
+}
+
+func (r *recorder) LeaveFunctionDeclaration(f *javascript.FunctionDeclaration) {
+	r.calls = append(r.calls, "LeaveFunctionDeclaration "+f.BindingIdentifier.Data)
+}
+
+func (r *recorder) VisitFormalParameters(*javascript.FormalParameters) bool {
+	r.calls = append(r.calls, "VisitFormalParameters")
+
+	return false
+}
+
+func (r *recorder) LeaveFormalParameters(*javascript.FormalParameters) {
+	r.calls = append(r.calls, "LeaveFormalParameters")
+}
+
+func (r *recorder) VisitPrimaryExpression(p *javascript.PrimaryExpression) bool {
+	if p.IdentifierReference != nil {
+		r.calls = append(r.calls, "VisitPrimaryExpression "+p.IdentifierReference.Data)
+	} else if p.Literal != nil {
+		r.calls = append(r.calls, "VisitPrimaryExpression "+p.Literal.Data)
+	}
+
+	return true
+}
+
+func (r *recorder) LeaveAdditiveExpression(*javascript.AdditiveExpression) {
+	r.calls = append(r.calls, "LeaveAdditiveExpression")
+}
+
+func TestVisit(t *testing.T) {
+	for n, test := range [...]struct {
+		Input  string
+		Output []string
+	}{
+		{ // 1
+			"a;",
+			[]string{
+				"VisitPrimaryExpression a",
+				"LeaveAdditiveExpression",
+			},
+		},
+		{ // 2
+			"function a(b) {\n\treturn b + 1;\n}",
+			[]string{
+				"VisitFunctionDeclaration a",
+				"VisitFormalParameters",
+				"LeaveFormalParameters",
+				"VisitPrimaryExpression b",
+				"LeaveAdditiveExpression",
+				"VisitPrimaryExpression 1",
+				"LeaveAdditiveExpression",
+				"LeaveFunctionDeclaration a",
+			},
+		},
+		{ // 3
+			"function a() {\n\tfunction b() {}\n\treturn c - d;\n}",
+			[]string{
+				"VisitFunctionDeclaration a",
+				"VisitFormalParameters",
+				"LeaveFormalParameters",
+				"VisitFunctionDeclaration b",
+				"VisitFormalParameters",
+				"LeaveFormalParameters",
+				"LeaveFunctionDeclaration b",
+				"VisitPrimaryExpression c",
+				"LeaveAdditiveExpression",
+				"VisitPrimaryExpression d",
+				"LeaveAdditiveExpression",
+				"LeaveFunctionDeclaration a",
+			},
+		},
+	} {
+		tk := parser.NewStringTokeniser(test.Input)
+
+		m, err := javascript.ParseModule(&tk)
+		if err != nil {
+			t.Errorf("test %d: unexpected error: %s", n+1, err)
+
+			continue
+		}
+
+		var r recorder
+
+		Visit(m, &r)
+
+		if !reflect.DeepEqual(r.calls, test.Output) {
+			t.Errorf("test %d: expecting calls %q, got %q", n+1, test.Output, r.calls)
+		}
+	}
+}